@@ -0,0 +1,2618 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: backend/activity/v1/activity.proto
+
+package v1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 活动内容，PC端和手机端分别设置编辑框内容或内容链接
+type ActivityContent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PcType        int32                  `protobuf:"varint,1,opt,name=pc_type,json=pcType,proto3" json:"pc_type" dc:"PC端内容类型 1=编辑框内容 2=内容链接"`             // PC端内容类型 1=编辑框内容 2=内容链接
+	PcContent     string                 `protobuf:"bytes,2,opt,name=pc_content,json=pcContent,proto3" json:"pc_content" dc:"PC端编辑框内容"`                   // PC端编辑框内容
+	PcLink        string                 `protobuf:"bytes,3,opt,name=pc_link,json=pcLink,proto3" json:"pc_link" dc:"PC端内容链接"`                             // PC端内容链接
+	MobileType    int32                  `protobuf:"varint,4,opt,name=mobile_type,json=mobileType,proto3" json:"mobile_type" dc:"手机端内容类型 1=编辑框内容 2=内容链接"` // 手机端内容类型 1=编辑框内容 2=内容链接
+	MobileContent string                 `protobuf:"bytes,5,opt,name=mobile_content,json=mobileContent,proto3" json:"mobile_content" dc:"手机端编辑框内容"`       // 手机端编辑框内容
+	MobileLink    string                 `protobuf:"bytes,6,opt,name=mobile_link,json=mobileLink,proto3" json:"mobile_link" dc:"手机端内容链接"`                 // 手机端内容链接
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at" dc:"更新时间"`                       // 更新时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityContent) Reset() {
+	*x = ActivityContent{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityContent) ProtoMessage() {}
+
+func (x *ActivityContent) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityContent.ProtoReflect.Descriptor instead.
+func (*ActivityContent) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ActivityContent) GetPcType() int32 {
+	if x != nil {
+		return x.PcType
+	}
+	return 0
+}
+
+func (x *ActivityContent) GetPcContent() string {
+	if x != nil {
+		return x.PcContent
+	}
+	return ""
+}
+
+func (x *ActivityContent) GetPcLink() string {
+	if x != nil {
+		return x.PcLink
+	}
+	return ""
+}
+
+func (x *ActivityContent) GetMobileType() int32 {
+	if x != nil {
+		return x.MobileType
+	}
+	return 0
+}
+
+func (x *ActivityContent) GetMobileContent() string {
+	if x != nil {
+		return x.MobileContent
+	}
+	return ""
+}
+
+func (x *ActivityContent) GetMobileLink() string {
+	if x != nil {
+		return x.MobileLink
+	}
+	return ""
+}
+
+func (x *ActivityContent) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// 活动模块
+type ActivityModuleInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"记录ID"`                                                                // 记录ID
+	ModuleType    int32                  `protobuf:"varint,2,opt,name=module_type,json=moduleType,proto3" json:"module_type" dc:"模块类型 1=充值 2=大转盘 3=开宝箱 4=砸金蛋 5=抢红包"` // 模块类型 1=充值 2=大转盘 3=开宝箱 4=砸金蛋 5=抢红包
+	ModuleId      int32                  `protobuf:"varint,3,opt,name=module_id,json=moduleId,proto3" json:"module_id" dc:"模块ID"`                                    // 模块ID
+	ModuleName    string                 `protobuf:"bytes,4,opt,name=module_name,json=moduleName,proto3" json:"module_name" dc:"模块名称"`                               // 模块名称
+	StartTime     string                 `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间"`                                  // 开始时间
+	EndTime       string                 `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间"`                                        // 结束时间
+	Status        int32                  `protobuf:"varint,7,opt,name=status,proto3" json:"status" dc:"状态 0=关闭 1=开启"`                                                // 状态 0=关闭 1=开启
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityModuleInfo) Reset() {
+	*x = ActivityModuleInfo{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityModuleInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityModuleInfo) ProtoMessage() {}
+
+func (x *ActivityModuleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityModuleInfo.ProtoReflect.Descriptor instead.
+func (*ActivityModuleInfo) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ActivityModuleInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ActivityModuleInfo) GetModuleType() int32 {
+	if x != nil {
+		return x.ModuleType
+	}
+	return 0
+}
+
+func (x *ActivityModuleInfo) GetModuleId() int32 {
+	if x != nil {
+		return x.ModuleId
+	}
+	return 0
+}
+
+func (x *ActivityModuleInfo) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
+	}
+	return ""
+}
+
+func (x *ActivityModuleInfo) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *ActivityModuleInfo) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *ActivityModuleInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 活动信息
+type ActivityInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"活动ID"`                                                        // 活动ID
+	ActivityName    string                 `protobuf:"bytes,2,opt,name=activity_name,json=activityName,proto3" json:"activity_name" dc:"活动名称"`                 // 活动名称
+	ActivityType    int32                  `protobuf:"varint,3,opt,name=activity_type,json=activityType,proto3" json:"activity_type" dc:"活动类型 1=自定义活动 2=充值活动"` // 活动类型 1=自定义活动 2=充值活动
+	Describe        string                 `protobuf:"bytes,4,opt,name=describe,proto3" json:"describe" dc:"活动描述"`                                             // 活动描述
+	Intro           string                 `protobuf:"bytes,5,opt,name=intro,proto3" json:"intro" dc:"活动简介"`                                                   // 活动简介
+	PcCover         string                 `protobuf:"bytes,6,opt,name=pc_cover,json=pcCover,proto3" json:"pc_cover" dc:"PC端封面"`                               // PC端封面
+	WapCover        string                 `protobuf:"bytes,7,opt,name=wap_cover,json=wapCover,proto3" json:"wap_cover" dc:"WAP端封面"`                           // WAP端封面
+	MobileCover     string                 `protobuf:"bytes,8,opt,name=mobile_cover,json=mobileCover,proto3" json:"mobile_cover" dc:"手机端封面"`                   // 手机端封面
+	StartTime       string                 `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间"`                          // 开始时间
+	EndTime         string                 `protobuf:"bytes,10,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间"`                               // 结束时间
+	Status          int32                  `protobuf:"varint,11,opt,name=status,proto3" json:"status" dc:"状态 0=关闭 1=开启"`                                       // 状态 0=关闭 1=开启
+	IsRelateGame    int32                  `protobuf:"varint,12,opt,name=is_relate_game,json=isRelateGame,proto3" json:"is_relate_game" dc:"是否关联游戏 0=否 1=是"`   // 是否关联游戏 0=否 1=是
+	RelateGameIds   []int32                `protobuf:"varint,13,rep,packed,name=relate_game_ids,json=relateGameIds,proto3" json:"relate_game_ids" dc:"关联游戏ID"` // 关联游戏ID
+	TemplateId      int32                  `protobuf:"varint,14,opt,name=template_id,json=templateId,proto3" json:"template_id" dc:"活动模板ID"`                   // 活动模板ID
+	ActivityDetails string                 `protobuf:"bytes,15,opt,name=activity_details,json=activityDetails,proto3" json:"activity_details" dc:"活动详情"`       // 活动详情
+	IsPublish       int32                  `protobuf:"varint,16,opt,name=is_publish,json=isPublish,proto3" json:"is_publish" dc:"发布状态 0=未发布 1=已发布"`            // 发布状态 0=未发布 1=已发布
+	Sort            int32                  `protobuf:"varint,17,opt,name=sort,proto3" json:"sort" dc:"排序，值越小越靠前"`                                              // 排序，值越小越靠前
+	Tags            []*ActivityTagInfo     `protobuf:"bytes,18,rep,name=tags,proto3" json:"tags" dc:"活动标签"`                                                    // 活动标签
+	Modules         []*ActivityModuleInfo  `protobuf:"bytes,19,rep,name=modules,proto3" json:"modules" dc:"活动模块，仅详情返回"`                                        // 活动模块，仅详情返回
+	Content         *ActivityContent       `protobuf:"bytes,20,opt,name=content,proto3" json:"content" dc:"活动内容，仅详情返回"`                                        // 活动内容，仅详情返回
+	CreatedAt       string                 `protobuf:"bytes,21,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间"`                         // 创建时间
+	UpdatedAt       string                 `protobuf:"bytes,22,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at" dc:"更新时间"`                         // 更新时间
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ActivityInfo) Reset() {
+	*x = ActivityInfo{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityInfo) ProtoMessage() {}
+
+func (x *ActivityInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityInfo.ProtoReflect.Descriptor instead.
+func (*ActivityInfo) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ActivityInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ActivityInfo) GetActivityName() string {
+	if x != nil {
+		return x.ActivityName
+	}
+	return ""
+}
+
+func (x *ActivityInfo) GetActivityType() int32 {
+	if x != nil {
+		return x.ActivityType
+	}
+	return 0
+}
+
+func (x *ActivityInfo) GetDescribe() string {
+	if x != nil {
+		return x.Describe
+	}
+	return ""
+}
+
+func (x *ActivityInfo) GetIntro() string {
+	if x != nil {
+		return x.Intro
+	}
+	return ""
+}
+
+func (x *ActivityInfo) GetPcCover() string {
+	if x != nil {
+		return x.PcCover
+	}
+	return ""
+}
+
+func (x *ActivityInfo) GetWapCover() string {
+	if x != nil {
+		return x.WapCover
+	}
+	return ""
+}
+
+func (x *ActivityInfo) GetMobileCover() string {
+	if x != nil {
+		return x.MobileCover
+	}
+	return ""
+}
+
+func (x *ActivityInfo) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *ActivityInfo) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *ActivityInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ActivityInfo) GetIsRelateGame() int32 {
+	if x != nil {
+		return x.IsRelateGame
+	}
+	return 0
+}
+
+func (x *ActivityInfo) GetRelateGameIds() []int32 {
+	if x != nil {
+		return x.RelateGameIds
+	}
+	return nil
+}
+
+func (x *ActivityInfo) GetTemplateId() int32 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *ActivityInfo) GetActivityDetails() string {
+	if x != nil {
+		return x.ActivityDetails
+	}
+	return ""
+}
+
+func (x *ActivityInfo) GetIsPublish() int32 {
+	if x != nil {
+		return x.IsPublish
+	}
+	return 0
+}
+
+func (x *ActivityInfo) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *ActivityInfo) GetTags() []*ActivityTagInfo {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ActivityInfo) GetModules() []*ActivityModuleInfo {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+func (x *ActivityInfo) GetContent() *ActivityContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ActivityInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ActivityInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// 获取活动列表请求
+type GetActivitiesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page" dc:"页码"`                                                           // 页码
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size" dc:"每页数量"`                                                         // 每页数量
+	ActivityName  string                 `protobuf:"bytes,3,opt,name=activity_name,json=activityName,proto3" json:"activity_name" dc:"活动名称 (可选，模糊搜索)"`            // 活动名称 (可选，模糊搜索)
+	ActivityType  int32                  `protobuf:"varint,4,opt,name=activity_type,json=activityType,proto3" json:"activity_type" dc:"活动类型 0=全部 1=自定义活动 2=充值活动"` // 活动类型 0=全部 1=自定义活动 2=充值活动
+	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status" dc:"状态 -1=全部 0=关闭 1=开启"`                                       // 状态 -1=全部 0=关闭 1=开启
+	IsPublish     int32                  `protobuf:"varint,6,opt,name=is_publish,json=isPublish,proto3" json:"is_publish" dc:"发布状态 -1=全部 0=未发布 1=已发布"`            // 发布状态 -1=全部 0=未发布 1=已发布
+	TagId         int32                  `protobuf:"varint,7,opt,name=tag_id,json=tagId,proto3" json:"tag_id" dc:"标签ID (可选)"`                                     // 标签ID (可选)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActivitiesReq) Reset() {
+	*x = GetActivitiesReq{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActivitiesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivitiesReq) ProtoMessage() {}
+
+func (x *GetActivitiesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivitiesReq.ProtoReflect.Descriptor instead.
+func (*GetActivitiesReq) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetActivitiesReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetActivitiesReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetActivitiesReq) GetActivityName() string {
+	if x != nil {
+		return x.ActivityName
+	}
+	return ""
+}
+
+func (x *GetActivitiesReq) GetActivityType() int32 {
+	if x != nil {
+		return x.ActivityType
+	}
+	return 0
+}
+
+func (x *GetActivitiesReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetActivitiesReq) GetIsPublish() int32 {
+	if x != nil {
+		return x.IsPublish
+	}
+	return 0
+}
+
+func (x *GetActivitiesReq) GetTagId() int32 {
+	if x != nil {
+		return x.TagId
+	}
+	return 0
+}
+
+// 获取活动列表响应
+type GetActivitiesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*ActivityInfo        `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"活动列表"`   // 活动列表
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count" dc:"总数量"` // 总数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActivitiesRes) Reset() {
+	*x = GetActivitiesRes{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActivitiesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivitiesRes) ProtoMessage() {}
+
+func (x *GetActivitiesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivitiesRes.ProtoReflect.Descriptor instead.
+func (*GetActivitiesRes) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetActivitiesRes) GetList() []*ActivityInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *GetActivitiesRes) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 获取活动详情请求
+type GetActivityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"活动ID"` // 活动ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActivityReq) Reset() {
+	*x = GetActivityReq{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActivityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivityReq) ProtoMessage() {}
+
+func (x *GetActivityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivityReq.ProtoReflect.Descriptor instead.
+func (*GetActivityReq) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetActivityReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 获取活动详情响应
+type GetActivityRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Activity      *ActivityInfo          `protobuf:"bytes,1,opt,name=activity,proto3" json:"activity" dc:"活动详情，不存在时为空"` // 活动详情，不存在时为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActivityRes) Reset() {
+	*x = GetActivityRes{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActivityRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivityRes) ProtoMessage() {}
+
+func (x *GetActivityRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivityRes.ProtoReflect.Descriptor instead.
+func (*GetActivityRes) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetActivityRes) GetActivity() *ActivityInfo {
+	if x != nil {
+		return x.Activity
+	}
+	return nil
+}
+
+// 创建活动请求
+type CreateActivityReq struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ActivityName    string                 `protobuf:"bytes,1,opt,name=activity_name,json=activityName,proto3" json:"activity_name" dc:"活动名称"`                 // 活动名称
+	ActivityType    int32                  `protobuf:"varint,2,opt,name=activity_type,json=activityType,proto3" json:"activity_type" dc:"活动类型 1=自定义活动 2=充值活动"` // 活动类型 1=自定义活动 2=充值活动
+	Describe        string                 `protobuf:"bytes,3,opt,name=describe,proto3" json:"describe" dc:"活动描述"`                                             // 活动描述
+	Intro           string                 `protobuf:"bytes,4,opt,name=intro,proto3" json:"intro" dc:"活动简介"`                                                   // 活动简介
+	PcCover         string                 `protobuf:"bytes,5,opt,name=pc_cover,json=pcCover,proto3" json:"pc_cover" dc:"PC端封面，需通过上传接口上传"`                     // PC端封面，需通过上传接口上传
+	WapCover        string                 `protobuf:"bytes,6,opt,name=wap_cover,json=wapCover,proto3" json:"wap_cover" dc:"WAP端封面，需通过上传接口上传"`                 // WAP端封面，需通过上传接口上传
+	MobileCover     string                 `protobuf:"bytes,7,opt,name=mobile_cover,json=mobileCover,proto3" json:"mobile_cover" dc:"手机端封面，需通过上传接口上传"`         // 手机端封面，需通过上传接口上传
+	StartTime       string                 `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间"`                          // 开始时间
+	EndTime         string                 `protobuf:"bytes,9,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间"`                                // 结束时间
+	Status          int32                  `protobuf:"varint,10,opt,name=status,proto3" json:"status" dc:"状态 0=关闭 1=开启"`                                       // 状态 0=关闭 1=开启
+	IsRelateGame    int32                  `protobuf:"varint,11,opt,name=is_relate_game,json=isRelateGame,proto3" json:"is_relate_game" dc:"是否关联游戏 0=否 1=是"`   // 是否关联游戏 0=否 1=是
+	RelateGameIds   []int32                `protobuf:"varint,12,rep,packed,name=relate_game_ids,json=relateGameIds,proto3" json:"relate_game_ids" dc:"关联游戏ID"` // 关联游戏ID
+	TemplateId      int32                  `protobuf:"varint,13,opt,name=template_id,json=templateId,proto3" json:"template_id" dc:"活动模板ID"`                   // 活动模板ID
+	ActivityDetails string                 `protobuf:"bytes,14,opt,name=activity_details,json=activityDetails,proto3" json:"activity_details" dc:"活动详情"`       // 活动详情
+	Sort            int32                  `protobuf:"varint,15,opt,name=sort,proto3" json:"sort" dc:"排序"`                                                     // 排序
+	TagIds          []int32                `protobuf:"varint,16,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids" dc:"标签ID"`                          // 标签ID
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateActivityReq) Reset() {
+	*x = CreateActivityReq{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateActivityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateActivityReq) ProtoMessage() {}
+
+func (x *CreateActivityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateActivityReq.ProtoReflect.Descriptor instead.
+func (*CreateActivityReq) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateActivityReq) GetActivityName() string {
+	if x != nil {
+		return x.ActivityName
+	}
+	return ""
+}
+
+func (x *CreateActivityReq) GetActivityType() int32 {
+	if x != nil {
+		return x.ActivityType
+	}
+	return 0
+}
+
+func (x *CreateActivityReq) GetDescribe() string {
+	if x != nil {
+		return x.Describe
+	}
+	return ""
+}
+
+func (x *CreateActivityReq) GetIntro() string {
+	if x != nil {
+		return x.Intro
+	}
+	return ""
+}
+
+func (x *CreateActivityReq) GetPcCover() string {
+	if x != nil {
+		return x.PcCover
+	}
+	return ""
+}
+
+func (x *CreateActivityReq) GetWapCover() string {
+	if x != nil {
+		return x.WapCover
+	}
+	return ""
+}
+
+func (x *CreateActivityReq) GetMobileCover() string {
+	if x != nil {
+		return x.MobileCover
+	}
+	return ""
+}
+
+func (x *CreateActivityReq) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *CreateActivityReq) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *CreateActivityReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *CreateActivityReq) GetIsRelateGame() int32 {
+	if x != nil {
+		return x.IsRelateGame
+	}
+	return 0
+}
+
+func (x *CreateActivityReq) GetRelateGameIds() []int32 {
+	if x != nil {
+		return x.RelateGameIds
+	}
+	return nil
+}
+
+func (x *CreateActivityReq) GetTemplateId() int32 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *CreateActivityReq) GetActivityDetails() string {
+	if x != nil {
+		return x.ActivityDetails
+	}
+	return ""
+}
+
+func (x *CreateActivityReq) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *CreateActivityReq) GetTagIds() []int32 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+// 创建活动响应
+type CreateActivityRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	Id            int32                  `protobuf:"varint,3,opt,name=id,proto3" json:"id" dc:"活动ID"`           // 活动ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateActivityRes) Reset() {
+	*x = CreateActivityRes{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateActivityRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateActivityRes) ProtoMessage() {}
+
+func (x *CreateActivityRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateActivityRes.ProtoReflect.Descriptor instead.
+func (*CreateActivityRes) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateActivityRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateActivityRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateActivityRes) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 修改活动请求
+type UpdateActivityReq struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"活动ID"`                                                        // 活动ID
+	ActivityName    string                 `protobuf:"bytes,2,opt,name=activity_name,json=activityName,proto3" json:"activity_name" dc:"活动名称"`                 // 活动名称
+	ActivityType    int32                  `protobuf:"varint,3,opt,name=activity_type,json=activityType,proto3" json:"activity_type" dc:"活动类型 1=自定义活动 2=充值活动"` // 活动类型 1=自定义活动 2=充值活动
+	Describe        string                 `protobuf:"bytes,4,opt,name=describe,proto3" json:"describe" dc:"活动描述"`                                             // 活动描述
+	Intro           string                 `protobuf:"bytes,5,opt,name=intro,proto3" json:"intro" dc:"活动简介"`                                                   // 活动简介
+	PcCover         string                 `protobuf:"bytes,6,opt,name=pc_cover,json=pcCover,proto3" json:"pc_cover" dc:"PC端封面"`                               // PC端封面
+	WapCover        string                 `protobuf:"bytes,7,opt,name=wap_cover,json=wapCover,proto3" json:"wap_cover" dc:"WAP端封面"`                           // WAP端封面
+	MobileCover     string                 `protobuf:"bytes,8,opt,name=mobile_cover,json=mobileCover,proto3" json:"mobile_cover" dc:"手机端封面"`                   // 手机端封面
+	StartTime       string                 `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间"`                          // 开始时间
+	EndTime         string                 `protobuf:"bytes,10,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间"`                               // 结束时间
+	Status          int32                  `protobuf:"varint,11,opt,name=status,proto3" json:"status" dc:"状态 0=关闭 1=开启"`                                       // 状态 0=关闭 1=开启
+	IsRelateGame    int32                  `protobuf:"varint,12,opt,name=is_relate_game,json=isRelateGame,proto3" json:"is_relate_game" dc:"是否关联游戏 0=否 1=是"`   // 是否关联游戏 0=否 1=是
+	RelateGameIds   []int32                `protobuf:"varint,13,rep,packed,name=relate_game_ids,json=relateGameIds,proto3" json:"relate_game_ids" dc:"关联游戏ID"` // 关联游戏ID
+	TemplateId      int32                  `protobuf:"varint,14,opt,name=template_id,json=templateId,proto3" json:"template_id" dc:"活动模板ID"`                   // 活动模板ID
+	ActivityDetails string                 `protobuf:"bytes,15,opt,name=activity_details,json=activityDetails,proto3" json:"activity_details" dc:"活动详情"`       // 活动详情
+	Sort            int32                  `protobuf:"varint,16,opt,name=sort,proto3" json:"sort" dc:"排序"`                                                     // 排序
+	TagIds          []int32                `protobuf:"varint,17,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids" dc:"标签ID"`                          // 标签ID
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateActivityReq) Reset() {
+	*x = UpdateActivityReq{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateActivityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateActivityReq) ProtoMessage() {}
+
+func (x *UpdateActivityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateActivityReq.ProtoReflect.Descriptor instead.
+func (*UpdateActivityReq) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateActivityReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateActivityReq) GetActivityName() string {
+	if x != nil {
+		return x.ActivityName
+	}
+	return ""
+}
+
+func (x *UpdateActivityReq) GetActivityType() int32 {
+	if x != nil {
+		return x.ActivityType
+	}
+	return 0
+}
+
+func (x *UpdateActivityReq) GetDescribe() string {
+	if x != nil {
+		return x.Describe
+	}
+	return ""
+}
+
+func (x *UpdateActivityReq) GetIntro() string {
+	if x != nil {
+		return x.Intro
+	}
+	return ""
+}
+
+func (x *UpdateActivityReq) GetPcCover() string {
+	if x != nil {
+		return x.PcCover
+	}
+	return ""
+}
+
+func (x *UpdateActivityReq) GetWapCover() string {
+	if x != nil {
+		return x.WapCover
+	}
+	return ""
+}
+
+func (x *UpdateActivityReq) GetMobileCover() string {
+	if x != nil {
+		return x.MobileCover
+	}
+	return ""
+}
+
+func (x *UpdateActivityReq) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *UpdateActivityReq) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *UpdateActivityReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *UpdateActivityReq) GetIsRelateGame() int32 {
+	if x != nil {
+		return x.IsRelateGame
+	}
+	return 0
+}
+
+func (x *UpdateActivityReq) GetRelateGameIds() []int32 {
+	if x != nil {
+		return x.RelateGameIds
+	}
+	return nil
+}
+
+func (x *UpdateActivityReq) GetTemplateId() int32 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *UpdateActivityReq) GetActivityDetails() string {
+	if x != nil {
+		return x.ActivityDetails
+	}
+	return ""
+}
+
+func (x *UpdateActivityReq) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *UpdateActivityReq) GetTagIds() []int32 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+// 修改活动响应
+type UpdateActivityRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateActivityRes) Reset() {
+	*x = UpdateActivityRes{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateActivityRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateActivityRes) ProtoMessage() {}
+
+func (x *UpdateActivityRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateActivityRes.ProtoReflect.Descriptor instead.
+func (*UpdateActivityRes) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateActivityRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateActivityRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 删除活动请求
+type DeleteActivityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"活动ID"` // 活动ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteActivityReq) Reset() {
+	*x = DeleteActivityReq{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteActivityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteActivityReq) ProtoMessage() {}
+
+func (x *DeleteActivityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteActivityReq.ProtoReflect.Descriptor instead.
+func (*DeleteActivityReq) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteActivityReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 删除活动响应
+type DeleteActivityRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteActivityRes) Reset() {
+	*x = DeleteActivityRes{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteActivityRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteActivityRes) ProtoMessage() {}
+
+func (x *DeleteActivityRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteActivityRes.ProtoReflect.Descriptor instead.
+func (*DeleteActivityRes) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteActivityRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteActivityRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 发布活动请求
+type PublishActivityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"活动ID"` // 活动ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishActivityReq) Reset() {
+	*x = PublishActivityReq{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishActivityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishActivityReq) ProtoMessage() {}
+
+func (x *PublishActivityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishActivityReq.ProtoReflect.Descriptor instead.
+func (*PublishActivityReq) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PublishActivityReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 发布活动响应
+type PublishActivityRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishActivityRes) Reset() {
+	*x = PublishActivityRes{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishActivityRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishActivityRes) ProtoMessage() {}
+
+func (x *PublishActivityRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishActivityRes.ProtoReflect.Descriptor instead.
+func (*PublishActivityRes) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PublishActivityRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PublishActivityRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 取消发布活动请求
+type UnpublishActivityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"活动ID"` // 活动ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishActivityReq) Reset() {
+	*x = UnpublishActivityReq{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishActivityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishActivityReq) ProtoMessage() {}
+
+func (x *UnpublishActivityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishActivityReq.ProtoReflect.Descriptor instead.
+func (*UnpublishActivityReq) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UnpublishActivityReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 取消发布活动响应
+type UnpublishActivityRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishActivityRes) Reset() {
+	*x = UnpublishActivityRes{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishActivityRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishActivityRes) ProtoMessage() {}
+
+func (x *UnpublishActivityRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishActivityRes.ProtoReflect.Descriptor instead.
+func (*UnpublishActivityRes) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UnpublishActivityRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UnpublishActivityRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 活动排序
+type ActivitySortItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"活动ID"`          // 活动ID
+	Sort          int32                  `protobuf:"varint,2,opt,name=sort,proto3" json:"sort" dc:"排序，值越小越靠前"` // 排序，值越小越靠前
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivitySortItem) Reset() {
+	*x = ActivitySortItem{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivitySortItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivitySortItem) ProtoMessage() {}
+
+func (x *ActivitySortItem) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivitySortItem.ProtoReflect.Descriptor instead.
+func (*ActivitySortItem) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ActivitySortItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ActivitySortItem) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+// 活动排序请求
+type SortActivitiesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ActivitySortItem    `protobuf:"bytes,1,rep,name=items,proto3" json:"items" dc:"需要调整排序的活动"` // 需要调整排序的活动
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortActivitiesReq) Reset() {
+	*x = SortActivitiesReq{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortActivitiesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortActivitiesReq) ProtoMessage() {}
+
+func (x *SortActivitiesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortActivitiesReq.ProtoReflect.Descriptor instead.
+func (*SortActivitiesReq) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SortActivitiesReq) GetItems() []*ActivitySortItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 活动排序响应
+type SortActivitiesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortActivitiesRes) Reset() {
+	*x = SortActivitiesRes{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortActivitiesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortActivitiesRes) ProtoMessage() {}
+
+func (x *SortActivitiesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortActivitiesRes.ProtoReflect.Descriptor instead.
+func (*SortActivitiesRes) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SortActivitiesRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SortActivitiesRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 保存活动模块请求，整体替换活动的模块
+type SaveActivityModulesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId    int32                  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id" dc:"活动ID"` // 活动ID
+	Modules       []*ActivityModuleInfo  `protobuf:"bytes,2,rep,name=modules,proto3" json:"modules" dc:"活动模块"`                          // 活动模块
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveActivityModulesReq) Reset() {
+	*x = SaveActivityModulesReq{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveActivityModulesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveActivityModulesReq) ProtoMessage() {}
+
+func (x *SaveActivityModulesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveActivityModulesReq.ProtoReflect.Descriptor instead.
+func (*SaveActivityModulesReq) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SaveActivityModulesReq) GetActivityId() int32 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *SaveActivityModulesReq) GetModules() []*ActivityModuleInfo {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+// 保存活动模块响应
+type SaveActivityModulesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveActivityModulesRes) Reset() {
+	*x = SaveActivityModulesRes{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveActivityModulesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveActivityModulesRes) ProtoMessage() {}
+
+func (x *SaveActivityModulesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveActivityModulesRes.ProtoReflect.Descriptor instead.
+func (*SaveActivityModulesRes) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SaveActivityModulesRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SaveActivityModulesRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 修改活动内容请求，自定义活动和充值活动分别保存
+type UpdateActivityContentReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId    int32                  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id" dc:"活动ID"` // 活动ID
+	Content       *ActivityContent       `protobuf:"bytes,2,opt,name=content,proto3" json:"content" dc:"活动内容"`                          // 活动内容
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateActivityContentReq) Reset() {
+	*x = UpdateActivityContentReq{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateActivityContentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateActivityContentReq) ProtoMessage() {}
+
+func (x *UpdateActivityContentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateActivityContentReq.ProtoReflect.Descriptor instead.
+func (*UpdateActivityContentReq) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateActivityContentReq) GetActivityId() int32 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *UpdateActivityContentReq) GetContent() *ActivityContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// 修改活动内容响应
+type UpdateActivityContentRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateActivityContentRes) Reset() {
+	*x = UpdateActivityContentRes{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateActivityContentRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateActivityContentRes) ProtoMessage() {}
+
+func (x *UpdateActivityContentRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateActivityContentRes.ProtoReflect.Descriptor instead.
+func (*UpdateActivityContentRes) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateActivityContentRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateActivityContentRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 活动标签
+type ActivityTagInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"标签ID"`                                                 // 标签ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name" dc:"标签名称"`                                              // 标签名称
+	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status" dc:"状态 0=禁用 1=启用"`                                 // 状态 0=禁用 1=启用
+	Sort          int32                  `protobuf:"varint,4,opt,name=sort,proto3" json:"sort" dc:"排序，值越小越靠前"`                                        // 排序，值越小越靠前
+	ActivityCount int32                  `protobuf:"varint,5,opt,name=activity_count,json=activityCount,proto3" json:"activity_count" dc:"使用该标签的活动数"` // 使用该标签的活动数
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间"`                   // 创建时间
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at" dc:"更新时间"`                   // 更新时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityTagInfo) Reset() {
+	*x = ActivityTagInfo{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityTagInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityTagInfo) ProtoMessage() {}
+
+func (x *ActivityTagInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityTagInfo.ProtoReflect.Descriptor instead.
+func (*ActivityTagInfo) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ActivityTagInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ActivityTagInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ActivityTagInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ActivityTagInfo) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *ActivityTagInfo) GetActivityCount() int32 {
+	if x != nil {
+		return x.ActivityCount
+	}
+	return 0
+}
+
+func (x *ActivityTagInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ActivityTagInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// 获取活动标签请求
+type GetActivityTagsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status" dc:"状态 -1=全部 0=禁用 1=启用"` // 状态 -1=全部 0=禁用 1=启用
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActivityTagsReq) Reset() {
+	*x = GetActivityTagsReq{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActivityTagsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivityTagsReq) ProtoMessage() {}
+
+func (x *GetActivityTagsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivityTagsReq.ProtoReflect.Descriptor instead.
+func (*GetActivityTagsReq) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetActivityTagsReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 获取活动标签响应
+type GetActivityTagsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*ActivityTagInfo     `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"标签列表"` // 标签列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActivityTagsRes) Reset() {
+	*x = GetActivityTagsRes{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActivityTagsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivityTagsRes) ProtoMessage() {}
+
+func (x *GetActivityTagsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivityTagsRes.ProtoReflect.Descriptor instead.
+func (*GetActivityTagsRes) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetActivityTagsRes) GetList() []*ActivityTagInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// 创建活动标签请求
+type CreateActivityTagReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name" dc:"标签名称"`              // 标签名称
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status" dc:"状态 0=禁用 1=启用"` // 状态 0=禁用 1=启用
+	Sort          int32                  `protobuf:"varint,3,opt,name=sort,proto3" json:"sort" dc:"排序"`               // 排序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateActivityTagReq) Reset() {
+	*x = CreateActivityTagReq{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateActivityTagReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateActivityTagReq) ProtoMessage() {}
+
+func (x *CreateActivityTagReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateActivityTagReq.ProtoReflect.Descriptor instead.
+func (*CreateActivityTagReq) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateActivityTagReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateActivityTagReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *CreateActivityTagReq) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+// 创建活动标签响应
+type CreateActivityTagRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	Id            int32                  `protobuf:"varint,3,opt,name=id,proto3" json:"id" dc:"标签ID"`           // 标签ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateActivityTagRes) Reset() {
+	*x = CreateActivityTagRes{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateActivityTagRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateActivityTagRes) ProtoMessage() {}
+
+func (x *CreateActivityTagRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateActivityTagRes.ProtoReflect.Descriptor instead.
+func (*CreateActivityTagRes) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateActivityTagRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateActivityTagRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateActivityTagRes) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 修改活动标签请求
+type UpdateActivityTagReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"标签ID"`                 // 标签ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name" dc:"标签名称"`              // 标签名称
+	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status" dc:"状态 0=禁用 1=启用"` // 状态 0=禁用 1=启用
+	Sort          int32                  `protobuf:"varint,4,opt,name=sort,proto3" json:"sort" dc:"排序"`               // 排序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateActivityTagReq) Reset() {
+	*x = UpdateActivityTagReq{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateActivityTagReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateActivityTagReq) ProtoMessage() {}
+
+func (x *UpdateActivityTagReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateActivityTagReq.ProtoReflect.Descriptor instead.
+func (*UpdateActivityTagReq) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateActivityTagReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateActivityTagReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateActivityTagReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *UpdateActivityTagReq) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+// 修改活动标签响应
+type UpdateActivityTagRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateActivityTagRes) Reset() {
+	*x = UpdateActivityTagRes{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateActivityTagRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateActivityTagRes) ProtoMessage() {}
+
+func (x *UpdateActivityTagRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateActivityTagRes.ProtoReflect.Descriptor instead.
+func (*UpdateActivityTagRes) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateActivityTagRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateActivityTagRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 删除活动标签请求
+type DeleteActivityTagReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"标签ID"` // 标签ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteActivityTagReq) Reset() {
+	*x = DeleteActivityTagReq{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteActivityTagReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteActivityTagReq) ProtoMessage() {}
+
+func (x *DeleteActivityTagReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteActivityTagReq.ProtoReflect.Descriptor instead.
+func (*DeleteActivityTagReq) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteActivityTagReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 删除活动标签响应
+type DeleteActivityTagRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteActivityTagRes) Reset() {
+	*x = DeleteActivityTagRes{}
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteActivityTagRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteActivityTagRes) ProtoMessage() {}
+
+func (x *DeleteActivityTagRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_activity_v1_activity_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteActivityTagRes.ProtoReflect.Descriptor instead.
+func (*DeleteActivityTagRes) Descriptor() ([]byte, []int) {
+	return file_backend_activity_v1_activity_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteActivityTagRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteActivityTagRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_backend_activity_v1_activity_proto protoreflect.FileDescriptor
+
+const file_backend_activity_v1_activity_proto_rawDesc = "" +
+	"\n" +
+	"\"backend/activity/v1/activity.proto\x12\bactivity\"\xea\x01\n" +
+	"\x0fActivityContent\x12\x17\n" +
+	"\apc_type\x18\x01 \x01(\x05R\x06pcType\x12\x1d\n" +
+	"\n" +
+	"pc_content\x18\x02 \x01(\tR\tpcContent\x12\x17\n" +
+	"\apc_link\x18\x03 \x01(\tR\x06pcLink\x12\x1f\n" +
+	"\vmobile_type\x18\x04 \x01(\x05R\n" +
+	"mobileType\x12%\n" +
+	"\x0emobile_content\x18\x05 \x01(\tR\rmobileContent\x12\x1f\n" +
+	"\vmobile_link\x18\x06 \x01(\tR\n" +
+	"mobileLink\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\"\xd5\x01\n" +
+	"\x12ActivityModuleInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vmodule_type\x18\x02 \x01(\x05R\n" +
+	"moduleType\x12\x1b\n" +
+	"\tmodule_id\x18\x03 \x01(\x05R\bmoduleId\x12\x1f\n" +
+	"\vmodule_name\x18\x04 \x01(\tR\n" +
+	"moduleName\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x06 \x01(\tR\aendTime\x12\x16\n" +
+	"\x06status\x18\a \x01(\x05R\x06status\"\xee\x05\n" +
+	"\fActivityInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12#\n" +
+	"\ractivity_name\x18\x02 \x01(\tR\factivityName\x12#\n" +
+	"\ractivity_type\x18\x03 \x01(\x05R\factivityType\x12\x1a\n" +
+	"\bdescribe\x18\x04 \x01(\tR\bdescribe\x12\x14\n" +
+	"\x05intro\x18\x05 \x01(\tR\x05intro\x12\x19\n" +
+	"\bpc_cover\x18\x06 \x01(\tR\apcCover\x12\x1b\n" +
+	"\twap_cover\x18\a \x01(\tR\bwapCover\x12!\n" +
+	"\fmobile_cover\x18\b \x01(\tR\vmobileCover\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\t \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\n" +
+	" \x01(\tR\aendTime\x12\x16\n" +
+	"\x06status\x18\v \x01(\x05R\x06status\x12$\n" +
+	"\x0eis_relate_game\x18\f \x01(\x05R\fisRelateGame\x12&\n" +
+	"\x0frelate_game_ids\x18\r \x03(\x05R\rrelateGameIds\x12\x1f\n" +
+	"\vtemplate_id\x18\x0e \x01(\x05R\n" +
+	"templateId\x12)\n" +
+	"\x10activity_details\x18\x0f \x01(\tR\x0factivityDetails\x12\x1d\n" +
+	"\n" +
+	"is_publish\x18\x10 \x01(\x05R\tisPublish\x12\x12\n" +
+	"\x04sort\x18\x11 \x01(\x05R\x04sort\x12-\n" +
+	"\x04tags\x18\x12 \x03(\v2\x19.activity.ActivityTagInfoR\x04tags\x126\n" +
+	"\amodules\x18\x13 \x03(\v2\x1c.activity.ActivityModuleInfoR\amodules\x123\n" +
+	"\acontent\x18\x14 \x01(\v2\x19.activity.ActivityContentR\acontent\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x15 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x16 \x01(\tR\tupdatedAt\"\xd2\x01\n" +
+	"\x10GetActivitiesReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12#\n" +
+	"\ractivity_name\x18\x03 \x01(\tR\factivityName\x12#\n" +
+	"\ractivity_type\x18\x04 \x01(\x05R\factivityType\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\x05R\x06status\x12\x1d\n" +
+	"\n" +
+	"is_publish\x18\x06 \x01(\x05R\tisPublish\x12\x15\n" +
+	"\x06tag_id\x18\a \x01(\x05R\x05tagId\"T\n" +
+	"\x10GetActivitiesRes\x12*\n" +
+	"\x04list\x18\x01 \x03(\v2\x16.activity.ActivityInfoR\x04list\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\" \n" +
+	"\x0eGetActivityReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"D\n" +
+	"\x0eGetActivityRes\x122\n" +
+	"\bactivity\x18\x01 \x01(\v2\x16.activity.ActivityInfoR\bactivity\"\x83\x04\n" +
+	"\x11CreateActivityReq\x12#\n" +
+	"\ractivity_name\x18\x01 \x01(\tR\factivityName\x12#\n" +
+	"\ractivity_type\x18\x02 \x01(\x05R\factivityType\x12\x1a\n" +
+	"\bdescribe\x18\x03 \x01(\tR\bdescribe\x12\x14\n" +
+	"\x05intro\x18\x04 \x01(\tR\x05intro\x12\x19\n" +
+	"\bpc_cover\x18\x05 \x01(\tR\apcCover\x12\x1b\n" +
+	"\twap_cover\x18\x06 \x01(\tR\bwapCover\x12!\n" +
+	"\fmobile_cover\x18\a \x01(\tR\vmobileCover\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\b \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\t \x01(\tR\aendTime\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\x05R\x06status\x12$\n" +
+	"\x0eis_relate_game\x18\v \x01(\x05R\fisRelateGame\x12&\n" +
+	"\x0frelate_game_ids\x18\f \x03(\x05R\rrelateGameIds\x12\x1f\n" +
+	"\vtemplate_id\x18\r \x01(\x05R\n" +
+	"templateId\x12)\n" +
+	"\x10activity_details\x18\x0e \x01(\tR\x0factivityDetails\x12\x12\n" +
+	"\x04sort\x18\x0f \x01(\x05R\x04sort\x12\x17\n" +
+	"\atag_ids\x18\x10 \x03(\x05R\x06tagIds\"W\n" +
+	"\x11CreateActivityRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\x05R\x02id\"\x93\x04\n" +
+	"\x11UpdateActivityReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12#\n" +
+	"\ractivity_name\x18\x02 \x01(\tR\factivityName\x12#\n" +
+	"\ractivity_type\x18\x03 \x01(\x05R\factivityType\x12\x1a\n" +
+	"\bdescribe\x18\x04 \x01(\tR\bdescribe\x12\x14\n" +
+	"\x05intro\x18\x05 \x01(\tR\x05intro\x12\x19\n" +
+	"\bpc_cover\x18\x06 \x01(\tR\apcCover\x12\x1b\n" +
+	"\twap_cover\x18\a \x01(\tR\bwapCover\x12!\n" +
+	"\fmobile_cover\x18\b \x01(\tR\vmobileCover\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\t \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\n" +
+	" \x01(\tR\aendTime\x12\x16\n" +
+	"\x06status\x18\v \x01(\x05R\x06status\x12$\n" +
+	"\x0eis_relate_game\x18\f \x01(\x05R\fisRelateGame\x12&\n" +
+	"\x0frelate_game_ids\x18\r \x03(\x05R\rrelateGameIds\x12\x1f\n" +
+	"\vtemplate_id\x18\x0e \x01(\x05R\n" +
+	"templateId\x12)\n" +
+	"\x10activity_details\x18\x0f \x01(\tR\x0factivityDetails\x12\x12\n" +
+	"\x04sort\x18\x10 \x01(\x05R\x04sort\x12\x17\n" +
+	"\atag_ids\x18\x11 \x03(\x05R\x06tagIds\"G\n" +
+	"\x11UpdateActivityRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"#\n" +
+	"\x11DeleteActivityReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"G\n" +
+	"\x11DeleteActivityRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"$\n" +
+	"\x12PublishActivityReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"H\n" +
+	"\x12PublishActivityRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"&\n" +
+	"\x14UnpublishActivityReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"J\n" +
+	"\x14UnpublishActivityRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"6\n" +
+	"\x10ActivitySortItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04sort\x18\x02 \x01(\x05R\x04sort\"E\n" +
+	"\x11SortActivitiesReq\x120\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.activity.ActivitySortItemR\x05items\"G\n" +
+	"\x11SortActivitiesRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"q\n" +
+	"\x16SaveActivityModulesReq\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x05R\n" +
+	"activityId\x126\n" +
+	"\amodules\x18\x02 \x03(\v2\x1c.activity.ActivityModuleInfoR\amodules\"L\n" +
+	"\x16SaveActivityModulesRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"p\n" +
+	"\x18UpdateActivityContentReq\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x05R\n" +
+	"activityId\x123\n" +
+	"\acontent\x18\x02 \x01(\v2\x19.activity.ActivityContentR\acontent\"N\n" +
+	"\x18UpdateActivityContentRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xc6\x01\n" +
+	"\x0fActivityTagInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\x05R\x06status\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\x05R\x04sort\x12%\n" +
+	"\x0eactivity_count\x18\x05 \x01(\x05R\ractivityCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\",\n" +
+	"\x12GetActivityTagsReq\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\"C\n" +
+	"\x12GetActivityTagsRes\x12-\n" +
+	"\x04list\x18\x01 \x03(\v2\x19.activity.ActivityTagInfoR\x04list\"V\n" +
+	"\x14CreateActivityTagReq\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\x05R\x04sort\"Z\n" +
+	"\x14CreateActivityTagRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\x05R\x02id\"f\n" +
+	"\x14UpdateActivityTagReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\x05R\x06status\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\x05R\x04sort\"J\n" +
+	"\x14UpdateActivityTagRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"&\n" +
+	"\x14DeleteActivityTagReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"J\n" +
+	"\x14DeleteActivityTagRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage2\x90\t\n" +
+	"\bActivity\x12I\n" +
+	"\rGetActivities\x12\x1a.activity.GetActivitiesReq\x1a\x1a.activity.GetActivitiesRes\"\x00\x12C\n" +
+	"\vGetActivity\x12\x18.activity.GetActivityReq\x1a\x18.activity.GetActivityRes\"\x00\x12L\n" +
+	"\x0eCreateActivity\x12\x1b.activity.CreateActivityReq\x1a\x1b.activity.CreateActivityRes\"\x00\x12L\n" +
+	"\x0eUpdateActivity\x12\x1b.activity.UpdateActivityReq\x1a\x1b.activity.UpdateActivityRes\"\x00\x12L\n" +
+	"\x0eDeleteActivity\x12\x1b.activity.DeleteActivityReq\x1a\x1b.activity.DeleteActivityRes\"\x00\x12O\n" +
+	"\x0fPublishActivity\x12\x1c.activity.PublishActivityReq\x1a\x1c.activity.PublishActivityRes\"\x00\x12U\n" +
+	"\x11UnpublishActivity\x12\x1e.activity.UnpublishActivityReq\x1a\x1e.activity.UnpublishActivityRes\"\x00\x12L\n" +
+	"\x0eSortActivities\x12\x1b.activity.SortActivitiesReq\x1a\x1b.activity.SortActivitiesRes\"\x00\x12[\n" +
+	"\x13SaveActivityModules\x12 .activity.SaveActivityModulesReq\x1a .activity.SaveActivityModulesRes\"\x00\x12a\n" +
+	"\x15UpdateActivityContent\x12\".activity.UpdateActivityContentReq\x1a\".activity.UpdateActivityContentRes\"\x00\x12O\n" +
+	"\x0fGetActivityTags\x12\x1c.activity.GetActivityTagsReq\x1a\x1c.activity.GetActivityTagsRes\"\x00\x12U\n" +
+	"\x11CreateActivityTag\x12\x1e.activity.CreateActivityTagReq\x1a\x1e.activity.CreateActivityTagRes\"\x00\x12U\n" +
+	"\x11UpdateActivityTag\x12\x1e.activity.UpdateActivityTagReq\x1a\x1e.activity.UpdateActivityTagRes\"\x00\x12U\n" +
+	"\x11DeleteActivityTag\x12\x1e.activity.DeleteActivityTagReq\x1a\x1e.activity.DeleteActivityTagRes\"\x00B(Z&jh_app_service/api/backend/activity/v1b\x06proto3"
+
+var (
+	file_backend_activity_v1_activity_proto_rawDescOnce sync.Once
+	file_backend_activity_v1_activity_proto_rawDescData []byte
+)
+
+func file_backend_activity_v1_activity_proto_rawDescGZIP() []byte {
+	file_backend_activity_v1_activity_proto_rawDescOnce.Do(func() {
+		file_backend_activity_v1_activity_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_backend_activity_v1_activity_proto_rawDesc), len(file_backend_activity_v1_activity_proto_rawDesc)))
+	})
+	return file_backend_activity_v1_activity_proto_rawDescData
+}
+
+var file_backend_activity_v1_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_backend_activity_v1_activity_proto_goTypes = []any{
+	(*ActivityContent)(nil),          // 0: activity.ActivityContent
+	(*ActivityModuleInfo)(nil),       // 1: activity.ActivityModuleInfo
+	(*ActivityInfo)(nil),             // 2: activity.ActivityInfo
+	(*GetActivitiesReq)(nil),         // 3: activity.GetActivitiesReq
+	(*GetActivitiesRes)(nil),         // 4: activity.GetActivitiesRes
+	(*GetActivityReq)(nil),           // 5: activity.GetActivityReq
+	(*GetActivityRes)(nil),           // 6: activity.GetActivityRes
+	(*CreateActivityReq)(nil),        // 7: activity.CreateActivityReq
+	(*CreateActivityRes)(nil),        // 8: activity.CreateActivityRes
+	(*UpdateActivityReq)(nil),        // 9: activity.UpdateActivityReq
+	(*UpdateActivityRes)(nil),        // 10: activity.UpdateActivityRes
+	(*DeleteActivityReq)(nil),        // 11: activity.DeleteActivityReq
+	(*DeleteActivityRes)(nil),        // 12: activity.DeleteActivityRes
+	(*PublishActivityReq)(nil),       // 13: activity.PublishActivityReq
+	(*PublishActivityRes)(nil),       // 14: activity.PublishActivityRes
+	(*UnpublishActivityReq)(nil),     // 15: activity.UnpublishActivityReq
+	(*UnpublishActivityRes)(nil),     // 16: activity.UnpublishActivityRes
+	(*ActivitySortItem)(nil),         // 17: activity.ActivitySortItem
+	(*SortActivitiesReq)(nil),        // 18: activity.SortActivitiesReq
+	(*SortActivitiesRes)(nil),        // 19: activity.SortActivitiesRes
+	(*SaveActivityModulesReq)(nil),   // 20: activity.SaveActivityModulesReq
+	(*SaveActivityModulesRes)(nil),   // 21: activity.SaveActivityModulesRes
+	(*UpdateActivityContentReq)(nil), // 22: activity.UpdateActivityContentReq
+	(*UpdateActivityContentRes)(nil), // 23: activity.UpdateActivityContentRes
+	(*ActivityTagInfo)(nil),          // 24: activity.ActivityTagInfo
+	(*GetActivityTagsReq)(nil),       // 25: activity.GetActivityTagsReq
+	(*GetActivityTagsRes)(nil),       // 26: activity.GetActivityTagsRes
+	(*CreateActivityTagReq)(nil),     // 27: activity.CreateActivityTagReq
+	(*CreateActivityTagRes)(nil),     // 28: activity.CreateActivityTagRes
+	(*UpdateActivityTagReq)(nil),     // 29: activity.UpdateActivityTagReq
+	(*UpdateActivityTagRes)(nil),     // 30: activity.UpdateActivityTagRes
+	(*DeleteActivityTagReq)(nil),     // 31: activity.DeleteActivityTagReq
+	(*DeleteActivityTagRes)(nil),     // 32: activity.DeleteActivityTagRes
+}
+var file_backend_activity_v1_activity_proto_depIdxs = []int32{
+	24, // 0: activity.ActivityInfo.tags:type_name -> activity.ActivityTagInfo
+	1,  // 1: activity.ActivityInfo.modules:type_name -> activity.ActivityModuleInfo
+	0,  // 2: activity.ActivityInfo.content:type_name -> activity.ActivityContent
+	2,  // 3: activity.GetActivitiesRes.list:type_name -> activity.ActivityInfo
+	2,  // 4: activity.GetActivityRes.activity:type_name -> activity.ActivityInfo
+	17, // 5: activity.SortActivitiesReq.items:type_name -> activity.ActivitySortItem
+	1,  // 6: activity.SaveActivityModulesReq.modules:type_name -> activity.ActivityModuleInfo
+	0,  // 7: activity.UpdateActivityContentReq.content:type_name -> activity.ActivityContent
+	24, // 8: activity.GetActivityTagsRes.list:type_name -> activity.ActivityTagInfo
+	3,  // 9: activity.Activity.GetActivities:input_type -> activity.GetActivitiesReq
+	5,  // 10: activity.Activity.GetActivity:input_type -> activity.GetActivityReq
+	7,  // 11: activity.Activity.CreateActivity:input_type -> activity.CreateActivityReq
+	9,  // 12: activity.Activity.UpdateActivity:input_type -> activity.UpdateActivityReq
+	11, // 13: activity.Activity.DeleteActivity:input_type -> activity.DeleteActivityReq
+	13, // 14: activity.Activity.PublishActivity:input_type -> activity.PublishActivityReq
+	15, // 15: activity.Activity.UnpublishActivity:input_type -> activity.UnpublishActivityReq
+	18, // 16: activity.Activity.SortActivities:input_type -> activity.SortActivitiesReq
+	20, // 17: activity.Activity.SaveActivityModules:input_type -> activity.SaveActivityModulesReq
+	22, // 18: activity.Activity.UpdateActivityContent:input_type -> activity.UpdateActivityContentReq
+	25, // 19: activity.Activity.GetActivityTags:input_type -> activity.GetActivityTagsReq
+	27, // 20: activity.Activity.CreateActivityTag:input_type -> activity.CreateActivityTagReq
+	29, // 21: activity.Activity.UpdateActivityTag:input_type -> activity.UpdateActivityTagReq
+	31, // 22: activity.Activity.DeleteActivityTag:input_type -> activity.DeleteActivityTagReq
+	4,  // 23: activity.Activity.GetActivities:output_type -> activity.GetActivitiesRes
+	6,  // 24: activity.Activity.GetActivity:output_type -> activity.GetActivityRes
+	8,  // 25: activity.Activity.CreateActivity:output_type -> activity.CreateActivityRes
+	10, // 26: activity.Activity.UpdateActivity:output_type -> activity.UpdateActivityRes
+	12, // 27: activity.Activity.DeleteActivity:output_type -> activity.DeleteActivityRes
+	14, // 28: activity.Activity.PublishActivity:output_type -> activity.PublishActivityRes
+	16, // 29: activity.Activity.UnpublishActivity:output_type -> activity.UnpublishActivityRes
+	19, // 30: activity.Activity.SortActivities:output_type -> activity.SortActivitiesRes
+	21, // 31: activity.Activity.SaveActivityModules:output_type -> activity.SaveActivityModulesRes
+	23, // 32: activity.Activity.UpdateActivityContent:output_type -> activity.UpdateActivityContentRes
+	26, // 33: activity.Activity.GetActivityTags:output_type -> activity.GetActivityTagsRes
+	28, // 34: activity.Activity.CreateActivityTag:output_type -> activity.CreateActivityTagRes
+	30, // 35: activity.Activity.UpdateActivityTag:output_type -> activity.UpdateActivityTagRes
+	32, // 36: activity.Activity.DeleteActivityTag:output_type -> activity.DeleteActivityTagRes
+	23, // [23:37] is the sub-list for method output_type
+	9,  // [9:23] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_backend_activity_v1_activity_proto_init() }
+func file_backend_activity_v1_activity_proto_init() {
+	if File_backend_activity_v1_activity_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backend_activity_v1_activity_proto_rawDesc), len(file_backend_activity_v1_activity_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   33,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_backend_activity_v1_activity_proto_goTypes,
+		DependencyIndexes: file_backend_activity_v1_activity_proto_depIdxs,
+		MessageInfos:      file_backend_activity_v1_activity_proto_msgTypes,
+	}.Build()
+	File_backend_activity_v1_activity_proto = out.File
+	file_backend_activity_v1_activity_proto_goTypes = nil
+	file_backend_activity_v1_activity_proto_depIdxs = nil
+}
@@ -0,0 +1,622 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.1
+// source: backend/activity/v1/activity.proto
+
+package v1
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Activity_GetActivities_FullMethodName         = "/activity.Activity/GetActivities"
+	Activity_GetActivity_FullMethodName           = "/activity.Activity/GetActivity"
+	Activity_CreateActivity_FullMethodName        = "/activity.Activity/CreateActivity"
+	Activity_UpdateActivity_FullMethodName        = "/activity.Activity/UpdateActivity"
+	Activity_DeleteActivity_FullMethodName        = "/activity.Activity/DeleteActivity"
+	Activity_PublishActivity_FullMethodName       = "/activity.Activity/PublishActivity"
+	Activity_UnpublishActivity_FullMethodName     = "/activity.Activity/UnpublishActivity"
+	Activity_SortActivities_FullMethodName        = "/activity.Activity/SortActivities"
+	Activity_SaveActivityModules_FullMethodName   = "/activity.Activity/SaveActivityModules"
+	Activity_UpdateActivityContent_FullMethodName = "/activity.Activity/UpdateActivityContent"
+	Activity_GetActivityTags_FullMethodName       = "/activity.Activity/GetActivityTags"
+	Activity_CreateActivityTag_FullMethodName     = "/activity.Activity/CreateActivityTag"
+	Activity_UpdateActivityTag_FullMethodName     = "/activity.Activity/UpdateActivityTag"
+	Activity_DeleteActivityTag_FullMethodName     = "/activity.Activity/DeleteActivityTag"
+)
+
+// ActivityClient is the client API for Activity service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ActivityClient interface {
+	// 活动接口
+	GetActivities(ctx context.Context, in *GetActivitiesReq, opts ...grpc.CallOption) (*GetActivitiesRes, error)
+	GetActivity(ctx context.Context, in *GetActivityReq, opts ...grpc.CallOption) (*GetActivityRes, error)
+	CreateActivity(ctx context.Context, in *CreateActivityReq, opts ...grpc.CallOption) (*CreateActivityRes, error)
+	UpdateActivity(ctx context.Context, in *UpdateActivityReq, opts ...grpc.CallOption) (*UpdateActivityRes, error)
+	DeleteActivity(ctx context.Context, in *DeleteActivityReq, opts ...grpc.CallOption) (*DeleteActivityRes, error)
+	PublishActivity(ctx context.Context, in *PublishActivityReq, opts ...grpc.CallOption) (*PublishActivityRes, error)
+	UnpublishActivity(ctx context.Context, in *UnpublishActivityReq, opts ...grpc.CallOption) (*UnpublishActivityRes, error)
+	SortActivities(ctx context.Context, in *SortActivitiesReq, opts ...grpc.CallOption) (*SortActivitiesRes, error)
+	// 活动模块和内容接口
+	SaveActivityModules(ctx context.Context, in *SaveActivityModulesReq, opts ...grpc.CallOption) (*SaveActivityModulesRes, error)
+	UpdateActivityContent(ctx context.Context, in *UpdateActivityContentReq, opts ...grpc.CallOption) (*UpdateActivityContentRes, error)
+	// 活动标签接口
+	GetActivityTags(ctx context.Context, in *GetActivityTagsReq, opts ...grpc.CallOption) (*GetActivityTagsRes, error)
+	CreateActivityTag(ctx context.Context, in *CreateActivityTagReq, opts ...grpc.CallOption) (*CreateActivityTagRes, error)
+	UpdateActivityTag(ctx context.Context, in *UpdateActivityTagReq, opts ...grpc.CallOption) (*UpdateActivityTagRes, error)
+	DeleteActivityTag(ctx context.Context, in *DeleteActivityTagReq, opts ...grpc.CallOption) (*DeleteActivityTagRes, error)
+}
+
+type activityClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewActivityClient(cc grpc.ClientConnInterface) ActivityClient {
+	return &activityClient{cc}
+}
+
+func (c *activityClient) GetActivities(ctx context.Context, in *GetActivitiesReq, opts ...grpc.CallOption) (*GetActivitiesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActivitiesRes)
+	err := c.cc.Invoke(ctx, Activity_GetActivities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityClient) GetActivity(ctx context.Context, in *GetActivityReq, opts ...grpc.CallOption) (*GetActivityRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActivityRes)
+	err := c.cc.Invoke(ctx, Activity_GetActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityClient) CreateActivity(ctx context.Context, in *CreateActivityReq, opts ...grpc.CallOption) (*CreateActivityRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateActivityRes)
+	err := c.cc.Invoke(ctx, Activity_CreateActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityClient) UpdateActivity(ctx context.Context, in *UpdateActivityReq, opts ...grpc.CallOption) (*UpdateActivityRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateActivityRes)
+	err := c.cc.Invoke(ctx, Activity_UpdateActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityClient) DeleteActivity(ctx context.Context, in *DeleteActivityReq, opts ...grpc.CallOption) (*DeleteActivityRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteActivityRes)
+	err := c.cc.Invoke(ctx, Activity_DeleteActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityClient) PublishActivity(ctx context.Context, in *PublishActivityReq, opts ...grpc.CallOption) (*PublishActivityRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishActivityRes)
+	err := c.cc.Invoke(ctx, Activity_PublishActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityClient) UnpublishActivity(ctx context.Context, in *UnpublishActivityReq, opts ...grpc.CallOption) (*UnpublishActivityRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpublishActivityRes)
+	err := c.cc.Invoke(ctx, Activity_UnpublishActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityClient) SortActivities(ctx context.Context, in *SortActivitiesReq, opts ...grpc.CallOption) (*SortActivitiesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SortActivitiesRes)
+	err := c.cc.Invoke(ctx, Activity_SortActivities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityClient) SaveActivityModules(ctx context.Context, in *SaveActivityModulesReq, opts ...grpc.CallOption) (*SaveActivityModulesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveActivityModulesRes)
+	err := c.cc.Invoke(ctx, Activity_SaveActivityModules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityClient) UpdateActivityContent(ctx context.Context, in *UpdateActivityContentReq, opts ...grpc.CallOption) (*UpdateActivityContentRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateActivityContentRes)
+	err := c.cc.Invoke(ctx, Activity_UpdateActivityContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityClient) GetActivityTags(ctx context.Context, in *GetActivityTagsReq, opts ...grpc.CallOption) (*GetActivityTagsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActivityTagsRes)
+	err := c.cc.Invoke(ctx, Activity_GetActivityTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityClient) CreateActivityTag(ctx context.Context, in *CreateActivityTagReq, opts ...grpc.CallOption) (*CreateActivityTagRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateActivityTagRes)
+	err := c.cc.Invoke(ctx, Activity_CreateActivityTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityClient) UpdateActivityTag(ctx context.Context, in *UpdateActivityTagReq, opts ...grpc.CallOption) (*UpdateActivityTagRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateActivityTagRes)
+	err := c.cc.Invoke(ctx, Activity_UpdateActivityTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityClient) DeleteActivityTag(ctx context.Context, in *DeleteActivityTagReq, opts ...grpc.CallOption) (*DeleteActivityTagRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteActivityTagRes)
+	err := c.cc.Invoke(ctx, Activity_DeleteActivityTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ActivityServer is the server API for Activity service.
+// All implementations must embed UnimplementedActivityServer
+// for forward compatibility.
+type ActivityServer interface {
+	// 活动接口
+	GetActivities(context.Context, *GetActivitiesReq) (*GetActivitiesRes, error)
+	GetActivity(context.Context, *GetActivityReq) (*GetActivityRes, error)
+	CreateActivity(context.Context, *CreateActivityReq) (*CreateActivityRes, error)
+	UpdateActivity(context.Context, *UpdateActivityReq) (*UpdateActivityRes, error)
+	DeleteActivity(context.Context, *DeleteActivityReq) (*DeleteActivityRes, error)
+	PublishActivity(context.Context, *PublishActivityReq) (*PublishActivityRes, error)
+	UnpublishActivity(context.Context, *UnpublishActivityReq) (*UnpublishActivityRes, error)
+	SortActivities(context.Context, *SortActivitiesReq) (*SortActivitiesRes, error)
+	// 活动模块和内容接口
+	SaveActivityModules(context.Context, *SaveActivityModulesReq) (*SaveActivityModulesRes, error)
+	UpdateActivityContent(context.Context, *UpdateActivityContentReq) (*UpdateActivityContentRes, error)
+	// 活动标签接口
+	GetActivityTags(context.Context, *GetActivityTagsReq) (*GetActivityTagsRes, error)
+	CreateActivityTag(context.Context, *CreateActivityTagReq) (*CreateActivityTagRes, error)
+	UpdateActivityTag(context.Context, *UpdateActivityTagReq) (*UpdateActivityTagRes, error)
+	DeleteActivityTag(context.Context, *DeleteActivityTagReq) (*DeleteActivityTagRes, error)
+	mustEmbedUnimplementedActivityServer()
+}
+
+// UnimplementedActivityServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedActivityServer struct{}
+
+func (UnimplementedActivityServer) GetActivities(context.Context, *GetActivitiesReq) (*GetActivitiesRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetActivities not implemented")
+}
+func (UnimplementedActivityServer) GetActivity(context.Context, *GetActivityReq) (*GetActivityRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetActivity not implemented")
+}
+func (UnimplementedActivityServer) CreateActivity(context.Context, *CreateActivityReq) (*CreateActivityRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateActivity not implemented")
+}
+func (UnimplementedActivityServer) UpdateActivity(context.Context, *UpdateActivityReq) (*UpdateActivityRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateActivity not implemented")
+}
+func (UnimplementedActivityServer) DeleteActivity(context.Context, *DeleteActivityReq) (*DeleteActivityRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteActivity not implemented")
+}
+func (UnimplementedActivityServer) PublishActivity(context.Context, *PublishActivityReq) (*PublishActivityRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method PublishActivity not implemented")
+}
+func (UnimplementedActivityServer) UnpublishActivity(context.Context, *UnpublishActivityReq) (*UnpublishActivityRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnpublishActivity not implemented")
+}
+func (UnimplementedActivityServer) SortActivities(context.Context, *SortActivitiesReq) (*SortActivitiesRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method SortActivities not implemented")
+}
+func (UnimplementedActivityServer) SaveActivityModules(context.Context, *SaveActivityModulesReq) (*SaveActivityModulesRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveActivityModules not implemented")
+}
+func (UnimplementedActivityServer) UpdateActivityContent(context.Context, *UpdateActivityContentReq) (*UpdateActivityContentRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateActivityContent not implemented")
+}
+func (UnimplementedActivityServer) GetActivityTags(context.Context, *GetActivityTagsReq) (*GetActivityTagsRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetActivityTags not implemented")
+}
+func (UnimplementedActivityServer) CreateActivityTag(context.Context, *CreateActivityTagReq) (*CreateActivityTagRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateActivityTag not implemented")
+}
+func (UnimplementedActivityServer) UpdateActivityTag(context.Context, *UpdateActivityTagReq) (*UpdateActivityTagRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateActivityTag not implemented")
+}
+func (UnimplementedActivityServer) DeleteActivityTag(context.Context, *DeleteActivityTagReq) (*DeleteActivityTagRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteActivityTag not implemented")
+}
+func (UnimplementedActivityServer) mustEmbedUnimplementedActivityServer() {}
+func (UnimplementedActivityServer) testEmbeddedByValue()                  {}
+
+// UnsafeActivityServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ActivityServer will
+// result in compilation errors.
+type UnsafeActivityServer interface {
+	mustEmbedUnimplementedActivityServer()
+}
+
+func RegisterActivityServer(s grpc.ServiceRegistrar, srv ActivityServer) {
+	// If the following call panics, it indicates UnimplementedActivityServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Activity_ServiceDesc, srv)
+}
+
+func _Activity_GetActivities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActivitiesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServer).GetActivities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Activity_GetActivities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServer).GetActivities(ctx, req.(*GetActivitiesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Activity_GetActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActivityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServer).GetActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Activity_GetActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServer).GetActivity(ctx, req.(*GetActivityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Activity_CreateActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateActivityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServer).CreateActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Activity_CreateActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServer).CreateActivity(ctx, req.(*CreateActivityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Activity_UpdateActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateActivityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServer).UpdateActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Activity_UpdateActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServer).UpdateActivity(ctx, req.(*UpdateActivityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Activity_DeleteActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteActivityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServer).DeleteActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Activity_DeleteActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServer).DeleteActivity(ctx, req.(*DeleteActivityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Activity_PublishActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishActivityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServer).PublishActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Activity_PublishActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServer).PublishActivity(ctx, req.(*PublishActivityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Activity_UnpublishActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpublishActivityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServer).UnpublishActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Activity_UnpublishActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServer).UnpublishActivity(ctx, req.(*UnpublishActivityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Activity_SortActivities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortActivitiesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServer).SortActivities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Activity_SortActivities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServer).SortActivities(ctx, req.(*SortActivitiesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Activity_SaveActivityModules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveActivityModulesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServer).SaveActivityModules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Activity_SaveActivityModules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServer).SaveActivityModules(ctx, req.(*SaveActivityModulesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Activity_UpdateActivityContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateActivityContentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServer).UpdateActivityContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Activity_UpdateActivityContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServer).UpdateActivityContent(ctx, req.(*UpdateActivityContentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Activity_GetActivityTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActivityTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServer).GetActivityTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Activity_GetActivityTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServer).GetActivityTags(ctx, req.(*GetActivityTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Activity_CreateActivityTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateActivityTagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServer).CreateActivityTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Activity_CreateActivityTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServer).CreateActivityTag(ctx, req.(*CreateActivityTagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Activity_UpdateActivityTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateActivityTagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServer).UpdateActivityTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Activity_UpdateActivityTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServer).UpdateActivityTag(ctx, req.(*UpdateActivityTagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Activity_DeleteActivityTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteActivityTagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServer).DeleteActivityTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Activity_DeleteActivityTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServer).DeleteActivityTag(ctx, req.(*DeleteActivityTagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Activity_ServiceDesc is the grpc.ServiceDesc for Activity service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Activity_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "activity.Activity",
+	HandlerType: (*ActivityServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetActivities",
+			Handler:    _Activity_GetActivities_Handler,
+		},
+		{
+			MethodName: "GetActivity",
+			Handler:    _Activity_GetActivity_Handler,
+		},
+		{
+			MethodName: "CreateActivity",
+			Handler:    _Activity_CreateActivity_Handler,
+		},
+		{
+			MethodName: "UpdateActivity",
+			Handler:    _Activity_UpdateActivity_Handler,
+		},
+		{
+			MethodName: "DeleteActivity",
+			Handler:    _Activity_DeleteActivity_Handler,
+		},
+		{
+			MethodName: "PublishActivity",
+			Handler:    _Activity_PublishActivity_Handler,
+		},
+		{
+			MethodName: "UnpublishActivity",
+			Handler:    _Activity_UnpublishActivity_Handler,
+		},
+		{
+			MethodName: "SortActivities",
+			Handler:    _Activity_SortActivities_Handler,
+		},
+		{
+			MethodName: "SaveActivityModules",
+			Handler:    _Activity_SaveActivityModules_Handler,
+		},
+		{
+			MethodName: "UpdateActivityContent",
+			Handler:    _Activity_UpdateActivityContent_Handler,
+		},
+		{
+			MethodName: "GetActivityTags",
+			Handler:    _Activity_GetActivityTags_Handler,
+		},
+		{
+			MethodName: "CreateActivityTag",
+			Handler:    _Activity_CreateActivityTag_Handler,
+		},
+		{
+			MethodName: "UpdateActivityTag",
+			Handler:    _Activity_UpdateActivityTag_Handler,
+		},
+		{
+			MethodName: "DeleteActivityTag",
+			Handler:    _Activity_DeleteActivityTag_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backend/activity/v1/activity.proto",
+}
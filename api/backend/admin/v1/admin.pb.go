@@ -1376,6 +1376,7 @@ type AdminLogInfo struct {
 	Ip            string                 `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip" dc:"IP地址"`                                // IP地址
 	Remark        string                 `protobuf:"bytes,3,opt,name=remark,proto3" json:"remark" dc:"操作备注"`                        // 操作备注
 	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间"` // 创建时间
+	Address       string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address" dc:"IP所在地区"`                    // IP所在地区
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1438,6 +1439,13 @@ func (x *AdminLogInfo) GetCreatedAt() string {
 	return ""
 }
 
+func (x *AdminLogInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
 // 获取管理员日志响应
 type GetAdminLogsRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1587,13 +1595,14 @@ const file_backend_admin_v1_admin_proto_rawDesc = "" +
 	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
 	"\x03end\x18\x03 \x01(\tR\x03end\x12\x12\n" +
 	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x12\n" +
-	"\x04size\x18\x05 \x01(\x05R\x04size\"q\n" +
+	"\x04size\x18\x05 \x01(\x05R\x04size\"\x8b\x01\n" +
 	"\fAdminLogInfo\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x0e\n" +
 	"\x02ip\x18\x02 \x01(\tR\x02ip\x12\x16\n" +
 	"\x06remark\x18\x03 \x01(\tR\x06remark\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\tR\tcreatedAt\"P\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\"P\n" +
 	"\x0fGetAdminLogsRes\x12'\n" +
 	"\x04list\x18\x01 \x03(\v2\x13.admin.AdminLogInfoR\x04list\x12\x14\n" +
 	"\x05count\x18\x02 \x01(\x05R\x05count2\x8f\x05\n" +
@@ -107,13 +107,13 @@ func (x *GetChangeListRes) GetList() map[int32]string {
 // 获取账变记录请求
 type GetBalanceChangesReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)"`  // 用户名 (可选)
-	ChangeType    int32                  `protobuf:"varint,2,opt,name=change_type,json=changeType,proto3" json:"change_type" dc:"账变类型 1=入款 2=出款 (可选)" dc:"账变类型 1=入款 2=出款 (可选)" dc:"账变类型 1=入款 2=出款 (可选)" dc:"账变类型 1=入款 2=出款 (可选)" dc:"账变类型 1=入款 2=出款 (可选)" dc:"账变类型 1=入款 2=出款 (可选)" dc:"账变类型 1=入款 2=出款 (可选)" dc:"账变类型 1=入款 2=出款 (可选)" dc:"账变类型 1=入款 2=出款 (可选)"`      // 账变类型 1=入款 2=出款 (可选)
-	TradeType     int32                  `protobuf:"varint,3,opt,name=trade_type,json=tradeType,proto3" json:"trade_type" dc:"交易类型 (可选)" dc:"交易类型 (可选)" dc:"交易类型 (可选)" dc:"交易类型 (可选)" dc:"交易类型 (可选)" dc:"交易类型 (可选)" dc:"交易类型 (可选)" dc:"交易类型 (可选)" dc:"交易类型 (可选)" dc:"交易类型 (可选)" dc:"交易类型 (可选)" dc:"交易类型 (可选)" dc:"交易类型 (可选)" dc:"交易类型 (可选)" dc:"交易类型 (可选)"`         // 交易类型 (可选)
-	StartTime     string                 `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)"`          // 开始时间 (可选)
-	EndTime       string                 `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)"` // 结束时间 (可选)
-	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码"`                                                                                                                     // 页码
-	Size          int32                  `protobuf:"varint,7,opt,name=size,proto3" json:"size" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量"`                                                                                 // 每页数量
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username" dc:"用户名 (可选)"`                                   // 用户名 (可选)
+	ChangeType    int32                  `protobuf:"varint,2,opt,name=change_type,json=changeType,proto3" json:"change_type" dc:"账变类型 1=入款 2=出款 (可选)"` // 账变类型 1=入款 2=出款 (可选)
+	TradeType     int32                  `protobuf:"varint,3,opt,name=trade_type,json=tradeType,proto3" json:"trade_type" dc:"交易类型 (可选)"`              // 交易类型 (可选)
+	StartTime     string                 `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间 (可选)"`               // 开始时间 (可选)
+	EndTime       string                 `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间 (可选)"`                     // 结束时间 (可选)
+	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page" dc:"页码"`                                                // 页码
+	Size          int32                  `protobuf:"varint,7,opt,name=size,proto3" json:"size" dc:"每页数量"`                                              // 每页数量
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -200,21 +200,21 @@ func (x *GetBalanceChangesReq) GetSize() int32 {
 // 账变记录信息
 type BalanceChangeInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID"`                                                          // ID
-	TradeType     int32                  `protobuf:"varint,2,opt,name=trade_type,json=tradeType,proto3" json:"trade_type" dc:"交易类型" dc:"交易类型" dc:"交易类型" dc:"交易类型" dc:"交易类型" dc:"交易类型" dc:"交易类型" dc:"交易类型" dc:"交易类型" dc:"交易类型" dc:"交易类型" dc:"交易类型" dc:"交易类型" dc:"交易类型" dc:"交易类型" dc:"交易类型" dc:"交易类型"` // 交易类型
-	TradeTypeName string                 `protobuf:"bytes,3,opt,name=trade_type_name,json=tradeTypeName,proto3" json:"trade_type_name" dc:"交易类型名称" dc:"交易类型名称" dc:"交易类型名称" dc:"交易类型名称" dc:"交易类型名称" dc:"交易类型名称" dc:"交易类型名称" dc:"交易类型名称" dc:"交易类型名称" dc:"交易类型名称" dc:"交易类型名称" dc:"交易类型名称" dc:"交易类型名称"`  // 交易类型名称
-	UserId        int32                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID"`          // 用户ID
-	Username      string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名"`                             // 用户名
-	TradeNo       string                 `protobuf:"bytes,6,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号"`                // 流水号
-	BalanceOld    float64                `protobuf:"fixed64,7,opt,name=balance_old,json=balanceOld,proto3" json:"balance_old" dc:"旧余额" dc:"旧余额" dc:"旧余额" dc:"旧余额" dc:"旧余额" dc:"旧余额" dc:"旧余额" dc:"旧余额" dc:"旧余额" dc:"旧余额" dc:"旧余额" dc:"旧余额" dc:"旧余额" dc:"旧余额" dc:"旧余额" dc:"旧余额" dc:"旧余额" dc:"旧余额"`     // 旧余额
-	Money         float64                `protobuf:"fixed64,8,opt,name=money,proto3" json:"money" dc:"变动金额" dc:"变动金额" dc:"变动金额" dc:"变动金额" dc:"变动金额" dc:"变动金额" dc:"变动金额" dc:"变动金额" dc:"变动金额" dc:"变动金额" dc:"变动金额" dc:"变动金额" dc:"变动金额" dc:"变动金额" dc:"变动金额" dc:"变动金额" dc:"变动金额" dc:"变动金额"`               // 变动金额
-	BalanceNew    float64                `protobuf:"fixed64,9,opt,name=balance_new,json=balanceNew,proto3" json:"balance_new" dc:"新余额" dc:"新余额" dc:"新余额" dc:"新余额" dc:"新余额" dc:"新余额" dc:"新余额" dc:"新余额" dc:"新余额" dc:"新余额" dc:"新余额" dc:"新余额" dc:"新余额" dc:"新余额" dc:"新余额" dc:"新余额" dc:"新余额" dc:"新余额"`     // 新余额
-	BalanceFrozen float64                `protobuf:"fixed64,10,opt,name=balance_frozen,json=balanceFrozen,proto3" json:"balance_frozen" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额"`       // 冻结余额
-	Status        int32                  `protobuf:"varint,11,opt,name=status,proto3" json:"status" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态"`                                                 // 状态
-	StatusName    string                 `protobuf:"bytes,12,opt,name=status_name,json=statusName,proto3" json:"status_name" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称"`        // 状态名称
-	Remark        string                 `protobuf:"bytes,13,opt,name=remark,proto3" json:"remark" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注"`                                                  // 备注
-	CreatedAt     string                 `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间"` // 创建时间
-	ChangeType    int32                  `protobuf:"varint,15,opt,name=change_type,json=changeType,proto3" json:"change_type" dc:"账变类型 1=入款 2=出款" dc:"账变类型 1=入款 2=出款" dc:"账变类型 1=入款 2=出款" dc:"账变类型 1=入款 2=出款" dc:"账变类型 1=入款 2=出款" dc:"账变类型 1=入款 2=出款" dc:"账变类型 1=入款 2=出款" dc:"账变类型 1=入款 2=出款"`       // 账变类型 1=入款 2=出款
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"ID"`                                                 // ID
+	TradeType     int32                  `protobuf:"varint,2,opt,name=trade_type,json=tradeType,proto3" json:"trade_type" dc:"交易类型"`                // 交易类型
+	TradeTypeName string                 `protobuf:"bytes,3,opt,name=trade_type_name,json=tradeTypeName,proto3" json:"trade_type_name" dc:"交易类型名称"` // 交易类型名称
+	UserId        int32                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"用户ID"`                         // 用户ID
+	Username      string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username" dc:"用户名"`                                     // 用户名
+	TradeNo       string                 `protobuf:"bytes,6,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no" dc:"流水号"`                        // 流水号
+	BalanceOld    float64                `protobuf:"fixed64,7,opt,name=balance_old,json=balanceOld,proto3" json:"balance_old" dc:"旧余额"`             // 旧余额
+	Money         float64                `protobuf:"fixed64,8,opt,name=money,proto3" json:"money" dc:"变动金额"`                                        // 变动金额
+	BalanceNew    float64                `protobuf:"fixed64,9,opt,name=balance_new,json=balanceNew,proto3" json:"balance_new" dc:"新余额"`             // 新余额
+	BalanceFrozen float64                `protobuf:"fixed64,10,opt,name=balance_frozen,json=balanceFrozen,proto3" json:"balance_frozen" dc:"冻结余额"`  // 冻结余额
+	Status        int32                  `protobuf:"varint,11,opt,name=status,proto3" json:"status" dc:"状态"`                                        // 状态
+	StatusName    string                 `protobuf:"bytes,12,opt,name=status_name,json=statusName,proto3" json:"status_name" dc:"状态名称"`             // 状态名称
+	Remark        string                 `protobuf:"bytes,13,opt,name=remark,proto3" json:"remark" dc:"备注"`                                         // 备注
+	CreatedAt     string                 `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间"`                // 创建时间
+	ChangeType    int32                  `protobuf:"varint,15,opt,name=change_type,json=changeType,proto3" json:"change_type" dc:"账变类型 1=入款 2=出款"`  // 账变类型 1=入款 2=出款
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -410,17 +410,17 @@ func (x *GetBalanceChangesRes) GetCount() int32 {
 // 获取充值记录请求
 type GetRechargePaymentsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)"`               // 用户名 (可选)
-	Gateway       int32                  `protobuf:"varint,2,opt,name=gateway,proto3" json:"gateway" dc:"网关类型 (可选)" dc:"网关类型 (可选)" dc:"网关类型 (可选)" dc:"网关类型 (可选)" dc:"网关类型 (可选)" dc:"网关类型 (可选)" dc:"网关类型 (可选)" dc:"网关类型 (可选)" dc:"网关类型 (可选)" dc:"网关类型 (可选)" dc:"网关类型 (可选)" dc:"网关类型 (可选)" dc:"网关类型 (可选)" dc:"网关类型 (可选)" dc:"网关类型 (可选)"`               // 网关类型 (可选)
-	PaymentId     int32                  `protobuf:"varint,3,opt,name=payment_id,json=paymentId,proto3" json:"payment_id" dc:"支付ID (可选)" dc:"支付ID (可选)" dc:"支付ID (可选)" dc:"支付ID (可选)" dc:"支付ID (可选)" dc:"支付ID (可选)" dc:"支付ID (可选)" dc:"支付ID (可选)" dc:"支付ID (可选)" dc:"支付ID (可选)" dc:"支付ID (可选)" dc:"支付ID (可选)" dc:"支付ID (可选)" dc:"支付ID (可选)"`         // 支付ID (可选)
-	AccountId     int32                  `protobuf:"varint,4,opt,name=account_id,json=accountId,proto3" json:"account_id" dc:"账号ID (可选)" dc:"账号ID (可选)" dc:"账号ID (可选)" dc:"账号ID (可选)" dc:"账号ID (可选)" dc:"账号ID (可选)" dc:"账号ID (可选)" dc:"账号ID (可选)" dc:"账号ID (可选)" dc:"账号ID (可选)" dc:"账号ID (可选)" dc:"账号ID (可选)" dc:"账号ID (可选)" dc:"账号ID (可选)"`         // 账号ID (可选)
-	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)"`        // 状态 (可选)
-	TradeNo       string                 `protobuf:"bytes,6,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)"`  // 流水号 (可选)
-	Domain        string                 `protobuf:"bytes,7,opt,name=domain,proto3" json:"domain" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)"`         // 域名 (可选)
-	StartTime     string                 `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)"`          // 开始时间 (可选)
-	EndTime       string                 `protobuf:"bytes,9,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)"` // 结束时间 (可选)
-	Page          int32                  `protobuf:"varint,10,opt,name=page,proto3" json:"page" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码"`                                                                                                     // 页码
-	Size          int32                  `protobuf:"varint,11,opt,name=size,proto3" json:"size" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量"`                                                                 // 每页数量
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username" dc:"用户名 (可选)"`                      // 用户名 (可选)
+	Gateway       int32                  `protobuf:"varint,2,opt,name=gateway,proto3" json:"gateway" dc:"网关类型 (可选)"`                      // 网关类型 (可选)
+	PaymentId     int32                  `protobuf:"varint,3,opt,name=payment_id,json=paymentId,proto3" json:"payment_id" dc:"支付ID (可选)"` // 支付ID (可选)
+	AccountId     int32                  `protobuf:"varint,4,opt,name=account_id,json=accountId,proto3" json:"account_id" dc:"账号ID (可选)"` // 账号ID (可选)
+	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status" dc:"状态 (可选)"`                          // 状态 (可选)
+	TradeNo       string                 `protobuf:"bytes,6,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no" dc:"流水号 (可选)"`         // 流水号 (可选)
+	Domain        string                 `protobuf:"bytes,7,opt,name=domain,proto3" json:"domain" dc:"域名 (可选)"`                           // 域名 (可选)
+	StartTime     string                 `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间 (可选)"`  // 开始时间 (可选)
+	EndTime       string                 `protobuf:"bytes,9,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间 (可选)"`        // 结束时间 (可选)
+	Page          int32                  `protobuf:"varint,10,opt,name=page,proto3" json:"page" dc:"页码"`                                  // 页码
+	Size          int32                  `protobuf:"varint,11,opt,name=size,proto3" json:"size" dc:"每页数量"`                                // 每页数量
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -535,26 +535,26 @@ func (x *GetRechargePaymentsReq) GetSize() int32 {
 // 充值记录信息
 type RechargePaymentInfo struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID"`                                                             // ID
-	UserId             int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID"`   // 用户ID
-	Username           string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名"`                                // 用户名
-	ActivityRechargeId int32                  `protobuf:"varint,4,opt,name=activity_recharge_id,json=activityRechargeId,proto3" json:"activity_recharge_id" dc:"充值活动ID" dc:"充值活动ID" dc:"充值活动ID" dc:"充值活动ID" dc:"充值活动ID" dc:"充值活动ID" dc:"充值活动ID" dc:"充值活动ID" dc:"充值活动ID" dc:"充值活动ID" dc:"充值活动ID" dc:"充值活动ID"` // 充值活动ID
-	Gateway            int32                  `protobuf:"varint,5,opt,name=gateway,proto3" json:"gateway" dc:"网关类型" dc:"网关类型" dc:"网关类型" dc:"网关类型" dc:"网关类型" dc:"网关类型" dc:"网关类型" dc:"网关类型" dc:"网关类型" dc:"网关类型" dc:"网关类型" dc:"网关类型" dc:"网关类型" dc:"网关类型" dc:"网关类型" dc:"网关类型" dc:"网关类型" dc:"网关类型"`               // 网关类型
-	GatewayName        string                 `protobuf:"bytes,6,opt,name=gateway_name,json=gatewayName,proto3" json:"gateway_name" dc:"网关名称" dc:"网关名称" dc:"网关名称" dc:"网关名称" dc:"网关名称" dc:"网关名称" dc:"网关名称" dc:"网关名称" dc:"网关名称" dc:"网关名称" dc:"网关名称" dc:"网关名称" dc:"网关名称" dc:"网关名称" dc:"网关名称" dc:"网关名称"`         // 网关名称
-	PaymentId          int32                  `protobuf:"varint,7,opt,name=payment_id,json=paymentId,proto3" json:"payment_id" dc:"支付ID" dc:"支付ID" dc:"支付ID" dc:"支付ID" dc:"支付ID" dc:"支付ID" dc:"支付ID" dc:"支付ID" dc:"支付ID" dc:"支付ID" dc:"支付ID" dc:"支付ID" dc:"支付ID" dc:"支付ID" dc:"支付ID" dc:"支付ID" dc:"支付ID"`    // 支付ID
-	PaymentName        string                 `protobuf:"bytes,8,opt,name=payment_name,json=paymentName,proto3" json:"payment_name" dc:"支付名称" dc:"支付名称" dc:"支付名称" dc:"支付名称" dc:"支付名称" dc:"支付名称" dc:"支付名称" dc:"支付名称" dc:"支付名称" dc:"支付名称" dc:"支付名称" dc:"支付名称" dc:"支付名称" dc:"支付名称" dc:"支付名称" dc:"支付名称"`         // 支付名称
-	PaymentAccountId   int32                  `protobuf:"varint,9,opt,name=payment_account_id,json=paymentAccountId,proto3" json:"payment_account_id" dc:"支付账号ID" dc:"支付账号ID" dc:"支付账号ID" dc:"支付账号ID" dc:"支付账号ID" dc:"支付账号ID" dc:"支付账号ID" dc:"支付账号ID" dc:"支付账号ID" dc:"支付账号ID" dc:"支付账号ID" dc:"支付账号ID"`       // 支付账号ID
-	BankValue          string                 `protobuf:"bytes,10,opt,name=bank_value,json=bankValue,proto3" json:"bank_value" dc:"银行代码" dc:"银行代码" dc:"银行代码" dc:"银行代码" dc:"银行代码" dc:"银行代码" dc:"银行代码" dc:"银行代码" dc:"银行代码" dc:"银行代码" dc:"银行代码" dc:"银行代码" dc:"银行代码" dc:"银行代码" dc:"银行代码" dc:"银行代码" dc:"银行代码"`    // 银行代码
-	TradeNo            string                 `protobuf:"bytes,11,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号"`                  // 流水号
-	Money              float64                `protobuf:"fixed64,12,opt,name=money,proto3" json:"money" dc:"充值金额" dc:"充值金额" dc:"充值金额" dc:"充值金额" dc:"充值金额" dc:"充值金额" dc:"充值金额" dc:"充值金额" dc:"充值金额" dc:"充值金额" dc:"充值金额" dc:"充值金额" dc:"充值金额" dc:"充值金额" dc:"充值金额" dc:"充值金额" dc:"充值金额" dc:"充值金额"`                 // 充值金额
-	Fee                float64                `protobuf:"fixed64,13,opt,name=fee,proto3" json:"fee" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费"`                                       // 手续费
-	Status             int32                  `protobuf:"varint,14,opt,name=status,proto3" json:"status" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态"`                                                    // 状态
-	StatusName         string                 `protobuf:"bytes,15,opt,name=status_name,json=statusName,proto3" json:"status_name" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称"` // 状态名称
-	AdminId            int32                  `protobuf:"varint,16,opt,name=admin_id,json=adminId,proto3" json:"admin_id" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID"`   // 管理员ID
-	AdminName          string                 `protobuf:"bytes,17,opt,name=admin_name,json=adminName,proto3" json:"admin_name" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称"`         // 管理员名称
-	Remark             string                 `protobuf:"bytes,18,opt,name=remark,proto3" json:"remark" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注"`                                                     // 备注
-	CreatedAt          string                 `protobuf:"bytes,19,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间"`    // 创建时间
-	UpdatedAt          string                 `protobuf:"bytes,20,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间"`    // 更新时间
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"ID"`                                                                 // ID
+	UserId             int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"用户ID"`                                         // 用户ID
+	Username           string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username" dc:"用户名"`                                                     // 用户名
+	ActivityRechargeId int32                  `protobuf:"varint,4,opt,name=activity_recharge_id,json=activityRechargeId,proto3" json:"activity_recharge_id" dc:"充值活动ID"` // 充值活动ID
+	Gateway            int32                  `protobuf:"varint,5,opt,name=gateway,proto3" json:"gateway" dc:"网关类型"`                                                     // 网关类型
+	GatewayName        string                 `protobuf:"bytes,6,opt,name=gateway_name,json=gatewayName,proto3" json:"gateway_name" dc:"网关名称"`                           // 网关名称
+	PaymentId          int32                  `protobuf:"varint,7,opt,name=payment_id,json=paymentId,proto3" json:"payment_id" dc:"支付ID"`                                // 支付ID
+	PaymentName        string                 `protobuf:"bytes,8,opt,name=payment_name,json=paymentName,proto3" json:"payment_name" dc:"支付名称"`                           // 支付名称
+	PaymentAccountId   int32                  `protobuf:"varint,9,opt,name=payment_account_id,json=paymentAccountId,proto3" json:"payment_account_id" dc:"支付账号ID"`       // 支付账号ID
+	BankValue          string                 `protobuf:"bytes,10,opt,name=bank_value,json=bankValue,proto3" json:"bank_value" dc:"银行代码"`                                // 银行代码
+	TradeNo            string                 `protobuf:"bytes,11,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no" dc:"流水号"`                                       // 流水号
+	Money              float64                `protobuf:"fixed64,12,opt,name=money,proto3" json:"money" dc:"充值金额"`                                                       // 充值金额
+	Fee                float64                `protobuf:"fixed64,13,opt,name=fee,proto3" json:"fee" dc:"手续费"`                                                            // 手续费
+	Status             int32                  `protobuf:"varint,14,opt,name=status,proto3" json:"status" dc:"状态"`                                                        // 状态
+	StatusName         string                 `protobuf:"bytes,15,opt,name=status_name,json=statusName,proto3" json:"status_name" dc:"状态名称"`                             // 状态名称
+	AdminId            int32                  `protobuf:"varint,16,opt,name=admin_id,json=adminId,proto3" json:"admin_id" dc:"管理员ID"`                                    // 管理员ID
+	AdminName          string                 `protobuf:"bytes,17,opt,name=admin_name,json=adminName,proto3" json:"admin_name" dc:"管理员名称"`                               // 管理员名称
+	Remark             string                 `protobuf:"bytes,18,opt,name=remark,proto3" json:"remark" dc:"备注"`                                                         // 备注
+	CreatedAt          string                 `protobuf:"bytes,19,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间"`                                // 创建时间
+	UpdatedAt          string                 `protobuf:"bytes,20,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at" dc:"更新时间"`                                // 更新时间
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -785,12 +785,12 @@ func (x *GetRechargePaymentsRes) GetCount() int32 {
 // 获取后台加款记录请求
 type GetRechargeManualsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)"`          // 用户名 (可选)
-	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)"` // 状态 (可选)
-	StartTime     string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)"`       // 开始时间 (可选)
-	EndTime       string                 `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)"`             // 结束时间 (可选)
-	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码"`                                                                     // 页码
-	Size          int32                  `protobuf:"varint,6,opt,name=size,proto3" json:"size" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量"`                                 // 每页数量
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username" dc:"用户名 (可选)"`                     // 用户名 (可选)
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status" dc:"状态 (可选)"`                         // 状态 (可选)
+	StartTime     string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间 (可选)"` // 开始时间 (可选)
+	EndTime       string                 `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间 (可选)"`       // 结束时间 (可选)
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page" dc:"页码"`                                  // 页码
+	Size          int32                  `protobuf:"varint,6,opt,name=size,proto3" json:"size" dc:"每页数量"`                                // 每页数量
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -870,17 +870,17 @@ func (x *GetRechargeManualsReq) GetSize() int32 {
 // 后台加款记录信息
 type RechargeManualInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID"`                                         // ID
-	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID"`   // 用户ID
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名"`            // 用户名
-	TradeNo       string                 `protobuf:"bytes,4,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号"`        // 流水号
-	Money         float64                `protobuf:"fixed64,5,opt,name=money,proto3" json:"money" dc:"加款金额" dc:"加款金额" dc:"加款金额" dc:"加款金额" dc:"加款金额" dc:"加款金额" dc:"加款金额" dc:"加款金额" dc:"加款金额" dc:"加款金额" dc:"加款金额" dc:"加款金额" dc:"加款金额" dc:"加款金额" dc:"加款金额" dc:"加款金额" dc:"加款金额"`        // 加款金额
-	Status        int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态"`                                 // 状态
-	StatusName    string                 `protobuf:"bytes,7,opt,name=status_name,json=statusName,proto3" json:"status_name" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称"`  // 状态名称
-	AdminId       int32                  `protobuf:"varint,8,opt,name=admin_id,json=adminId,proto3" json:"admin_id" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID"`      // 管理员ID
-	AdminName     string                 `protobuf:"bytes,9,opt,name=admin_name,json=adminName,proto3" json:"admin_name" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称"` // 管理员名称
-	Remark        string                 `protobuf:"bytes,10,opt,name=remark,proto3" json:"remark" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注"`                                 // 备注
-	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间"`    // 创建时间
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"ID"`                                    // ID
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"用户ID"`            // 用户ID
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username" dc:"用户名"`                        // 用户名
+	TradeNo       string                 `protobuf:"bytes,4,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no" dc:"流水号"`           // 流水号
+	Money         float64                `protobuf:"fixed64,5,opt,name=money,proto3" json:"money" dc:"加款金额"`                           // 加款金额
+	Status        int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status" dc:"状态"`                            // 状态
+	StatusName    string                 `protobuf:"bytes,7,opt,name=status_name,json=statusName,proto3" json:"status_name" dc:"状态名称"` // 状态名称
+	AdminId       int32                  `protobuf:"varint,8,opt,name=admin_id,json=adminId,proto3" json:"admin_id" dc:"管理员ID"`        // 管理员ID
+	AdminName     string                 `protobuf:"bytes,9,opt,name=admin_name,json=adminName,proto3" json:"admin_name" dc:"管理员名称"`   // 管理员名称
+	Remark        string                 `protobuf:"bytes,10,opt,name=remark,proto3" json:"remark" dc:"备注"`                            // 备注
+	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间"`   // 创建时间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -995,8 +995,8 @@ func (x *RechargeManualInfo) GetCreatedAt() string {
 // 获取后台加款记录响应
 type GetRechargeManualsRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	List          []*RechargeManualInfo  `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"后台加款记录列表" dc:"后台加款记录列表" dc:"后台加款记录列表" dc:"后台加款记录列表" dc:"后台加款记录列表" dc:"后台加款记录列表"` // 后台加款记录列表
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count" dc:"总数量" dc:"总数量" dc:"总数量" dc:"总数量" dc:"总数量" dc:"总数量" dc:"总数量" dc:"总数量" dc:"总数量"` // 总数量
+	List          []*RechargeManualInfo  `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"后台加款记录列表"` // 后台加款记录列表
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count" dc:"总数量"`   // 总数量
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1048,7 +1048,7 @@ func (x *GetRechargeManualsRes) GetCount() int32 {
 // 确认支付订单请求
 type ConfirmPaymentOrderReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"订单ID" dc:"订单ID"` // 订单ID
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"订单ID"`           // 订单ID
 	Remark        string                 `protobuf:"bytes,2,opt,name=remark,proto3" json:"remark" dc:"备注 (可选)"` // 备注 (可选)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1101,8 +1101,8 @@ func (x *ConfirmPaymentOrderReq) GetRemark() string {
 // 确认支付订单响应
 type ConfirmPaymentOrderRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功"`          // 是否成功
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息"` // 响应消息
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1151,24 +1151,130 @@ func (x *ConfirmPaymentOrderRes) GetMessage() string {
 	return ""
 }
 
+// 入款订单创建通知请求，由会员端创建入款订单后调用，推送给后台 deposit 主题
+type NotifyRechargeCreatedReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TradeType     int32                  `protobuf:"varint,1,opt,name=trade_type,json=tradeType,proto3" json:"trade_type" dc:"入款类型 1=在线入款 2=转账入款"` // 入款类型 1=在线入款 2=转账入款
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id" dc:"订单ID"`                                              // 订单ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifyRechargeCreatedReq) Reset() {
+	*x = NotifyRechargeCreatedReq{}
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyRechargeCreatedReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyRechargeCreatedReq) ProtoMessage() {}
+
+func (x *NotifyRechargeCreatedReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyRechargeCreatedReq.ProtoReflect.Descriptor instead.
+func (*NotifyRechargeCreatedReq) Descriptor() ([]byte, []int) {
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NotifyRechargeCreatedReq) GetTradeType() int32 {
+	if x != nil {
+		return x.TradeType
+	}
+	return 0
+}
+
+func (x *NotifyRechargeCreatedReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 入款订单创建通知响应
+type NotifyRechargeCreatedRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifyRechargeCreatedRes) Reset() {
+	*x = NotifyRechargeCreatedRes{}
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyRechargeCreatedRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyRechargeCreatedRes) ProtoMessage() {}
+
+func (x *NotifyRechargeCreatedRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyRechargeCreatedRes.ProtoReflect.Descriptor instead.
+func (*NotifyRechargeCreatedRes) Descriptor() ([]byte, []int) {
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *NotifyRechargeCreatedRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *NotifyRechargeCreatedRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 // 获取提现记录请求
 type GetWithdrawsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)"`              // 用户名 (可选)
-	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)"`      // 状态 (可选)
-	TradeNo       string                 `protobuf:"bytes,3,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)" dc:"流水号 (可选)"`               // 流水号 (可选)
-	Domain        string                 `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)" dc:"域名 (可选)"`       // 域名 (可选)
-	StartTime     string                 `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)"`          // 开始时间 (可选)
-	EndTime       string                 `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)"` // 结束时间 (可选)
-	Page          int32                  `protobuf:"varint,7,opt,name=page,proto3" json:"page" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码"`                                                                                       // 页码
-	Size          int32                  `protobuf:"varint,8,opt,name=size,proto3" json:"size" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量"`                                                   // 每页数量
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username" dc:"用户名 (可选)"`                     // 用户名 (可选)
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status" dc:"状态 (可选)"`                         // 状态 (可选)
+	TradeNo       string                 `protobuf:"bytes,3,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no" dc:"流水号 (可选)"`        // 流水号 (可选)
+	Domain        string                 `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain" dc:"域名 (可选)"`                          // 域名 (可选)
+	StartTime     string                 `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间 (可选)"` // 开始时间 (可选)
+	EndTime       string                 `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间 (可选)"`       // 结束时间 (可选)
+	Page          int32                  `protobuf:"varint,7,opt,name=page,proto3" json:"page" dc:"页码"`                                  // 页码
+	Size          int32                  `protobuf:"varint,8,opt,name=size,proto3" json:"size" dc:"每页数量"`                                // 每页数量
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetWithdrawsReq) Reset() {
 	*x = GetWithdrawsReq{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[13]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1180,7 +1286,7 @@ func (x *GetWithdrawsReq) String() string {
 func (*GetWithdrawsReq) ProtoMessage() {}
 
 func (x *GetWithdrawsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[13]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1193,7 +1299,7 @@ func (x *GetWithdrawsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWithdrawsReq.ProtoReflect.Descriptor instead.
 func (*GetWithdrawsReq) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{13}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetWithdrawsReq) GetUsername() string {
@@ -1255,31 +1361,31 @@ func (x *GetWithdrawsReq) GetSize() int32 {
 // 提现记录信息
 type WithdrawInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID"`                                                 // ID
-	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID"` // 用户ID
-	UserLevelId   int32                  `protobuf:"varint,3,opt,name=user_level_id,json=userLevelId,proto3" json:"user_level_id" dc:"用户层级ID" dc:"用户层级ID" dc:"用户层级ID" dc:"用户层级ID" dc:"用户层级ID" dc:"用户层级ID" dc:"用户层级ID" dc:"用户层级ID" dc:"用户层级ID" dc:"用户层级ID" dc:"用户层级ID" dc:"用户层级ID"`          // 用户层级ID
-	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名"`                    // 用户名
-	TradeNo       string                 `protobuf:"bytes,5,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号"`       // 流水号
-	Money         float64                `protobuf:"fixed64,6,opt,name=money,proto3" json:"money" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额"`      // 提现金额
-	Fee           float64                `protobuf:"fixed64,7,opt,name=fee,proto3" json:"fee" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费"`                            // 手续费
-	BankName      string                 `protobuf:"bytes,8,opt,name=bank_name,json=bankName,proto3" json:"bank_name" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称"`      // 银行名称
-	CardAccount   string                 `protobuf:"bytes,9,opt,name=card_account,json=cardAccount,proto3" json:"card_account" dc:"银行户名" dc:"银行户名" dc:"银行户名" dc:"银行户名" dc:"银行户名" dc:"银行户名" dc:"银行户名" dc:"银行户名" dc:"银行户名" dc:"银行户名" dc:"银行户名" dc:"银行户名" dc:"银行户名" dc:"银行户名" dc:"银行户名"`       // 银行户名
-	CardNo        string                 `protobuf:"bytes,10,opt,name=card_no,json=cardNo,proto3" json:"card_no" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号"`                           // 卡号
-	DepositBank   string                 `protobuf:"bytes,11,opt,name=deposit_bank,json=depositBank,proto3" json:"deposit_bank" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行"`   // 开户行
-	Status        int32                  `protobuf:"varint,12,opt,name=status,proto3" json:"status" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态"`                                        // 状态
-	StatusName    string                 `protobuf:"bytes,13,opt,name=status_name,json=statusName,proto3" json:"status_name" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称"`         // 状态名称
-	AdminId       int32                  `protobuf:"varint,14,opt,name=admin_id,json=adminId,proto3" json:"admin_id" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID"`  // 管理员ID
-	AdminName     string                 `protobuf:"bytes,15,opt,name=admin_name,json=adminName,proto3" json:"admin_name" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称"`        // 管理员名称
-	Remark        string                 `protobuf:"bytes,16,opt,name=remark,proto3" json:"remark" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注"`                                         // 备注
-	CreatedAt     string                 `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间"`  // 创建时间
-	UpdatedAt     string                 `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间"`  // 更新时间
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"ID"`                                            // ID
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"用户ID"`                    // 用户ID
+	UserLevelId   int32                  `protobuf:"varint,3,opt,name=user_level_id,json=userLevelId,proto3" json:"user_level_id" dc:"用户层级ID"` // 用户层级ID
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username" dc:"用户名"`                                // 用户名
+	TradeNo       string                 `protobuf:"bytes,5,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no" dc:"流水号"`                   // 流水号
+	Money         float64                `protobuf:"fixed64,6,opt,name=money,proto3" json:"money" dc:"提现金额"`                                   // 提现金额
+	Fee           float64                `protobuf:"fixed64,7,opt,name=fee,proto3" json:"fee" dc:"手续费"`                                        // 手续费
+	BankName      string                 `protobuf:"bytes,8,opt,name=bank_name,json=bankName,proto3" json:"bank_name" dc:"银行名称"`               // 银行名称
+	CardAccount   string                 `protobuf:"bytes,9,opt,name=card_account,json=cardAccount,proto3" json:"card_account" dc:"银行户名"`      // 银行户名
+	CardNo        string                 `protobuf:"bytes,10,opt,name=card_no,json=cardNo,proto3" json:"card_no" dc:"卡号"`                      // 卡号
+	DepositBank   string                 `protobuf:"bytes,11,opt,name=deposit_bank,json=depositBank,proto3" json:"deposit_bank" dc:"开户行"`      // 开户行
+	Status        int32                  `protobuf:"varint,12,opt,name=status,proto3" json:"status" dc:"状态"`                                   // 状态
+	StatusName    string                 `protobuf:"bytes,13,opt,name=status_name,json=statusName,proto3" json:"status_name" dc:"状态名称"`        // 状态名称
+	AdminId       int32                  `protobuf:"varint,14,opt,name=admin_id,json=adminId,proto3" json:"admin_id" dc:"管理员ID"`               // 管理员ID
+	AdminName     string                 `protobuf:"bytes,15,opt,name=admin_name,json=adminName,proto3" json:"admin_name" dc:"管理员名称"`          // 管理员名称
+	Remark        string                 `protobuf:"bytes,16,opt,name=remark,proto3" json:"remark" dc:"备注"`                                    // 备注
+	CreatedAt     string                 `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间"`           // 创建时间
+	UpdatedAt     string                 `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at" dc:"更新时间"`           // 更新时间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WithdrawInfo) Reset() {
 	*x = WithdrawInfo{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[14]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1291,7 +1397,7 @@ func (x *WithdrawInfo) String() string {
 func (*WithdrawInfo) ProtoMessage() {}
 
 func (x *WithdrawInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[14]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1304,7 +1410,7 @@ func (x *WithdrawInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawInfo.ProtoReflect.Descriptor instead.
 func (*WithdrawInfo) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{14}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *WithdrawInfo) GetId() int64 {
@@ -1444,7 +1550,7 @@ type GetWithdrawsRes struct {
 
 func (x *GetWithdrawsRes) Reset() {
 	*x = GetWithdrawsRes{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[15]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1456,7 +1562,7 @@ func (x *GetWithdrawsRes) String() string {
 func (*GetWithdrawsRes) ProtoMessage() {}
 
 func (x *GetWithdrawsRes) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[15]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1469,7 +1575,7 @@ func (x *GetWithdrawsRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWithdrawsRes.ProtoReflect.Descriptor instead.
 func (*GetWithdrawsRes) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{15}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetWithdrawsRes) GetList() []*WithdrawInfo {
@@ -1489,19 +1595,19 @@ func (x *GetWithdrawsRes) GetCount() int32 {
 // 获取后台提现记录请求
 type GetWithdrawManualsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)" dc:"用户名 (可选)"`          // 用户名 (可选)
-	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)"` // 状态 (可选)
-	StartTime     string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)" dc:"开始时间 (可选)"`       // 开始时间 (可选)
-	EndTime       string                 `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)" dc:"结束时间 (可选)"`             // 结束时间 (可选)
-	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码"`                                                                     // 页码
-	Size          int32                  `protobuf:"varint,6,opt,name=size,proto3" json:"size" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量"`                                 // 每页数量
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username" dc:"用户名 (可选)"`                     // 用户名 (可选)
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status" dc:"状态 (可选)"`                         // 状态 (可选)
+	StartTime     string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间 (可选)"` // 开始时间 (可选)
+	EndTime       string                 `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间 (可选)"`       // 结束时间 (可选)
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page" dc:"页码"`                                  // 页码
+	Size          int32                  `protobuf:"varint,6,opt,name=size,proto3" json:"size" dc:"每页数量"`                                // 每页数量
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetWithdrawManualsReq) Reset() {
 	*x = GetWithdrawManualsReq{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[16]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1513,7 +1619,7 @@ func (x *GetWithdrawManualsReq) String() string {
 func (*GetWithdrawManualsReq) ProtoMessage() {}
 
 func (x *GetWithdrawManualsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[16]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1526,7 +1632,7 @@ func (x *GetWithdrawManualsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWithdrawManualsReq.ProtoReflect.Descriptor instead.
 func (*GetWithdrawManualsReq) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{16}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetWithdrawManualsReq) GetUsername() string {
@@ -1574,24 +1680,24 @@ func (x *GetWithdrawManualsReq) GetSize() int32 {
 // 后台提现记录信息
 type WithdrawManualInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID"`                                         // ID
-	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID"`   // 用户ID
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名"`            // 用户名
-	TradeNo       string                 `protobuf:"bytes,4,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号"`        // 流水号
-	Money         float64                `protobuf:"fixed64,5,opt,name=money,proto3" json:"money" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额"`        // 提现金额
-	Status        int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态"`                                 // 状态
-	StatusName    string                 `protobuf:"bytes,7,opt,name=status_name,json=statusName,proto3" json:"status_name" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称"`  // 状态名称
-	AdminId       int32                  `protobuf:"varint,8,opt,name=admin_id,json=adminId,proto3" json:"admin_id" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID" dc:"管理员ID"`      // 管理员ID
-	AdminName     string                 `protobuf:"bytes,9,opt,name=admin_name,json=adminName,proto3" json:"admin_name" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称" dc:"管理员名称"` // 管理员名称
-	Remark        string                 `protobuf:"bytes,10,opt,name=remark,proto3" json:"remark" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注"`                                 // 备注
-	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间"`    // 创建时间
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"ID"`                                    // ID
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"用户ID"`            // 用户ID
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username" dc:"用户名"`                        // 用户名
+	TradeNo       string                 `protobuf:"bytes,4,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no" dc:"流水号"`           // 流水号
+	Money         float64                `protobuf:"fixed64,5,opt,name=money,proto3" json:"money" dc:"提现金额"`                           // 提现金额
+	Status        int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status" dc:"状态"`                            // 状态
+	StatusName    string                 `protobuf:"bytes,7,opt,name=status_name,json=statusName,proto3" json:"status_name" dc:"状态名称"` // 状态名称
+	AdminId       int32                  `protobuf:"varint,8,opt,name=admin_id,json=adminId,proto3" json:"admin_id" dc:"管理员ID"`        // 管理员ID
+	AdminName     string                 `protobuf:"bytes,9,opt,name=admin_name,json=adminName,proto3" json:"admin_name" dc:"管理员名称"`   // 管理员名称
+	Remark        string                 `protobuf:"bytes,10,opt,name=remark,proto3" json:"remark" dc:"备注"`                            // 备注
+	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间"`   // 创建时间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WithdrawManualInfo) Reset() {
 	*x = WithdrawManualInfo{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[17]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1603,7 +1709,7 @@ func (x *WithdrawManualInfo) String() string {
 func (*WithdrawManualInfo) ProtoMessage() {}
 
 func (x *WithdrawManualInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[17]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1616,7 +1722,7 @@ func (x *WithdrawManualInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawManualInfo.ProtoReflect.Descriptor instead.
 func (*WithdrawManualInfo) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{17}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *WithdrawManualInfo) GetId() int64 {
@@ -1699,15 +1805,15 @@ func (x *WithdrawManualInfo) GetCreatedAt() string {
 // 获取后台提现记录响应
 type GetWithdrawManualsRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	List          []*WithdrawManualInfo  `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"后台提现记录列表" dc:"后台提现记录列表" dc:"后台提现记录列表" dc:"后台提现记录列表" dc:"后台提现记录列表" dc:"后台提现记录列表"` // 后台提现记录列表
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count" dc:"总数量" dc:"总数量" dc:"总数量" dc:"总数量" dc:"总数量" dc:"总数量" dc:"总数量" dc:"总数量" dc:"总数量"` // 总数量
+	List          []*WithdrawManualInfo  `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"后台提现记录列表"` // 后台提现记录列表
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count" dc:"总数量"`   // 总数量
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetWithdrawManualsRes) Reset() {
 	*x = GetWithdrawManualsRes{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[18]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1719,7 +1825,7 @@ func (x *GetWithdrawManualsRes) String() string {
 func (*GetWithdrawManualsRes) ProtoMessage() {}
 
 func (x *GetWithdrawManualsRes) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[18]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1732,7 +1838,7 @@ func (x *GetWithdrawManualsRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWithdrawManualsRes.ProtoReflect.Descriptor instead.
 func (*GetWithdrawManualsRes) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{18}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetWithdrawManualsRes) GetList() []*WithdrawManualInfo {
@@ -1759,7 +1865,7 @@ type GetWithdrawReviewReq struct {
 
 func (x *GetWithdrawReviewReq) Reset() {
 	*x = GetWithdrawReviewReq{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[19]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1771,7 +1877,7 @@ func (x *GetWithdrawReviewReq) String() string {
 func (*GetWithdrawReviewReq) ProtoMessage() {}
 
 func (x *GetWithdrawReviewReq) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[19]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1784,7 +1890,7 @@ func (x *GetWithdrawReviewReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWithdrawReviewReq.ProtoReflect.Descriptor instead.
 func (*GetWithdrawReviewReq) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{19}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetWithdrawReviewReq) GetId() int64 {
@@ -1796,36 +1902,34 @@ func (x *GetWithdrawReviewReq) GetId() int64 {
 
 // 提现审核信息
 type WithdrawReviewInfo struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID"`                                  // ID
-	UserId      int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID"`      // 用户ID
-	Username    string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名"`     // 用户名
-	TradeNo     string                 `protobuf:"bytes,4,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号" dc:"流水号"` // 流水号
-	Money       float64                `protobuf:"fixed64,5,opt,name=money,proto3" json:"money" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额" dc:"提现金额"` // 提现金额
-	Fee         float64                `protobuf:"fixed64,6,opt,name=fee,proto3" json:"fee" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费" dc:"手续费"`             // 手续费
-	BankName    string                 `protobuf:"bytes,7,opt,name=bank_name,json=bankName,proto3" json:"bank_name" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称" dc:"银行名称"` // 银行名称
-	CardAccount string                 `protobuf:"bytes,8,opt,name=card_account,json=cardAccount,proto3" json:"card_account" dc:"银行户名" dc:"银行户名" dc:"银行户名" dc:"银行户名" dc:"银行户名" dc:"银行户名" dc:"银行户名" dc:"银行户名" dc:"银行户名" dc:"银行户名" dc:"银行户名" dc:"银行户名" dc:"银行户名" dc:"银行户名"`  // 银行户名
-	CardNo      string                 `protobuf:"bytes,9,opt,name=card_no,json=cardNo,proto3" json:"card_no" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号" dc:"卡号"`             // 卡号
-	DepositBank string                 `protobuf:"bytes,10,opt,name=deposit_bank,json=depositBank,proto3" json:"deposit_bank" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行" dc:"开户行"`      // 开户行
-	Status      int32                  `protobuf:"varint,11,opt,name=status,proto3" json:"status" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态"`                         // 状态
-	Remark      string                 `protobuf:"bytes,12,opt,name=remark,proto3" json:"remark" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注"`                          // 备注
-	CreatedAt   string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间"`       // 创建时间
-	// 用户信息
-	UserRealname      string  `protobuf:"bytes,14,opt,name=user_realname,json=userRealname,proto3" json:"user_realname" dc:"用户信息用户真实姓名" dc:"用户信息用户真实姓名" dc:"用户信息用户真实姓名" dc:"用户信息用户真实姓名" dc:"用户信息用户真实姓名" dc:"用户信息用户真实姓名" dc:"用户信息用户真实姓名" dc:"用户信息用户真实姓名" dc:"用户信息用户真实姓名" dc:"用户信息用户真实姓名"`           // 用户真实姓名
-	UserMobile        string  `protobuf:"bytes,15,opt,name=user_mobile,json=userMobile,proto3" json:"user_mobile" dc:"用户手机号" dc:"用户手机号" dc:"用户手机号" dc:"用户手机号" dc:"用户手机号" dc:"用户手机号" dc:"用户手机号" dc:"用户手机号" dc:"用户手机号" dc:"用户手机号" dc:"用户手机号" dc:"用户手机号" dc:"用户手机号" dc:"用户手机号" dc:"用户手机号" dc:"用户手机号"` // 用户手机号
-	UserBalance       float64 `protobuf:"fixed64,16,opt,name=user_balance,json=userBalance,proto3" json:"user_balance" dc:"用户余额" dc:"用户余额" dc:"用户余额" dc:"用户余额" dc:"用户余额" dc:"用户余额" dc:"用户余额" dc:"用户余额" dc:"用户余额" dc:"用户余额" dc:"用户余额" dc:"用户余额" dc:"用户余额" dc:"用户余额" dc:"用户余额" dc:"用户余额"`            // 用户余额
-	UserBalanceFrozen float64 `protobuf:"fixed64,17,opt,name=user_balance_frozen,json=userBalanceFrozen,proto3" json:"user_balance_frozen" dc:"用户冻结余额" dc:"用户冻结余额" dc:"用户冻结余额" dc:"用户冻结余额" dc:"用户冻结余额" dc:"用户冻结余额" dc:"用户冻结余额" dc:"用户冻结余额" dc:"用户冻结余额" dc:"用户冻结余额" dc:"用户冻结余额" dc:"用户冻结余额"`        // 用户冻结余额
-	// 统计信息
-	TotalRecharge float64 `protobuf:"fixed64,18,opt,name=total_recharge,json=totalRecharge,proto3" json:"total_recharge" dc:"统计信息总充值" dc:"统计信息总充值" dc:"统计信息总充值" dc:"统计信息总充值" dc:"统计信息总充值" dc:"统计信息总充值" dc:"统计信息总充值" dc:"统计信息总充值" dc:"统计信息总充值"`   // 总充值
-	TotalWithdraw float64 `protobuf:"fixed64,19,opt,name=total_withdraw,json=totalWithdraw,proto3" json:"total_withdraw" dc:"总提现" dc:"总提现" dc:"总提现" dc:"总提现" dc:"总提现" dc:"总提现" dc:"总提现" dc:"总提现" dc:"总提现" dc:"总提现" dc:"总提现" dc:"总提现" dc:"总提现"`   // 总提现
-	WithdrawCount int32   `protobuf:"varint,20,opt,name=withdraw_count,json=withdrawCount,proto3" json:"withdraw_count" dc:"提现次数" dc:"提现次数" dc:"提现次数" dc:"提现次数" dc:"提现次数" dc:"提现次数" dc:"提现次数" dc:"提现次数" dc:"提现次数" dc:"提现次数" dc:"提现次数" dc:"提现次数"` // 提现次数
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"ID"`                                                                // ID
+	UserId            int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"用户ID"`                                        // 用户ID
+	Username          string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username" dc:"用户名"`                                                    // 用户名
+	TradeNo           string                 `protobuf:"bytes,4,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no" dc:"流水号"`                                       // 流水号
+	Money             float64                `protobuf:"fixed64,5,opt,name=money,proto3" json:"money" dc:"提现金额"`                                                       // 提现金额
+	Fee               float64                `protobuf:"fixed64,6,opt,name=fee,proto3" json:"fee" dc:"手续费"`                                                            // 手续费
+	BankName          string                 `protobuf:"bytes,7,opt,name=bank_name,json=bankName,proto3" json:"bank_name" dc:"银行名称"`                                   // 银行名称
+	CardAccount       string                 `protobuf:"bytes,8,opt,name=card_account,json=cardAccount,proto3" json:"card_account" dc:"银行户名"`                          // 银行户名
+	CardNo            string                 `protobuf:"bytes,9,opt,name=card_no,json=cardNo,proto3" json:"card_no" dc:"卡号"`                                           // 卡号
+	DepositBank       string                 `protobuf:"bytes,10,opt,name=deposit_bank,json=depositBank,proto3" json:"deposit_bank" dc:"开户行"`                          // 开户行
+	Status            int32                  `protobuf:"varint,11,opt,name=status,proto3" json:"status" dc:"状态"`                                                       // 状态
+	Remark            string                 `protobuf:"bytes,12,opt,name=remark,proto3" json:"remark" dc:"备注"`                                                        // 备注
+	CreatedAt         string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间"`                               // 创建时间
+	UserRealname      string                 `protobuf:"bytes,14,opt,name=user_realname,json=userRealname,proto3" json:"user_realname" dc:"用户真实姓名"`                    // 用户真实姓名
+	UserMobile        string                 `protobuf:"bytes,15,opt,name=user_mobile,json=userMobile,proto3" json:"user_mobile" dc:"用户手机号"`                           // 用户手机号
+	UserBalance       float64                `protobuf:"fixed64,16,opt,name=user_balance,json=userBalance,proto3" json:"user_balance" dc:"用户余额"`                       // 用户余额
+	UserBalanceFrozen float64                `protobuf:"fixed64,17,opt,name=user_balance_frozen,json=userBalanceFrozen,proto3" json:"user_balance_frozen" dc:"用户冻结余额"` // 用户冻结余额
+	TotalRecharge     float64                `protobuf:"fixed64,18,opt,name=total_recharge,json=totalRecharge,proto3" json:"total_recharge" dc:"总充值"`                  // 总充值
+	TotalWithdraw     float64                `protobuf:"fixed64,19,opt,name=total_withdraw,json=totalWithdraw,proto3" json:"total_withdraw" dc:"总提现"`                  // 总提现
+	WithdrawCount     int32                  `protobuf:"varint,20,opt,name=withdraw_count,json=withdrawCount,proto3" json:"withdraw_count" dc:"提现次数"`                  // 提现次数
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *WithdrawReviewInfo) Reset() {
 	*x = WithdrawReviewInfo{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[20]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1837,7 +1941,7 @@ func (x *WithdrawReviewInfo) String() string {
 func (*WithdrawReviewInfo) ProtoMessage() {}
 
 func (x *WithdrawReviewInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[20]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1850,7 +1954,7 @@ func (x *WithdrawReviewInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawReviewInfo.ProtoReflect.Descriptor instead.
 func (*WithdrawReviewInfo) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{20}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *WithdrawReviewInfo) GetId() int64 {
@@ -2003,7 +2107,7 @@ type GetWithdrawReviewRes struct {
 
 func (x *GetWithdrawReviewRes) Reset() {
 	*x = GetWithdrawReviewRes{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[21]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2015,7 +2119,7 @@ func (x *GetWithdrawReviewRes) String() string {
 func (*GetWithdrawReviewRes) ProtoMessage() {}
 
 func (x *GetWithdrawReviewRes) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[21]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2028,7 +2132,7 @@ func (x *GetWithdrawReviewRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWithdrawReviewRes.ProtoReflect.Descriptor instead.
 func (*GetWithdrawReviewRes) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{21}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetWithdrawReviewRes) GetData() *WithdrawReviewInfo {
@@ -2041,17 +2145,17 @@ func (x *GetWithdrawReviewRes) GetData() *WithdrawReviewInfo {
 // 处理提现请求
 type DealWithWithdrawReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"提现记录ID" dc:"提现记录ID" dc:"提现记录ID" dc:"提现记录ID" dc:"提现记录ID" dc:"提现记录ID" dc:"提现记录ID" dc:"提现记录ID" dc:"提现记录ID" dc:"提现记录ID" dc:"提现记录ID" dc:"提现记录ID" dc:"提现记录ID" dc:"提现记录ID" dc:"提现记录ID" dc:"提现记录ID" dc:"提现记录ID"`          // 提现记录ID
-	Type          int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type" dc:"处理类型 1=确认 0=拒绝 2=补单" dc:"处理类型 1=确认 0=拒绝 2=补单" dc:"处理类型 1=确认 0=拒绝 2=补单" dc:"处理类型 1=确认 0=拒绝 2=补单" dc:"处理类型 1=确认 0=拒绝 2=补单" dc:"处理类型 1=确认 0=拒绝 2=补单" dc:"处理类型 1=确认 0=拒绝 2=补单" dc:"处理类型 1=确认 0=拒绝 2=补单"`          // 处理类型 1=确认 0=拒绝 2=补单
-	Fee           float64                `protobuf:"fixed64,3,opt,name=fee,proto3" json:"fee" dc:"手续费 (可选)" dc:"手续费 (可选)" dc:"手续费 (可选)" dc:"手续费 (可选)" dc:"手续费 (可选)" dc:"手续费 (可选)" dc:"手续费 (可选)" dc:"手续费 (可选)" dc:"手续费 (可选)" dc:"手续费 (可选)" dc:"手续费 (可选)" dc:"手续费 (可选)" dc:"手续费 (可选)" dc:"手续费 (可选)" dc:"手续费 (可选)"` // 手续费 (可选)
-	Remark        string                 `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark" dc:"备注 (可选)" dc:"备注 (可选)" dc:"备注 (可选)" dc:"备注 (可选)" dc:"备注 (可选)" dc:"备注 (可选)" dc:"备注 (可选)" dc:"备注 (可选)" dc:"备注 (可选)" dc:"备注 (可选)" dc:"备注 (可选)" dc:"备注 (可选)" dc:"备注 (可选)" dc:"备注 (可选)" dc:"备注 (可选)"`            // 备注 (可选)
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"提现记录ID"`                  // 提现记录ID
+	Type          int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type" dc:"处理类型 1=确认 0=拒绝 2=补单"` // 处理类型 1=确认 0=拒绝 2=补单
+	Fee           float64                `protobuf:"fixed64,3,opt,name=fee,proto3" json:"fee" dc:"手续费 (可选)"`             // 手续费 (可选)
+	Remark        string                 `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark" dc:"备注 (可选)"`          // 备注 (可选)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DealWithWithdrawReq) Reset() {
 	*x = DealWithWithdrawReq{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[22]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2063,7 +2167,7 @@ func (x *DealWithWithdrawReq) String() string {
 func (*DealWithWithdrawReq) ProtoMessage() {}
 
 func (x *DealWithWithdrawReq) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[22]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2076,7 +2180,7 @@ func (x *DealWithWithdrawReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DealWithWithdrawReq.ProtoReflect.Descriptor instead.
 func (*DealWithWithdrawReq) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{22}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *DealWithWithdrawReq) GetId() int64 {
@@ -2110,15 +2214,15 @@ func (x *DealWithWithdrawReq) GetRemark() string {
 // 处理提现响应
 type DealWithWithdrawRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功"`          // 是否成功
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息"` // 响应消息
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DealWithWithdrawRes) Reset() {
 	*x = DealWithWithdrawRes{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[23]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2130,7 +2234,7 @@ func (x *DealWithWithdrawRes) String() string {
 func (*DealWithWithdrawRes) ProtoMessage() {}
 
 func (x *DealWithWithdrawRes) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[23]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2143,7 +2247,7 @@ func (x *DealWithWithdrawRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DealWithWithdrawRes.ProtoReflect.Descriptor instead.
 func (*DealWithWithdrawRes) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{23}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *DealWithWithdrawRes) GetSuccess() bool {
@@ -2160,6 +2264,120 @@ func (x *DealWithWithdrawRes) GetMessage() string {
 	return ""
 }
 
+// 出款申请创建通知请求，由会员端创建出款申请后调用，推送给后台 withdraw 主题
+type NotifyWithdrawCreatedReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"用户ID"`  // 用户ID
+	TradeNo       string                 `protobuf:"bytes,2,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no" dc:"流水号"` // 流水号
+	Money         float64                `protobuf:"fixed64,3,opt,name=money,proto3" json:"money" dc:"提现金额"`                 // 提现金额
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifyWithdrawCreatedReq) Reset() {
+	*x = NotifyWithdrawCreatedReq{}
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyWithdrawCreatedReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyWithdrawCreatedReq) ProtoMessage() {}
+
+func (x *NotifyWithdrawCreatedReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyWithdrawCreatedReq.ProtoReflect.Descriptor instead.
+func (*NotifyWithdrawCreatedReq) Descriptor() ([]byte, []int) {
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *NotifyWithdrawCreatedReq) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *NotifyWithdrawCreatedReq) GetTradeNo() string {
+	if x != nil {
+		return x.TradeNo
+	}
+	return ""
+}
+
+func (x *NotifyWithdrawCreatedReq) GetMoney() float64 {
+	if x != nil {
+		return x.Money
+	}
+	return 0
+}
+
+// 出款申请创建通知响应
+type NotifyWithdrawCreatedRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifyWithdrawCreatedRes) Reset() {
+	*x = NotifyWithdrawCreatedRes{}
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyWithdrawCreatedRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyWithdrawCreatedRes) ProtoMessage() {}
+
+func (x *NotifyWithdrawCreatedRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyWithdrawCreatedRes.ProtoReflect.Descriptor instead.
+func (*NotifyWithdrawCreatedRes) Descriptor() ([]byte, []int) {
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *NotifyWithdrawCreatedRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *NotifyWithdrawCreatedRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 // 查询用户余额请求
 type QueryUserBalanceReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2170,7 +2388,7 @@ type QueryUserBalanceReq struct {
 
 func (x *QueryUserBalanceReq) Reset() {
 	*x = QueryUserBalanceReq{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[24]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2182,7 +2400,7 @@ func (x *QueryUserBalanceReq) String() string {
 func (*QueryUserBalanceReq) ProtoMessage() {}
 
 func (x *QueryUserBalanceReq) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[24]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2195,7 +2413,7 @@ func (x *QueryUserBalanceReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryUserBalanceReq.ProtoReflect.Descriptor instead.
 func (*QueryUserBalanceReq) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{24}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *QueryUserBalanceReq) GetUserId() int32 {
@@ -2208,19 +2426,19 @@ func (x *QueryUserBalanceReq) GetUserId() int32 {
 // 用户余额信息
 type UserBalanceInfo struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserId         int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID"`   // 用户ID
-	Username       string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名"`  // 用户名
-	Balance        float64                `protobuf:"fixed64,3,opt,name=balance,proto3" json:"balance" dc:"可用余额" dc:"可用余额" dc:"可用余额" dc:"可用余额" dc:"可用余额" dc:"可用余额" dc:"可用余额" dc:"可用余额" dc:"可用余额" dc:"可用余额" dc:"可用余额" dc:"可用余额" dc:"可用余额" dc:"可用余额" dc:"可用余额" dc:"可用余额"`    // 可用余额
-	BalanceFrozen  float64                `protobuf:"fixed64,4,opt,name=balance_frozen,json=balanceFrozen,proto3" json:"balance_frozen" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额" dc:"冻结余额"` // 冻结余额
-	Points         float64                `protobuf:"fixed64,5,opt,name=points,proto3" json:"points" dc:"积分" dc:"积分" dc:"积分" dc:"积分" dc:"积分" dc:"积分" dc:"积分" dc:"积分" dc:"积分" dc:"积分" dc:"积分" dc:"积分" dc:"积分" dc:"积分" dc:"积分" dc:"积分" dc:"积分" dc:"积分"`                      // 积分
-	LastUpdateTime string                 `protobuf:"bytes,6,opt,name=last_update_time,json=lastUpdateTime,proto3" json:"last_update_time" dc:"最后更新时间" dc:"最后更新时间" dc:"最后更新时间" dc:"最后更新时间" dc:"最后更新时间" dc:"最后更新时间" dc:"最后更新时间" dc:"最后更新时间" dc:"最后更新时间" dc:"最后更新时间"`        // 最后更新时间
+	UserId         int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"用户ID"`                            // 用户ID
+	Username       string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username" dc:"用户名"`                                        // 用户名
+	Balance        float64                `protobuf:"fixed64,3,opt,name=balance,proto3" json:"balance" dc:"可用余额"`                                       // 可用余额
+	BalanceFrozen  float64                `protobuf:"fixed64,4,opt,name=balance_frozen,json=balanceFrozen,proto3" json:"balance_frozen" dc:"冻结余额"`      // 冻结余额
+	Points         float64                `protobuf:"fixed64,5,opt,name=points,proto3" json:"points" dc:"积分"`                                           // 积分
+	LastUpdateTime string                 `protobuf:"bytes,6,opt,name=last_update_time,json=lastUpdateTime,proto3" json:"last_update_time" dc:"最后更新时间"` // 最后更新时间
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UserBalanceInfo) Reset() {
 	*x = UserBalanceInfo{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[25]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2232,7 +2450,7 @@ func (x *UserBalanceInfo) String() string {
 func (*UserBalanceInfo) ProtoMessage() {}
 
 func (x *UserBalanceInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[25]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2245,7 +2463,7 @@ func (x *UserBalanceInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserBalanceInfo.ProtoReflect.Descriptor instead.
 func (*UserBalanceInfo) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{25}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *UserBalanceInfo) GetUserId() int32 {
@@ -2300,7 +2518,7 @@ type QueryUserBalanceRes struct {
 
 func (x *QueryUserBalanceRes) Reset() {
 	*x = QueryUserBalanceRes{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[26]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2312,7 +2530,7 @@ func (x *QueryUserBalanceRes) String() string {
 func (*QueryUserBalanceRes) ProtoMessage() {}
 
 func (x *QueryUserBalanceRes) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[26]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2325,7 +2543,7 @@ func (x *QueryUserBalanceRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryUserBalanceRes.ProtoReflect.Descriptor instead.
 func (*QueryUserBalanceRes) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{26}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *QueryUserBalanceRes) GetData() *UserBalanceInfo {
@@ -2346,7 +2564,7 @@ type QueryGameBalanceReq struct {
 
 func (x *QueryGameBalanceReq) Reset() {
 	*x = QueryGameBalanceReq{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[27]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2358,7 +2576,7 @@ func (x *QueryGameBalanceReq) String() string {
 func (*QueryGameBalanceReq) ProtoMessage() {}
 
 func (x *QueryGameBalanceReq) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[27]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2371,7 +2589,7 @@ func (x *QueryGameBalanceReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryGameBalanceReq.ProtoReflect.Descriptor instead.
 func (*QueryGameBalanceReq) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{27}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *QueryGameBalanceReq) GetGameId() int32 {
@@ -2391,19 +2609,19 @@ func (x *QueryGameBalanceReq) GetUserId() int32 {
 // 游戏余额信息
 type GameBalanceInfo struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	GameId         int32                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id" dc:"游戏ID" dc:"游戏ID" dc:"游戏ID" dc:"游戏ID" dc:"游戏ID" dc:"游戏ID" dc:"游戏ID" dc:"游戏ID" dc:"游戏ID" dc:"游戏ID" dc:"游戏ID" dc:"游戏ID" dc:"游戏ID" dc:"游戏ID" dc:"游戏ID"`  // 游戏ID
-	GameName       string                 `protobuf:"bytes,2,opt,name=game_name,json=gameName,proto3" json:"game_name" dc:"游戏名称" dc:"游戏名称" dc:"游戏名称" dc:"游戏名称" dc:"游戏名称" dc:"游戏名称" dc:"游戏名称" dc:"游戏名称" dc:"游戏名称" dc:"游戏名称" dc:"游戏名称" dc:"游戏名称" dc:"游戏名称" dc:"游戏名称"`       // 游戏名称
-	UserId         int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID"`  // 用户ID
-	Username       string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名" dc:"用户名"` // 用户名
-	Balance        float64                `protobuf:"fixed64,5,opt,name=balance,proto3" json:"balance" dc:"游戏余额" dc:"游戏余额" dc:"游戏余额" dc:"游戏余额" dc:"游戏余额" dc:"游戏余额" dc:"游戏余额" dc:"游戏余额" dc:"游戏余额" dc:"游戏余额" dc:"游戏余额" dc:"游戏余额" dc:"游戏余额" dc:"游戏余额" dc:"游戏余额" dc:"游戏余额"`   // 游戏余额
-	LastUpdateTime string                 `protobuf:"bytes,6,opt,name=last_update_time,json=lastUpdateTime,proto3" json:"last_update_time" dc:"最后更新时间" dc:"最后更新时间" dc:"最后更新时间" dc:"最后更新时间" dc:"最后更新时间" dc:"最后更新时间" dc:"最后更新时间" dc:"最后更新时间" dc:"最后更新时间" dc:"最后更新时间"`       // 最后更新时间
+	GameId         int32                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id" dc:"游戏ID"`                            // 游戏ID
+	GameName       string                 `protobuf:"bytes,2,opt,name=game_name,json=gameName,proto3" json:"game_name" dc:"游戏名称"`                       // 游戏名称
+	UserId         int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"用户ID"`                            // 用户ID
+	Username       string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username" dc:"用户名"`                                        // 用户名
+	Balance        float64                `protobuf:"fixed64,5,opt,name=balance,proto3" json:"balance" dc:"游戏余额"`                                       // 游戏余额
+	LastUpdateTime string                 `protobuf:"bytes,6,opt,name=last_update_time,json=lastUpdateTime,proto3" json:"last_update_time" dc:"最后更新时间"` // 最后更新时间
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GameBalanceInfo) Reset() {
 	*x = GameBalanceInfo{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[28]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2415,7 +2633,7 @@ func (x *GameBalanceInfo) String() string {
 func (*GameBalanceInfo) ProtoMessage() {}
 
 func (x *GameBalanceInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[28]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2428,7 +2646,7 @@ func (x *GameBalanceInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameBalanceInfo.ProtoReflect.Descriptor instead.
 func (*GameBalanceInfo) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{28}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GameBalanceInfo) GetGameId() int32 {
@@ -2483,7 +2701,7 @@ type QueryGameBalanceRes struct {
 
 func (x *QueryGameBalanceRes) Reset() {
 	*x = QueryGameBalanceRes{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[29]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2495,7 +2713,7 @@ func (x *QueryGameBalanceRes) String() string {
 func (*QueryGameBalanceRes) ProtoMessage() {}
 
 func (x *QueryGameBalanceRes) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[29]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2508,7 +2726,7 @@ func (x *QueryGameBalanceRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryGameBalanceRes.ProtoReflect.Descriptor instead.
 func (*QueryGameBalanceRes) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{29}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *QueryGameBalanceRes) GetData() *GameBalanceInfo {
@@ -2518,20 +2736,243 @@ func (x *QueryGameBalanceRes) GetData() *GameBalanceInfo {
 	return nil
 }
 
+// 游戏转账请求，由会员端在会员转入或转出游戏时调用
+type TransferGameReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"用户ID"`            // 用户ID
+	GameId        int32                  `protobuf:"varint,2,opt,name=game_id,json=gameId,proto3" json:"game_id" dc:"游戏ID"`            // 游戏ID
+	Direction     int32                  `protobuf:"varint,3,opt,name=direction,proto3" json:"direction" dc:"转账方向 1=账户转入游戏 2=游戏转出到账户"` // 转账方向 1=账户转入游戏 2=游戏转出到账户
+	Money         float64                `protobuf:"fixed64,4,opt,name=money,proto3" json:"money" dc:"转账金额"`                           // 转账金额
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferGameReq) Reset() {
+	*x = TransferGameReq{}
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferGameReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferGameReq) ProtoMessage() {}
+
+func (x *TransferGameReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferGameReq.ProtoReflect.Descriptor instead.
+func (*TransferGameReq) Descriptor() ([]byte, []int) {
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TransferGameReq) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TransferGameReq) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *TransferGameReq) GetDirection() int32 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+func (x *TransferGameReq) GetMoney() float64 {
+	if x != nil {
+		return x.Money
+	}
+	return 0
+}
+
+// 游戏转账订单信息
+type GameTransferInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderNo       string                 `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no" dc:"本站订单号"`                     // 本站订单号
+	VendorOrderNo string                 `protobuf:"bytes,2,opt,name=vendor_order_no,json=vendorOrderNo,proto3" json:"vendor_order_no" dc:"厂商订单号"` // 厂商订单号
+	GameId        int32                  `protobuf:"varint,3,opt,name=game_id,json=gameId,proto3" json:"game_id" dc:"游戏ID"`                        // 游戏ID
+	Direction     int32                  `protobuf:"varint,4,opt,name=direction,proto3" json:"direction" dc:"转账方向 1=账户转入游戏 2=游戏转出到账户"`             // 转账方向 1=账户转入游戏 2=游戏转出到账户
+	Money         float64                `protobuf:"fixed64,5,opt,name=money,proto3" json:"money" dc:"转账金额"`                                       // 转账金额
+	Status        int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status" dc:"状态 0=处理中 1=成功 2=失败 3=待对账"`                  // 状态 0=处理中 1=成功 2=失败 3=待对账
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message" dc:"厂商返回信息"`                                   // 厂商返回信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameTransferInfo) Reset() {
+	*x = GameTransferInfo{}
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameTransferInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameTransferInfo) ProtoMessage() {}
+
+func (x *GameTransferInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameTransferInfo.ProtoReflect.Descriptor instead.
+func (*GameTransferInfo) Descriptor() ([]byte, []int) {
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GameTransferInfo) GetOrderNo() string {
+	if x != nil {
+		return x.OrderNo
+	}
+	return ""
+}
+
+func (x *GameTransferInfo) GetVendorOrderNo() string {
+	if x != nil {
+		return x.VendorOrderNo
+	}
+	return ""
+}
+
+func (x *GameTransferInfo) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *GameTransferInfo) GetDirection() int32 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+func (x *GameTransferInfo) GetMoney() float64 {
+	if x != nil {
+		return x.Money
+	}
+	return 0
+}
+
+func (x *GameTransferInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GameTransferInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 游戏转账响应，待对账的订单由对账任务完成，不需要重新发起
+type TransferGameRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功，转账失败时为 false"` // 是否成功，转账失败时为 false
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`               // 响应消息
+	Data          *GameTransferInfo      `protobuf:"bytes,3,opt,name=data,proto3" json:"data" dc:"转账订单，未创建订单时为空"`            // 转账订单，未创建订单时为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferGameRes) Reset() {
+	*x = TransferGameRes{}
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferGameRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferGameRes) ProtoMessage() {}
+
+func (x *TransferGameRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferGameRes.ProtoReflect.Descriptor instead.
+func (*TransferGameRes) Descriptor() ([]byte, []int) {
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TransferGameRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TransferGameRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TransferGameRes) GetData() *GameTransferInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 // 手动操作用户余额请求
 type ManualUserBalanceReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID" dc:"用户ID"`   // 用户ID
-	Type          int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type" dc:"操作类型 1=加款 2=扣款" dc:"操作类型 1=加款 2=扣款" dc:"操作类型 1=加款 2=扣款" dc:"操作类型 1=加款 2=扣款" dc:"操作类型 1=加款 2=扣款" dc:"操作类型 1=加款 2=扣款" dc:"操作类型 1=加款 2=扣款" dc:"操作类型 1=加款 2=扣款"` // 操作类型 1=加款 2=扣款
-	Money         float64                `protobuf:"fixed64,3,opt,name=money,proto3" json:"money" dc:"操作金额" dc:"操作金额" dc:"操作金额" dc:"操作金额" dc:"操作金额" dc:"操作金额" dc:"操作金额" dc:"操作金额" dc:"操作金额" dc:"操作金额" dc:"操作金额" dc:"操作金额" dc:"操作金额" dc:"操作金额" dc:"操作金额"`        // 操作金额
-	Remark        string                 `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注" dc:"备注"`              // 备注
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"用户ID"` // 用户ID
+	Type          int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type" dc:"操作类型 1=加款 2=扣款"`         // 操作类型 1=加款 2=扣款
+	Money         float64                `protobuf:"fixed64,3,opt,name=money,proto3" json:"money" dc:"操作金额"`                // 操作金额
+	Remark        string                 `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark" dc:"备注"`                  // 备注
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ManualUserBalanceReq) Reset() {
 	*x = ManualUserBalanceReq{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[30]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2543,7 +2984,7 @@ func (x *ManualUserBalanceReq) String() string {
 func (*ManualUserBalanceReq) ProtoMessage() {}
 
 func (x *ManualUserBalanceReq) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[30]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2556,7 +2997,7 @@ func (x *ManualUserBalanceReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ManualUserBalanceReq.ProtoReflect.Descriptor instead.
 func (*ManualUserBalanceReq) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{30}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ManualUserBalanceReq) GetUserId() int32 {
@@ -2590,17 +3031,17 @@ func (x *ManualUserBalanceReq) GetRemark() string {
 // 手动操作用户余额响应
 type ManualUserBalanceRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功"`      // 是否成功
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息"`       // 响应消息
-	BalanceOld    float64                `protobuf:"fixed64,3,opt,name=balance_old,json=balanceOld,proto3" json:"balance_old" dc:"操作前余额" dc:"操作前余额" dc:"操作前余额" dc:"操作前余额" dc:"操作前余额" dc:"操作前余额" dc:"操作前余额" dc:"操作前余额" dc:"操作前余额" dc:"操作前余额"` // 操作前余额
-	BalanceNew    float64                `protobuf:"fixed64,4,opt,name=balance_new,json=balanceNew,proto3" json:"balance_new" dc:"操作后余额" dc:"操作后余额" dc:"操作后余额" dc:"操作后余额" dc:"操作后余额" dc:"操作后余额" dc:"操作后余额" dc:"操作后余额" dc:"操作后余额" dc:"操作后余额"` // 操作后余额
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"`                           // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`                            // 响应消息
+	BalanceOld    float64                `protobuf:"fixed64,3,opt,name=balance_old,json=balanceOld,proto3" json:"balance_old" dc:"操作前余额"` // 操作前余额
+	BalanceNew    float64                `protobuf:"fixed64,4,opt,name=balance_new,json=balanceNew,proto3" json:"balance_new" dc:"操作后余额"` // 操作后余额
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ManualUserBalanceRes) Reset() {
 	*x = ManualUserBalanceRes{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[31]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2612,7 +3053,7 @@ func (x *ManualUserBalanceRes) String() string {
 func (*ManualUserBalanceRes) ProtoMessage() {}
 
 func (x *ManualUserBalanceRes) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[31]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2625,7 +3066,7 @@ func (x *ManualUserBalanceRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ManualUserBalanceRes.ProtoReflect.Descriptor instead.
 func (*ManualUserBalanceRes) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{31}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ManualUserBalanceRes) GetSuccess() bool {
@@ -2659,17 +3100,17 @@ func (x *ManualUserBalanceRes) GetBalanceNew() float64 {
 // 获取支付接口列表请求
 type GetPaymentAccountsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PaymentId     int32                  `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id" dc:"支付ID (可选)" dc:"支付ID (可选)" dc:"支付ID (可选)" dc:"支付ID (可选)" dc:"支付ID (可选)" dc:"支付ID (可选)" dc:"支付ID (可选)" dc:"支付ID (可选)" dc:"支付ID (可选)"` // 支付ID (可选)
-	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)" dc:"状态 (可选)"`   // 状态 (可选)
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码" dc:"页码"`                   // 页码
-	Size          int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量" dc:"每页数量"`             // 每页数量
+	PaymentId     int32                  `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id" dc:"支付ID (可选)"` // 支付ID (可选)
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status" dc:"状态 (可选)"`                          // 状态 (可选)
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page" dc:"页码"`                                   // 页码
+	Size          int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size" dc:"每页数量"`                                 // 每页数量
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetPaymentAccountsReq) Reset() {
 	*x = GetPaymentAccountsReq{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[32]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2681,7 +3122,7 @@ func (x *GetPaymentAccountsReq) String() string {
 func (*GetPaymentAccountsReq) ProtoMessage() {}
 
 func (x *GetPaymentAccountsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[32]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2694,7 +3135,7 @@ func (x *GetPaymentAccountsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPaymentAccountsReq.ProtoReflect.Descriptor instead.
 func (*GetPaymentAccountsReq) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{32}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetPaymentAccountsReq) GetPaymentId() int32 {
@@ -2728,36 +3169,36 @@ func (x *GetPaymentAccountsReq) GetSize() int32 {
 // 支付接口信息
 type PaymentAccountInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID" dc:"ID"`                                                                            // ID
-	SiteId        int32                  `protobuf:"varint,2,opt,name=site_id,json=siteId,proto3" json:"site_id" dc:"站点ID" dc:"站点ID" dc:"站点ID" dc:"站点ID" dc:"站点ID" dc:"站点ID" dc:"站点ID" dc:"站点ID" dc:"站点ID" dc:"站点ID" dc:"站点ID" dc:"站点ID" dc:"站点ID" dc:"站点ID" dc:"站点ID" dc:"站点ID" dc:"站点ID" dc:"站点ID"`                  // 站点ID
-	PaymentId     int32                  `protobuf:"varint,3,opt,name=payment_id,json=paymentId,proto3" json:"payment_id" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID"`       // 第三方支付ID
-	Gateway       int32                  `protobuf:"varint,4,opt,name=gateway,proto3" json:"gateway" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关"`                              // 支付网关
-	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称"`                                     // 接口名称
-	Domain        string                 `protobuf:"bytes,6,opt,name=domain,proto3" json:"domain" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名"`                                 // 支付域名
-	MerchantNo    string                 `protobuf:"bytes,7,opt,name=merchant_no,json=merchantNo,proto3" json:"merchant_no" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号"`                         // 商户号
-	Md5Key        string                 `protobuf:"bytes,8,opt,name=md5_key,json=md5Key,proto3" json:"md5_key" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥"` // MD5密钥
-	EachMin       float64                `protobuf:"fixed64,9,opt,name=each_min,json=eachMin,proto3" json:"each_min" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低"`              // 单笔最低
-	EachMax       float64                `protobuf:"fixed64,10,opt,name=each_max,json=eachMax,proto3" json:"each_max" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高"`             // 单笔最高
-	DailyMax      float64                `protobuf:"fixed64,11,opt,name=daily_max,json=dailyMax,proto3" json:"daily_max" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限"`          // 单日停用上限
-	TodayCount    int32                  `protobuf:"varint,12,opt,name=today_count,json=todayCount,proto3" json:"today_count" dc:"今日入款次数" dc:"今日入款次数" dc:"今日入款次数" dc:"今日入款次数" dc:"今日入款次数" dc:"今日入款次数" dc:"今日入款次数" dc:"今日入款次数" dc:"今日入款次数" dc:"今日入款次数" dc:"今日入款次数" dc:"今日入款次数" dc:"今日入款次数" dc:"今日入款次数" dc:"今日入款次数"`     // 今日入款次数
-	TodayAmount   float64                `protobuf:"fixed64,13,opt,name=today_amount,json=todayAmount,proto3" json:"today_amount" dc:"今日总转账" dc:"今日总转账" dc:"今日总转账" dc:"今日总转账" dc:"今日总转账" dc:"今日总转账" dc:"今日总转账" dc:"今日总转账" dc:"今日总转账" dc:"今日总转账" dc:"今日总转账" dc:"今日总转账" dc:"今日总转账" dc:"今日总转账" dc:"今日总转账" dc:"今日总转账"`     // 今日总转账
-	Status        int32                  `protobuf:"varint,14,opt,name=status,proto3" json:"status" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态"`                                                                   // 状态
-	StatusName    string                 `protobuf:"bytes,15,opt,name=status_name,json=statusName,proto3" json:"status_name" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称" dc:"状态名称"`      // 状态名称
-	Sort          int32                  `protobuf:"varint,16,opt,name=sort,proto3" json:"sort" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序"`                                                                       // 排序
-	CreatedAt     string                 `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间" dc:"创建时间"`         // 创建时间
-	UpdatedAt     string                 `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间" dc:"更新时间"`         // 更新时间
-	PublicKey     string                 `protobuf:"bytes,19,opt,name=public_key,json=publicKey,proto3" json:"public_key" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥"`                                             // 公钥
-	PrivateKey    string                 `protobuf:"bytes,20,opt,name=private_key,json=privateKey,proto3" json:"private_key" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥"`                                          // 私钥
-	IsDecimal     int32                  `protobuf:"varint,21,opt,name=is_decimal,json=isDecimal,proto3" json:"is_decimal" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数"`        // 是否携带小数
-	IsInt         int32                  `protobuf:"varint,22,opt,name=is_int,json=isInt,proto3" json:"is_int" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组"`     // 是否为规定整数数组
-	MoneyList     string                 `protobuf:"bytes,23,opt,name=money_list,json=moneyList,proto3" json:"money_list" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组"`       // 可选的金额数组
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"ID"`                                           // ID
+	SiteId        int32                  `protobuf:"varint,2,opt,name=site_id,json=siteId,proto3" json:"site_id" dc:"站点ID"`                   // 站点ID
+	PaymentId     int32                  `protobuf:"varint,3,opt,name=payment_id,json=paymentId,proto3" json:"payment_id" dc:"第三方支付ID"`       // 第三方支付ID
+	Gateway       int32                  `protobuf:"varint,4,opt,name=gateway,proto3" json:"gateway" dc:"支付网关"`                               // 支付网关
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name" dc:"接口名称"`                                      // 接口名称
+	Domain        string                 `protobuf:"bytes,6,opt,name=domain,proto3" json:"domain" dc:"支付域名"`                                  // 支付域名
+	MerchantNo    string                 `protobuf:"bytes,7,opt,name=merchant_no,json=merchantNo,proto3" json:"merchant_no" dc:"商户号"`         // 商户号
+	Md5Key        string                 `protobuf:"bytes,8,opt,name=md5_key,json=md5Key,proto3" json:"md5_key" dc:"MD5密钥"`                   // MD5密钥
+	EachMin       float64                `protobuf:"fixed64,9,opt,name=each_min,json=eachMin,proto3" json:"each_min" dc:"单笔最低"`               // 单笔最低
+	EachMax       float64                `protobuf:"fixed64,10,opt,name=each_max,json=eachMax,proto3" json:"each_max" dc:"单笔最高"`              // 单笔最高
+	DailyMax      float64                `protobuf:"fixed64,11,opt,name=daily_max,json=dailyMax,proto3" json:"daily_max" dc:"单日停用上限"`         // 单日停用上限
+	TodayCount    int32                  `protobuf:"varint,12,opt,name=today_count,json=todayCount,proto3" json:"today_count" dc:"今日入款次数"`    // 今日入款次数
+	TodayAmount   float64                `protobuf:"fixed64,13,opt,name=today_amount,json=todayAmount,proto3" json:"today_amount" dc:"今日总转账"` // 今日总转账
+	Status        int32                  `protobuf:"varint,14,opt,name=status,proto3" json:"status" dc:"状态"`                                  // 状态
+	StatusName    string                 `protobuf:"bytes,15,opt,name=status_name,json=statusName,proto3" json:"status_name" dc:"状态名称"`       // 状态名称
+	Sort          int32                  `protobuf:"varint,16,opt,name=sort,proto3" json:"sort" dc:"排序"`                                      // 排序
+	CreatedAt     string                 `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间"`          // 创建时间
+	UpdatedAt     string                 `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at" dc:"更新时间"`          // 更新时间
+	PublicKey     string                 `protobuf:"bytes,19,opt,name=public_key,json=publicKey,proto3" json:"public_key" dc:"公钥"`            // 公钥
+	PrivateKey    string                 `protobuf:"bytes,20,opt,name=private_key,json=privateKey,proto3" json:"private_key" dc:"私钥"`         // 私钥
+	IsDecimal     int32                  `protobuf:"varint,21,opt,name=is_decimal,json=isDecimal,proto3" json:"is_decimal" dc:"是否携带小数"`       // 是否携带小数
+	IsInt         int32                  `protobuf:"varint,22,opt,name=is_int,json=isInt,proto3" json:"is_int" dc:"是否为规定整数数组"`                // 是否为规定整数数组
+	MoneyList     string                 `protobuf:"bytes,23,opt,name=money_list,json=moneyList,proto3" json:"money_list" dc:"可选的金额数组"`       // 可选的金额数组
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PaymentAccountInfo) Reset() {
 	*x = PaymentAccountInfo{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[33]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2769,7 +3210,7 @@ func (x *PaymentAccountInfo) String() string {
 func (*PaymentAccountInfo) ProtoMessage() {}
 
 func (x *PaymentAccountInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[33]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2782,7 +3223,7 @@ func (x *PaymentAccountInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentAccountInfo.ProtoReflect.Descriptor instead.
 func (*PaymentAccountInfo) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{33}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *PaymentAccountInfo) GetId() int32 {
@@ -2957,7 +3398,7 @@ type GetPaymentAccountsRes struct {
 
 func (x *GetPaymentAccountsRes) Reset() {
 	*x = GetPaymentAccountsRes{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[34]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2969,7 +3410,7 @@ func (x *GetPaymentAccountsRes) String() string {
 func (*GetPaymentAccountsRes) ProtoMessage() {}
 
 func (x *GetPaymentAccountsRes) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[34]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2982,7 +3423,7 @@ func (x *GetPaymentAccountsRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPaymentAccountsRes.ProtoReflect.Descriptor instead.
 func (*GetPaymentAccountsRes) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{34}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GetPaymentAccountsRes) GetList() []*PaymentAccountInfo {
@@ -3002,29 +3443,29 @@ func (x *GetPaymentAccountsRes) GetCount() int32 {
 // 创建支付接口请求
 type CreatePaymentAccountReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PaymentId     int32                  `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID"`          // 第三方支付ID
-	Gateway       int32                  `protobuf:"varint,2,opt,name=gateway,proto3" json:"gateway" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关"`                    // 支付网关
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称"`                           // 接口名称
-	Domain        string                 `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名"`                       // 支付域名
-	MerchantNo    string                 `protobuf:"bytes,5,opt,name=merchant_no,json=merchantNo,proto3" json:"merchant_no" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号"`               // 商户号
-	Md5Key        string                 `protobuf:"bytes,6,opt,name=md5_key,json=md5Key,proto3" json:"md5_key" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥"`  // MD5密钥
-	EachMin       float64                `protobuf:"fixed64,7,opt,name=each_min,json=eachMin,proto3" json:"each_min" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低"`              // 单笔最低
-	EachMax       float64                `protobuf:"fixed64,8,opt,name=each_max,json=eachMax,proto3" json:"each_max" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高"`              // 单笔最高
-	DailyMax      float64                `protobuf:"fixed64,9,opt,name=daily_max,json=dailyMax,proto3" json:"daily_max" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限"` // 单日停用上限
-	Status        int32                  `protobuf:"varint,10,opt,name=status,proto3" json:"status" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态"`                                                         // 状态
-	Sort          int32                  `protobuf:"varint,11,opt,name=sort,proto3" json:"sort" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序"`                                                             // 排序
-	PublicKey     string                 `protobuf:"bytes,12,opt,name=public_key,json=publicKey,proto3" json:"public_key" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥"`                                   // 公钥
-	PrivateKey    string                 `protobuf:"bytes,13,opt,name=private_key,json=privateKey,proto3" json:"private_key" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥"`                                // 私钥
-	IsDecimal     int32                  `protobuf:"varint,14,opt,name=is_decimal,json=isDecimal,proto3" json:"is_decimal" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数"`          // 是否携带小数
-	IsInt         int32                  `protobuf:"varint,15,opt,name=is_int,json=isInt,proto3" json:"is_int" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组"`          // 是否为规定整数数组
-	MoneyList     string                 `protobuf:"bytes,16,opt,name=money_list,json=moneyList,proto3" json:"money_list" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组"`          // 可选的金额数组
+	PaymentId     int32                  `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id" dc:"第三方支付ID"` // 第三方支付ID
+	Gateway       int32                  `protobuf:"varint,2,opt,name=gateway,proto3" json:"gateway" dc:"支付网关"`                         // 支付网关
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name" dc:"接口名称"`                                // 接口名称
+	Domain        string                 `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain" dc:"支付域名"`                            // 支付域名
+	MerchantNo    string                 `protobuf:"bytes,5,opt,name=merchant_no,json=merchantNo,proto3" json:"merchant_no" dc:"商户号"`   // 商户号
+	Md5Key        string                 `protobuf:"bytes,6,opt,name=md5_key,json=md5Key,proto3" json:"md5_key" dc:"MD5密钥"`             // MD5密钥
+	EachMin       float64                `protobuf:"fixed64,7,opt,name=each_min,json=eachMin,proto3" json:"each_min" dc:"单笔最低"`         // 单笔最低
+	EachMax       float64                `protobuf:"fixed64,8,opt,name=each_max,json=eachMax,proto3" json:"each_max" dc:"单笔最高"`         // 单笔最高
+	DailyMax      float64                `protobuf:"fixed64,9,opt,name=daily_max,json=dailyMax,proto3" json:"daily_max" dc:"单日停用上限"`    // 单日停用上限
+	Status        int32                  `protobuf:"varint,10,opt,name=status,proto3" json:"status" dc:"状态"`                            // 状态
+	Sort          int32                  `protobuf:"varint,11,opt,name=sort,proto3" json:"sort" dc:"排序"`                                // 排序
+	PublicKey     string                 `protobuf:"bytes,12,opt,name=public_key,json=publicKey,proto3" json:"public_key" dc:"公钥"`      // 公钥
+	PrivateKey    string                 `protobuf:"bytes,13,opt,name=private_key,json=privateKey,proto3" json:"private_key" dc:"私钥"`   // 私钥
+	IsDecimal     int32                  `protobuf:"varint,14,opt,name=is_decimal,json=isDecimal,proto3" json:"is_decimal" dc:"是否携带小数"` // 是否携带小数
+	IsInt         int32                  `protobuf:"varint,15,opt,name=is_int,json=isInt,proto3" json:"is_int" dc:"是否为规定整数数组"`          // 是否为规定整数数组
+	MoneyList     string                 `protobuf:"bytes,16,opt,name=money_list,json=moneyList,proto3" json:"money_list" dc:"可选的金额数组"` // 可选的金额数组
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreatePaymentAccountReq) Reset() {
 	*x = CreatePaymentAccountReq{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[35]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3036,7 +3477,7 @@ func (x *CreatePaymentAccountReq) String() string {
 func (*CreatePaymentAccountReq) ProtoMessage() {}
 
 func (x *CreatePaymentAccountReq) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[35]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3049,7 +3490,7 @@ func (x *CreatePaymentAccountReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePaymentAccountReq.ProtoReflect.Descriptor instead.
 func (*CreatePaymentAccountReq) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{35}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *CreatePaymentAccountReq) GetPaymentId() int32 {
@@ -3167,15 +3608,15 @@ func (x *CreatePaymentAccountReq) GetMoneyList() string {
 // 创建支付接口响应
 type CreatePaymentAccountRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功"`          // 是否成功
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息"` // 响应消息
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreatePaymentAccountRes) Reset() {
 	*x = CreatePaymentAccountRes{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[36]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3187,7 +3628,7 @@ func (x *CreatePaymentAccountRes) String() string {
 func (*CreatePaymentAccountRes) ProtoMessage() {}
 
 func (x *CreatePaymentAccountRes) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[36]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3200,7 +3641,7 @@ func (x *CreatePaymentAccountRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePaymentAccountRes.ProtoReflect.Descriptor instead.
 func (*CreatePaymentAccountRes) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{36}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *CreatePaymentAccountRes) GetSuccess() bool {
@@ -3227,7 +3668,7 @@ type GetPaymentAccountUpdateReq struct {
 
 func (x *GetPaymentAccountUpdateReq) Reset() {
 	*x = GetPaymentAccountUpdateReq{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[37]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3239,7 +3680,7 @@ func (x *GetPaymentAccountUpdateReq) String() string {
 func (*GetPaymentAccountUpdateReq) ProtoMessage() {}
 
 func (x *GetPaymentAccountUpdateReq) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[37]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3252,7 +3693,7 @@ func (x *GetPaymentAccountUpdateReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPaymentAccountUpdateReq.ProtoReflect.Descriptor instead.
 func (*GetPaymentAccountUpdateReq) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{37}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *GetPaymentAccountUpdateReq) GetId() int32 {
@@ -3272,7 +3713,7 @@ type GetPaymentAccountUpdateRes struct {
 
 func (x *GetPaymentAccountUpdateRes) Reset() {
 	*x = GetPaymentAccountUpdateRes{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[38]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3284,7 +3725,7 @@ func (x *GetPaymentAccountUpdateRes) String() string {
 func (*GetPaymentAccountUpdateRes) ProtoMessage() {}
 
 func (x *GetPaymentAccountUpdateRes) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[38]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3297,7 +3738,7 @@ func (x *GetPaymentAccountUpdateRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPaymentAccountUpdateRes.ProtoReflect.Descriptor instead.
 func (*GetPaymentAccountUpdateRes) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{38}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *GetPaymentAccountUpdateRes) GetData() *PaymentAccountInfo {
@@ -3310,30 +3751,30 @@ func (x *GetPaymentAccountUpdateRes) GetData() *PaymentAccountInfo {
 // 更新支付接口请求
 type UpdatePaymentAccountReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"支付接口ID" dc:"支付接口ID" dc:"支付接口ID" dc:"支付接口ID" dc:"支付接口ID" dc:"支付接口ID" dc:"支付接口ID" dc:"支付接口ID" dc:"支付接口ID" dc:"支付接口ID" dc:"支付接口ID" dc:"支付接口ID" dc:"支付接口ID" dc:"支付接口ID" dc:"支付接口ID" dc:"支付接口ID" dc:"支付接口ID"`       // 支付接口ID
-	PaymentId     int32                  `protobuf:"varint,2,opt,name=payment_id,json=paymentId,proto3" json:"payment_id" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID" dc:"第三方支付ID"`           // 第三方支付ID
-	Gateway       int32                  `protobuf:"varint,3,opt,name=gateway,proto3" json:"gateway" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关" dc:"支付网关"`                     // 支付网关
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称" dc:"接口名称"`                            // 接口名称
-	Domain        string                 `protobuf:"bytes,5,opt,name=domain,proto3" json:"domain" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名" dc:"支付域名"`                        // 支付域名
-	MerchantNo    string                 `protobuf:"bytes,6,opt,name=merchant_no,json=merchantNo,proto3" json:"merchant_no" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号" dc:"商户号"`                // 商户号
-	Md5Key        string                 `protobuf:"bytes,7,opt,name=md5_key,json=md5Key,proto3" json:"md5_key" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥" dc:"MD5密钥"`   // MD5密钥
-	EachMin       float64                `protobuf:"fixed64,8,opt,name=each_min,json=eachMin,proto3" json:"each_min" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低" dc:"单笔最低"`     // 单笔最低
-	EachMax       float64                `protobuf:"fixed64,9,opt,name=each_max,json=eachMax,proto3" json:"each_max" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高" dc:"单笔最高"`     // 单笔最高
-	DailyMax      float64                `protobuf:"fixed64,10,opt,name=daily_max,json=dailyMax,proto3" json:"daily_max" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限" dc:"单日停用上限"` // 单日停用上限
-	Status        int32                  `protobuf:"varint,11,opt,name=status,proto3" json:"status" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态" dc:"状态"`                                                          // 状态
-	Sort          int32                  `protobuf:"varint,12,opt,name=sort,proto3" json:"sort" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序" dc:"排序"`                                                              // 排序
-	PublicKey     string                 `protobuf:"bytes,13,opt,name=public_key,json=publicKey,proto3" json:"public_key" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥" dc:"公钥"`                                    // 公钥
-	PrivateKey    string                 `protobuf:"bytes,14,opt,name=private_key,json=privateKey,proto3" json:"private_key" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥" dc:"私钥"`                                 // 私钥
-	IsDecimal     int32                  `protobuf:"varint,15,opt,name=is_decimal,json=isDecimal,proto3" json:"is_decimal" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数" dc:"是否携带小数"`           // 是否携带小数
-	IsInt         int32                  `protobuf:"varint,16,opt,name=is_int,json=isInt,proto3" json:"is_int" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组" dc:"是否为规定整数数组"`           // 是否为规定整数数组
-	MoneyList     string                 `protobuf:"bytes,17,opt,name=money_list,json=moneyList,proto3" json:"money_list" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组" dc:"可选的金额数组"`           // 可选的金额数组
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"支付接口ID"`                                 // 支付接口ID
+	PaymentId     int32                  `protobuf:"varint,2,opt,name=payment_id,json=paymentId,proto3" json:"payment_id" dc:"第三方支付ID"` // 第三方支付ID
+	Gateway       int32                  `protobuf:"varint,3,opt,name=gateway,proto3" json:"gateway" dc:"支付网关"`                         // 支付网关
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name" dc:"接口名称"`                                // 接口名称
+	Domain        string                 `protobuf:"bytes,5,opt,name=domain,proto3" json:"domain" dc:"支付域名"`                            // 支付域名
+	MerchantNo    string                 `protobuf:"bytes,6,opt,name=merchant_no,json=merchantNo,proto3" json:"merchant_no" dc:"商户号"`   // 商户号
+	Md5Key        string                 `protobuf:"bytes,7,opt,name=md5_key,json=md5Key,proto3" json:"md5_key" dc:"MD5密钥"`             // MD5密钥
+	EachMin       float64                `protobuf:"fixed64,8,opt,name=each_min,json=eachMin,proto3" json:"each_min" dc:"单笔最低"`         // 单笔最低
+	EachMax       float64                `protobuf:"fixed64,9,opt,name=each_max,json=eachMax,proto3" json:"each_max" dc:"单笔最高"`         // 单笔最高
+	DailyMax      float64                `protobuf:"fixed64,10,opt,name=daily_max,json=dailyMax,proto3" json:"daily_max" dc:"单日停用上限"`   // 单日停用上限
+	Status        int32                  `protobuf:"varint,11,opt,name=status,proto3" json:"status" dc:"状态"`                            // 状态
+	Sort          int32                  `protobuf:"varint,12,opt,name=sort,proto3" json:"sort" dc:"排序"`                                // 排序
+	PublicKey     string                 `protobuf:"bytes,13,opt,name=public_key,json=publicKey,proto3" json:"public_key" dc:"公钥"`      // 公钥
+	PrivateKey    string                 `protobuf:"bytes,14,opt,name=private_key,json=privateKey,proto3" json:"private_key" dc:"私钥"`   // 私钥
+	IsDecimal     int32                  `protobuf:"varint,15,opt,name=is_decimal,json=isDecimal,proto3" json:"is_decimal" dc:"是否携带小数"` // 是否携带小数
+	IsInt         int32                  `protobuf:"varint,16,opt,name=is_int,json=isInt,proto3" json:"is_int" dc:"是否为规定整数数组"`          // 是否为规定整数数组
+	MoneyList     string                 `protobuf:"bytes,17,opt,name=money_list,json=moneyList,proto3" json:"money_list" dc:"可选的金额数组"` // 可选的金额数组
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdatePaymentAccountReq) Reset() {
 	*x = UpdatePaymentAccountReq{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[39]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3345,7 +3786,7 @@ func (x *UpdatePaymentAccountReq) String() string {
 func (*UpdatePaymentAccountReq) ProtoMessage() {}
 
 func (x *UpdatePaymentAccountReq) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[39]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3358,7 +3799,7 @@ func (x *UpdatePaymentAccountReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePaymentAccountReq.ProtoReflect.Descriptor instead.
 func (*UpdatePaymentAccountReq) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{39}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *UpdatePaymentAccountReq) GetId() int32 {
@@ -3483,15 +3924,15 @@ func (x *UpdatePaymentAccountReq) GetMoneyList() string {
 // 更新支付接口响应
 type UpdatePaymentAccountRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功"`          // 是否成功
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息"` // 响应消息
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdatePaymentAccountRes) Reset() {
 	*x = UpdatePaymentAccountRes{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[40]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3503,7 +3944,7 @@ func (x *UpdatePaymentAccountRes) String() string {
 func (*UpdatePaymentAccountRes) ProtoMessage() {}
 
 func (x *UpdatePaymentAccountRes) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[40]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3516,7 +3957,7 @@ func (x *UpdatePaymentAccountRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePaymentAccountRes.ProtoReflect.Descriptor instead.
 func (*UpdatePaymentAccountRes) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{40}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *UpdatePaymentAccountRes) GetSuccess() bool {
@@ -3543,7 +3984,7 @@ type DeletePaymentAccountReq struct {
 
 func (x *DeletePaymentAccountReq) Reset() {
 	*x = DeletePaymentAccountReq{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[41]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3555,7 +3996,7 @@ func (x *DeletePaymentAccountReq) String() string {
 func (*DeletePaymentAccountReq) ProtoMessage() {}
 
 func (x *DeletePaymentAccountReq) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[41]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3568,7 +4009,7 @@ func (x *DeletePaymentAccountReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePaymentAccountReq.ProtoReflect.Descriptor instead.
 func (*DeletePaymentAccountReq) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{41}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *DeletePaymentAccountReq) GetId() int32 {
@@ -3581,15 +4022,15 @@ func (x *DeletePaymentAccountReq) GetId() int32 {
 // 删除支付接口响应
 type DeletePaymentAccountRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功" dc:"是否成功"`          // 是否成功
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息" dc:"响应消息"` // 响应消息
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeletePaymentAccountRes) Reset() {
 	*x = DeletePaymentAccountRes{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[42]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3601,7 +4042,7 @@ func (x *DeletePaymentAccountRes) String() string {
 func (*DeletePaymentAccountRes) ProtoMessage() {}
 
 func (x *DeletePaymentAccountRes) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[42]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3614,7 +4055,7 @@ func (x *DeletePaymentAccountRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePaymentAccountRes.ProtoReflect.Descriptor instead.
 func (*DeletePaymentAccountRes) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{42}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *DeletePaymentAccountRes) GetSuccess() bool {
@@ -3640,7 +4081,7 @@ type GetManualListReq struct {
 
 func (x *GetManualListReq) Reset() {
 	*x = GetManualListReq{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[43]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3652,7 +4093,7 @@ func (x *GetManualListReq) String() string {
 func (*GetManualListReq) ProtoMessage() {}
 
 func (x *GetManualListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[43]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3665,7 +4106,7 @@ func (x *GetManualListReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetManualListReq.ProtoReflect.Descriptor instead.
 func (*GetManualListReq) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{43}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{50}
 }
 
 // 获取操作类型列表响应
@@ -3678,7 +4119,7 @@ type GetManualListRes struct {
 
 func (x *GetManualListRes) Reset() {
 	*x = GetManualListRes{}
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[44]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3690,7 +4131,7 @@ func (x *GetManualListRes) String() string {
 func (*GetManualListRes) ProtoMessage() {}
 
 func (x *GetManualListRes) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_balance_v1_balance_proto_msgTypes[44]
+	mi := &file_backend_balance_v1_balance_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3703,7 +4144,7 @@ func (x *GetManualListRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetManualListRes.ProtoReflect.Descriptor instead.
 func (*GetManualListRes) Descriptor() ([]byte, []int) {
-	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{44}
+	return file_backend_balance_v1_balance_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *GetManualListRes) GetList() map[int32]string {
@@ -3840,6 +4281,13 @@ const file_backend_balance_v1_balance_proto_rawDesc = "" +
 	"\x06remark\x18\x02 \x01(\tR\x06remark\"L\n" +
 	"\x16ConfirmPaymentOrderRes\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"I\n" +
+	"\x18NotifyRechargeCreatedReq\x12\x1d\n" +
+	"\n" +
+	"trade_type\x18\x01 \x01(\x05R\ttradeType\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\"N\n" +
+	"\x18NotifyRechargeCreatedRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"\xda\x01\n" +
 	"\x0fGetWithdrawsReq\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x16\n" +
@@ -3940,6 +4388,13 @@ const file_backend_balance_v1_balance_proto_rawDesc = "" +
 	"\x06remark\x18\x04 \x01(\tR\x06remark\"I\n" +
 	"\x13DealWithWithdrawRes\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"d\n" +
+	"\x18NotifyWithdrawCreatedReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x19\n" +
+	"\btrade_no\x18\x02 \x01(\tR\atradeNo\x12\x14\n" +
+	"\x05money\x18\x03 \x01(\x01R\x05money\"N\n" +
+	"\x18NotifyWithdrawCreatedRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\".\n" +
 	"\x13QueryUserBalanceReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\"\xc9\x01\n" +
@@ -3963,7 +4418,24 @@ const file_backend_balance_v1_balance_proto_rawDesc = "" +
 	"\abalance\x18\x05 \x01(\x01R\abalance\x12(\n" +
 	"\x10last_update_time\x18\x06 \x01(\tR\x0elastUpdateTime\"C\n" +
 	"\x13QueryGameBalanceRes\x12,\n" +
-	"\x04data\x18\x01 \x01(\v2\x18.balance.GameBalanceInfoR\x04data\"q\n" +
+	"\x04data\x18\x01 \x01(\v2\x18.balance.GameBalanceInfoR\x04data\"w\n" +
+	"\x0fTransferGameReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\x05R\x06gameId\x12\x1c\n" +
+	"\tdirection\x18\x03 \x01(\x05R\tdirection\x12\x14\n" +
+	"\x05money\x18\x04 \x01(\x01R\x05money\"\xd4\x01\n" +
+	"\x10GameTransferInfo\x12\x19\n" +
+	"\border_no\x18\x01 \x01(\tR\aorderNo\x12&\n" +
+	"\x0fvendor_order_no\x18\x02 \x01(\tR\rvendorOrderNo\x12\x17\n" +
+	"\agame_id\x18\x03 \x01(\x05R\x06gameId\x12\x1c\n" +
+	"\tdirection\x18\x04 \x01(\x05R\tdirection\x12\x14\n" +
+	"\x05money\x18\x05 \x01(\x01R\x05money\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\"t\n" +
+	"\x0fTransferGameRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
+	"\x04data\x18\x03 \x01(\v2\x19.balance.GameTransferInfoR\x04data\"q\n" +
 	"\x14ManualUserBalanceReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\x05R\x04type\x12\x14\n" +
@@ -4089,26 +4561,29 @@ const file_backend_balance_v1_balance_proto_rawDesc = "" +
 	"\x04list\x18\x01 \x03(\v2#.balance.GetManualListRes.ListEntryR\x04list\x1a7\n" +
 	"\tListEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\x95\f\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\x9d\x0e\n" +
 	"\aBalance\x12S\n" +
 	"\x11GetBalanceChanges\x12\x1d.balance.GetBalanceChangesReq\x1a\x1d.balance.GetBalanceChangesRes\"\x00\x12G\n" +
 	"\rGetChangeList\x12\x19.balance.GetChangeListReq\x1a\x19.balance.GetChangeListRes\"\x00\x12Y\n" +
 	"\x13GetRechargePayments\x12\x1f.balance.GetRechargePaymentsReq\x1a\x1f.balance.GetRechargePaymentsRes\"\x00\x12V\n" +
 	"\x12GetRechargeManuals\x12\x1e.balance.GetRechargeManualsReq\x1a\x1e.balance.GetRechargeManualsRes\"\x00\x12Y\n" +
-	"\x13ConfirmPaymentOrder\x12\x1f.balance.ConfirmPaymentOrderReq\x1a\x1f.balance.ConfirmPaymentOrderRes\"\x00\x12D\n" +
+	"\x13ConfirmPaymentOrder\x12\x1f.balance.ConfirmPaymentOrderReq\x1a\x1f.balance.ConfirmPaymentOrderRes\"\x00\x12_\n" +
+	"\x15NotifyRechargeCreated\x12!.balance.NotifyRechargeCreatedReq\x1a!.balance.NotifyRechargeCreatedRes\"\x00\x12D\n" +
 	"\fGetWithdraws\x12\x18.balance.GetWithdrawsReq\x1a\x18.balance.GetWithdrawsRes\"\x00\x12V\n" +
 	"\x12GetWithdrawManuals\x12\x1e.balance.GetWithdrawManualsReq\x1a\x1e.balance.GetWithdrawManualsRes\"\x00\x12S\n" +
 	"\x11GetWithdrawReview\x12\x1d.balance.GetWithdrawReviewReq\x1a\x1d.balance.GetWithdrawReviewRes\"\x00\x12P\n" +
-	"\x10DealWithWithdraw\x12\x1c.balance.DealWithWithdrawReq\x1a\x1c.balance.DealWithWithdrawRes\"\x00\x12P\n" +
+	"\x10DealWithWithdraw\x12\x1c.balance.DealWithWithdrawReq\x1a\x1c.balance.DealWithWithdrawRes\"\x00\x12_\n" +
+	"\x15NotifyWithdrawCreated\x12!.balance.NotifyWithdrawCreatedReq\x1a!.balance.NotifyWithdrawCreatedRes\"\x00\x12P\n" +
 	"\x10QueryUserBalance\x12\x1c.balance.QueryUserBalanceReq\x1a\x1c.balance.QueryUserBalanceRes\"\x00\x12P\n" +
-	"\x10QueryGameBalance\x12\x1c.balance.QueryGameBalanceReq\x1a\x1c.balance.QueryGameBalanceRes\"\x00\x12S\n" +
+	"\x10QueryGameBalance\x12\x1c.balance.QueryGameBalanceReq\x1a\x1c.balance.QueryGameBalanceRes\"\x00\x12D\n" +
+	"\fTransferGame\x12\x18.balance.TransferGameReq\x1a\x18.balance.TransferGameRes\"\x00\x12S\n" +
 	"\x11ManualUserBalance\x12\x1d.balance.ManualUserBalanceReq\x1a\x1d.balance.ManualUserBalanceRes\"\x00\x12V\n" +
 	"\x12GetPaymentAccounts\x12\x1e.balance.GetPaymentAccountsReq\x1a\x1e.balance.GetPaymentAccountsRes\"\x00\x12\\\n" +
 	"\x14CreatePaymentAccount\x12 .balance.CreatePaymentAccountReq\x1a .balance.CreatePaymentAccountRes\"\x00\x12e\n" +
 	"\x17GetPaymentAccountUpdate\x12#.balance.GetPaymentAccountUpdateReq\x1a#.balance.GetPaymentAccountUpdateRes\"\x00\x12\\\n" +
 	"\x14UpdatePaymentAccount\x12 .balance.UpdatePaymentAccountReq\x1a .balance.UpdatePaymentAccountRes\"\x00\x12\\\n" +
 	"\x14DeletePaymentAccount\x12 .balance.DeletePaymentAccountReq\x1a .balance.DeletePaymentAccountRes\"\x00\x12G\n" +
-	"\rGetManualList\x12\x19.balance.GetManualListReq\x1a\x19.balance.GetManualListRes\"\x00B+Z)jh_balance_service/api/backend/balance/v1b\x06proto3"
+	"\rGetManualList\x12\x19.balance.GetManualListReq\x1a\x19.balance.GetManualListRes\"\x00B'Z%jh_app_service/api/backend/balance/v1b\x06proto3"
 
 var (
 	file_backend_balance_v1_balance_proto_rawDescOnce sync.Once
@@ -4122,7 +4597,7 @@ func file_backend_balance_v1_balance_proto_rawDescGZIP() []byte {
 	return file_backend_balance_v1_balance_proto_rawDescData
 }
 
-var file_backend_balance_v1_balance_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
+var file_backend_balance_v1_balance_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
 var file_backend_balance_v1_balance_proto_goTypes = []any{
 	(*GetChangeListReq)(nil),           // 0: balance.GetChangeListReq
 	(*GetChangeListRes)(nil),           // 1: balance.GetChangeListRes
@@ -4137,95 +4612,109 @@ var file_backend_balance_v1_balance_proto_goTypes = []any{
 	(*GetRechargeManualsRes)(nil),      // 10: balance.GetRechargeManualsRes
 	(*ConfirmPaymentOrderReq)(nil),     // 11: balance.ConfirmPaymentOrderReq
 	(*ConfirmPaymentOrderRes)(nil),     // 12: balance.ConfirmPaymentOrderRes
-	(*GetWithdrawsReq)(nil),            // 13: balance.GetWithdrawsReq
-	(*WithdrawInfo)(nil),               // 14: balance.WithdrawInfo
-	(*GetWithdrawsRes)(nil),            // 15: balance.GetWithdrawsRes
-	(*GetWithdrawManualsReq)(nil),      // 16: balance.GetWithdrawManualsReq
-	(*WithdrawManualInfo)(nil),         // 17: balance.WithdrawManualInfo
-	(*GetWithdrawManualsRes)(nil),      // 18: balance.GetWithdrawManualsRes
-	(*GetWithdrawReviewReq)(nil),       // 19: balance.GetWithdrawReviewReq
-	(*WithdrawReviewInfo)(nil),         // 20: balance.WithdrawReviewInfo
-	(*GetWithdrawReviewRes)(nil),       // 21: balance.GetWithdrawReviewRes
-	(*DealWithWithdrawReq)(nil),        // 22: balance.DealWithWithdrawReq
-	(*DealWithWithdrawRes)(nil),        // 23: balance.DealWithWithdrawRes
-	(*QueryUserBalanceReq)(nil),        // 24: balance.QueryUserBalanceReq
-	(*UserBalanceInfo)(nil),            // 25: balance.UserBalanceInfo
-	(*QueryUserBalanceRes)(nil),        // 26: balance.QueryUserBalanceRes
-	(*QueryGameBalanceReq)(nil),        // 27: balance.QueryGameBalanceReq
-	(*GameBalanceInfo)(nil),            // 28: balance.GameBalanceInfo
-	(*QueryGameBalanceRes)(nil),        // 29: balance.QueryGameBalanceRes
-	(*ManualUserBalanceReq)(nil),       // 30: balance.ManualUserBalanceReq
-	(*ManualUserBalanceRes)(nil),       // 31: balance.ManualUserBalanceRes
-	(*GetPaymentAccountsReq)(nil),      // 32: balance.GetPaymentAccountsReq
-	(*PaymentAccountInfo)(nil),         // 33: balance.PaymentAccountInfo
-	(*GetPaymentAccountsRes)(nil),      // 34: balance.GetPaymentAccountsRes
-	(*CreatePaymentAccountReq)(nil),    // 35: balance.CreatePaymentAccountReq
-	(*CreatePaymentAccountRes)(nil),    // 36: balance.CreatePaymentAccountRes
-	(*GetPaymentAccountUpdateReq)(nil), // 37: balance.GetPaymentAccountUpdateReq
-	(*GetPaymentAccountUpdateRes)(nil), // 38: balance.GetPaymentAccountUpdateRes
-	(*UpdatePaymentAccountReq)(nil),    // 39: balance.UpdatePaymentAccountReq
-	(*UpdatePaymentAccountRes)(nil),    // 40: balance.UpdatePaymentAccountRes
-	(*DeletePaymentAccountReq)(nil),    // 41: balance.DeletePaymentAccountReq
-	(*DeletePaymentAccountRes)(nil),    // 42: balance.DeletePaymentAccountRes
-	(*GetManualListReq)(nil),           // 43: balance.GetManualListReq
-	(*GetManualListRes)(nil),           // 44: balance.GetManualListRes
-	nil,                                // 45: balance.GetChangeListRes.ListEntry
-	nil,                                // 46: balance.GetManualListRes.ListEntry
+	(*NotifyRechargeCreatedReq)(nil),   // 13: balance.NotifyRechargeCreatedReq
+	(*NotifyRechargeCreatedRes)(nil),   // 14: balance.NotifyRechargeCreatedRes
+	(*GetWithdrawsReq)(nil),            // 15: balance.GetWithdrawsReq
+	(*WithdrawInfo)(nil),               // 16: balance.WithdrawInfo
+	(*GetWithdrawsRes)(nil),            // 17: balance.GetWithdrawsRes
+	(*GetWithdrawManualsReq)(nil),      // 18: balance.GetWithdrawManualsReq
+	(*WithdrawManualInfo)(nil),         // 19: balance.WithdrawManualInfo
+	(*GetWithdrawManualsRes)(nil),      // 20: balance.GetWithdrawManualsRes
+	(*GetWithdrawReviewReq)(nil),       // 21: balance.GetWithdrawReviewReq
+	(*WithdrawReviewInfo)(nil),         // 22: balance.WithdrawReviewInfo
+	(*GetWithdrawReviewRes)(nil),       // 23: balance.GetWithdrawReviewRes
+	(*DealWithWithdrawReq)(nil),        // 24: balance.DealWithWithdrawReq
+	(*DealWithWithdrawRes)(nil),        // 25: balance.DealWithWithdrawRes
+	(*NotifyWithdrawCreatedReq)(nil),   // 26: balance.NotifyWithdrawCreatedReq
+	(*NotifyWithdrawCreatedRes)(nil),   // 27: balance.NotifyWithdrawCreatedRes
+	(*QueryUserBalanceReq)(nil),        // 28: balance.QueryUserBalanceReq
+	(*UserBalanceInfo)(nil),            // 29: balance.UserBalanceInfo
+	(*QueryUserBalanceRes)(nil),        // 30: balance.QueryUserBalanceRes
+	(*QueryGameBalanceReq)(nil),        // 31: balance.QueryGameBalanceReq
+	(*GameBalanceInfo)(nil),            // 32: balance.GameBalanceInfo
+	(*QueryGameBalanceRes)(nil),        // 33: balance.QueryGameBalanceRes
+	(*TransferGameReq)(nil),            // 34: balance.TransferGameReq
+	(*GameTransferInfo)(nil),           // 35: balance.GameTransferInfo
+	(*TransferGameRes)(nil),            // 36: balance.TransferGameRes
+	(*ManualUserBalanceReq)(nil),       // 37: balance.ManualUserBalanceReq
+	(*ManualUserBalanceRes)(nil),       // 38: balance.ManualUserBalanceRes
+	(*GetPaymentAccountsReq)(nil),      // 39: balance.GetPaymentAccountsReq
+	(*PaymentAccountInfo)(nil),         // 40: balance.PaymentAccountInfo
+	(*GetPaymentAccountsRes)(nil),      // 41: balance.GetPaymentAccountsRes
+	(*CreatePaymentAccountReq)(nil),    // 42: balance.CreatePaymentAccountReq
+	(*CreatePaymentAccountRes)(nil),    // 43: balance.CreatePaymentAccountRes
+	(*GetPaymentAccountUpdateReq)(nil), // 44: balance.GetPaymentAccountUpdateReq
+	(*GetPaymentAccountUpdateRes)(nil), // 45: balance.GetPaymentAccountUpdateRes
+	(*UpdatePaymentAccountReq)(nil),    // 46: balance.UpdatePaymentAccountReq
+	(*UpdatePaymentAccountRes)(nil),    // 47: balance.UpdatePaymentAccountRes
+	(*DeletePaymentAccountReq)(nil),    // 48: balance.DeletePaymentAccountReq
+	(*DeletePaymentAccountRes)(nil),    // 49: balance.DeletePaymentAccountRes
+	(*GetManualListReq)(nil),           // 50: balance.GetManualListReq
+	(*GetManualListRes)(nil),           // 51: balance.GetManualListRes
+	nil,                                // 52: balance.GetChangeListRes.ListEntry
+	nil,                                // 53: balance.GetManualListRes.ListEntry
 }
 var file_backend_balance_v1_balance_proto_depIdxs = []int32{
-	45, // 0: balance.GetChangeListRes.list:type_name -> balance.GetChangeListRes.ListEntry
+	52, // 0: balance.GetChangeListRes.list:type_name -> balance.GetChangeListRes.ListEntry
 	3,  // 1: balance.GetBalanceChangesRes.list:type_name -> balance.BalanceChangeInfo
 	6,  // 2: balance.GetRechargePaymentsRes.list:type_name -> balance.RechargePaymentInfo
 	9,  // 3: balance.GetRechargeManualsRes.list:type_name -> balance.RechargeManualInfo
-	14, // 4: balance.GetWithdrawsRes.list:type_name -> balance.WithdrawInfo
-	17, // 5: balance.GetWithdrawManualsRes.list:type_name -> balance.WithdrawManualInfo
-	20, // 6: balance.GetWithdrawReviewRes.data:type_name -> balance.WithdrawReviewInfo
-	25, // 7: balance.QueryUserBalanceRes.data:type_name -> balance.UserBalanceInfo
-	28, // 8: balance.QueryGameBalanceRes.data:type_name -> balance.GameBalanceInfo
-	33, // 9: balance.GetPaymentAccountsRes.list:type_name -> balance.PaymentAccountInfo
-	33, // 10: balance.GetPaymentAccountUpdateRes.data:type_name -> balance.PaymentAccountInfo
-	46, // 11: balance.GetManualListRes.list:type_name -> balance.GetManualListRes.ListEntry
-	2,  // 12: balance.Balance.GetBalanceChanges:input_type -> balance.GetBalanceChangesReq
-	0,  // 13: balance.Balance.GetChangeList:input_type -> balance.GetChangeListReq
-	5,  // 14: balance.Balance.GetRechargePayments:input_type -> balance.GetRechargePaymentsReq
-	8,  // 15: balance.Balance.GetRechargeManuals:input_type -> balance.GetRechargeManualsReq
-	11, // 16: balance.Balance.ConfirmPaymentOrder:input_type -> balance.ConfirmPaymentOrderReq
-	13, // 17: balance.Balance.GetWithdraws:input_type -> balance.GetWithdrawsReq
-	16, // 18: balance.Balance.GetWithdrawManuals:input_type -> balance.GetWithdrawManualsReq
-	19, // 19: balance.Balance.GetWithdrawReview:input_type -> balance.GetWithdrawReviewReq
-	22, // 20: balance.Balance.DealWithWithdraw:input_type -> balance.DealWithWithdrawReq
-	24, // 21: balance.Balance.QueryUserBalance:input_type -> balance.QueryUserBalanceReq
-	27, // 22: balance.Balance.QueryGameBalance:input_type -> balance.QueryGameBalanceReq
-	30, // 23: balance.Balance.ManualUserBalance:input_type -> balance.ManualUserBalanceReq
-	32, // 24: balance.Balance.GetPaymentAccounts:input_type -> balance.GetPaymentAccountsReq
-	35, // 25: balance.Balance.CreatePaymentAccount:input_type -> balance.CreatePaymentAccountReq
-	37, // 26: balance.Balance.GetPaymentAccountUpdate:input_type -> balance.GetPaymentAccountUpdateReq
-	39, // 27: balance.Balance.UpdatePaymentAccount:input_type -> balance.UpdatePaymentAccountReq
-	41, // 28: balance.Balance.DeletePaymentAccount:input_type -> balance.DeletePaymentAccountReq
-	43, // 29: balance.Balance.GetManualList:input_type -> balance.GetManualListReq
-	4,  // 30: balance.Balance.GetBalanceChanges:output_type -> balance.GetBalanceChangesRes
-	1,  // 31: balance.Balance.GetChangeList:output_type -> balance.GetChangeListRes
-	7,  // 32: balance.Balance.GetRechargePayments:output_type -> balance.GetRechargePaymentsRes
-	10, // 33: balance.Balance.GetRechargeManuals:output_type -> balance.GetRechargeManualsRes
-	12, // 34: balance.Balance.ConfirmPaymentOrder:output_type -> balance.ConfirmPaymentOrderRes
-	15, // 35: balance.Balance.GetWithdraws:output_type -> balance.GetWithdrawsRes
-	18, // 36: balance.Balance.GetWithdrawManuals:output_type -> balance.GetWithdrawManualsRes
-	21, // 37: balance.Balance.GetWithdrawReview:output_type -> balance.GetWithdrawReviewRes
-	23, // 38: balance.Balance.DealWithWithdraw:output_type -> balance.DealWithWithdrawRes
-	26, // 39: balance.Balance.QueryUserBalance:output_type -> balance.QueryUserBalanceRes
-	29, // 40: balance.Balance.QueryGameBalance:output_type -> balance.QueryGameBalanceRes
-	31, // 41: balance.Balance.ManualUserBalance:output_type -> balance.ManualUserBalanceRes
-	34, // 42: balance.Balance.GetPaymentAccounts:output_type -> balance.GetPaymentAccountsRes
-	36, // 43: balance.Balance.CreatePaymentAccount:output_type -> balance.CreatePaymentAccountRes
-	38, // 44: balance.Balance.GetPaymentAccountUpdate:output_type -> balance.GetPaymentAccountUpdateRes
-	40, // 45: balance.Balance.UpdatePaymentAccount:output_type -> balance.UpdatePaymentAccountRes
-	42, // 46: balance.Balance.DeletePaymentAccount:output_type -> balance.DeletePaymentAccountRes
-	44, // 47: balance.Balance.GetManualList:output_type -> balance.GetManualListRes
-	30, // [30:48] is the sub-list for method output_type
-	12, // [12:30] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	16, // 4: balance.GetWithdrawsRes.list:type_name -> balance.WithdrawInfo
+	19, // 5: balance.GetWithdrawManualsRes.list:type_name -> balance.WithdrawManualInfo
+	22, // 6: balance.GetWithdrawReviewRes.data:type_name -> balance.WithdrawReviewInfo
+	29, // 7: balance.QueryUserBalanceRes.data:type_name -> balance.UserBalanceInfo
+	32, // 8: balance.QueryGameBalanceRes.data:type_name -> balance.GameBalanceInfo
+	35, // 9: balance.TransferGameRes.data:type_name -> balance.GameTransferInfo
+	40, // 10: balance.GetPaymentAccountsRes.list:type_name -> balance.PaymentAccountInfo
+	40, // 11: balance.GetPaymentAccountUpdateRes.data:type_name -> balance.PaymentAccountInfo
+	53, // 12: balance.GetManualListRes.list:type_name -> balance.GetManualListRes.ListEntry
+	2,  // 13: balance.Balance.GetBalanceChanges:input_type -> balance.GetBalanceChangesReq
+	0,  // 14: balance.Balance.GetChangeList:input_type -> balance.GetChangeListReq
+	5,  // 15: balance.Balance.GetRechargePayments:input_type -> balance.GetRechargePaymentsReq
+	8,  // 16: balance.Balance.GetRechargeManuals:input_type -> balance.GetRechargeManualsReq
+	11, // 17: balance.Balance.ConfirmPaymentOrder:input_type -> balance.ConfirmPaymentOrderReq
+	13, // 18: balance.Balance.NotifyRechargeCreated:input_type -> balance.NotifyRechargeCreatedReq
+	15, // 19: balance.Balance.GetWithdraws:input_type -> balance.GetWithdrawsReq
+	18, // 20: balance.Balance.GetWithdrawManuals:input_type -> balance.GetWithdrawManualsReq
+	21, // 21: balance.Balance.GetWithdrawReview:input_type -> balance.GetWithdrawReviewReq
+	24, // 22: balance.Balance.DealWithWithdraw:input_type -> balance.DealWithWithdrawReq
+	26, // 23: balance.Balance.NotifyWithdrawCreated:input_type -> balance.NotifyWithdrawCreatedReq
+	28, // 24: balance.Balance.QueryUserBalance:input_type -> balance.QueryUserBalanceReq
+	31, // 25: balance.Balance.QueryGameBalance:input_type -> balance.QueryGameBalanceReq
+	34, // 26: balance.Balance.TransferGame:input_type -> balance.TransferGameReq
+	37, // 27: balance.Balance.ManualUserBalance:input_type -> balance.ManualUserBalanceReq
+	39, // 28: balance.Balance.GetPaymentAccounts:input_type -> balance.GetPaymentAccountsReq
+	42, // 29: balance.Balance.CreatePaymentAccount:input_type -> balance.CreatePaymentAccountReq
+	44, // 30: balance.Balance.GetPaymentAccountUpdate:input_type -> balance.GetPaymentAccountUpdateReq
+	46, // 31: balance.Balance.UpdatePaymentAccount:input_type -> balance.UpdatePaymentAccountReq
+	48, // 32: balance.Balance.DeletePaymentAccount:input_type -> balance.DeletePaymentAccountReq
+	50, // 33: balance.Balance.GetManualList:input_type -> balance.GetManualListReq
+	4,  // 34: balance.Balance.GetBalanceChanges:output_type -> balance.GetBalanceChangesRes
+	1,  // 35: balance.Balance.GetChangeList:output_type -> balance.GetChangeListRes
+	7,  // 36: balance.Balance.GetRechargePayments:output_type -> balance.GetRechargePaymentsRes
+	10, // 37: balance.Balance.GetRechargeManuals:output_type -> balance.GetRechargeManualsRes
+	12, // 38: balance.Balance.ConfirmPaymentOrder:output_type -> balance.ConfirmPaymentOrderRes
+	14, // 39: balance.Balance.NotifyRechargeCreated:output_type -> balance.NotifyRechargeCreatedRes
+	17, // 40: balance.Balance.GetWithdraws:output_type -> balance.GetWithdrawsRes
+	20, // 41: balance.Balance.GetWithdrawManuals:output_type -> balance.GetWithdrawManualsRes
+	23, // 42: balance.Balance.GetWithdrawReview:output_type -> balance.GetWithdrawReviewRes
+	25, // 43: balance.Balance.DealWithWithdraw:output_type -> balance.DealWithWithdrawRes
+	27, // 44: balance.Balance.NotifyWithdrawCreated:output_type -> balance.NotifyWithdrawCreatedRes
+	30, // 45: balance.Balance.QueryUserBalance:output_type -> balance.QueryUserBalanceRes
+	33, // 46: balance.Balance.QueryGameBalance:output_type -> balance.QueryGameBalanceRes
+	36, // 47: balance.Balance.TransferGame:output_type -> balance.TransferGameRes
+	38, // 48: balance.Balance.ManualUserBalance:output_type -> balance.ManualUserBalanceRes
+	41, // 49: balance.Balance.GetPaymentAccounts:output_type -> balance.GetPaymentAccountsRes
+	43, // 50: balance.Balance.CreatePaymentAccount:output_type -> balance.CreatePaymentAccountRes
+	45, // 51: balance.Balance.GetPaymentAccountUpdate:output_type -> balance.GetPaymentAccountUpdateRes
+	47, // 52: balance.Balance.UpdatePaymentAccount:output_type -> balance.UpdatePaymentAccountRes
+	49, // 53: balance.Balance.DeletePaymentAccount:output_type -> balance.DeletePaymentAccountRes
+	51, // 54: balance.Balance.GetManualList:output_type -> balance.GetManualListRes
+	34, // [34:55] is the sub-list for method output_type
+	13, // [13:34] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_backend_balance_v1_balance_proto_init() }
@@ -4239,7 +4728,7 @@ func file_backend_balance_v1_balance_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backend_balance_v1_balance_proto_rawDesc), len(file_backend_balance_v1_balance_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   47,
+			NumMessages:   54,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
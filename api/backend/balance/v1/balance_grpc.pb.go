@@ -25,12 +25,15 @@ const (
 	Balance_GetRechargePayments_FullMethodName     = "/balance.Balance/GetRechargePayments"
 	Balance_GetRechargeManuals_FullMethodName      = "/balance.Balance/GetRechargeManuals"
 	Balance_ConfirmPaymentOrder_FullMethodName     = "/balance.Balance/ConfirmPaymentOrder"
+	Balance_NotifyRechargeCreated_FullMethodName   = "/balance.Balance/NotifyRechargeCreated"
 	Balance_GetWithdraws_FullMethodName            = "/balance.Balance/GetWithdraws"
 	Balance_GetWithdrawManuals_FullMethodName      = "/balance.Balance/GetWithdrawManuals"
 	Balance_GetWithdrawReview_FullMethodName       = "/balance.Balance/GetWithdrawReview"
 	Balance_DealWithWithdraw_FullMethodName        = "/balance.Balance/DealWithWithdraw"
+	Balance_NotifyWithdrawCreated_FullMethodName   = "/balance.Balance/NotifyWithdrawCreated"
 	Balance_QueryUserBalance_FullMethodName        = "/balance.Balance/QueryUserBalance"
 	Balance_QueryGameBalance_FullMethodName        = "/balance.Balance/QueryGameBalance"
+	Balance_TransferGame_FullMethodName            = "/balance.Balance/TransferGame"
 	Balance_ManualUserBalance_FullMethodName       = "/balance.Balance/ManualUserBalance"
 	Balance_GetPaymentAccounts_FullMethodName      = "/balance.Balance/GetPaymentAccounts"
 	Balance_CreatePaymentAccount_FullMethodName    = "/balance.Balance/CreatePaymentAccount"
@@ -52,14 +55,17 @@ type BalanceClient interface {
 	GetRechargePayments(ctx context.Context, in *GetRechargePaymentsReq, opts ...grpc.CallOption) (*GetRechargePaymentsRes, error)
 	GetRechargeManuals(ctx context.Context, in *GetRechargeManualsReq, opts ...grpc.CallOption) (*GetRechargeManualsRes, error)
 	ConfirmPaymentOrder(ctx context.Context, in *ConfirmPaymentOrderReq, opts ...grpc.CallOption) (*ConfirmPaymentOrderRes, error)
+	NotifyRechargeCreated(ctx context.Context, in *NotifyRechargeCreatedReq, opts ...grpc.CallOption) (*NotifyRechargeCreatedRes, error)
 	// 提现记录相关
 	GetWithdraws(ctx context.Context, in *GetWithdrawsReq, opts ...grpc.CallOption) (*GetWithdrawsRes, error)
 	GetWithdrawManuals(ctx context.Context, in *GetWithdrawManualsReq, opts ...grpc.CallOption) (*GetWithdrawManualsRes, error)
 	GetWithdrawReview(ctx context.Context, in *GetWithdrawReviewReq, opts ...grpc.CallOption) (*GetWithdrawReviewRes, error)
 	DealWithWithdraw(ctx context.Context, in *DealWithWithdrawReq, opts ...grpc.CallOption) (*DealWithWithdrawRes, error)
+	NotifyWithdrawCreated(ctx context.Context, in *NotifyWithdrawCreatedReq, opts ...grpc.CallOption) (*NotifyWithdrawCreatedRes, error)
 	// 余额查询和操作相关
 	QueryUserBalance(ctx context.Context, in *QueryUserBalanceReq, opts ...grpc.CallOption) (*QueryUserBalanceRes, error)
 	QueryGameBalance(ctx context.Context, in *QueryGameBalanceReq, opts ...grpc.CallOption) (*QueryGameBalanceRes, error)
+	TransferGame(ctx context.Context, in *TransferGameReq, opts ...grpc.CallOption) (*TransferGameRes, error)
 	ManualUserBalance(ctx context.Context, in *ManualUserBalanceReq, opts ...grpc.CallOption) (*ManualUserBalanceRes, error)
 	// 支付接口管理相关
 	GetPaymentAccounts(ctx context.Context, in *GetPaymentAccountsReq, opts ...grpc.CallOption) (*GetPaymentAccountsRes, error)
@@ -129,6 +135,16 @@ func (c *balanceClient) ConfirmPaymentOrder(ctx context.Context, in *ConfirmPaym
 	return out, nil
 }
 
+func (c *balanceClient) NotifyRechargeCreated(ctx context.Context, in *NotifyRechargeCreatedReq, opts ...grpc.CallOption) (*NotifyRechargeCreatedRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotifyRechargeCreatedRes)
+	err := c.cc.Invoke(ctx, Balance_NotifyRechargeCreated_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *balanceClient) GetWithdraws(ctx context.Context, in *GetWithdrawsReq, opts ...grpc.CallOption) (*GetWithdrawsRes, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetWithdrawsRes)
@@ -169,6 +185,16 @@ func (c *balanceClient) DealWithWithdraw(ctx context.Context, in *DealWithWithdr
 	return out, nil
 }
 
+func (c *balanceClient) NotifyWithdrawCreated(ctx context.Context, in *NotifyWithdrawCreatedReq, opts ...grpc.CallOption) (*NotifyWithdrawCreatedRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotifyWithdrawCreatedRes)
+	err := c.cc.Invoke(ctx, Balance_NotifyWithdrawCreated_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *balanceClient) QueryUserBalance(ctx context.Context, in *QueryUserBalanceReq, opts ...grpc.CallOption) (*QueryUserBalanceRes, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryUserBalanceRes)
@@ -189,6 +215,16 @@ func (c *balanceClient) QueryGameBalance(ctx context.Context, in *QueryGameBalan
 	return out, nil
 }
 
+func (c *balanceClient) TransferGame(ctx context.Context, in *TransferGameReq, opts ...grpc.CallOption) (*TransferGameRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferGameRes)
+	err := c.cc.Invoke(ctx, Balance_TransferGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *balanceClient) ManualUserBalance(ctx context.Context, in *ManualUserBalanceReq, opts ...grpc.CallOption) (*ManualUserBalanceRes, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ManualUserBalanceRes)
@@ -271,14 +307,17 @@ type BalanceServer interface {
 	GetRechargePayments(context.Context, *GetRechargePaymentsReq) (*GetRechargePaymentsRes, error)
 	GetRechargeManuals(context.Context, *GetRechargeManualsReq) (*GetRechargeManualsRes, error)
 	ConfirmPaymentOrder(context.Context, *ConfirmPaymentOrderReq) (*ConfirmPaymentOrderRes, error)
+	NotifyRechargeCreated(context.Context, *NotifyRechargeCreatedReq) (*NotifyRechargeCreatedRes, error)
 	// 提现记录相关
 	GetWithdraws(context.Context, *GetWithdrawsReq) (*GetWithdrawsRes, error)
 	GetWithdrawManuals(context.Context, *GetWithdrawManualsReq) (*GetWithdrawManualsRes, error)
 	GetWithdrawReview(context.Context, *GetWithdrawReviewReq) (*GetWithdrawReviewRes, error)
 	DealWithWithdraw(context.Context, *DealWithWithdrawReq) (*DealWithWithdrawRes, error)
+	NotifyWithdrawCreated(context.Context, *NotifyWithdrawCreatedReq) (*NotifyWithdrawCreatedRes, error)
 	// 余额查询和操作相关
 	QueryUserBalance(context.Context, *QueryUserBalanceReq) (*QueryUserBalanceRes, error)
 	QueryGameBalance(context.Context, *QueryGameBalanceReq) (*QueryGameBalanceRes, error)
+	TransferGame(context.Context, *TransferGameReq) (*TransferGameRes, error)
 	ManualUserBalance(context.Context, *ManualUserBalanceReq) (*ManualUserBalanceRes, error)
 	// 支付接口管理相关
 	GetPaymentAccounts(context.Context, *GetPaymentAccountsReq) (*GetPaymentAccountsRes, error)
@@ -313,6 +352,9 @@ func (UnimplementedBalanceServer) GetRechargeManuals(context.Context, *GetRechar
 func (UnimplementedBalanceServer) ConfirmPaymentOrder(context.Context, *ConfirmPaymentOrderReq) (*ConfirmPaymentOrderRes, error) {
 	return nil, status.Error(codes.Unimplemented, "method ConfirmPaymentOrder not implemented")
 }
+func (UnimplementedBalanceServer) NotifyRechargeCreated(context.Context, *NotifyRechargeCreatedReq) (*NotifyRechargeCreatedRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method NotifyRechargeCreated not implemented")
+}
 func (UnimplementedBalanceServer) GetWithdraws(context.Context, *GetWithdrawsReq) (*GetWithdrawsRes, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetWithdraws not implemented")
 }
@@ -325,12 +367,18 @@ func (UnimplementedBalanceServer) GetWithdrawReview(context.Context, *GetWithdra
 func (UnimplementedBalanceServer) DealWithWithdraw(context.Context, *DealWithWithdrawReq) (*DealWithWithdrawRes, error) {
 	return nil, status.Error(codes.Unimplemented, "method DealWithWithdraw not implemented")
 }
+func (UnimplementedBalanceServer) NotifyWithdrawCreated(context.Context, *NotifyWithdrawCreatedReq) (*NotifyWithdrawCreatedRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method NotifyWithdrawCreated not implemented")
+}
 func (UnimplementedBalanceServer) QueryUserBalance(context.Context, *QueryUserBalanceReq) (*QueryUserBalanceRes, error) {
 	return nil, status.Error(codes.Unimplemented, "method QueryUserBalance not implemented")
 }
 func (UnimplementedBalanceServer) QueryGameBalance(context.Context, *QueryGameBalanceReq) (*QueryGameBalanceRes, error) {
 	return nil, status.Error(codes.Unimplemented, "method QueryGameBalance not implemented")
 }
+func (UnimplementedBalanceServer) TransferGame(context.Context, *TransferGameReq) (*TransferGameRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferGame not implemented")
+}
 func (UnimplementedBalanceServer) ManualUserBalance(context.Context, *ManualUserBalanceReq) (*ManualUserBalanceRes, error) {
 	return nil, status.Error(codes.Unimplemented, "method ManualUserBalance not implemented")
 }
@@ -463,6 +511,24 @@ func _Balance_ConfirmPaymentOrder_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Balance_NotifyRechargeCreated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifyRechargeCreatedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BalanceServer).NotifyRechargeCreated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Balance_NotifyRechargeCreated_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BalanceServer).NotifyRechargeCreated(ctx, req.(*NotifyRechargeCreatedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Balance_GetWithdraws_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetWithdrawsReq)
 	if err := dec(in); err != nil {
@@ -535,6 +601,24 @@ func _Balance_DealWithWithdraw_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Balance_NotifyWithdrawCreated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifyWithdrawCreatedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BalanceServer).NotifyWithdrawCreated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Balance_NotifyWithdrawCreated_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BalanceServer).NotifyWithdrawCreated(ctx, req.(*NotifyWithdrawCreatedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Balance_QueryUserBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryUserBalanceReq)
 	if err := dec(in); err != nil {
@@ -571,6 +655,24 @@ func _Balance_QueryGameBalance_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Balance_TransferGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferGameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BalanceServer).TransferGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Balance_TransferGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BalanceServer).TransferGame(ctx, req.(*TransferGameReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Balance_ManualUserBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ManualUserBalanceReq)
 	if err := dec(in); err != nil {
@@ -724,6 +826,10 @@ var Balance_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConfirmPaymentOrder",
 			Handler:    _Balance_ConfirmPaymentOrder_Handler,
 		},
+		{
+			MethodName: "NotifyRechargeCreated",
+			Handler:    _Balance_NotifyRechargeCreated_Handler,
+		},
 		{
 			MethodName: "GetWithdraws",
 			Handler:    _Balance_GetWithdraws_Handler,
@@ -740,6 +846,10 @@ var Balance_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DealWithWithdraw",
 			Handler:    _Balance_DealWithWithdraw_Handler,
 		},
+		{
+			MethodName: "NotifyWithdrawCreated",
+			Handler:    _Balance_NotifyWithdrawCreated_Handler,
+		},
 		{
 			MethodName: "QueryUserBalance",
 			Handler:    _Balance_QueryUserBalance_Handler,
@@ -748,6 +858,10 @@ var Balance_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryGameBalance",
 			Handler:    _Balance_QueryGameBalance_Handler,
 		},
+		{
+			MethodName: "TransferGame",
+			Handler:    _Balance_TransferGame_Handler,
+		},
 		{
 			MethodName: "ManualUserBalance",
 			Handler:    _Balance_ManualUserBalance_Handler,
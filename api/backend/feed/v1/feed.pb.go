@@ -0,0 +1,229 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: backend/feed/v1/feed.proto
+
+package v1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SubscribeReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topics        []string               `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics" dc:"订阅的主题 risk/notice，为空时订阅全部有权限的主题"`                          // 订阅的主题 risk/notice，为空时订阅全部有权限的主题
+	LastEventId   uint64                 `protobuf:"varint,2,opt,name=last_event_id,json=lastEventId,proto3" json:"last_event_id" dc:"断线重连时传入最后收到的事件ID，补发之后的事件"` // 断线重连时传入最后收到的事件ID，补发之后的事件
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeReq) Reset() {
+	*x = SubscribeReq{}
+	mi := &file_backend_feed_v1_feed_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeReq) ProtoMessage() {}
+
+func (x *SubscribeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_feed_v1_feed_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeReq.ProtoReflect.Descriptor instead.
+func (*SubscribeReq) Descriptor() ([]byte, []int) {
+	return file_backend_feed_v1_feed_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubscribeReq) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *SubscribeReq) GetLastEventId() uint64 {
+	if x != nil {
+		return x.LastEventId
+	}
+	return 0
+}
+
+type FeedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"事件ID，心跳事件为0"`                                  // 事件ID，心跳事件为0
+	Kind          int32                  `protobuf:"varint,2,opt,name=kind,proto3" json:"kind" dc:"事件类别 1=业务事件 2=心跳 3=需要重新加载 (断线期间的事件已无法补发)"` // 事件类别 1=业务事件 2=心跳 3=需要重新加载 (断线期间的事件已无法补发)
+	Topic         string                 `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic" dc:"事件主题"`                                    // 事件主题
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title" dc:"事件标题"`                                    // 事件标题
+	Data          string                 `protobuf:"bytes,5,opt,name=data,proto3" json:"data" dc:"事件内容 (JSON)"`                               // 事件内容 (JSON)
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"事件时间"`           // 事件时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedEvent) Reset() {
+	*x = FeedEvent{}
+	mi := &file_backend_feed_v1_feed_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedEvent) ProtoMessage() {}
+
+func (x *FeedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_feed_v1_feed_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedEvent.ProtoReflect.Descriptor instead.
+func (*FeedEvent) Descriptor() ([]byte, []int) {
+	return file_backend_feed_v1_feed_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FeedEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FeedEvent) GetKind() int32 {
+	if x != nil {
+		return x.Kind
+	}
+	return 0
+}
+
+func (x *FeedEvent) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *FeedEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *FeedEvent) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *FeedEvent) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+var File_backend_feed_v1_feed_proto protoreflect.FileDescriptor
+
+const file_backend_feed_v1_feed_proto_rawDesc = "" +
+	"\n" +
+	"\x1abackend/feed/v1/feed.proto\x12\x04feed\"J\n" +
+	"\fSubscribeReq\x12\x16\n" +
+	"\x06topics\x18\x01 \x03(\tR\x06topics\x12\"\n" +
+	"\rlast_event_id\x18\x02 \x01(\x04R\vlastEventId\"\x8e\x01\n" +
+	"\tFeedEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\x05R\x04kind\x12\x14\n" +
+	"\x05topic\x18\x03 \x01(\tR\x05topic\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x12\n" +
+	"\x04data\x18\x05 \x01(\tR\x04data\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt2<\n" +
+	"\x04Feed\x124\n" +
+	"\tSubscribe\x12\x12.feed.SubscribeReq\x1a\x0f.feed.FeedEvent\"\x000\x01B$Z\"jh_app_service/api/backend/feed/v1b\x06proto3"
+
+var (
+	file_backend_feed_v1_feed_proto_rawDescOnce sync.Once
+	file_backend_feed_v1_feed_proto_rawDescData []byte
+)
+
+func file_backend_feed_v1_feed_proto_rawDescGZIP() []byte {
+	file_backend_feed_v1_feed_proto_rawDescOnce.Do(func() {
+		file_backend_feed_v1_feed_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_backend_feed_v1_feed_proto_rawDesc), len(file_backend_feed_v1_feed_proto_rawDesc)))
+	})
+	return file_backend_feed_v1_feed_proto_rawDescData
+}
+
+var file_backend_feed_v1_feed_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_backend_feed_v1_feed_proto_goTypes = []any{
+	(*SubscribeReq)(nil), // 0: feed.SubscribeReq
+	(*FeedEvent)(nil),    // 1: feed.FeedEvent
+}
+var file_backend_feed_v1_feed_proto_depIdxs = []int32{
+	0, // 0: feed.Feed.Subscribe:input_type -> feed.SubscribeReq
+	1, // 1: feed.Feed.Subscribe:output_type -> feed.FeedEvent
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_backend_feed_v1_feed_proto_init() }
+func file_backend_feed_v1_feed_proto_init() {
+	if File_backend_feed_v1_feed_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backend_feed_v1_feed_proto_rawDesc), len(file_backend_feed_v1_feed_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_backend_feed_v1_feed_proto_goTypes,
+		DependencyIndexes: file_backend_feed_v1_feed_proto_depIdxs,
+		MessageInfos:      file_backend_feed_v1_feed_proto_msgTypes,
+	}.Build()
+	File_backend_feed_v1_feed_proto = out.File
+	file_backend_feed_v1_feed_proto_goTypes = nil
+	file_backend_feed_v1_feed_proto_depIdxs = nil
+}
@@ -0,0 +1,3697 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: backend/payment/v1/payment.proto
+
+package v1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 获取可用入款渠道请求
+type GetPaymentChannelsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"会员ID"` // 会员ID
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount" dc:"入款金额"`              // 入款金额
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPaymentChannelsReq) Reset() {
+	*x = GetPaymentChannelsReq{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentChannelsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentChannelsReq) ProtoMessage() {}
+
+func (x *GetPaymentChannelsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentChannelsReq.ProtoReflect.Descriptor instead.
+func (*GetPaymentChannelsReq) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetPaymentChannelsReq) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetPaymentChannelsReq) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// 入款渠道
+type PaymentChannel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          int32                  `protobuf:"varint,1,opt,name=type,proto3" json:"type" dc:"渠道类型 1=在线支付 2=转账汇款"`                             // 渠道类型 1=在线支付 2=转账汇款
+	AccountId     int32                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id" dc:"支付接口ID或转账接口ID"`       // 支付接口ID或转账接口ID
+	Gateway       int32                  `protobuf:"varint,3,opt,name=gateway,proto3" json:"gateway" dc:"支付网关 (在线支付) 或转账类型 (转账汇款)"`                 // 支付网关 (在线支付) 或转账类型 (转账汇款)
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name" dc:"接口名称"`                                            // 接口名称
+	EachMin       float64                `protobuf:"fixed64,5,opt,name=each_min,json=eachMin,proto3" json:"each_min" dc:"单笔最低"`                     // 单笔最低
+	EachMax       float64                `protobuf:"fixed64,6,opt,name=each_max,json=eachMax,proto3" json:"each_max" dc:"单笔最高 0=不限"`                // 单笔最高 0=不限
+	DailyRemain   float64                `protobuf:"fixed64,7,opt,name=daily_remain,json=dailyRemain,proto3" json:"daily_remain" dc:"今日剩余额度 -1=不限"` // 今日剩余额度 -1=不限
+	Sort          int32                  `protobuf:"varint,8,opt,name=sort,proto3" json:"sort" dc:"排序"`                                             // 排序
+	Weight        int32                  `protobuf:"varint,9,opt,name=weight,proto3" json:"weight" dc:"权重"`                                         // 权重
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentChannel) Reset() {
+	*x = PaymentChannel{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentChannel) ProtoMessage() {}
+
+func (x *PaymentChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentChannel.ProtoReflect.Descriptor instead.
+func (*PaymentChannel) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PaymentChannel) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *PaymentChannel) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *PaymentChannel) GetGateway() int32 {
+	if x != nil {
+		return x.Gateway
+	}
+	return 0
+}
+
+func (x *PaymentChannel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PaymentChannel) GetEachMin() float64 {
+	if x != nil {
+		return x.EachMin
+	}
+	return 0
+}
+
+func (x *PaymentChannel) GetEachMax() float64 {
+	if x != nil {
+		return x.EachMax
+	}
+	return 0
+}
+
+func (x *PaymentChannel) GetDailyRemain() float64 {
+	if x != nil {
+		return x.DailyRemain
+	}
+	return 0
+}
+
+func (x *PaymentChannel) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *PaymentChannel) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// 获取可用入款渠道响应
+type GetPaymentChannelsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*PaymentChannel      `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"可用渠道列表，按推荐顺序排列"` // 可用渠道列表，按推荐顺序排列
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPaymentChannelsRes) Reset() {
+	*x = GetPaymentChannelsRes{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentChannelsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentChannelsRes) ProtoMessage() {}
+
+func (x *GetPaymentChannelsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentChannelsRes.ProtoReflect.Descriptor instead.
+func (*GetPaymentChannelsRes) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetPaymentChannelsRes) GetList() []*PaymentChannel {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// 上报支付网关调用结果请求，会员端每次调用支付网关下单后上报，用于统计失败率和自动熔断
+type ReportGatewayResultReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int32                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id" dc:"支付接口ID"`    // 支付接口ID
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success" dc:"是否下单成功"`                         // 是否下单成功
+	LatencyMs     int64                  `protobuf:"varint,3,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms" dc:"调用耗时 (毫秒)"` // 调用耗时 (毫秒)
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error" dc:"失败原因"`                                // 失败原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportGatewayResultReq) Reset() {
+	*x = ReportGatewayResultReq{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportGatewayResultReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportGatewayResultReq) ProtoMessage() {}
+
+func (x *ReportGatewayResultReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportGatewayResultReq.ProtoReflect.Descriptor instead.
+func (*ReportGatewayResultReq) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReportGatewayResultReq) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ReportGatewayResultReq) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReportGatewayResultReq) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *ReportGatewayResultReq) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// 上报支付网关调用结果响应
+type ReportGatewayResultRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportGatewayResultRes) Reset() {
+	*x = ReportGatewayResultRes{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportGatewayResultRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportGatewayResultRes) ProtoMessage() {}
+
+func (x *ReportGatewayResultRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportGatewayResultRes.ProtoReflect.Descriptor instead.
+func (*ReportGatewayResultRes) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReportGatewayResultRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReportGatewayResultRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 获取转账接口列表请求
+type GetTransferAccountsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BankType      int32                  `protobuf:"varint,1,opt,name=bank_type,json=bankType,proto3" json:"bank_type" dc:"转账类型 (可选)"` // 转账类型 (可选)
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status" dc:"状态 (可选) 0=全部 1=可用 2=禁用"`        // 状态 (可选) 0=全部 1=可用 2=禁用
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page" dc:"页码"`                                // 页码
+	Size          int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size" dc:"每页数量"`                              // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransferAccountsReq) Reset() {
+	*x = GetTransferAccountsReq{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransferAccountsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransferAccountsReq) ProtoMessage() {}
+
+func (x *GetTransferAccountsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransferAccountsReq.ProtoReflect.Descriptor instead.
+func (*GetTransferAccountsReq) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetTransferAccountsReq) GetBankType() int32 {
+	if x != nil {
+		return x.BankType
+	}
+	return 0
+}
+
+func (x *GetTransferAccountsReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetTransferAccountsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetTransferAccountsReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// 转账接口信息
+type TransferAccountInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"转账接口ID"`                                             // 转账接口ID
+	BankType      int32                  `protobuf:"varint,2,opt,name=bank_type,json=bankType,proto3" json:"bank_type" dc:"转账类型 1=网银转账 2=微信 3=支付宝"` // 转账类型 1=网银转账 2=微信 3=支付宝
+	BankName      string                 `protobuf:"bytes,3,opt,name=bank_name,json=bankName,proto3" json:"bank_name" dc:"银行名称"`                    // 银行名称
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name" dc:"转账接口名称"`                                          // 转账接口名称
+	BankUrl       string                 `protobuf:"bytes,5,opt,name=bank_url,json=bankUrl,proto3" json:"bank_url" dc:"银行链接"`                       // 银行链接
+	Qrcode        string                 `protobuf:"bytes,6,opt,name=qrcode,proto3" json:"qrcode" dc:"二维码图片地址"`                                     // 二维码图片地址
+	CardAccount   string                 `protobuf:"bytes,7,opt,name=card_account,json=cardAccount,proto3" json:"card_account" dc:"银行户名或者第三方收款人"`   // 银行户名或者第三方收款人
+	CardNo        string                 `protobuf:"bytes,8,opt,name=card_no,json=cardNo,proto3" json:"card_no" dc:"银行卡号或者第三方账号 (列表中脱敏)"`           // 银行卡号或者第三方账号 (列表中脱敏)
+	DepositBank   string                 `protobuf:"bytes,9,opt,name=deposit_bank,json=depositBank,proto3" json:"deposit_bank" dc:"开户行"`            // 开户行
+	EachMin       float64                `protobuf:"fixed64,10,opt,name=each_min,json=eachMin,proto3" json:"each_min" dc:"单笔最低"`                    // 单笔最低
+	EachMax       float64                `protobuf:"fixed64,11,opt,name=each_max,json=eachMax,proto3" json:"each_max" dc:"单笔最高"`                    // 单笔最高
+	DailyMax      float64                `protobuf:"fixed64,12,opt,name=daily_max,json=dailyMax,proto3" json:"daily_max" dc:"单日上限 0=不限"`            // 单日上限 0=不限
+	TodayCount    int32                  `protobuf:"varint,13,opt,name=today_count,json=todayCount,proto3" json:"today_count" dc:"今日入款次数"`          // 今日入款次数
+	TodayAmount   float64                `protobuf:"fixed64,14,opt,name=today_amount,json=todayAmount,proto3" json:"today_amount" dc:"今日转账总额"`      // 今日转账总额
+	Status        int32                  `protobuf:"varint,15,opt,name=status,proto3" json:"status" dc:"状态 1=可用 0=禁用"`                              // 状态 1=可用 0=禁用
+	Sort          int32                  `protobuf:"varint,16,opt,name=sort,proto3" json:"sort" dc:"排序"`                                            // 排序
+	Weight        int32                  `protobuf:"varint,17,opt,name=weight,proto3" json:"weight" dc:"权重"`                                        // 权重
+	Remark        string                 `protobuf:"bytes,18,opt,name=remark,proto3" json:"remark" dc:"备注"`                                         // 备注
+	LevelIds      []int32                `protobuf:"varint,19,rep,packed,name=level_ids,json=levelIds,proto3" json:"level_ids" dc:"适用的会员层级ID"`      // 适用的会员层级ID
+	CreatedAt     string                 `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间"`                // 创建时间
+	UpdatedAt     string                 `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at" dc:"更新时间"`                // 更新时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferAccountInfo) Reset() {
+	*x = TransferAccountInfo{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferAccountInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferAccountInfo) ProtoMessage() {}
+
+func (x *TransferAccountInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferAccountInfo.ProtoReflect.Descriptor instead.
+func (*TransferAccountInfo) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TransferAccountInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransferAccountInfo) GetBankType() int32 {
+	if x != nil {
+		return x.BankType
+	}
+	return 0
+}
+
+func (x *TransferAccountInfo) GetBankName() string {
+	if x != nil {
+		return x.BankName
+	}
+	return ""
+}
+
+func (x *TransferAccountInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransferAccountInfo) GetBankUrl() string {
+	if x != nil {
+		return x.BankUrl
+	}
+	return ""
+}
+
+func (x *TransferAccountInfo) GetQrcode() string {
+	if x != nil {
+		return x.Qrcode
+	}
+	return ""
+}
+
+func (x *TransferAccountInfo) GetCardAccount() string {
+	if x != nil {
+		return x.CardAccount
+	}
+	return ""
+}
+
+func (x *TransferAccountInfo) GetCardNo() string {
+	if x != nil {
+		return x.CardNo
+	}
+	return ""
+}
+
+func (x *TransferAccountInfo) GetDepositBank() string {
+	if x != nil {
+		return x.DepositBank
+	}
+	return ""
+}
+
+func (x *TransferAccountInfo) GetEachMin() float64 {
+	if x != nil {
+		return x.EachMin
+	}
+	return 0
+}
+
+func (x *TransferAccountInfo) GetEachMax() float64 {
+	if x != nil {
+		return x.EachMax
+	}
+	return 0
+}
+
+func (x *TransferAccountInfo) GetDailyMax() float64 {
+	if x != nil {
+		return x.DailyMax
+	}
+	return 0
+}
+
+func (x *TransferAccountInfo) GetTodayCount() int32 {
+	if x != nil {
+		return x.TodayCount
+	}
+	return 0
+}
+
+func (x *TransferAccountInfo) GetTodayAmount() float64 {
+	if x != nil {
+		return x.TodayAmount
+	}
+	return 0
+}
+
+func (x *TransferAccountInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *TransferAccountInfo) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *TransferAccountInfo) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *TransferAccountInfo) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *TransferAccountInfo) GetLevelIds() []int32 {
+	if x != nil {
+		return x.LevelIds
+	}
+	return nil
+}
+
+func (x *TransferAccountInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *TransferAccountInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// 获取转账接口列表响应
+type GetTransferAccountsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*TransferAccountInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"转账接口列表"` // 转账接口列表
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count" dc:"总数量"` // 总数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransferAccountsRes) Reset() {
+	*x = GetTransferAccountsRes{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransferAccountsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransferAccountsRes) ProtoMessage() {}
+
+func (x *GetTransferAccountsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransferAccountsRes.ProtoReflect.Descriptor instead.
+func (*GetTransferAccountsRes) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTransferAccountsRes) GetList() []*TransferAccountInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *GetTransferAccountsRes) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 创建转账接口请求
+type CreateTransferAccountReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BankType      int32                  `protobuf:"varint,1,opt,name=bank_type,json=bankType,proto3" json:"bank_type" dc:"转账类型 1=网银转账 2=微信 3=支付宝"` // 转账类型 1=网银转账 2=微信 3=支付宝
+	BankName      string                 `protobuf:"bytes,2,opt,name=bank_name,json=bankName,proto3" json:"bank_name" dc:"银行名称"`                    // 银行名称
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name" dc:"转账接口名称"`                                          // 转账接口名称
+	BankUrl       string                 `protobuf:"bytes,4,opt,name=bank_url,json=bankUrl,proto3" json:"bank_url" dc:"银行链接"`                       // 银行链接
+	Qrcode        string                 `protobuf:"bytes,5,opt,name=qrcode,proto3" json:"qrcode" dc:"二维码图片地址，须为上传接口返回的地址"`                         // 二维码图片地址，须为上传接口返回的地址
+	CardAccount   string                 `protobuf:"bytes,6,opt,name=card_account,json=cardAccount,proto3" json:"card_account" dc:"银行户名或者第三方收款人"`   // 银行户名或者第三方收款人
+	CardNo        string                 `protobuf:"bytes,7,opt,name=card_no,json=cardNo,proto3" json:"card_no" dc:"银行卡号或者第三方账号"`                   // 银行卡号或者第三方账号
+	DepositBank   string                 `protobuf:"bytes,8,opt,name=deposit_bank,json=depositBank,proto3" json:"deposit_bank" dc:"开户行"`            // 开户行
+	EachMin       float64                `protobuf:"fixed64,9,opt,name=each_min,json=eachMin,proto3" json:"each_min" dc:"单笔最低"`                     // 单笔最低
+	EachMax       float64                `protobuf:"fixed64,10,opt,name=each_max,json=eachMax,proto3" json:"each_max" dc:"单笔最高"`                    // 单笔最高
+	DailyMax      float64                `protobuf:"fixed64,11,opt,name=daily_max,json=dailyMax,proto3" json:"daily_max" dc:"单日上限 0=不限"`            // 单日上限 0=不限
+	Status        int32                  `protobuf:"varint,12,opt,name=status,proto3" json:"status" dc:"状态 1=可用 0=禁用"`                              // 状态 1=可用 0=禁用
+	Sort          int32                  `protobuf:"varint,13,opt,name=sort,proto3" json:"sort" dc:"排序"`                                            // 排序
+	Weight        int32                  `protobuf:"varint,14,opt,name=weight,proto3" json:"weight" dc:"权重"`                                        // 权重
+	Remark        string                 `protobuf:"bytes,15,opt,name=remark,proto3" json:"remark" dc:"备注"`                                         // 备注
+	LevelIds      []int32                `protobuf:"varint,16,rep,packed,name=level_ids,json=levelIds,proto3" json:"level_ids" dc:"适用的会员层级ID"`      // 适用的会员层级ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTransferAccountReq) Reset() {
+	*x = CreateTransferAccountReq{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransferAccountReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransferAccountReq) ProtoMessage() {}
+
+func (x *CreateTransferAccountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransferAccountReq.ProtoReflect.Descriptor instead.
+func (*CreateTransferAccountReq) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateTransferAccountReq) GetBankType() int32 {
+	if x != nil {
+		return x.BankType
+	}
+	return 0
+}
+
+func (x *CreateTransferAccountReq) GetBankName() string {
+	if x != nil {
+		return x.BankName
+	}
+	return ""
+}
+
+func (x *CreateTransferAccountReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTransferAccountReq) GetBankUrl() string {
+	if x != nil {
+		return x.BankUrl
+	}
+	return ""
+}
+
+func (x *CreateTransferAccountReq) GetQrcode() string {
+	if x != nil {
+		return x.Qrcode
+	}
+	return ""
+}
+
+func (x *CreateTransferAccountReq) GetCardAccount() string {
+	if x != nil {
+		return x.CardAccount
+	}
+	return ""
+}
+
+func (x *CreateTransferAccountReq) GetCardNo() string {
+	if x != nil {
+		return x.CardNo
+	}
+	return ""
+}
+
+func (x *CreateTransferAccountReq) GetDepositBank() string {
+	if x != nil {
+		return x.DepositBank
+	}
+	return ""
+}
+
+func (x *CreateTransferAccountReq) GetEachMin() float64 {
+	if x != nil {
+		return x.EachMin
+	}
+	return 0
+}
+
+func (x *CreateTransferAccountReq) GetEachMax() float64 {
+	if x != nil {
+		return x.EachMax
+	}
+	return 0
+}
+
+func (x *CreateTransferAccountReq) GetDailyMax() float64 {
+	if x != nil {
+		return x.DailyMax
+	}
+	return 0
+}
+
+func (x *CreateTransferAccountReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *CreateTransferAccountReq) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *CreateTransferAccountReq) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *CreateTransferAccountReq) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *CreateTransferAccountReq) GetLevelIds() []int32 {
+	if x != nil {
+		return x.LevelIds
+	}
+	return nil
+}
+
+// 创建转账接口响应
+type CreateTransferAccountRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	Id            int32                  `protobuf:"varint,3,opt,name=id,proto3" json:"id" dc:"转账接口ID"`         // 转账接口ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTransferAccountRes) Reset() {
+	*x = CreateTransferAccountRes{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransferAccountRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransferAccountRes) ProtoMessage() {}
+
+func (x *CreateTransferAccountRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransferAccountRes.ProtoReflect.Descriptor instead.
+func (*CreateTransferAccountRes) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateTransferAccountRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateTransferAccountRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateTransferAccountRes) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 获取转账接口编辑信息请求
+type GetTransferAccountUpdateReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"转账接口ID"` // 转账接口ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransferAccountUpdateReq) Reset() {
+	*x = GetTransferAccountUpdateReq{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransferAccountUpdateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransferAccountUpdateReq) ProtoMessage() {}
+
+func (x *GetTransferAccountUpdateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransferAccountUpdateReq.ProtoReflect.Descriptor instead.
+func (*GetTransferAccountUpdateReq) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetTransferAccountUpdateReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 获取转账接口编辑信息响应
+type GetTransferAccountUpdateRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Info          *TransferAccountInfo   `protobuf:"bytes,1,opt,name=info,proto3" json:"info" dc:"转账接口信息 (卡号不脱敏)"` // 转账接口信息 (卡号不脱敏)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransferAccountUpdateRes) Reset() {
+	*x = GetTransferAccountUpdateRes{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransferAccountUpdateRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransferAccountUpdateRes) ProtoMessage() {}
+
+func (x *GetTransferAccountUpdateRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransferAccountUpdateRes.ProtoReflect.Descriptor instead.
+func (*GetTransferAccountUpdateRes) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetTransferAccountUpdateRes) GetInfo() *TransferAccountInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// 更新转账接口请求
+type UpdateTransferAccountReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"转账接口ID"`                                             // 转账接口ID
+	BankType      int32                  `protobuf:"varint,2,opt,name=bank_type,json=bankType,proto3" json:"bank_type" dc:"转账类型 1=网银转账 2=微信 3=支付宝"` // 转账类型 1=网银转账 2=微信 3=支付宝
+	BankName      string                 `protobuf:"bytes,3,opt,name=bank_name,json=bankName,proto3" json:"bank_name" dc:"银行名称"`                    // 银行名称
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name" dc:"转账接口名称"`                                          // 转账接口名称
+	BankUrl       string                 `protobuf:"bytes,5,opt,name=bank_url,json=bankUrl,proto3" json:"bank_url" dc:"银行链接"`                       // 银行链接
+	Qrcode        string                 `protobuf:"bytes,6,opt,name=qrcode,proto3" json:"qrcode" dc:"二维码图片地址，须为上传接口返回的地址"`                         // 二维码图片地址，须为上传接口返回的地址
+	CardAccount   string                 `protobuf:"bytes,7,opt,name=card_account,json=cardAccount,proto3" json:"card_account" dc:"银行户名或者第三方收款人"`   // 银行户名或者第三方收款人
+	CardNo        string                 `protobuf:"bytes,8,opt,name=card_no,json=cardNo,proto3" json:"card_no" dc:"银行卡号或者第三方账号，传入脱敏值表示不修改"`        // 银行卡号或者第三方账号，传入脱敏值表示不修改
+	DepositBank   string                 `protobuf:"bytes,9,opt,name=deposit_bank,json=depositBank,proto3" json:"deposit_bank" dc:"开户行"`            // 开户行
+	EachMin       float64                `protobuf:"fixed64,10,opt,name=each_min,json=eachMin,proto3" json:"each_min" dc:"单笔最低"`                    // 单笔最低
+	EachMax       float64                `protobuf:"fixed64,11,opt,name=each_max,json=eachMax,proto3" json:"each_max" dc:"单笔最高"`                    // 单笔最高
+	DailyMax      float64                `protobuf:"fixed64,12,opt,name=daily_max,json=dailyMax,proto3" json:"daily_max" dc:"单日上限 0=不限"`            // 单日上限 0=不限
+	Status        int32                  `protobuf:"varint,13,opt,name=status,proto3" json:"status" dc:"状态 1=可用 0=禁用"`                              // 状态 1=可用 0=禁用
+	Sort          int32                  `protobuf:"varint,14,opt,name=sort,proto3" json:"sort" dc:"排序"`                                            // 排序
+	Weight        int32                  `protobuf:"varint,15,opt,name=weight,proto3" json:"weight" dc:"权重"`                                        // 权重
+	Remark        string                 `protobuf:"bytes,16,opt,name=remark,proto3" json:"remark" dc:"备注"`                                         // 备注
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTransferAccountReq) Reset() {
+	*x = UpdateTransferAccountReq{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTransferAccountReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTransferAccountReq) ProtoMessage() {}
+
+func (x *UpdateTransferAccountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTransferAccountReq.ProtoReflect.Descriptor instead.
+func (*UpdateTransferAccountReq) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateTransferAccountReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTransferAccountReq) GetBankType() int32 {
+	if x != nil {
+		return x.BankType
+	}
+	return 0
+}
+
+func (x *UpdateTransferAccountReq) GetBankName() string {
+	if x != nil {
+		return x.BankName
+	}
+	return ""
+}
+
+func (x *UpdateTransferAccountReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTransferAccountReq) GetBankUrl() string {
+	if x != nil {
+		return x.BankUrl
+	}
+	return ""
+}
+
+func (x *UpdateTransferAccountReq) GetQrcode() string {
+	if x != nil {
+		return x.Qrcode
+	}
+	return ""
+}
+
+func (x *UpdateTransferAccountReq) GetCardAccount() string {
+	if x != nil {
+		return x.CardAccount
+	}
+	return ""
+}
+
+func (x *UpdateTransferAccountReq) GetCardNo() string {
+	if x != nil {
+		return x.CardNo
+	}
+	return ""
+}
+
+func (x *UpdateTransferAccountReq) GetDepositBank() string {
+	if x != nil {
+		return x.DepositBank
+	}
+	return ""
+}
+
+func (x *UpdateTransferAccountReq) GetEachMin() float64 {
+	if x != nil {
+		return x.EachMin
+	}
+	return 0
+}
+
+func (x *UpdateTransferAccountReq) GetEachMax() float64 {
+	if x != nil {
+		return x.EachMax
+	}
+	return 0
+}
+
+func (x *UpdateTransferAccountReq) GetDailyMax() float64 {
+	if x != nil {
+		return x.DailyMax
+	}
+	return 0
+}
+
+func (x *UpdateTransferAccountReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *UpdateTransferAccountReq) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *UpdateTransferAccountReq) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *UpdateTransferAccountReq) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+// 更新转账接口响应
+type UpdateTransferAccountRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTransferAccountRes) Reset() {
+	*x = UpdateTransferAccountRes{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTransferAccountRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTransferAccountRes) ProtoMessage() {}
+
+func (x *UpdateTransferAccountRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTransferAccountRes.ProtoReflect.Descriptor instead.
+func (*UpdateTransferAccountRes) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateTransferAccountRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateTransferAccountRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 删除转账接口请求
+type DeleteTransferAccountReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"转账接口ID"` // 转账接口ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTransferAccountReq) Reset() {
+	*x = DeleteTransferAccountReq{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTransferAccountReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTransferAccountReq) ProtoMessage() {}
+
+func (x *DeleteTransferAccountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTransferAccountReq.ProtoReflect.Descriptor instead.
+func (*DeleteTransferAccountReq) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteTransferAccountReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 删除转账接口响应
+type DeleteTransferAccountRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTransferAccountRes) Reset() {
+	*x = DeleteTransferAccountRes{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTransferAccountRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTransferAccountRes) ProtoMessage() {}
+
+func (x *DeleteTransferAccountRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTransferAccountRes.ProtoReflect.Descriptor instead.
+func (*DeleteTransferAccountRes) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteTransferAccountRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteTransferAccountRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 转账接口排序项
+type TransferAccountSortItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"转账接口ID"`  // 转账接口ID
+	Sort          int32                  `protobuf:"varint,2,opt,name=sort,proto3" json:"sort" dc:"排序值"` // 排序值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferAccountSortItem) Reset() {
+	*x = TransferAccountSortItem{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferAccountSortItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferAccountSortItem) ProtoMessage() {}
+
+func (x *TransferAccountSortItem) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferAccountSortItem.ProtoReflect.Descriptor instead.
+func (*TransferAccountSortItem) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TransferAccountSortItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransferAccountSortItem) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+// 转账接口排序请求
+type SortTransferAccountsReq struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Items         []*TransferAccountSortItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items" dc:"排序列表"` // 排序列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortTransferAccountsReq) Reset() {
+	*x = SortTransferAccountsReq{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortTransferAccountsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortTransferAccountsReq) ProtoMessage() {}
+
+func (x *SortTransferAccountsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortTransferAccountsReq.ProtoReflect.Descriptor instead.
+func (*SortTransferAccountsReq) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SortTransferAccountsReq) GetItems() []*TransferAccountSortItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 转账接口排序响应
+type SortTransferAccountsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortTransferAccountsRes) Reset() {
+	*x = SortTransferAccountsRes{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortTransferAccountsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortTransferAccountsRes) ProtoMessage() {}
+
+func (x *SortTransferAccountsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortTransferAccountsRes.ProtoReflect.Descriptor instead.
+func (*SortTransferAccountsRes) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SortTransferAccountsRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SortTransferAccountsRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 启用/禁用转账接口请求
+type SetTransferAccountStatusReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"转账接口ID"`               // 转账接口ID
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status" dc:"状态 1=可用 0=禁用"` // 状态 1=可用 0=禁用
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTransferAccountStatusReq) Reset() {
+	*x = SetTransferAccountStatusReq{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransferAccountStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransferAccountStatusReq) ProtoMessage() {}
+
+func (x *SetTransferAccountStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransferAccountStatusReq.ProtoReflect.Descriptor instead.
+func (*SetTransferAccountStatusReq) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetTransferAccountStatusReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetTransferAccountStatusReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 启用/禁用转账接口响应
+type SetTransferAccountStatusRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTransferAccountStatusRes) Reset() {
+	*x = SetTransferAccountStatusRes{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransferAccountStatusRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransferAccountStatusRes) ProtoMessage() {}
+
+func (x *SetTransferAccountStatusRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransferAccountStatusRes.ProtoReflect.Descriptor instead.
+func (*SetTransferAccountStatusRes) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetTransferAccountStatusRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetTransferAccountStatusRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 获取转账接口适用层级请求
+type GetTransferAccountLevelsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"转账接口ID"` // 转账接口ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransferAccountLevelsReq) Reset() {
+	*x = GetTransferAccountLevelsReq{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransferAccountLevelsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransferAccountLevelsReq) ProtoMessage() {}
+
+func (x *GetTransferAccountLevelsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransferAccountLevelsReq.ProtoReflect.Descriptor instead.
+func (*GetTransferAccountLevelsReq) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetTransferAccountLevelsReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 会员层级选项
+type TransferLevelItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LevelId       int32                  `protobuf:"varint,1,opt,name=level_id,json=levelId,proto3" json:"level_id" dc:"会员层级ID"` // 会员层级ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name" dc:"层级名称"`                         // 层级名称
+	Checked       bool                   `protobuf:"varint,3,opt,name=checked,proto3" json:"checked" dc:"是否已关联"`                 // 是否已关联
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferLevelItem) Reset() {
+	*x = TransferLevelItem{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferLevelItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferLevelItem) ProtoMessage() {}
+
+func (x *TransferLevelItem) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferLevelItem.ProtoReflect.Descriptor instead.
+func (*TransferLevelItem) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TransferLevelItem) GetLevelId() int32 {
+	if x != nil {
+		return x.LevelId
+	}
+	return 0
+}
+
+func (x *TransferLevelItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransferLevelItem) GetChecked() bool {
+	if x != nil {
+		return x.Checked
+	}
+	return false
+}
+
+// 获取转账接口适用层级响应
+type GetTransferAccountLevelsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*TransferLevelItem   `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"层级列表"` // 层级列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransferAccountLevelsRes) Reset() {
+	*x = GetTransferAccountLevelsRes{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransferAccountLevelsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransferAccountLevelsRes) ProtoMessage() {}
+
+func (x *GetTransferAccountLevelsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransferAccountLevelsRes.ProtoReflect.Descriptor instead.
+func (*GetTransferAccountLevelsRes) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetTransferAccountLevelsRes) GetList() []*TransferLevelItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// 保存转账接口适用层级请求
+type SaveTransferAccountLevelsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"转账接口ID"`                                               // 转账接口ID
+	LevelIds      []int32                `protobuf:"varint,2,rep,packed,name=level_ids,json=levelIds,proto3" json:"level_ids" dc:"会员层级ID，为空表示取消所有层级"` // 会员层级ID，为空表示取消所有层级
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveTransferAccountLevelsReq) Reset() {
+	*x = SaveTransferAccountLevelsReq{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveTransferAccountLevelsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveTransferAccountLevelsReq) ProtoMessage() {}
+
+func (x *SaveTransferAccountLevelsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveTransferAccountLevelsReq.ProtoReflect.Descriptor instead.
+func (*SaveTransferAccountLevelsReq) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SaveTransferAccountLevelsReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SaveTransferAccountLevelsReq) GetLevelIds() []int32 {
+	if x != nil {
+		return x.LevelIds
+	}
+	return nil
+}
+
+// 保存转账接口适用层级响应
+type SaveTransferAccountLevelsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveTransferAccountLevelsRes) Reset() {
+	*x = SaveTransferAccountLevelsRes{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveTransferAccountLevelsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveTransferAccountLevelsRes) ProtoMessage() {}
+
+func (x *SaveTransferAccountLevelsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveTransferAccountLevelsRes.ProtoReflect.Descriptor instead.
+func (*SaveTransferAccountLevelsRes) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SaveTransferAccountLevelsRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SaveTransferAccountLevelsRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 导入银行流水请求
+type ImportBankStatementReq struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	FileData          []byte                 `protobuf:"bytes,1,opt,name=file_data,json=fileData,proto3" json:"file_data" dc:"CSV文件内容，首行为表头，需包含交易时间、金额、付款人列"`                         // CSV文件内容，首行为表头，需包含交易时间、金额、付款人列
+	FileName          string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name" dc:"原始文件名"`                                                 // 原始文件名
+	TransferAccountId int32                  `protobuf:"varint,3,opt,name=transfer_account_id,json=transferAccountId,proto3" json:"transfer_account_id" dc:"收款转账接口ID (可选)，只匹配该接口的订单"` // 收款转账接口ID (可选)，只匹配该接口的订单
+	MatchWindow       int32                  `protobuf:"varint,4,opt,name=match_window,json=matchWindow,proto3" json:"match_window" dc:"匹配时间窗口(分钟) (可选)，默认取配置 payment.matchWindow"`   // 匹配时间窗口(分钟) (可选)，默认取配置 payment.matchWindow
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ImportBankStatementReq) Reset() {
+	*x = ImportBankStatementReq{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportBankStatementReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBankStatementReq) ProtoMessage() {}
+
+func (x *ImportBankStatementReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBankStatementReq.ProtoReflect.Descriptor instead.
+func (*ImportBankStatementReq) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ImportBankStatementReq) GetFileData() []byte {
+	if x != nil {
+		return x.FileData
+	}
+	return nil
+}
+
+func (x *ImportBankStatementReq) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ImportBankStatementReq) GetTransferAccountId() int32 {
+	if x != nil {
+		return x.TransferAccountId
+	}
+	return 0
+}
+
+func (x *ImportBankStatementReq) GetMatchWindow() int32 {
+	if x != nil {
+		return x.MatchWindow
+	}
+	return 0
+}
+
+// 导入银行流水响应
+type ImportBankStatementRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	Batch         *StatementBatchInfo    `protobuf:"bytes,3,opt,name=batch,proto3" json:"batch" dc:"对账批次"`      // 对账批次
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportBankStatementRes) Reset() {
+	*x = ImportBankStatementRes{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportBankStatementRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBankStatementRes) ProtoMessage() {}
+
+func (x *ImportBankStatementRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBankStatementRes.ProtoReflect.Descriptor instead.
+func (*ImportBankStatementRes) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ImportBankStatementRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ImportBankStatementRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportBankStatementRes) GetBatch() *StatementBatchInfo {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+// 对账批次信息
+type StatementBatchInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"批次ID"`                                                              // 批次ID
+	TransferAccountId int32                  `protobuf:"varint,2,opt,name=transfer_account_id,json=transferAccountId,proto3" json:"transfer_account_id" dc:"收款转账接口ID"` // 收款转账接口ID
+	FileName          string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name" dc:"导入文件名"`                                  // 导入文件名
+	MatchWindow       int32                  `protobuf:"varint,4,opt,name=match_window,json=matchWindow,proto3" json:"match_window" dc:"匹配时间窗口(分钟)"`                   // 匹配时间窗口(分钟)
+	TotalRows         int32                  `protobuf:"varint,5,opt,name=total_rows,json=totalRows,proto3" json:"total_rows" dc:"总行数"`                                // 总行数
+	MatchedRows       int32                  `protobuf:"varint,6,opt,name=matched_rows,json=matchedRows,proto3" json:"matched_rows" dc:"自动匹配行数"`                       // 自动匹配行数
+	ReviewRows        int32                  `protobuf:"varint,7,opt,name=review_rows,json=reviewRows,proto3" json:"review_rows" dc:"待复核行数"`                           // 待复核行数
+	UnmatchedRows     int32                  `protobuf:"varint,8,opt,name=unmatched_rows,json=unmatchedRows,proto3" json:"unmatched_rows" dc:"未匹配行数"`                  // 未匹配行数
+	InvalidRows       int32                  `protobuf:"varint,9,opt,name=invalid_rows,json=invalidRows,proto3" json:"invalid_rows" dc:"无效行数"`                         // 无效行数
+	AdminName         string                 `protobuf:"bytes,10,opt,name=admin_name,json=adminName,proto3" json:"admin_name" dc:"导入管理员"`                              // 导入管理员
+	CreatedAt         string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"导入时间"`                               // 导入时间
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StatementBatchInfo) Reset() {
+	*x = StatementBatchInfo{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatementBatchInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatementBatchInfo) ProtoMessage() {}
+
+func (x *StatementBatchInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatementBatchInfo.ProtoReflect.Descriptor instead.
+func (*StatementBatchInfo) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *StatementBatchInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StatementBatchInfo) GetTransferAccountId() int32 {
+	if x != nil {
+		return x.TransferAccountId
+	}
+	return 0
+}
+
+func (x *StatementBatchInfo) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *StatementBatchInfo) GetMatchWindow() int32 {
+	if x != nil {
+		return x.MatchWindow
+	}
+	return 0
+}
+
+func (x *StatementBatchInfo) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *StatementBatchInfo) GetMatchedRows() int32 {
+	if x != nil {
+		return x.MatchedRows
+	}
+	return 0
+}
+
+func (x *StatementBatchInfo) GetReviewRows() int32 {
+	if x != nil {
+		return x.ReviewRows
+	}
+	return 0
+}
+
+func (x *StatementBatchInfo) GetUnmatchedRows() int32 {
+	if x != nil {
+		return x.UnmatchedRows
+	}
+	return 0
+}
+
+func (x *StatementBatchInfo) GetInvalidRows() int32 {
+	if x != nil {
+		return x.InvalidRows
+	}
+	return 0
+}
+
+func (x *StatementBatchInfo) GetAdminName() string {
+	if x != nil {
+		return x.AdminName
+	}
+	return ""
+}
+
+func (x *StatementBatchInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 获取对账批次列表请求
+type GetStatementBatchesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page" dc:"页码"`   // 页码
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size" dc:"每页数量"` // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatementBatchesReq) Reset() {
+	*x = GetStatementBatchesReq{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatementBatchesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatementBatchesReq) ProtoMessage() {}
+
+func (x *GetStatementBatchesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatementBatchesReq.ProtoReflect.Descriptor instead.
+func (*GetStatementBatchesReq) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetStatementBatchesReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetStatementBatchesReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// 获取对账批次列表响应
+type GetStatementBatchesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*StatementBatchInfo  `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"批次列表"`   // 批次列表
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count" dc:"总数量"` // 总数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatementBatchesRes) Reset() {
+	*x = GetStatementBatchesRes{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatementBatchesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatementBatchesRes) ProtoMessage() {}
+
+func (x *GetStatementBatchesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatementBatchesRes.ProtoReflect.Descriptor instead.
+func (*GetStatementBatchesRes) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetStatementBatchesRes) GetList() []*StatementBatchInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *GetStatementBatchesRes) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 获取对账明细请求
+type GetStatementItemsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BatchId       int32                  `protobuf:"varint,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id" dc:"批次ID (可选)"`                     // 批次ID (可选)
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status" dc:"状态 (可选) 1=已匹配 2=待复核 3=未匹配 4=无效 5=复核已确认 6=复核已忽略"` // 状态 (可选) 1=已匹配 2=待复核 3=未匹配 4=无效 5=复核已确认 6=复核已忽略
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page" dc:"页码"`                                                 // 页码
+	Size          int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size" dc:"每页数量"`                                               // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatementItemsReq) Reset() {
+	*x = GetStatementItemsReq{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatementItemsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatementItemsReq) ProtoMessage() {}
+
+func (x *GetStatementItemsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatementItemsReq.ProtoReflect.Descriptor instead.
+func (*GetStatementItemsReq) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetStatementItemsReq) GetBatchId() int32 {
+	if x != nil {
+		return x.BatchId
+	}
+	return 0
+}
+
+func (x *GetStatementItemsReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetStatementItemsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetStatementItemsReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// 对账明细
+type StatementItemInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"明细ID"`                                                             // 明细ID
+	BatchId          int32                  `protobuf:"varint,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id" dc:"批次ID"`                                    // 批次ID
+	RowNo            int32                  `protobuf:"varint,3,opt,name=row_no,json=rowNo,proto3" json:"row_no" dc:"文件行号"`                                          // 文件行号
+	TradeTime        string                 `protobuf:"bytes,4,opt,name=trade_time,json=tradeTime,proto3" json:"trade_time" dc:"交易时间"`                               // 交易时间
+	Amount           float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount" dc:"交易金额"`                                                    // 交易金额
+	PayerName        string                 `protobuf:"bytes,6,opt,name=payer_name,json=payerName,proto3" json:"payer_name" dc:"付款人"`                                // 付款人
+	BankRef          string                 `protobuf:"bytes,7,opt,name=bank_ref,json=bankRef,proto3" json:"bank_ref" dc:"银行流水号"`                                    // 银行流水号
+	Status           int32                  `protobuf:"varint,8,opt,name=status,proto3" json:"status" dc:"状态"`                                                       // 状态
+	StatusName       string                 `protobuf:"bytes,9,opt,name=status_name,json=statusName,proto3" json:"status_name" dc:"状态名称"`                            // 状态名称
+	RechargeManualId int64                  `protobuf:"varint,10,opt,name=recharge_manual_id,json=rechargeManualId,proto3" json:"recharge_manual_id" dc:"匹配的入款订单ID"` // 匹配的入款订单ID
+	CandidateIds     []int64                `protobuf:"varint,11,rep,packed,name=candidate_ids,json=candidateIds,proto3" json:"candidate_ids" dc:"候选入款订单ID"`         // 候选入款订单ID
+	Reason           string                 `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason" dc:"待复核或未匹配原因"`                                                // 待复核或未匹配原因
+	ReviewedBy       string                 `protobuf:"bytes,13,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by" dc:"复核管理员"`                          // 复核管理员
+	ReviewedAt       string                 `protobuf:"bytes,14,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at" dc:"复核时间"`                           // 复核时间
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StatementItemInfo) Reset() {
+	*x = StatementItemInfo{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatementItemInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatementItemInfo) ProtoMessage() {}
+
+func (x *StatementItemInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatementItemInfo.ProtoReflect.Descriptor instead.
+func (*StatementItemInfo) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *StatementItemInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StatementItemInfo) GetBatchId() int32 {
+	if x != nil {
+		return x.BatchId
+	}
+	return 0
+}
+
+func (x *StatementItemInfo) GetRowNo() int32 {
+	if x != nil {
+		return x.RowNo
+	}
+	return 0
+}
+
+func (x *StatementItemInfo) GetTradeTime() string {
+	if x != nil {
+		return x.TradeTime
+	}
+	return ""
+}
+
+func (x *StatementItemInfo) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *StatementItemInfo) GetPayerName() string {
+	if x != nil {
+		return x.PayerName
+	}
+	return ""
+}
+
+func (x *StatementItemInfo) GetBankRef() string {
+	if x != nil {
+		return x.BankRef
+	}
+	return ""
+}
+
+func (x *StatementItemInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *StatementItemInfo) GetStatusName() string {
+	if x != nil {
+		return x.StatusName
+	}
+	return ""
+}
+
+func (x *StatementItemInfo) GetRechargeManualId() int64 {
+	if x != nil {
+		return x.RechargeManualId
+	}
+	return 0
+}
+
+func (x *StatementItemInfo) GetCandidateIds() []int64 {
+	if x != nil {
+		return x.CandidateIds
+	}
+	return nil
+}
+
+func (x *StatementItemInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StatementItemInfo) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *StatementItemInfo) GetReviewedAt() string {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return ""
+}
+
+// 获取对账明细响应
+type GetStatementItemsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*StatementItemInfo   `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"明细列表"`   // 明细列表
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count" dc:"总数量"` // 总数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatementItemsRes) Reset() {
+	*x = GetStatementItemsRes{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatementItemsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatementItemsRes) ProtoMessage() {}
+
+func (x *GetStatementItemsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatementItemsRes.ProtoReflect.Descriptor instead.
+func (*GetStatementItemsRes) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetStatementItemsRes) GetList() []*StatementItemInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *GetStatementItemsRes) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 复核对账明细请求
+type ResolveStatementItemReq struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"明细ID"`                                                                 // 明细ID
+	Action           int32                  `protobuf:"varint,2,opt,name=action,proto3" json:"action" dc:"操作 1=确认入款 2=忽略"`                                               // 操作 1=确认入款 2=忽略
+	RechargeManualId int64                  `protobuf:"varint,3,opt,name=recharge_manual_id,json=rechargeManualId,proto3" json:"recharge_manual_id" dc:"确认入款时指定的入款订单ID"` // 确认入款时指定的入款订单ID
+	Remark           string                 `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark" dc:"备注 (可选)"`                                                       // 备注 (可选)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ResolveStatementItemReq) Reset() {
+	*x = ResolveStatementItemReq{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveStatementItemReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveStatementItemReq) ProtoMessage() {}
+
+func (x *ResolveStatementItemReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveStatementItemReq.ProtoReflect.Descriptor instead.
+func (*ResolveStatementItemReq) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ResolveStatementItemReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResolveStatementItemReq) GetAction() int32 {
+	if x != nil {
+		return x.Action
+	}
+	return 0
+}
+
+func (x *ResolveStatementItemReq) GetRechargeManualId() int64 {
+	if x != nil {
+		return x.RechargeManualId
+	}
+	return 0
+}
+
+func (x *ResolveStatementItemReq) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+// 复核对账明细响应
+type ResolveStatementItemRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveStatementItemRes) Reset() {
+	*x = ResolveStatementItemRes{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveStatementItemRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveStatementItemRes) ProtoMessage() {}
+
+func (x *ResolveStatementItemRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveStatementItemRes.ProtoReflect.Descriptor instead.
+func (*ResolveStatementItemRes) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ResolveStatementItemRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ResolveStatementItemRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 获取对账差异列表请求
+type GetReconcileDiscrepanciesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date" dc:"开始日期 (可选) 格式 2006-01-02"`        // 开始日期 (可选) 格式 2006-01-02
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date" dc:"结束日期 (可选) 格式 2006-01-02"`              // 结束日期 (可选) 格式 2006-01-02
+	ChannelType   int32                  `protobuf:"varint,3,opt,name=channel_type,json=channelType,proto3" json:"channel_type" dc:"渠道类型 (可选) 1=在线支付 2=转账汇款"` // 渠道类型 (可选) 1=在线支付 2=转账汇款
+	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status" dc:"状态 (可选) 1=待处理 2=已处理 3=已忽略"`                            // 状态 (可选) 1=待处理 2=已处理 3=已忽略
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page" dc:"页码"`                                                       // 页码
+	Size          int32                  `protobuf:"varint,6,opt,name=size,proto3" json:"size" dc:"每页数量"`                                                     // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReconcileDiscrepanciesReq) Reset() {
+	*x = GetReconcileDiscrepanciesReq{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconcileDiscrepanciesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconcileDiscrepanciesReq) ProtoMessage() {}
+
+func (x *GetReconcileDiscrepanciesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconcileDiscrepanciesReq.ProtoReflect.Descriptor instead.
+func (*GetReconcileDiscrepanciesReq) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetReconcileDiscrepanciesReq) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetReconcileDiscrepanciesReq) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetReconcileDiscrepanciesReq) GetChannelType() int32 {
+	if x != nil {
+		return x.ChannelType
+	}
+	return 0
+}
+
+func (x *GetReconcileDiscrepanciesReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetReconcileDiscrepanciesReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetReconcileDiscrepanciesReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// 对账差异
+type ReconcileDiscrepancyInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"差异ID"`                                                    // 差异ID
+	StatDate      string                 `protobuf:"bytes,2,opt,name=stat_date,json=statDate,proto3" json:"stat_date" dc:"对账日期"`                         // 对账日期
+	ChannelType   int32                  `protobuf:"varint,3,opt,name=channel_type,json=channelType,proto3" json:"channel_type" dc:"渠道类型 1=在线支付 2=转账汇款"` // 渠道类型 1=在线支付 2=转账汇款
+	AccountId     int32                  `protobuf:"varint,4,opt,name=account_id,json=accountId,proto3" json:"account_id" dc:"支付接口ID或转账接口ID"`            // 支付接口ID或转账接口ID
+	AccountName   string                 `protobuf:"bytes,5,opt,name=account_name,json=accountName,proto3" json:"account_name" dc:"接口名称"`                // 接口名称
+	CounterCount  int32                  `protobuf:"varint,6,opt,name=counter_count,json=counterCount,proto3" json:"counter_count" dc:"渠道统计入款次数"`        // 渠道统计入款次数
+	CounterAmount float64                `protobuf:"fixed64,7,opt,name=counter_amount,json=counterAmount,proto3" json:"counter_amount" dc:"渠道统计入款金额"`    // 渠道统计入款金额
+	OrderCount    int32                  `protobuf:"varint,8,opt,name=order_count,json=orderCount,proto3" json:"order_count" dc:"已确认订单笔数"`               // 已确认订单笔数
+	OrderAmount   float64                `protobuf:"fixed64,9,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount" dc:"已确认订单金额"`           // 已确认订单金额
+	LedgerCount   int32                  `protobuf:"varint,10,opt,name=ledger_count,json=ledgerCount,proto3" json:"ledger_count" dc:"账变入款笔数"`            // 账变入款笔数
+	LedgerAmount  float64                `protobuf:"fixed64,11,opt,name=ledger_amount,json=ledgerAmount,proto3" json:"ledger_amount" dc:"账变入款金额"`        // 账变入款金额
+	Status        int32                  `protobuf:"varint,12,opt,name=status,proto3" json:"status" dc:"状态 1=待处理 2=已处理 3=已忽略"`                           // 状态 1=待处理 2=已处理 3=已忽略
+	StatusName    string                 `protobuf:"bytes,13,opt,name=status_name,json=statusName,proto3" json:"status_name" dc:"状态名称"`                  // 状态名称
+	ResolvedBy    string                 `protobuf:"bytes,14,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by" dc:"处理管理员"`                 // 处理管理员
+	ResolvedAt    string                 `protobuf:"bytes,15,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at" dc:"处理时间"`                  // 处理时间
+	ResolveRemark string                 `protobuf:"bytes,16,opt,name=resolve_remark,json=resolveRemark,proto3" json:"resolve_remark" dc:"处理说明"`         // 处理说明
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileDiscrepancyInfo) Reset() {
+	*x = ReconcileDiscrepancyInfo{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileDiscrepancyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileDiscrepancyInfo) ProtoMessage() {}
+
+func (x *ReconcileDiscrepancyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileDiscrepancyInfo.ProtoReflect.Descriptor instead.
+func (*ReconcileDiscrepancyInfo) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ReconcileDiscrepancyInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReconcileDiscrepancyInfo) GetStatDate() string {
+	if x != nil {
+		return x.StatDate
+	}
+	return ""
+}
+
+func (x *ReconcileDiscrepancyInfo) GetChannelType() int32 {
+	if x != nil {
+		return x.ChannelType
+	}
+	return 0
+}
+
+func (x *ReconcileDiscrepancyInfo) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ReconcileDiscrepancyInfo) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *ReconcileDiscrepancyInfo) GetCounterCount() int32 {
+	if x != nil {
+		return x.CounterCount
+	}
+	return 0
+}
+
+func (x *ReconcileDiscrepancyInfo) GetCounterAmount() float64 {
+	if x != nil {
+		return x.CounterAmount
+	}
+	return 0
+}
+
+func (x *ReconcileDiscrepancyInfo) GetOrderCount() int32 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *ReconcileDiscrepancyInfo) GetOrderAmount() float64 {
+	if x != nil {
+		return x.OrderAmount
+	}
+	return 0
+}
+
+func (x *ReconcileDiscrepancyInfo) GetLedgerCount() int32 {
+	if x != nil {
+		return x.LedgerCount
+	}
+	return 0
+}
+
+func (x *ReconcileDiscrepancyInfo) GetLedgerAmount() float64 {
+	if x != nil {
+		return x.LedgerAmount
+	}
+	return 0
+}
+
+func (x *ReconcileDiscrepancyInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ReconcileDiscrepancyInfo) GetStatusName() string {
+	if x != nil {
+		return x.StatusName
+	}
+	return ""
+}
+
+func (x *ReconcileDiscrepancyInfo) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+func (x *ReconcileDiscrepancyInfo) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+func (x *ReconcileDiscrepancyInfo) GetResolveRemark() string {
+	if x != nil {
+		return x.ResolveRemark
+	}
+	return ""
+}
+
+// 获取对账差异列表响应
+type GetReconcileDiscrepanciesRes struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	List          []*ReconcileDiscrepancyInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"差异列表"`   // 差异列表
+	Count         int32                       `protobuf:"varint,2,opt,name=count,proto3" json:"count" dc:"总数量"` // 总数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReconcileDiscrepanciesRes) Reset() {
+	*x = GetReconcileDiscrepanciesRes{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconcileDiscrepanciesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconcileDiscrepanciesRes) ProtoMessage() {}
+
+func (x *GetReconcileDiscrepanciesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconcileDiscrepanciesRes.ProtoReflect.Descriptor instead.
+func (*GetReconcileDiscrepanciesRes) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetReconcileDiscrepanciesRes) GetList() []*ReconcileDiscrepancyInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *GetReconcileDiscrepanciesRes) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 处理对账差异请求
+type ResolveReconcileDiscrepancyReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"差异ID"`                     // 差异ID
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status" dc:"处理结果 2=已处理 3=已忽略"` // 处理结果 2=已处理 3=已忽略
+	Remark        string                 `protobuf:"bytes,3,opt,name=remark,proto3" json:"remark" dc:"处理说明"`              // 处理说明
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveReconcileDiscrepancyReq) Reset() {
+	*x = ResolveReconcileDiscrepancyReq{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReconcileDiscrepancyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReconcileDiscrepancyReq) ProtoMessage() {}
+
+func (x *ResolveReconcileDiscrepancyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReconcileDiscrepancyReq.ProtoReflect.Descriptor instead.
+func (*ResolveReconcileDiscrepancyReq) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ResolveReconcileDiscrepancyReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResolveReconcileDiscrepancyReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ResolveReconcileDiscrepancyReq) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+// 处理对账差异响应
+type ResolveReconcileDiscrepancyRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveReconcileDiscrepancyRes) Reset() {
+	*x = ResolveReconcileDiscrepancyRes{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReconcileDiscrepancyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReconcileDiscrepancyRes) ProtoMessage() {}
+
+func (x *ResolveReconcileDiscrepancyRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReconcileDiscrepancyRes.ProtoReflect.Descriptor instead.
+func (*ResolveReconcileDiscrepancyRes) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ResolveReconcileDiscrepancyRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ResolveReconcileDiscrepancyRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 获取支付接口健康状态请求
+type GetPaymentChannelHealthReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gateway       int32                  `protobuf:"varint,1,opt,name=gateway,proto3" json:"gateway" dc:"支付网关 (可选)"`                                                  // 支付网关 (可选)
+	CircuitState  int32                  `protobuf:"varint,2,opt,name=circuit_state,json=circuitState,proto3" json:"circuit_state" dc:"熔断状态 -1=全部 0=正常 1=已熔断 2=半开探测"` // 熔断状态 -1=全部 0=正常 1=已熔断 2=半开探测
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPaymentChannelHealthReq) Reset() {
+	*x = GetPaymentChannelHealthReq{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentChannelHealthReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentChannelHealthReq) ProtoMessage() {}
+
+func (x *GetPaymentChannelHealthReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentChannelHealthReq.ProtoReflect.Descriptor instead.
+func (*GetPaymentChannelHealthReq) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetPaymentChannelHealthReq) GetGateway() int32 {
+	if x != nil {
+		return x.Gateway
+	}
+	return 0
+}
+
+func (x *GetPaymentChannelHealthReq) GetCircuitState() int32 {
+	if x != nil {
+		return x.CircuitState
+	}
+	return 0
+}
+
+// 支付接口健康状态
+type PaymentChannelHealthInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AccountId        int32                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id" dc:"支付接口ID"`                          // 支付接口ID
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name" dc:"接口名称"`                                                        // 接口名称
+	Gateway          int32                  `protobuf:"varint,3,opt,name=gateway,proto3" json:"gateway" dc:"支付网关"`                                                 // 支付网关
+	Status           int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status" dc:"接口状态 1=可用 0=禁用"`                                         // 接口状态 1=可用 0=禁用
+	CircuitState     int32                  `protobuf:"varint,5,opt,name=circuit_state,json=circuitState,proto3" json:"circuit_state" dc:"熔断状态 0=正常 1=已熔断 2=半开探测"` // 熔断状态 0=正常 1=已熔断 2=半开探测
+	CircuitStateName string                 `protobuf:"bytes,6,opt,name=circuit_state_name,json=circuitStateName,proto3" json:"circuit_state_name" dc:"熔断状态名称"`    // 熔断状态名称
+	CircuitOpenedAt  string                 `protobuf:"bytes,7,opt,name=circuit_opened_at,json=circuitOpenedAt,proto3" json:"circuit_opened_at" dc:"熔断或开始探测时间"`    // 熔断或开始探测时间
+	CircuitReason    string                 `protobuf:"bytes,8,opt,name=circuit_reason,json=circuitReason,proto3" json:"circuit_reason" dc:"熔断原因"`                 // 熔断原因
+	WindowCalls      int32                  `protobuf:"varint,9,opt,name=window_calls,json=windowCalls,proto3" json:"window_calls" dc:"统计窗口内调用次数"`                 // 统计窗口内调用次数
+	WindowFailures   int32                  `protobuf:"varint,10,opt,name=window_failures,json=windowFailures,proto3" json:"window_failures" dc:"统计窗口内失败次数"`       // 统计窗口内失败次数
+	FailureRatio     float64                `protobuf:"fixed64,11,opt,name=failure_ratio,json=failureRatio,proto3" json:"failure_ratio" dc:"统计窗口内失败率"`             // 统计窗口内失败率
+	AvgLatencyMs     int64                  `protobuf:"varint,12,opt,name=avg_latency_ms,json=avgLatencyMs,proto3" json:"avg_latency_ms" dc:"统计窗口内平均耗时 (毫秒)"`      // 统计窗口内平均耗时 (毫秒)
+	TotalCalls       int64                  `protobuf:"varint,13,opt,name=total_calls,json=totalCalls,proto3" json:"total_calls" dc:"服务启动以来调用次数"`                  // 服务启动以来调用次数
+	TotalFailures    int64                  `protobuf:"varint,14,opt,name=total_failures,json=totalFailures,proto3" json:"total_failures" dc:"服务启动以来失败次数"`         // 服务启动以来失败次数
+	LastFailureAt    string                 `protobuf:"bytes,15,opt,name=last_failure_at,json=lastFailureAt,proto3" json:"last_failure_at" dc:"最近失败时间"`            // 最近失败时间
+	LastError        string                 `protobuf:"bytes,16,opt,name=last_error,json=lastError,proto3" json:"last_error" dc:"最近失败原因"`                          // 最近失败原因
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PaymentChannelHealthInfo) Reset() {
+	*x = PaymentChannelHealthInfo{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentChannelHealthInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentChannelHealthInfo) ProtoMessage() {}
+
+func (x *PaymentChannelHealthInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentChannelHealthInfo.ProtoReflect.Descriptor instead.
+func (*PaymentChannelHealthInfo) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PaymentChannelHealthInfo) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *PaymentChannelHealthInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PaymentChannelHealthInfo) GetGateway() int32 {
+	if x != nil {
+		return x.Gateway
+	}
+	return 0
+}
+
+func (x *PaymentChannelHealthInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PaymentChannelHealthInfo) GetCircuitState() int32 {
+	if x != nil {
+		return x.CircuitState
+	}
+	return 0
+}
+
+func (x *PaymentChannelHealthInfo) GetCircuitStateName() string {
+	if x != nil {
+		return x.CircuitStateName
+	}
+	return ""
+}
+
+func (x *PaymentChannelHealthInfo) GetCircuitOpenedAt() string {
+	if x != nil {
+		return x.CircuitOpenedAt
+	}
+	return ""
+}
+
+func (x *PaymentChannelHealthInfo) GetCircuitReason() string {
+	if x != nil {
+		return x.CircuitReason
+	}
+	return ""
+}
+
+func (x *PaymentChannelHealthInfo) GetWindowCalls() int32 {
+	if x != nil {
+		return x.WindowCalls
+	}
+	return 0
+}
+
+func (x *PaymentChannelHealthInfo) GetWindowFailures() int32 {
+	if x != nil {
+		return x.WindowFailures
+	}
+	return 0
+}
+
+func (x *PaymentChannelHealthInfo) GetFailureRatio() float64 {
+	if x != nil {
+		return x.FailureRatio
+	}
+	return 0
+}
+
+func (x *PaymentChannelHealthInfo) GetAvgLatencyMs() int64 {
+	if x != nil {
+		return x.AvgLatencyMs
+	}
+	return 0
+}
+
+func (x *PaymentChannelHealthInfo) GetTotalCalls() int64 {
+	if x != nil {
+		return x.TotalCalls
+	}
+	return 0
+}
+
+func (x *PaymentChannelHealthInfo) GetTotalFailures() int64 {
+	if x != nil {
+		return x.TotalFailures
+	}
+	return 0
+}
+
+func (x *PaymentChannelHealthInfo) GetLastFailureAt() string {
+	if x != nil {
+		return x.LastFailureAt
+	}
+	return ""
+}
+
+func (x *PaymentChannelHealthInfo) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+// 获取支付接口健康状态响应
+type GetPaymentChannelHealthRes struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	List          []*PaymentChannelHealthInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"支付接口列表"` // 支付接口列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPaymentChannelHealthRes) Reset() {
+	*x = GetPaymentChannelHealthRes{}
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentChannelHealthRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentChannelHealthRes) ProtoMessage() {}
+
+func (x *GetPaymentChannelHealthRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_payment_v1_payment_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentChannelHealthRes.ProtoReflect.Descriptor instead.
+func (*GetPaymentChannelHealthRes) Descriptor() ([]byte, []int) {
+	return file_backend_payment_v1_payment_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetPaymentChannelHealthRes) GetList() []*PaymentChannelHealthInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+var File_backend_payment_v1_payment_proto protoreflect.FileDescriptor
+
+const file_backend_payment_v1_payment_proto_rawDesc = "" +
+	"\n" +
+	" backend/payment/v1/payment.proto\x12\apayment\"H\n" +
+	"\x15GetPaymentChannelsReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\"\xf6\x01\n" +
+	"\x0ePaymentChannel\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\x05R\x04type\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x05R\taccountId\x12\x18\n" +
+	"\agateway\x18\x03 \x01(\x05R\agateway\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x19\n" +
+	"\beach_min\x18\x05 \x01(\x01R\aeachMin\x12\x19\n" +
+	"\beach_max\x18\x06 \x01(\x01R\aeachMax\x12!\n" +
+	"\fdaily_remain\x18\a \x01(\x01R\vdailyRemain\x12\x12\n" +
+	"\x04sort\x18\b \x01(\x05R\x04sort\x12\x16\n" +
+	"\x06weight\x18\t \x01(\x05R\x06weight\"D\n" +
+	"\x15GetPaymentChannelsRes\x12+\n" +
+	"\x04list\x18\x01 \x03(\v2\x17.payment.PaymentChannelR\x04list\"\x86\x01\n" +
+	"\x16ReportGatewayResultReq\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x05R\taccountId\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x03 \x01(\x03R\tlatencyMs\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"L\n" +
+	"\x16ReportGatewayResultRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"u\n" +
+	"\x16GetTransferAccountsReq\x12\x1b\n" +
+	"\tbank_type\x18\x01 \x01(\x05R\bbankType\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x05R\x04size\"\xd3\x04\n" +
+	"\x13TransferAccountInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
+	"\tbank_type\x18\x02 \x01(\x05R\bbankType\x12\x1b\n" +
+	"\tbank_name\x18\x03 \x01(\tR\bbankName\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x19\n" +
+	"\bbank_url\x18\x05 \x01(\tR\abankUrl\x12\x16\n" +
+	"\x06qrcode\x18\x06 \x01(\tR\x06qrcode\x12!\n" +
+	"\fcard_account\x18\a \x01(\tR\vcardAccount\x12\x17\n" +
+	"\acard_no\x18\b \x01(\tR\x06cardNo\x12!\n" +
+	"\fdeposit_bank\x18\t \x01(\tR\vdepositBank\x12\x19\n" +
+	"\beach_min\x18\n" +
+	" \x01(\x01R\aeachMin\x12\x19\n" +
+	"\beach_max\x18\v \x01(\x01R\aeachMax\x12\x1b\n" +
+	"\tdaily_max\x18\f \x01(\x01R\bdailyMax\x12\x1f\n" +
+	"\vtoday_count\x18\r \x01(\x05R\n" +
+	"todayCount\x12!\n" +
+	"\ftoday_amount\x18\x0e \x01(\x01R\vtodayAmount\x12\x16\n" +
+	"\x06status\x18\x0f \x01(\x05R\x06status\x12\x12\n" +
+	"\x04sort\x18\x10 \x01(\x05R\x04sort\x12\x16\n" +
+	"\x06weight\x18\x11 \x01(\x05R\x06weight\x12\x16\n" +
+	"\x06remark\x18\x12 \x01(\tR\x06remark\x12\x1b\n" +
+	"\tlevel_ids\x18\x13 \x03(\x05R\blevelIds\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x15 \x01(\tR\tupdatedAt\"`\n" +
+	"\x16GetTransferAccountsRes\x120\n" +
+	"\x04list\x18\x01 \x03(\v2\x1c.payment.TransferAccountInfoR\x04list\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\xc6\x03\n" +
+	"\x18CreateTransferAccountReq\x12\x1b\n" +
+	"\tbank_type\x18\x01 \x01(\x05R\bbankType\x12\x1b\n" +
+	"\tbank_name\x18\x02 \x01(\tR\bbankName\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x19\n" +
+	"\bbank_url\x18\x04 \x01(\tR\abankUrl\x12\x16\n" +
+	"\x06qrcode\x18\x05 \x01(\tR\x06qrcode\x12!\n" +
+	"\fcard_account\x18\x06 \x01(\tR\vcardAccount\x12\x17\n" +
+	"\acard_no\x18\a \x01(\tR\x06cardNo\x12!\n" +
+	"\fdeposit_bank\x18\b \x01(\tR\vdepositBank\x12\x19\n" +
+	"\beach_min\x18\t \x01(\x01R\aeachMin\x12\x19\n" +
+	"\beach_max\x18\n" +
+	" \x01(\x01R\aeachMax\x12\x1b\n" +
+	"\tdaily_max\x18\v \x01(\x01R\bdailyMax\x12\x16\n" +
+	"\x06status\x18\f \x01(\x05R\x06status\x12\x12\n" +
+	"\x04sort\x18\r \x01(\x05R\x04sort\x12\x16\n" +
+	"\x06weight\x18\x0e \x01(\x05R\x06weight\x12\x16\n" +
+	"\x06remark\x18\x0f \x01(\tR\x06remark\x12\x1b\n" +
+	"\tlevel_ids\x18\x10 \x03(\x05R\blevelIds\"^\n" +
+	"\x18CreateTransferAccountRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\x05R\x02id\"-\n" +
+	"\x1bGetTransferAccountUpdateReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"O\n" +
+	"\x1bGetTransferAccountUpdateRes\x120\n" +
+	"\x04info\x18\x01 \x01(\v2\x1c.payment.TransferAccountInfoR\x04info\"\xb9\x03\n" +
+	"\x18UpdateTransferAccountReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
+	"\tbank_type\x18\x02 \x01(\x05R\bbankType\x12\x1b\n" +
+	"\tbank_name\x18\x03 \x01(\tR\bbankName\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x19\n" +
+	"\bbank_url\x18\x05 \x01(\tR\abankUrl\x12\x16\n" +
+	"\x06qrcode\x18\x06 \x01(\tR\x06qrcode\x12!\n" +
+	"\fcard_account\x18\a \x01(\tR\vcardAccount\x12\x17\n" +
+	"\acard_no\x18\b \x01(\tR\x06cardNo\x12!\n" +
+	"\fdeposit_bank\x18\t \x01(\tR\vdepositBank\x12\x19\n" +
+	"\beach_min\x18\n" +
+	" \x01(\x01R\aeachMin\x12\x19\n" +
+	"\beach_max\x18\v \x01(\x01R\aeachMax\x12\x1b\n" +
+	"\tdaily_max\x18\f \x01(\x01R\bdailyMax\x12\x16\n" +
+	"\x06status\x18\r \x01(\x05R\x06status\x12\x12\n" +
+	"\x04sort\x18\x0e \x01(\x05R\x04sort\x12\x16\n" +
+	"\x06weight\x18\x0f \x01(\x05R\x06weight\x12\x16\n" +
+	"\x06remark\x18\x10 \x01(\tR\x06remark\"N\n" +
+	"\x18UpdateTransferAccountRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"*\n" +
+	"\x18DeleteTransferAccountReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"N\n" +
+	"\x18DeleteTransferAccountRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"=\n" +
+	"\x17TransferAccountSortItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04sort\x18\x02 \x01(\x05R\x04sort\"Q\n" +
+	"\x17SortTransferAccountsReq\x126\n" +
+	"\x05items\x18\x01 \x03(\v2 .payment.TransferAccountSortItemR\x05items\"M\n" +
+	"\x17SortTransferAccountsRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"E\n" +
+	"\x1bSetTransferAccountStatusReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\"Q\n" +
+	"\x1bSetTransferAccountStatusRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"-\n" +
+	"\x1bGetTransferAccountLevelsReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\\\n" +
+	"\x11TransferLevelItem\x12\x19\n" +
+	"\blevel_id\x18\x01 \x01(\x05R\alevelId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\achecked\x18\x03 \x01(\bR\achecked\"M\n" +
+	"\x1bGetTransferAccountLevelsRes\x12.\n" +
+	"\x04list\x18\x01 \x03(\v2\x1a.payment.TransferLevelItemR\x04list\"K\n" +
+	"\x1cSaveTransferAccountLevelsReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
+	"\tlevel_ids\x18\x02 \x03(\x05R\blevelIds\"R\n" +
+	"\x1cSaveTransferAccountLevelsRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xa5\x01\n" +
+	"\x16ImportBankStatementReq\x12\x1b\n" +
+	"\tfile_data\x18\x01 \x01(\fR\bfileData\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12.\n" +
+	"\x13transfer_account_id\x18\x03 \x01(\x05R\x11transferAccountId\x12!\n" +
+	"\fmatch_window\x18\x04 \x01(\x05R\vmatchWindow\"\x7f\n" +
+	"\x16ImportBankStatementRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x121\n" +
+	"\x05batch\x18\x03 \x01(\v2\x1b.payment.StatementBatchInfoR\x05batch\"\xff\x02\n" +
+	"\x12StatementBatchInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12.\n" +
+	"\x13transfer_account_id\x18\x02 \x01(\x05R\x11transferAccountId\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12!\n" +
+	"\fmatch_window\x18\x04 \x01(\x05R\vmatchWindow\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x05 \x01(\x05R\ttotalRows\x12!\n" +
+	"\fmatched_rows\x18\x06 \x01(\x05R\vmatchedRows\x12\x1f\n" +
+	"\vreview_rows\x18\a \x01(\x05R\n" +
+	"reviewRows\x12%\n" +
+	"\x0eunmatched_rows\x18\b \x01(\x05R\runmatchedRows\x12!\n" +
+	"\finvalid_rows\x18\t \x01(\x05R\vinvalidRows\x12\x1d\n" +
+	"\n" +
+	"admin_name\x18\n" +
+	" \x01(\tR\tadminName\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\"@\n" +
+	"\x16GetStatementBatchesReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\"_\n" +
+	"\x16GetStatementBatchesRes\x12/\n" +
+	"\x04list\x18\x01 \x03(\v2\x1b.payment.StatementBatchInfoR\x04list\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"q\n" +
+	"\x14GetStatementItemsReq\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\x05R\abatchId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x05R\x04size\"\xac\x03\n" +
+	"\x11StatementItemInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
+	"\bbatch_id\x18\x02 \x01(\x05R\abatchId\x12\x15\n" +
+	"\x06row_no\x18\x03 \x01(\x05R\x05rowNo\x12\x1d\n" +
+	"\n" +
+	"trade_time\x18\x04 \x01(\tR\ttradeTime\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x1d\n" +
+	"\n" +
+	"payer_name\x18\x06 \x01(\tR\tpayerName\x12\x19\n" +
+	"\bbank_ref\x18\a \x01(\tR\abankRef\x12\x16\n" +
+	"\x06status\x18\b \x01(\x05R\x06status\x12\x1f\n" +
+	"\vstatus_name\x18\t \x01(\tR\n" +
+	"statusName\x12,\n" +
+	"\x12recharge_manual_id\x18\n" +
+	" \x01(\x03R\x10rechargeManualId\x12#\n" +
+	"\rcandidate_ids\x18\v \x03(\x03R\fcandidateIds\x12\x16\n" +
+	"\x06reason\x18\f \x01(\tR\x06reason\x12\x1f\n" +
+	"\vreviewed_by\x18\r \x01(\tR\n" +
+	"reviewedBy\x12\x1f\n" +
+	"\vreviewed_at\x18\x0e \x01(\tR\n" +
+	"reviewedAt\"\\\n" +
+	"\x14GetStatementItemsRes\x12.\n" +
+	"\x04list\x18\x01 \x03(\v2\x1a.payment.StatementItemInfoR\x04list\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\x87\x01\n" +
+	"\x17ResolveStatementItemReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\x05R\x06action\x12,\n" +
+	"\x12recharge_manual_id\x18\x03 \x01(\x03R\x10rechargeManualId\x12\x16\n" +
+	"\x06remark\x18\x04 \x01(\tR\x06remark\"M\n" +
+	"\x17ResolveStatementItemRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xbb\x01\n" +
+	"\x1cGetReconcileDiscrepanciesReq\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12!\n" +
+	"\fchannel_type\x18\x03 \x01(\x05R\vchannelType\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x05R\x04size\"\xa6\x04\n" +
+	"\x18ReconcileDiscrepancyInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
+	"\tstat_date\x18\x02 \x01(\tR\bstatDate\x12!\n" +
+	"\fchannel_type\x18\x03 \x01(\x05R\vchannelType\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x04 \x01(\x05R\taccountId\x12!\n" +
+	"\faccount_name\x18\x05 \x01(\tR\vaccountName\x12#\n" +
+	"\rcounter_count\x18\x06 \x01(\x05R\fcounterCount\x12%\n" +
+	"\x0ecounter_amount\x18\a \x01(\x01R\rcounterAmount\x12\x1f\n" +
+	"\vorder_count\x18\b \x01(\x05R\n" +
+	"orderCount\x12!\n" +
+	"\forder_amount\x18\t \x01(\x01R\vorderAmount\x12!\n" +
+	"\fledger_count\x18\n" +
+	" \x01(\x05R\vledgerCount\x12#\n" +
+	"\rledger_amount\x18\v \x01(\x01R\fledgerAmount\x12\x16\n" +
+	"\x06status\x18\f \x01(\x05R\x06status\x12\x1f\n" +
+	"\vstatus_name\x18\r \x01(\tR\n" +
+	"statusName\x12\x1f\n" +
+	"\vresolved_by\x18\x0e \x01(\tR\n" +
+	"resolvedBy\x12\x1f\n" +
+	"\vresolved_at\x18\x0f \x01(\tR\n" +
+	"resolvedAt\x12%\n" +
+	"\x0eresolve_remark\x18\x10 \x01(\tR\rresolveRemark\"k\n" +
+	"\x1cGetReconcileDiscrepanciesRes\x125\n" +
+	"\x04list\x18\x01 \x03(\v2!.payment.ReconcileDiscrepancyInfoR\x04list\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"`\n" +
+	"\x1eResolveReconcileDiscrepancyReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x16\n" +
+	"\x06remark\x18\x03 \x01(\tR\x06remark\"T\n" +
+	"\x1eResolveReconcileDiscrepancyRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"[\n" +
+	"\x1aGetPaymentChannelHealthReq\x12\x18\n" +
+	"\agateway\x18\x01 \x01(\x05R\agateway\x12#\n" +
+	"\rcircuit_state\x18\x02 \x01(\x05R\fcircuitState\"\xcb\x04\n" +
+	"\x18PaymentChannelHealthInfo\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x05R\taccountId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\agateway\x18\x03 \x01(\x05R\agateway\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\x12#\n" +
+	"\rcircuit_state\x18\x05 \x01(\x05R\fcircuitState\x12,\n" +
+	"\x12circuit_state_name\x18\x06 \x01(\tR\x10circuitStateName\x12*\n" +
+	"\x11circuit_opened_at\x18\a \x01(\tR\x0fcircuitOpenedAt\x12%\n" +
+	"\x0ecircuit_reason\x18\b \x01(\tR\rcircuitReason\x12!\n" +
+	"\fwindow_calls\x18\t \x01(\x05R\vwindowCalls\x12'\n" +
+	"\x0fwindow_failures\x18\n" +
+	" \x01(\x05R\x0ewindowFailures\x12#\n" +
+	"\rfailure_ratio\x18\v \x01(\x01R\ffailureRatio\x12$\n" +
+	"\x0eavg_latency_ms\x18\f \x01(\x03R\favgLatencyMs\x12\x1f\n" +
+	"\vtotal_calls\x18\r \x01(\x03R\n" +
+	"totalCalls\x12%\n" +
+	"\x0etotal_failures\x18\x0e \x01(\x03R\rtotalFailures\x12&\n" +
+	"\x0flast_failure_at\x18\x0f \x01(\tR\rlastFailureAt\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x10 \x01(\tR\tlastError\"S\n" +
+	"\x1aGetPaymentChannelHealthRes\x125\n" +
+	"\x04list\x18\x01 \x03(\v2!.payment.PaymentChannelHealthInfoR\x04list2\xf3\r\n" +
+	"\aPayment\x12V\n" +
+	"\x12GetPaymentChannels\x12\x1e.payment.GetPaymentChannelsReq\x1a\x1e.payment.GetPaymentChannelsRes\"\x00\x12Y\n" +
+	"\x13ReportGatewayResult\x12\x1f.payment.ReportGatewayResultReq\x1a\x1f.payment.ReportGatewayResultRes\"\x00\x12Y\n" +
+	"\x13GetTransferAccounts\x12\x1f.payment.GetTransferAccountsReq\x1a\x1f.payment.GetTransferAccountsRes\"\x00\x12_\n" +
+	"\x15CreateTransferAccount\x12!.payment.CreateTransferAccountReq\x1a!.payment.CreateTransferAccountRes\"\x00\x12h\n" +
+	"\x18GetTransferAccountUpdate\x12$.payment.GetTransferAccountUpdateReq\x1a$.payment.GetTransferAccountUpdateRes\"\x00\x12_\n" +
+	"\x15UpdateTransferAccount\x12!.payment.UpdateTransferAccountReq\x1a!.payment.UpdateTransferAccountRes\"\x00\x12_\n" +
+	"\x15DeleteTransferAccount\x12!.payment.DeleteTransferAccountReq\x1a!.payment.DeleteTransferAccountRes\"\x00\x12\\\n" +
+	"\x14SortTransferAccounts\x12 .payment.SortTransferAccountsReq\x1a .payment.SortTransferAccountsRes\"\x00\x12h\n" +
+	"\x18SetTransferAccountStatus\x12$.payment.SetTransferAccountStatusReq\x1a$.payment.SetTransferAccountStatusRes\"\x00\x12h\n" +
+	"\x18GetTransferAccountLevels\x12$.payment.GetTransferAccountLevelsReq\x1a$.payment.GetTransferAccountLevelsRes\"\x00\x12k\n" +
+	"\x19SaveTransferAccountLevels\x12%.payment.SaveTransferAccountLevelsReq\x1a%.payment.SaveTransferAccountLevelsRes\"\x00\x12Y\n" +
+	"\x13ImportBankStatement\x12\x1f.payment.ImportBankStatementReq\x1a\x1f.payment.ImportBankStatementRes\"\x00\x12Y\n" +
+	"\x13GetStatementBatches\x12\x1f.payment.GetStatementBatchesReq\x1a\x1f.payment.GetStatementBatchesRes\"\x00\x12S\n" +
+	"\x11GetStatementItems\x12\x1d.payment.GetStatementItemsReq\x1a\x1d.payment.GetStatementItemsRes\"\x00\x12\\\n" +
+	"\x14ResolveStatementItem\x12 .payment.ResolveStatementItemReq\x1a .payment.ResolveStatementItemRes\"\x00\x12k\n" +
+	"\x19GetReconcileDiscrepancies\x12%.payment.GetReconcileDiscrepanciesReq\x1a%.payment.GetReconcileDiscrepanciesRes\"\x00\x12q\n" +
+	"\x1bResolveReconcileDiscrepancy\x12'.payment.ResolveReconcileDiscrepancyReq\x1a'.payment.ResolveReconcileDiscrepancyRes\"\x00\x12e\n" +
+	"\x17GetPaymentChannelHealth\x12#.payment.GetPaymentChannelHealthReq\x1a#.payment.GetPaymentChannelHealthRes\"\x00B'Z%jh_app_service/api/backend/payment/v1b\x06proto3"
+
+var (
+	file_backend_payment_v1_payment_proto_rawDescOnce sync.Once
+	file_backend_payment_v1_payment_proto_rawDescData []byte
+)
+
+func file_backend_payment_v1_payment_proto_rawDescGZIP() []byte {
+	file_backend_payment_v1_payment_proto_rawDescOnce.Do(func() {
+		file_backend_payment_v1_payment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_backend_payment_v1_payment_proto_rawDesc), len(file_backend_payment_v1_payment_proto_rawDesc)))
+	})
+	return file_backend_payment_v1_payment_proto_rawDescData
+}
+
+var file_backend_payment_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_backend_payment_v1_payment_proto_goTypes = []any{
+	(*GetPaymentChannelsReq)(nil),          // 0: payment.GetPaymentChannelsReq
+	(*PaymentChannel)(nil),                 // 1: payment.PaymentChannel
+	(*GetPaymentChannelsRes)(nil),          // 2: payment.GetPaymentChannelsRes
+	(*ReportGatewayResultReq)(nil),         // 3: payment.ReportGatewayResultReq
+	(*ReportGatewayResultRes)(nil),         // 4: payment.ReportGatewayResultRes
+	(*GetTransferAccountsReq)(nil),         // 5: payment.GetTransferAccountsReq
+	(*TransferAccountInfo)(nil),            // 6: payment.TransferAccountInfo
+	(*GetTransferAccountsRes)(nil),         // 7: payment.GetTransferAccountsRes
+	(*CreateTransferAccountReq)(nil),       // 8: payment.CreateTransferAccountReq
+	(*CreateTransferAccountRes)(nil),       // 9: payment.CreateTransferAccountRes
+	(*GetTransferAccountUpdateReq)(nil),    // 10: payment.GetTransferAccountUpdateReq
+	(*GetTransferAccountUpdateRes)(nil),    // 11: payment.GetTransferAccountUpdateRes
+	(*UpdateTransferAccountReq)(nil),       // 12: payment.UpdateTransferAccountReq
+	(*UpdateTransferAccountRes)(nil),       // 13: payment.UpdateTransferAccountRes
+	(*DeleteTransferAccountReq)(nil),       // 14: payment.DeleteTransferAccountReq
+	(*DeleteTransferAccountRes)(nil),       // 15: payment.DeleteTransferAccountRes
+	(*TransferAccountSortItem)(nil),        // 16: payment.TransferAccountSortItem
+	(*SortTransferAccountsReq)(nil),        // 17: payment.SortTransferAccountsReq
+	(*SortTransferAccountsRes)(nil),        // 18: payment.SortTransferAccountsRes
+	(*SetTransferAccountStatusReq)(nil),    // 19: payment.SetTransferAccountStatusReq
+	(*SetTransferAccountStatusRes)(nil),    // 20: payment.SetTransferAccountStatusRes
+	(*GetTransferAccountLevelsReq)(nil),    // 21: payment.GetTransferAccountLevelsReq
+	(*TransferLevelItem)(nil),              // 22: payment.TransferLevelItem
+	(*GetTransferAccountLevelsRes)(nil),    // 23: payment.GetTransferAccountLevelsRes
+	(*SaveTransferAccountLevelsReq)(nil),   // 24: payment.SaveTransferAccountLevelsReq
+	(*SaveTransferAccountLevelsRes)(nil),   // 25: payment.SaveTransferAccountLevelsRes
+	(*ImportBankStatementReq)(nil),         // 26: payment.ImportBankStatementReq
+	(*ImportBankStatementRes)(nil),         // 27: payment.ImportBankStatementRes
+	(*StatementBatchInfo)(nil),             // 28: payment.StatementBatchInfo
+	(*GetStatementBatchesReq)(nil),         // 29: payment.GetStatementBatchesReq
+	(*GetStatementBatchesRes)(nil),         // 30: payment.GetStatementBatchesRes
+	(*GetStatementItemsReq)(nil),           // 31: payment.GetStatementItemsReq
+	(*StatementItemInfo)(nil),              // 32: payment.StatementItemInfo
+	(*GetStatementItemsRes)(nil),           // 33: payment.GetStatementItemsRes
+	(*ResolveStatementItemReq)(nil),        // 34: payment.ResolveStatementItemReq
+	(*ResolveStatementItemRes)(nil),        // 35: payment.ResolveStatementItemRes
+	(*GetReconcileDiscrepanciesReq)(nil),   // 36: payment.GetReconcileDiscrepanciesReq
+	(*ReconcileDiscrepancyInfo)(nil),       // 37: payment.ReconcileDiscrepancyInfo
+	(*GetReconcileDiscrepanciesRes)(nil),   // 38: payment.GetReconcileDiscrepanciesRes
+	(*ResolveReconcileDiscrepancyReq)(nil), // 39: payment.ResolveReconcileDiscrepancyReq
+	(*ResolveReconcileDiscrepancyRes)(nil), // 40: payment.ResolveReconcileDiscrepancyRes
+	(*GetPaymentChannelHealthReq)(nil),     // 41: payment.GetPaymentChannelHealthReq
+	(*PaymentChannelHealthInfo)(nil),       // 42: payment.PaymentChannelHealthInfo
+	(*GetPaymentChannelHealthRes)(nil),     // 43: payment.GetPaymentChannelHealthRes
+}
+var file_backend_payment_v1_payment_proto_depIdxs = []int32{
+	1,  // 0: payment.GetPaymentChannelsRes.list:type_name -> payment.PaymentChannel
+	6,  // 1: payment.GetTransferAccountsRes.list:type_name -> payment.TransferAccountInfo
+	6,  // 2: payment.GetTransferAccountUpdateRes.info:type_name -> payment.TransferAccountInfo
+	16, // 3: payment.SortTransferAccountsReq.items:type_name -> payment.TransferAccountSortItem
+	22, // 4: payment.GetTransferAccountLevelsRes.list:type_name -> payment.TransferLevelItem
+	28, // 5: payment.ImportBankStatementRes.batch:type_name -> payment.StatementBatchInfo
+	28, // 6: payment.GetStatementBatchesRes.list:type_name -> payment.StatementBatchInfo
+	32, // 7: payment.GetStatementItemsRes.list:type_name -> payment.StatementItemInfo
+	37, // 8: payment.GetReconcileDiscrepanciesRes.list:type_name -> payment.ReconcileDiscrepancyInfo
+	42, // 9: payment.GetPaymentChannelHealthRes.list:type_name -> payment.PaymentChannelHealthInfo
+	0,  // 10: payment.Payment.GetPaymentChannels:input_type -> payment.GetPaymentChannelsReq
+	3,  // 11: payment.Payment.ReportGatewayResult:input_type -> payment.ReportGatewayResultReq
+	5,  // 12: payment.Payment.GetTransferAccounts:input_type -> payment.GetTransferAccountsReq
+	8,  // 13: payment.Payment.CreateTransferAccount:input_type -> payment.CreateTransferAccountReq
+	10, // 14: payment.Payment.GetTransferAccountUpdate:input_type -> payment.GetTransferAccountUpdateReq
+	12, // 15: payment.Payment.UpdateTransferAccount:input_type -> payment.UpdateTransferAccountReq
+	14, // 16: payment.Payment.DeleteTransferAccount:input_type -> payment.DeleteTransferAccountReq
+	17, // 17: payment.Payment.SortTransferAccounts:input_type -> payment.SortTransferAccountsReq
+	19, // 18: payment.Payment.SetTransferAccountStatus:input_type -> payment.SetTransferAccountStatusReq
+	21, // 19: payment.Payment.GetTransferAccountLevels:input_type -> payment.GetTransferAccountLevelsReq
+	24, // 20: payment.Payment.SaveTransferAccountLevels:input_type -> payment.SaveTransferAccountLevelsReq
+	26, // 21: payment.Payment.ImportBankStatement:input_type -> payment.ImportBankStatementReq
+	29, // 22: payment.Payment.GetStatementBatches:input_type -> payment.GetStatementBatchesReq
+	31, // 23: payment.Payment.GetStatementItems:input_type -> payment.GetStatementItemsReq
+	34, // 24: payment.Payment.ResolveStatementItem:input_type -> payment.ResolveStatementItemReq
+	36, // 25: payment.Payment.GetReconcileDiscrepancies:input_type -> payment.GetReconcileDiscrepanciesReq
+	39, // 26: payment.Payment.ResolveReconcileDiscrepancy:input_type -> payment.ResolveReconcileDiscrepancyReq
+	41, // 27: payment.Payment.GetPaymentChannelHealth:input_type -> payment.GetPaymentChannelHealthReq
+	2,  // 28: payment.Payment.GetPaymentChannels:output_type -> payment.GetPaymentChannelsRes
+	4,  // 29: payment.Payment.ReportGatewayResult:output_type -> payment.ReportGatewayResultRes
+	7,  // 30: payment.Payment.GetTransferAccounts:output_type -> payment.GetTransferAccountsRes
+	9,  // 31: payment.Payment.CreateTransferAccount:output_type -> payment.CreateTransferAccountRes
+	11, // 32: payment.Payment.GetTransferAccountUpdate:output_type -> payment.GetTransferAccountUpdateRes
+	13, // 33: payment.Payment.UpdateTransferAccount:output_type -> payment.UpdateTransferAccountRes
+	15, // 34: payment.Payment.DeleteTransferAccount:output_type -> payment.DeleteTransferAccountRes
+	18, // 35: payment.Payment.SortTransferAccounts:output_type -> payment.SortTransferAccountsRes
+	20, // 36: payment.Payment.SetTransferAccountStatus:output_type -> payment.SetTransferAccountStatusRes
+	23, // 37: payment.Payment.GetTransferAccountLevels:output_type -> payment.GetTransferAccountLevelsRes
+	25, // 38: payment.Payment.SaveTransferAccountLevels:output_type -> payment.SaveTransferAccountLevelsRes
+	27, // 39: payment.Payment.ImportBankStatement:output_type -> payment.ImportBankStatementRes
+	30, // 40: payment.Payment.GetStatementBatches:output_type -> payment.GetStatementBatchesRes
+	33, // 41: payment.Payment.GetStatementItems:output_type -> payment.GetStatementItemsRes
+	35, // 42: payment.Payment.ResolveStatementItem:output_type -> payment.ResolveStatementItemRes
+	38, // 43: payment.Payment.GetReconcileDiscrepancies:output_type -> payment.GetReconcileDiscrepanciesRes
+	40, // 44: payment.Payment.ResolveReconcileDiscrepancy:output_type -> payment.ResolveReconcileDiscrepancyRes
+	43, // 45: payment.Payment.GetPaymentChannelHealth:output_type -> payment.GetPaymentChannelHealthRes
+	28, // [28:46] is the sub-list for method output_type
+	10, // [10:28] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_backend_payment_v1_payment_proto_init() }
+func file_backend_payment_v1_payment_proto_init() {
+	if File_backend_payment_v1_payment_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backend_payment_v1_payment_proto_rawDesc), len(file_backend_payment_v1_payment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   44,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_backend_payment_v1_payment_proto_goTypes,
+		DependencyIndexes: file_backend_payment_v1_payment_proto_depIdxs,
+		MessageInfos:      file_backend_payment_v1_payment_proto_msgTypes,
+	}.Build()
+	File_backend_payment_v1_payment_proto = out.File
+	file_backend_payment_v1_payment_proto_goTypes = nil
+	file_backend_payment_v1_payment_proto_depIdxs = nil
+}
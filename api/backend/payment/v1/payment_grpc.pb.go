@@ -0,0 +1,778 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.1
+// source: backend/payment/v1/payment.proto
+
+package v1
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Payment_GetPaymentChannels_FullMethodName          = "/payment.Payment/GetPaymentChannels"
+	Payment_ReportGatewayResult_FullMethodName         = "/payment.Payment/ReportGatewayResult"
+	Payment_GetTransferAccounts_FullMethodName         = "/payment.Payment/GetTransferAccounts"
+	Payment_CreateTransferAccount_FullMethodName       = "/payment.Payment/CreateTransferAccount"
+	Payment_GetTransferAccountUpdate_FullMethodName    = "/payment.Payment/GetTransferAccountUpdate"
+	Payment_UpdateTransferAccount_FullMethodName       = "/payment.Payment/UpdateTransferAccount"
+	Payment_DeleteTransferAccount_FullMethodName       = "/payment.Payment/DeleteTransferAccount"
+	Payment_SortTransferAccounts_FullMethodName        = "/payment.Payment/SortTransferAccounts"
+	Payment_SetTransferAccountStatus_FullMethodName    = "/payment.Payment/SetTransferAccountStatus"
+	Payment_GetTransferAccountLevels_FullMethodName    = "/payment.Payment/GetTransferAccountLevels"
+	Payment_SaveTransferAccountLevels_FullMethodName   = "/payment.Payment/SaveTransferAccountLevels"
+	Payment_ImportBankStatement_FullMethodName         = "/payment.Payment/ImportBankStatement"
+	Payment_GetStatementBatches_FullMethodName         = "/payment.Payment/GetStatementBatches"
+	Payment_GetStatementItems_FullMethodName           = "/payment.Payment/GetStatementItems"
+	Payment_ResolveStatementItem_FullMethodName        = "/payment.Payment/ResolveStatementItem"
+	Payment_GetReconcileDiscrepancies_FullMethodName   = "/payment.Payment/GetReconcileDiscrepancies"
+	Payment_ResolveReconcileDiscrepancy_FullMethodName = "/payment.Payment/ResolveReconcileDiscrepancy"
+	Payment_GetPaymentChannelHealth_FullMethodName     = "/payment.Payment/GetPaymentChannelHealth"
+)
+
+// PaymentClient is the client API for Payment service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PaymentClient interface {
+	// 入款渠道路由
+	GetPaymentChannels(ctx context.Context, in *GetPaymentChannelsReq, opts ...grpc.CallOption) (*GetPaymentChannelsRes, error)
+	ReportGatewayResult(ctx context.Context, in *ReportGatewayResultReq, opts ...grpc.CallOption) (*ReportGatewayResultRes, error)
+	// 转账汇款接口管理
+	GetTransferAccounts(ctx context.Context, in *GetTransferAccountsReq, opts ...grpc.CallOption) (*GetTransferAccountsRes, error)
+	CreateTransferAccount(ctx context.Context, in *CreateTransferAccountReq, opts ...grpc.CallOption) (*CreateTransferAccountRes, error)
+	GetTransferAccountUpdate(ctx context.Context, in *GetTransferAccountUpdateReq, opts ...grpc.CallOption) (*GetTransferAccountUpdateRes, error)
+	UpdateTransferAccount(ctx context.Context, in *UpdateTransferAccountReq, opts ...grpc.CallOption) (*UpdateTransferAccountRes, error)
+	DeleteTransferAccount(ctx context.Context, in *DeleteTransferAccountReq, opts ...grpc.CallOption) (*DeleteTransferAccountRes, error)
+	SortTransferAccounts(ctx context.Context, in *SortTransferAccountsReq, opts ...grpc.CallOption) (*SortTransferAccountsRes, error)
+	SetTransferAccountStatus(ctx context.Context, in *SetTransferAccountStatusReq, opts ...grpc.CallOption) (*SetTransferAccountStatusRes, error)
+	GetTransferAccountLevels(ctx context.Context, in *GetTransferAccountLevelsReq, opts ...grpc.CallOption) (*GetTransferAccountLevelsRes, error)
+	SaveTransferAccountLevels(ctx context.Context, in *SaveTransferAccountLevelsReq, opts ...grpc.CallOption) (*SaveTransferAccountLevelsRes, error)
+	// 银行流水对账
+	ImportBankStatement(ctx context.Context, in *ImportBankStatementReq, opts ...grpc.CallOption) (*ImportBankStatementRes, error)
+	GetStatementBatches(ctx context.Context, in *GetStatementBatchesReq, opts ...grpc.CallOption) (*GetStatementBatchesRes, error)
+	GetStatementItems(ctx context.Context, in *GetStatementItemsReq, opts ...grpc.CallOption) (*GetStatementItemsRes, error)
+	ResolveStatementItem(ctx context.Context, in *ResolveStatementItemReq, opts ...grpc.CallOption) (*ResolveStatementItemRes, error)
+	// 入款日对账
+	GetReconcileDiscrepancies(ctx context.Context, in *GetReconcileDiscrepanciesReq, opts ...grpc.CallOption) (*GetReconcileDiscrepanciesRes, error)
+	ResolveReconcileDiscrepancy(ctx context.Context, in *ResolveReconcileDiscrepancyReq, opts ...grpc.CallOption) (*ResolveReconcileDiscrepancyRes, error)
+	// 支付接口健康状态
+	GetPaymentChannelHealth(ctx context.Context, in *GetPaymentChannelHealthReq, opts ...grpc.CallOption) (*GetPaymentChannelHealthRes, error)
+}
+
+type paymentClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPaymentClient(cc grpc.ClientConnInterface) PaymentClient {
+	return &paymentClient{cc}
+}
+
+func (c *paymentClient) GetPaymentChannels(ctx context.Context, in *GetPaymentChannelsReq, opts ...grpc.CallOption) (*GetPaymentChannelsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPaymentChannelsRes)
+	err := c.cc.Invoke(ctx, Payment_GetPaymentChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) ReportGatewayResult(ctx context.Context, in *ReportGatewayResultReq, opts ...grpc.CallOption) (*ReportGatewayResultRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportGatewayResultRes)
+	err := c.cc.Invoke(ctx, Payment_ReportGatewayResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) GetTransferAccounts(ctx context.Context, in *GetTransferAccountsReq, opts ...grpc.CallOption) (*GetTransferAccountsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransferAccountsRes)
+	err := c.cc.Invoke(ctx, Payment_GetTransferAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) CreateTransferAccount(ctx context.Context, in *CreateTransferAccountReq, opts ...grpc.CallOption) (*CreateTransferAccountRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTransferAccountRes)
+	err := c.cc.Invoke(ctx, Payment_CreateTransferAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) GetTransferAccountUpdate(ctx context.Context, in *GetTransferAccountUpdateReq, opts ...grpc.CallOption) (*GetTransferAccountUpdateRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransferAccountUpdateRes)
+	err := c.cc.Invoke(ctx, Payment_GetTransferAccountUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) UpdateTransferAccount(ctx context.Context, in *UpdateTransferAccountReq, opts ...grpc.CallOption) (*UpdateTransferAccountRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTransferAccountRes)
+	err := c.cc.Invoke(ctx, Payment_UpdateTransferAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) DeleteTransferAccount(ctx context.Context, in *DeleteTransferAccountReq, opts ...grpc.CallOption) (*DeleteTransferAccountRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTransferAccountRes)
+	err := c.cc.Invoke(ctx, Payment_DeleteTransferAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) SortTransferAccounts(ctx context.Context, in *SortTransferAccountsReq, opts ...grpc.CallOption) (*SortTransferAccountsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SortTransferAccountsRes)
+	err := c.cc.Invoke(ctx, Payment_SortTransferAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) SetTransferAccountStatus(ctx context.Context, in *SetTransferAccountStatusReq, opts ...grpc.CallOption) (*SetTransferAccountStatusRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTransferAccountStatusRes)
+	err := c.cc.Invoke(ctx, Payment_SetTransferAccountStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) GetTransferAccountLevels(ctx context.Context, in *GetTransferAccountLevelsReq, opts ...grpc.CallOption) (*GetTransferAccountLevelsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransferAccountLevelsRes)
+	err := c.cc.Invoke(ctx, Payment_GetTransferAccountLevels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) SaveTransferAccountLevels(ctx context.Context, in *SaveTransferAccountLevelsReq, opts ...grpc.CallOption) (*SaveTransferAccountLevelsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveTransferAccountLevelsRes)
+	err := c.cc.Invoke(ctx, Payment_SaveTransferAccountLevels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) ImportBankStatement(ctx context.Context, in *ImportBankStatementReq, opts ...grpc.CallOption) (*ImportBankStatementRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportBankStatementRes)
+	err := c.cc.Invoke(ctx, Payment_ImportBankStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) GetStatementBatches(ctx context.Context, in *GetStatementBatchesReq, opts ...grpc.CallOption) (*GetStatementBatchesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStatementBatchesRes)
+	err := c.cc.Invoke(ctx, Payment_GetStatementBatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) GetStatementItems(ctx context.Context, in *GetStatementItemsReq, opts ...grpc.CallOption) (*GetStatementItemsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStatementItemsRes)
+	err := c.cc.Invoke(ctx, Payment_GetStatementItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) ResolveStatementItem(ctx context.Context, in *ResolveStatementItemReq, opts ...grpc.CallOption) (*ResolveStatementItemRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveStatementItemRes)
+	err := c.cc.Invoke(ctx, Payment_ResolveStatementItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) GetReconcileDiscrepancies(ctx context.Context, in *GetReconcileDiscrepanciesReq, opts ...grpc.CallOption) (*GetReconcileDiscrepanciesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReconcileDiscrepanciesRes)
+	err := c.cc.Invoke(ctx, Payment_GetReconcileDiscrepancies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) ResolveReconcileDiscrepancy(ctx context.Context, in *ResolveReconcileDiscrepancyReq, opts ...grpc.CallOption) (*ResolveReconcileDiscrepancyRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveReconcileDiscrepancyRes)
+	err := c.cc.Invoke(ctx, Payment_ResolveReconcileDiscrepancy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) GetPaymentChannelHealth(ctx context.Context, in *GetPaymentChannelHealthReq, opts ...grpc.CallOption) (*GetPaymentChannelHealthRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPaymentChannelHealthRes)
+	err := c.cc.Invoke(ctx, Payment_GetPaymentChannelHealth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PaymentServer is the server API for Payment service.
+// All implementations must embed UnimplementedPaymentServer
+// for forward compatibility.
+type PaymentServer interface {
+	// 入款渠道路由
+	GetPaymentChannels(context.Context, *GetPaymentChannelsReq) (*GetPaymentChannelsRes, error)
+	ReportGatewayResult(context.Context, *ReportGatewayResultReq) (*ReportGatewayResultRes, error)
+	// 转账汇款接口管理
+	GetTransferAccounts(context.Context, *GetTransferAccountsReq) (*GetTransferAccountsRes, error)
+	CreateTransferAccount(context.Context, *CreateTransferAccountReq) (*CreateTransferAccountRes, error)
+	GetTransferAccountUpdate(context.Context, *GetTransferAccountUpdateReq) (*GetTransferAccountUpdateRes, error)
+	UpdateTransferAccount(context.Context, *UpdateTransferAccountReq) (*UpdateTransferAccountRes, error)
+	DeleteTransferAccount(context.Context, *DeleteTransferAccountReq) (*DeleteTransferAccountRes, error)
+	SortTransferAccounts(context.Context, *SortTransferAccountsReq) (*SortTransferAccountsRes, error)
+	SetTransferAccountStatus(context.Context, *SetTransferAccountStatusReq) (*SetTransferAccountStatusRes, error)
+	GetTransferAccountLevels(context.Context, *GetTransferAccountLevelsReq) (*GetTransferAccountLevelsRes, error)
+	SaveTransferAccountLevels(context.Context, *SaveTransferAccountLevelsReq) (*SaveTransferAccountLevelsRes, error)
+	// 银行流水对账
+	ImportBankStatement(context.Context, *ImportBankStatementReq) (*ImportBankStatementRes, error)
+	GetStatementBatches(context.Context, *GetStatementBatchesReq) (*GetStatementBatchesRes, error)
+	GetStatementItems(context.Context, *GetStatementItemsReq) (*GetStatementItemsRes, error)
+	ResolveStatementItem(context.Context, *ResolveStatementItemReq) (*ResolveStatementItemRes, error)
+	// 入款日对账
+	GetReconcileDiscrepancies(context.Context, *GetReconcileDiscrepanciesReq) (*GetReconcileDiscrepanciesRes, error)
+	ResolveReconcileDiscrepancy(context.Context, *ResolveReconcileDiscrepancyReq) (*ResolveReconcileDiscrepancyRes, error)
+	// 支付接口健康状态
+	GetPaymentChannelHealth(context.Context, *GetPaymentChannelHealthReq) (*GetPaymentChannelHealthRes, error)
+	mustEmbedUnimplementedPaymentServer()
+}
+
+// UnimplementedPaymentServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPaymentServer struct{}
+
+func (UnimplementedPaymentServer) GetPaymentChannels(context.Context, *GetPaymentChannelsReq) (*GetPaymentChannelsRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPaymentChannels not implemented")
+}
+func (UnimplementedPaymentServer) ReportGatewayResult(context.Context, *ReportGatewayResultReq) (*ReportGatewayResultRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReportGatewayResult not implemented")
+}
+func (UnimplementedPaymentServer) GetTransferAccounts(context.Context, *GetTransferAccountsReq) (*GetTransferAccountsRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTransferAccounts not implemented")
+}
+func (UnimplementedPaymentServer) CreateTransferAccount(context.Context, *CreateTransferAccountReq) (*CreateTransferAccountRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTransferAccount not implemented")
+}
+func (UnimplementedPaymentServer) GetTransferAccountUpdate(context.Context, *GetTransferAccountUpdateReq) (*GetTransferAccountUpdateRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTransferAccountUpdate not implemented")
+}
+func (UnimplementedPaymentServer) UpdateTransferAccount(context.Context, *UpdateTransferAccountReq) (*UpdateTransferAccountRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTransferAccount not implemented")
+}
+func (UnimplementedPaymentServer) DeleteTransferAccount(context.Context, *DeleteTransferAccountReq) (*DeleteTransferAccountRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTransferAccount not implemented")
+}
+func (UnimplementedPaymentServer) SortTransferAccounts(context.Context, *SortTransferAccountsReq) (*SortTransferAccountsRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method SortTransferAccounts not implemented")
+}
+func (UnimplementedPaymentServer) SetTransferAccountStatus(context.Context, *SetTransferAccountStatusReq) (*SetTransferAccountStatusRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTransferAccountStatus not implemented")
+}
+func (UnimplementedPaymentServer) GetTransferAccountLevels(context.Context, *GetTransferAccountLevelsReq) (*GetTransferAccountLevelsRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTransferAccountLevels not implemented")
+}
+func (UnimplementedPaymentServer) SaveTransferAccountLevels(context.Context, *SaveTransferAccountLevelsReq) (*SaveTransferAccountLevelsRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveTransferAccountLevels not implemented")
+}
+func (UnimplementedPaymentServer) ImportBankStatement(context.Context, *ImportBankStatementReq) (*ImportBankStatementRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportBankStatement not implemented")
+}
+func (UnimplementedPaymentServer) GetStatementBatches(context.Context, *GetStatementBatchesReq) (*GetStatementBatchesRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStatementBatches not implemented")
+}
+func (UnimplementedPaymentServer) GetStatementItems(context.Context, *GetStatementItemsReq) (*GetStatementItemsRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStatementItems not implemented")
+}
+func (UnimplementedPaymentServer) ResolveStatementItem(context.Context, *ResolveStatementItemReq) (*ResolveStatementItemRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolveStatementItem not implemented")
+}
+func (UnimplementedPaymentServer) GetReconcileDiscrepancies(context.Context, *GetReconcileDiscrepanciesReq) (*GetReconcileDiscrepanciesRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReconcileDiscrepancies not implemented")
+}
+func (UnimplementedPaymentServer) ResolveReconcileDiscrepancy(context.Context, *ResolveReconcileDiscrepancyReq) (*ResolveReconcileDiscrepancyRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolveReconcileDiscrepancy not implemented")
+}
+func (UnimplementedPaymentServer) GetPaymentChannelHealth(context.Context, *GetPaymentChannelHealthReq) (*GetPaymentChannelHealthRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPaymentChannelHealth not implemented")
+}
+func (UnimplementedPaymentServer) mustEmbedUnimplementedPaymentServer() {}
+func (UnimplementedPaymentServer) testEmbeddedByValue()                 {}
+
+// UnsafePaymentServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PaymentServer will
+// result in compilation errors.
+type UnsafePaymentServer interface {
+	mustEmbedUnimplementedPaymentServer()
+}
+
+func RegisterPaymentServer(s grpc.ServiceRegistrar, srv PaymentServer) {
+	// If the following call panics, it indicates UnimplementedPaymentServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Payment_ServiceDesc, srv)
+}
+
+func _Payment_GetPaymentChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentChannelsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).GetPaymentChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_GetPaymentChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).GetPaymentChannels(ctx, req.(*GetPaymentChannelsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_ReportGatewayResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportGatewayResultReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).ReportGatewayResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_ReportGatewayResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).ReportGatewayResult(ctx, req.(*ReportGatewayResultReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_GetTransferAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransferAccountsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).GetTransferAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_GetTransferAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).GetTransferAccounts(ctx, req.(*GetTransferAccountsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_CreateTransferAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransferAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).CreateTransferAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_CreateTransferAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).CreateTransferAccount(ctx, req.(*CreateTransferAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_GetTransferAccountUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransferAccountUpdateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).GetTransferAccountUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_GetTransferAccountUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).GetTransferAccountUpdate(ctx, req.(*GetTransferAccountUpdateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_UpdateTransferAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTransferAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).UpdateTransferAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_UpdateTransferAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).UpdateTransferAccount(ctx, req.(*UpdateTransferAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_DeleteTransferAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTransferAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).DeleteTransferAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_DeleteTransferAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).DeleteTransferAccount(ctx, req.(*DeleteTransferAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_SortTransferAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortTransferAccountsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).SortTransferAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_SortTransferAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).SortTransferAccounts(ctx, req.(*SortTransferAccountsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_SetTransferAccountStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTransferAccountStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).SetTransferAccountStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_SetTransferAccountStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).SetTransferAccountStatus(ctx, req.(*SetTransferAccountStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_GetTransferAccountLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransferAccountLevelsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).GetTransferAccountLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_GetTransferAccountLevels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).GetTransferAccountLevels(ctx, req.(*GetTransferAccountLevelsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_SaveTransferAccountLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveTransferAccountLevelsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).SaveTransferAccountLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_SaveTransferAccountLevels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).SaveTransferAccountLevels(ctx, req.(*SaveTransferAccountLevelsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_ImportBankStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportBankStatementReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).ImportBankStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_ImportBankStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).ImportBankStatement(ctx, req.(*ImportBankStatementReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_GetStatementBatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatementBatchesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).GetStatementBatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_GetStatementBatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).GetStatementBatches(ctx, req.(*GetStatementBatchesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_GetStatementItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatementItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).GetStatementItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_GetStatementItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).GetStatementItems(ctx, req.(*GetStatementItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_ResolveStatementItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveStatementItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).ResolveStatementItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_ResolveStatementItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).ResolveStatementItem(ctx, req.(*ResolveStatementItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_GetReconcileDiscrepancies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReconcileDiscrepanciesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).GetReconcileDiscrepancies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_GetReconcileDiscrepancies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).GetReconcileDiscrepancies(ctx, req.(*GetReconcileDiscrepanciesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_ResolveReconcileDiscrepancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReconcileDiscrepancyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).ResolveReconcileDiscrepancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_ResolveReconcileDiscrepancy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).ResolveReconcileDiscrepancy(ctx, req.(*ResolveReconcileDiscrepancyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_GetPaymentChannelHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentChannelHealthReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).GetPaymentChannelHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_GetPaymentChannelHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).GetPaymentChannelHealth(ctx, req.(*GetPaymentChannelHealthReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Payment_ServiceDesc is the grpc.ServiceDesc for Payment service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Payment_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "payment.Payment",
+	HandlerType: (*PaymentServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPaymentChannels",
+			Handler:    _Payment_GetPaymentChannels_Handler,
+		},
+		{
+			MethodName: "ReportGatewayResult",
+			Handler:    _Payment_ReportGatewayResult_Handler,
+		},
+		{
+			MethodName: "GetTransferAccounts",
+			Handler:    _Payment_GetTransferAccounts_Handler,
+		},
+		{
+			MethodName: "CreateTransferAccount",
+			Handler:    _Payment_CreateTransferAccount_Handler,
+		},
+		{
+			MethodName: "GetTransferAccountUpdate",
+			Handler:    _Payment_GetTransferAccountUpdate_Handler,
+		},
+		{
+			MethodName: "UpdateTransferAccount",
+			Handler:    _Payment_UpdateTransferAccount_Handler,
+		},
+		{
+			MethodName: "DeleteTransferAccount",
+			Handler:    _Payment_DeleteTransferAccount_Handler,
+		},
+		{
+			MethodName: "SortTransferAccounts",
+			Handler:    _Payment_SortTransferAccounts_Handler,
+		},
+		{
+			MethodName: "SetTransferAccountStatus",
+			Handler:    _Payment_SetTransferAccountStatus_Handler,
+		},
+		{
+			MethodName: "GetTransferAccountLevels",
+			Handler:    _Payment_GetTransferAccountLevels_Handler,
+		},
+		{
+			MethodName: "SaveTransferAccountLevels",
+			Handler:    _Payment_SaveTransferAccountLevels_Handler,
+		},
+		{
+			MethodName: "ImportBankStatement",
+			Handler:    _Payment_ImportBankStatement_Handler,
+		},
+		{
+			MethodName: "GetStatementBatches",
+			Handler:    _Payment_GetStatementBatches_Handler,
+		},
+		{
+			MethodName: "GetStatementItems",
+			Handler:    _Payment_GetStatementItems_Handler,
+		},
+		{
+			MethodName: "ResolveStatementItem",
+			Handler:    _Payment_ResolveStatementItem_Handler,
+		},
+		{
+			MethodName: "GetReconcileDiscrepancies",
+			Handler:    _Payment_GetReconcileDiscrepancies_Handler,
+		},
+		{
+			MethodName: "ResolveReconcileDiscrepancy",
+			Handler:    _Payment_ResolveReconcileDiscrepancy_Handler,
+		},
+		{
+			MethodName: "GetPaymentChannelHealth",
+			Handler:    _Payment_GetPaymentChannelHealth_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backend/payment/v1/payment.proto",
+}
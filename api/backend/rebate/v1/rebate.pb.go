@@ -0,0 +1,2142 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: backend/rebate/v1/rebate.proto
+
+package v1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 会员单个游戏的返水明细
+type RebateDetailInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"明细ID，预览时为0"`                                                // 明细ID，预览时为0
+	RebateDate     string                 `protobuf:"bytes,2,opt,name=rebate_date,json=rebateDate,proto3" json:"rebate_date" dc:"返水日期"`                     // 返水日期
+	UserId         int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"会员ID"`                                // 会员ID
+	Username       string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username" dc:"会员账号"`                                           // 会员账号
+	LevelId        int32                  `protobuf:"varint,5,opt,name=level_id,json=levelId,proto3" json:"level_id" dc:"会员层级ID"`                           // 会员层级ID
+	LevelName      string                 `protobuf:"bytes,6,opt,name=level_name,json=levelName,proto3" json:"level_name" dc:"会员层级名称"`                      // 会员层级名称
+	GradeId        int32                  `protobuf:"varint,7,opt,name=grade_id,json=gradeId,proto3" json:"grade_id" dc:"会员等级ID"`                           // 会员等级ID
+	GradeName      string                 `protobuf:"bytes,8,opt,name=grade_name,json=gradeName,proto3" json:"grade_name" dc:"会员等级名称"`                      // 会员等级名称
+	RuleId         int32                  `protobuf:"varint,9,opt,name=rule_id,json=ruleId,proto3" json:"rule_id" dc:"返水规则ID"`                              // 返水规则ID
+	RuleName       string                 `protobuf:"bytes,10,opt,name=rule_name,json=ruleName,proto3" json:"rule_name" dc:"返水规则名称"`                        // 返水规则名称
+	GameId         int32                  `protobuf:"varint,11,opt,name=game_id,json=gameId,proto3" json:"game_id" dc:"游戏ID"`                               // 游戏ID
+	GameName       string                 `protobuf:"bytes,12,opt,name=game_name,json=gameName,proto3" json:"game_name" dc:"游戏名称"`                          // 游戏名称
+	GameType       int32                  `protobuf:"varint,13,opt,name=game_type,json=gameType,proto3" json:"game_type" dc:"游戏类型 1=体育 2=彩票 3=真人视讯 4=电子游戏"` // 游戏类型 1=体育 2=彩票 3=真人视讯 4=电子游戏
+	ValidBetAmount float64                `protobuf:"fixed64,14,opt,name=valid_bet_amount,json=validBetAmount,proto3" json:"valid_bet_amount" dc:"有效投注金额"`  // 有效投注金额
+	Percent        float64                `protobuf:"fixed64,15,opt,name=percent,proto3" json:"percent" dc:"返水比例 (%)"`                                      // 返水比例 (%)
+	GradePercent   float64                `protobuf:"fixed64,16,opt,name=grade_percent,json=gradePercent,proto3" json:"grade_percent" dc:"等级额外返水比例 (%)"`    // 等级额外返水比例 (%)
+	Money          float64                `protobuf:"fixed64,17,opt,name=money,proto3" json:"money" dc:"返水金额"`                                              // 返水金额
+	Status         int32                  `protobuf:"varint,18,opt,name=status,proto3" json:"status" dc:"状态 0=待审核 1=已发放 2=已驳回"`                             // 状态 0=待审核 1=已发放 2=已驳回
+	TradeNo        string                 `protobuf:"bytes,19,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no" dc:"返水流水号"`                            // 返水流水号
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RebateDetailInfo) Reset() {
+	*x = RebateDetailInfo{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebateDetailInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebateDetailInfo) ProtoMessage() {}
+
+func (x *RebateDetailInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebateDetailInfo.ProtoReflect.Descriptor instead.
+func (*RebateDetailInfo) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RebateDetailInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RebateDetailInfo) GetRebateDate() string {
+	if x != nil {
+		return x.RebateDate
+	}
+	return ""
+}
+
+func (x *RebateDetailInfo) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RebateDetailInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RebateDetailInfo) GetLevelId() int32 {
+	if x != nil {
+		return x.LevelId
+	}
+	return 0
+}
+
+func (x *RebateDetailInfo) GetLevelName() string {
+	if x != nil {
+		return x.LevelName
+	}
+	return ""
+}
+
+func (x *RebateDetailInfo) GetGradeId() int32 {
+	if x != nil {
+		return x.GradeId
+	}
+	return 0
+}
+
+func (x *RebateDetailInfo) GetGradeName() string {
+	if x != nil {
+		return x.GradeName
+	}
+	return ""
+}
+
+func (x *RebateDetailInfo) GetRuleId() int32 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *RebateDetailInfo) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *RebateDetailInfo) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *RebateDetailInfo) GetGameName() string {
+	if x != nil {
+		return x.GameName
+	}
+	return ""
+}
+
+func (x *RebateDetailInfo) GetGameType() int32 {
+	if x != nil {
+		return x.GameType
+	}
+	return 0
+}
+
+func (x *RebateDetailInfo) GetValidBetAmount() float64 {
+	if x != nil {
+		return x.ValidBetAmount
+	}
+	return 0
+}
+
+func (x *RebateDetailInfo) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *RebateDetailInfo) GetGradePercent() float64 {
+	if x != nil {
+		return x.GradePercent
+	}
+	return 0
+}
+
+func (x *RebateDetailInfo) GetMoney() float64 {
+	if x != nil {
+		return x.Money
+	}
+	return 0
+}
+
+func (x *RebateDetailInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *RebateDetailInfo) GetTradeNo() string {
+	if x != nil {
+		return x.TradeNo
+	}
+	return ""
+}
+
+// 预览返水请求，只计算不保存
+type PreviewRebateReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date" dc:"返水日期，格式 2006-01-02，默认昨天"` // 返水日期，格式 2006-01-02，默认昨天
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page" dc:"页码"`                     // 页码
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size" dc:"每页数量"`                   // 每页数量
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username" dc:"会员账号 (可选)"`       // 会员账号 (可选)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewRebateReq) Reset() {
+	*x = PreviewRebateReq{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewRebateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewRebateReq) ProtoMessage() {}
+
+func (x *PreviewRebateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewRebateReq.ProtoReflect.Descriptor instead.
+func (*PreviewRebateReq) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PreviewRebateReq) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PreviewRebateReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *PreviewRebateReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PreviewRebateReq) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// 预览返水响应
+type PreviewRebateRes struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"`                                          // 是否成功
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`                                           // 响应消息
+	UserCount      int32                  `protobuf:"varint,3,opt,name=user_count,json=userCount,proto3" json:"user_count" dc:"返水人数"`                     // 返水人数
+	ValidBetAmount float64                `protobuf:"fixed64,4,opt,name=valid_bet_amount,json=validBetAmount,proto3" json:"valid_bet_amount" dc:"有效投注合计"` // 有效投注合计
+	Money          float64                `protobuf:"fixed64,5,opt,name=money,proto3" json:"money" dc:"返水金额合计"`                                           // 返水金额合计
+	List           []*RebateDetailInfo    `protobuf:"bytes,6,rep,name=list,proto3" json:"list" dc:"返水明细"`                                                 // 返水明细
+	Count          int32                  `protobuf:"varint,7,opt,name=count,proto3" json:"count" dc:"明细总数量"`                                             // 明细总数量
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PreviewRebateRes) Reset() {
+	*x = PreviewRebateRes{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewRebateRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewRebateRes) ProtoMessage() {}
+
+func (x *PreviewRebateRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewRebateRes.ProtoReflect.Descriptor instead.
+func (*PreviewRebateRes) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PreviewRebateRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PreviewRebateRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PreviewRebateRes) GetUserCount() int32 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+func (x *PreviewRebateRes) GetValidBetAmount() float64 {
+	if x != nil {
+		return x.ValidBetAmount
+	}
+	return 0
+}
+
+func (x *PreviewRebateRes) GetMoney() float64 {
+	if x != nil {
+		return x.Money
+	}
+	return 0
+}
+
+func (x *PreviewRebateRes) GetList() []*RebateDetailInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *PreviewRebateRes) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 计算返水请求，保存为待审核，待审核或已驳回的日期可重新计算
+type CalculateRebateReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date" dc:"返水日期，格式 2006-01-02，默认昨天"` // 返水日期，格式 2006-01-02，默认昨天
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateRebateReq) Reset() {
+	*x = CalculateRebateReq{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateRebateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateRebateReq) ProtoMessage() {}
+
+func (x *CalculateRebateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateRebateReq.ProtoReflect.Descriptor instead.
+func (*CalculateRebateReq) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CalculateRebateReq) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// 计算返水响应
+type CalculateRebateRes struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"`                                          // 是否成功
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`                                           // 响应消息
+	UserCount      int32                  `protobuf:"varint,3,opt,name=user_count,json=userCount,proto3" json:"user_count" dc:"返水人数"`                     // 返水人数
+	ValidBetAmount float64                `protobuf:"fixed64,4,opt,name=valid_bet_amount,json=validBetAmount,proto3" json:"valid_bet_amount" dc:"有效投注合计"` // 有效投注合计
+	Money          float64                `protobuf:"fixed64,5,opt,name=money,proto3" json:"money" dc:"返水金额合计"`                                           // 返水金额合计
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CalculateRebateRes) Reset() {
+	*x = CalculateRebateRes{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateRebateRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateRebateRes) ProtoMessage() {}
+
+func (x *CalculateRebateRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateRebateRes.ProtoReflect.Descriptor instead.
+func (*CalculateRebateRes) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CalculateRebateRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CalculateRebateRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CalculateRebateRes) GetUserCount() int32 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+func (x *CalculateRebateRes) GetValidBetAmount() float64 {
+	if x != nil {
+		return x.ValidBetAmount
+	}
+	return 0
+}
+
+func (x *CalculateRebateRes) GetMoney() float64 {
+	if x != nil {
+		return x.Money
+	}
+	return 0
+}
+
+// 审核通过并发放返水请求，发放中断时可重复提交补发
+type ApproveRebateReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date" dc:"返水日期"`     // 返水日期
+	Remark        string                 `protobuf:"bytes,2,opt,name=remark,proto3" json:"remark" dc:"审核备注"` // 审核备注
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveRebateReq) Reset() {
+	*x = ApproveRebateReq{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveRebateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRebateReq) ProtoMessage() {}
+
+func (x *ApproveRebateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRebateReq.ProtoReflect.Descriptor instead.
+func (*ApproveRebateReq) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApproveRebateReq) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ApproveRebateReq) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+// 审核通过并发放返水响应
+type ApproveRebateRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"`         // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`          // 响应消息
+	Paid          int32                  `protobuf:"varint,3,opt,name=paid,proto3" json:"paid" dc:"本次发放人数"`             // 本次发放人数
+	Money         float64                `protobuf:"fixed64,4,opt,name=money,proto3" json:"money" dc:"本次发放金额"`          // 本次发放金额
+	Failed        int32                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed" dc:"发放失败人数，可重新提交补发"` // 发放失败人数，可重新提交补发
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveRebateRes) Reset() {
+	*x = ApproveRebateRes{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveRebateRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRebateRes) ProtoMessage() {}
+
+func (x *ApproveRebateRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRebateRes.ProtoReflect.Descriptor instead.
+func (*ApproveRebateRes) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApproveRebateRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ApproveRebateRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ApproveRebateRes) GetPaid() int32 {
+	if x != nil {
+		return x.Paid
+	}
+	return 0
+}
+
+func (x *ApproveRebateRes) GetMoney() float64 {
+	if x != nil {
+		return x.Money
+	}
+	return 0
+}
+
+func (x *ApproveRebateRes) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+// 驳回返水请求
+type RejectRebateReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date" dc:"返水日期"`     // 返水日期
+	Remark        string                 `protobuf:"bytes,2,opt,name=remark,proto3" json:"remark" dc:"驳回原因"` // 驳回原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectRebateReq) Reset() {
+	*x = RejectRebateReq{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectRebateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectRebateReq) ProtoMessage() {}
+
+func (x *RejectRebateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectRebateReq.ProtoReflect.Descriptor instead.
+func (*RejectRebateReq) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RejectRebateReq) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *RejectRebateReq) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+// 驳回返水响应
+type RejectRebateRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectRebateRes) Reset() {
+	*x = RejectRebateRes{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectRebateRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectRebateRes) ProtoMessage() {}
+
+func (x *RejectRebateRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectRebateRes.ProtoReflect.Descriptor instead.
+func (*RejectRebateRes) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RejectRebateRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RejectRebateRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 获取返水记录请求
+type GetRebateHistoriesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page" dc:"页码"`                                  // 页码
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size" dc:"每页数量"`                                // 每页数量
+	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status" dc:"状态 -1=全部 0=待审核 1=已发放 2=已驳回"`      // 状态 -1=全部 0=待审核 1=已发放 2=已驳回
+	StartDate     string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date" dc:"开始日期 (可选)"` // 开始日期 (可选)
+	EndDate       string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date" dc:"结束日期 (可选)"`       // 结束日期 (可选)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRebateHistoriesReq) Reset() {
+	*x = GetRebateHistoriesReq{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRebateHistoriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRebateHistoriesReq) ProtoMessage() {}
+
+func (x *GetRebateHistoriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRebateHistoriesReq.ProtoReflect.Descriptor instead.
+func (*GetRebateHistoriesReq) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetRebateHistoriesReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetRebateHistoriesReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetRebateHistoriesReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetRebateHistoriesReq) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetRebateHistoriesReq) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// 返水记录
+type RebateHistoryInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"记录ID"`                                                    // 记录ID
+	RebateDate     string                 `protobuf:"bytes,2,opt,name=rebate_date,json=rebateDate,proto3" json:"rebate_date" dc:"返水日期"`                   // 返水日期
+	UserCount      int32                  `protobuf:"varint,3,opt,name=user_count,json=userCount,proto3" json:"user_count" dc:"返水人数"`                     // 返水人数
+	ValidBetAmount float64                `protobuf:"fixed64,4,opt,name=valid_bet_amount,json=validBetAmount,proto3" json:"valid_bet_amount" dc:"有效投注合计"` // 有效投注合计
+	Money          float64                `protobuf:"fixed64,5,opt,name=money,proto3" json:"money" dc:"返水金额合计"`                                           // 返水金额合计
+	Status         int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status" dc:"状态 0=待审核 1=已发放 2=已驳回"`                            // 状态 0=待审核 1=已发放 2=已驳回
+	AdminId        int32                  `protobuf:"varint,7,opt,name=admin_id,json=adminId,proto3" json:"admin_id" dc:"审核管理员ID"`                        // 审核管理员ID
+	ApprovedAt     string                 `protobuf:"bytes,8,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at" dc:"审核时间"`                   // 审核时间
+	Remark         string                 `protobuf:"bytes,9,opt,name=remark,proto3" json:"remark" dc:"审核备注"`                                             // 审核备注
+	CreatedAt      string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"计算时间"`                     // 计算时间
+	UpdatedAt      string                 `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at" dc:"更新时间"`                     // 更新时间
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RebateHistoryInfo) Reset() {
+	*x = RebateHistoryInfo{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebateHistoryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebateHistoryInfo) ProtoMessage() {}
+
+func (x *RebateHistoryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebateHistoryInfo.ProtoReflect.Descriptor instead.
+func (*RebateHistoryInfo) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RebateHistoryInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RebateHistoryInfo) GetRebateDate() string {
+	if x != nil {
+		return x.RebateDate
+	}
+	return ""
+}
+
+func (x *RebateHistoryInfo) GetUserCount() int32 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+func (x *RebateHistoryInfo) GetValidBetAmount() float64 {
+	if x != nil {
+		return x.ValidBetAmount
+	}
+	return 0
+}
+
+func (x *RebateHistoryInfo) GetMoney() float64 {
+	if x != nil {
+		return x.Money
+	}
+	return 0
+}
+
+func (x *RebateHistoryInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *RebateHistoryInfo) GetAdminId() int32 {
+	if x != nil {
+		return x.AdminId
+	}
+	return 0
+}
+
+func (x *RebateHistoryInfo) GetApprovedAt() string {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return ""
+}
+
+func (x *RebateHistoryInfo) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *RebateHistoryInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RebateHistoryInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// 获取返水记录响应
+type GetRebateHistoriesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*RebateHistoryInfo   `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"返水记录"`   // 返水记录
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count" dc:"总数量"` // 总数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRebateHistoriesRes) Reset() {
+	*x = GetRebateHistoriesRes{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRebateHistoriesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRebateHistoriesRes) ProtoMessage() {}
+
+func (x *GetRebateHistoriesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRebateHistoriesRes.ProtoReflect.Descriptor instead.
+func (*GetRebateHistoriesRes) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetRebateHistoriesRes) GetList() []*RebateHistoryInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *GetRebateHistoriesRes) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 获取返水明细请求
+type GetRebateDetailsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date" dc:"返水日期"`                            // 返水日期
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page" dc:"页码"`                             // 页码
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size" dc:"每页数量"`                           // 每页数量
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username" dc:"会员账号 (可选)"`               // 会员账号 (可选)
+	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status" dc:"状态 -1=全部 0=待审核 1=已发放 2=已驳回"` // 状态 -1=全部 0=待审核 1=已发放 2=已驳回
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRebateDetailsReq) Reset() {
+	*x = GetRebateDetailsReq{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRebateDetailsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRebateDetailsReq) ProtoMessage() {}
+
+func (x *GetRebateDetailsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRebateDetailsReq.ProtoReflect.Descriptor instead.
+func (*GetRebateDetailsReq) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetRebateDetailsReq) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetRebateDetailsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetRebateDetailsReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetRebateDetailsReq) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetRebateDetailsReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 获取返水明细响应
+type GetRebateDetailsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*RebateDetailInfo    `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"返水明细"`            // 返水明细
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count" dc:"总数量"`          // 总数量
+	Money         float64                `protobuf:"fixed64,3,opt,name=money,proto3" json:"money" dc:"符合条件的返水金额合计"` // 符合条件的返水金额合计
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRebateDetailsRes) Reset() {
+	*x = GetRebateDetailsRes{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRebateDetailsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRebateDetailsRes) ProtoMessage() {}
+
+func (x *GetRebateDetailsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRebateDetailsRes.ProtoReflect.Descriptor instead.
+func (*GetRebateDetailsRes) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetRebateDetailsRes) GetList() []*RebateDetailInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *GetRebateDetailsRes) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetRebateDetailsRes) GetMoney() float64 {
+	if x != nil {
+		return x.Money
+	}
+	return 0
+}
+
+// 档位中单个游戏的返水比例
+type RebateRuleGame struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int32                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id" dc:"游戏ID"`            // 游戏ID
+	GameName      string                 `protobuf:"bytes,2,opt,name=game_name,json=gameName,proto3" json:"game_name" dc:"游戏名称，保存时忽略"` // 游戏名称，保存时忽略
+	Percent       float64                `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent" dc:"返水比例 (%)"`                   // 返水比例 (%)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebateRuleGame) Reset() {
+	*x = RebateRuleGame{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebateRuleGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebateRuleGame) ProtoMessage() {}
+
+func (x *RebateRuleGame) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebateRuleGame.ProtoReflect.Descriptor instead.
+func (*RebateRuleGame) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RebateRuleGame) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *RebateRuleGame) GetGameName() string {
+	if x != nil {
+		return x.GameName
+	}
+	return ""
+}
+
+func (x *RebateRuleGame) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+// 返水档位，单日有效投注在 [最小金额, 最大金额) 内适用
+type RebateRuleTier struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"档位ID，保存时忽略"`                                                                        // 档位ID，保存时忽略
+	DailyValidBetMin float64                `protobuf:"fixed64,2,opt,name=daily_valid_bet_min,json=dailyValidBetMin,proto3" json:"daily_valid_bet_min" dc:"单日有效投注最小金额"`               // 单日有效投注最小金额
+	DailyValidBetMax float64                `protobuf:"fixed64,3,opt,name=daily_valid_bet_max,json=dailyValidBetMax,proto3" json:"daily_valid_bet_max" dc:"单日有效投注最大金额，0=不限，只能用于最后一档"` // 单日有效投注最大金额，0=不限，只能用于最后一档
+	Games            []*RebateRuleGame      `protobuf:"bytes,4,rep,name=games,proto3" json:"games" dc:"各游戏返水比例"`                                                                      // 各游戏返水比例
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RebateRuleTier) Reset() {
+	*x = RebateRuleTier{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebateRuleTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebateRuleTier) ProtoMessage() {}
+
+func (x *RebateRuleTier) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebateRuleTier.ProtoReflect.Descriptor instead.
+func (*RebateRuleTier) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RebateRuleTier) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RebateRuleTier) GetDailyValidBetMin() float64 {
+	if x != nil {
+		return x.DailyValidBetMin
+	}
+	return 0
+}
+
+func (x *RebateRuleTier) GetDailyValidBetMax() float64 {
+	if x != nil {
+		return x.DailyValidBetMax
+	}
+	return 0
+}
+
+func (x *RebateRuleTier) GetGames() []*RebateRuleGame {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+// 返水规则信息
+type RebateRuleInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"规则ID"`                                          // 规则ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name" dc:"规则名称"`                                       // 规则名称
+	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status" dc:"状态 0=禁用 1=启用"`                          // 状态 0=禁用 1=启用
+	Tiers         []*RebateRuleTier      `protobuf:"bytes,4,rep,name=tiers,proto3" json:"tiers" dc:"返水档位，列表中不返回"`                              // 返水档位，列表中不返回
+	LevelCount    int32                  `protobuf:"varint,5,opt,name=level_count,json=levelCount,proto3" json:"level_count" dc:"使用该规则的会员层级数"` // 使用该规则的会员层级数
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间"`            // 创建时间
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at" dc:"更新时间"`            // 更新时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebateRuleInfo) Reset() {
+	*x = RebateRuleInfo{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebateRuleInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebateRuleInfo) ProtoMessage() {}
+
+func (x *RebateRuleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebateRuleInfo.ProtoReflect.Descriptor instead.
+func (*RebateRuleInfo) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RebateRuleInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RebateRuleInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RebateRuleInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *RebateRuleInfo) GetTiers() []*RebateRuleTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+func (x *RebateRuleInfo) GetLevelCount() int32 {
+	if x != nil {
+		return x.LevelCount
+	}
+	return 0
+}
+
+func (x *RebateRuleInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RebateRuleInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// 获取返水规则列表请求
+type GetRebateRulesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status" dc:"状态 -1=全部 0=禁用 1=启用"` // 状态 -1=全部 0=禁用 1=启用
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRebateRulesReq) Reset() {
+	*x = GetRebateRulesReq{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRebateRulesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRebateRulesReq) ProtoMessage() {}
+
+func (x *GetRebateRulesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRebateRulesReq.ProtoReflect.Descriptor instead.
+func (*GetRebateRulesReq) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetRebateRulesReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 获取返水规则列表响应
+type GetRebateRulesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*RebateRuleInfo      `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"规则列表"` // 规则列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRebateRulesRes) Reset() {
+	*x = GetRebateRulesRes{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRebateRulesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRebateRulesRes) ProtoMessage() {}
+
+func (x *GetRebateRulesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRebateRulesRes.ProtoReflect.Descriptor instead.
+func (*GetRebateRulesRes) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetRebateRulesRes) GetList() []*RebateRuleInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// 获取返水规则详情请求
+type GetRebateRuleReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"规则ID"` // 规则ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRebateRuleReq) Reset() {
+	*x = GetRebateRuleReq{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRebateRuleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRebateRuleReq) ProtoMessage() {}
+
+func (x *GetRebateRuleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRebateRuleReq.ProtoReflect.Descriptor instead.
+func (*GetRebateRuleReq) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetRebateRuleReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 获取返水规则详情响应
+type GetRebateRuleRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *RebateRuleInfo        `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule" dc:"规则详情，不存在时为空"` // 规则详情，不存在时为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRebateRuleRes) Reset() {
+	*x = GetRebateRuleRes{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRebateRuleRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRebateRuleRes) ProtoMessage() {}
+
+func (x *GetRebateRuleRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRebateRuleRes.ProtoReflect.Descriptor instead.
+func (*GetRebateRuleRes) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetRebateRuleRes) GetRule() *RebateRuleInfo {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// 创建返水规则请求
+type CreateRebateRuleReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name" dc:"规则名称"`              // 规则名称
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status" dc:"状态 0=禁用 1=启用"` // 状态 0=禁用 1=启用
+	Tiers         []*RebateRuleTier      `protobuf:"bytes,3,rep,name=tiers,proto3" json:"tiers" dc:"返水档位"`            // 返水档位
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRebateRuleReq) Reset() {
+	*x = CreateRebateRuleReq{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRebateRuleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRebateRuleReq) ProtoMessage() {}
+
+func (x *CreateRebateRuleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRebateRuleReq.ProtoReflect.Descriptor instead.
+func (*CreateRebateRuleReq) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateRebateRuleReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRebateRuleReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *CreateRebateRuleReq) GetTiers() []*RebateRuleTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+// 创建返水规则响应
+type CreateRebateRuleRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	Id            int32                  `protobuf:"varint,3,opt,name=id,proto3" json:"id" dc:"规则ID"`           // 规则ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRebateRuleRes) Reset() {
+	*x = CreateRebateRuleRes{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRebateRuleRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRebateRuleRes) ProtoMessage() {}
+
+func (x *CreateRebateRuleRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRebateRuleRes.ProtoReflect.Descriptor instead.
+func (*CreateRebateRuleRes) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateRebateRuleRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateRebateRuleRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateRebateRuleRes) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 修改返水规则请求，档位和返水比例整体替换
+type UpdateRebateRuleReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"规则ID"`                 // 规则ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name" dc:"规则名称"`              // 规则名称
+	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status" dc:"状态 0=禁用 1=启用"` // 状态 0=禁用 1=启用
+	Tiers         []*RebateRuleTier      `protobuf:"bytes,4,rep,name=tiers,proto3" json:"tiers" dc:"返水档位"`            // 返水档位
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRebateRuleReq) Reset() {
+	*x = UpdateRebateRuleReq{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRebateRuleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRebateRuleReq) ProtoMessage() {}
+
+func (x *UpdateRebateRuleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRebateRuleReq.ProtoReflect.Descriptor instead.
+func (*UpdateRebateRuleReq) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateRebateRuleReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateRebateRuleReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRebateRuleReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *UpdateRebateRuleReq) GetTiers() []*RebateRuleTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+// 修改返水规则响应
+type UpdateRebateRuleRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRebateRuleRes) Reset() {
+	*x = UpdateRebateRuleRes{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRebateRuleRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRebateRuleRes) ProtoMessage() {}
+
+func (x *UpdateRebateRuleRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRebateRuleRes.ProtoReflect.Descriptor instead.
+func (*UpdateRebateRuleRes) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateRebateRuleRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateRebateRuleRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 删除返水规则请求
+type DeleteRebateRuleReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"规则ID"` // 规则ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRebateRuleReq) Reset() {
+	*x = DeleteRebateRuleReq{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRebateRuleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRebateRuleReq) ProtoMessage() {}
+
+func (x *DeleteRebateRuleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRebateRuleReq.ProtoReflect.Descriptor instead.
+func (*DeleteRebateRuleReq) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteRebateRuleReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 删除返水规则响应
+type DeleteRebateRuleRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRebateRuleRes) Reset() {
+	*x = DeleteRebateRuleRes{}
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRebateRuleRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRebateRuleRes) ProtoMessage() {}
+
+func (x *DeleteRebateRuleRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_rebate_v1_rebate_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRebateRuleRes.ProtoReflect.Descriptor instead.
+func (*DeleteRebateRuleRes) Descriptor() ([]byte, []int) {
+	return file_backend_rebate_v1_rebate_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteRebateRuleRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteRebateRuleRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_backend_rebate_v1_rebate_proto protoreflect.FileDescriptor
+
+const file_backend_rebate_v1_rebate_proto_rawDesc = "" +
+	"\n" +
+	"\x1ebackend/rebate/v1/rebate.proto\x12\x06rebate\"\xa7\x04\n" +
+	"\x10RebateDetailInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vrebate_date\x18\x02 \x01(\tR\n" +
+	"rebateDate\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x05R\x06userId\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x19\n" +
+	"\blevel_id\x18\x05 \x01(\x05R\alevelId\x12\x1d\n" +
+	"\n" +
+	"level_name\x18\x06 \x01(\tR\tlevelName\x12\x19\n" +
+	"\bgrade_id\x18\a \x01(\x05R\agradeId\x12\x1d\n" +
+	"\n" +
+	"grade_name\x18\b \x01(\tR\tgradeName\x12\x17\n" +
+	"\arule_id\x18\t \x01(\x05R\x06ruleId\x12\x1b\n" +
+	"\trule_name\x18\n" +
+	" \x01(\tR\bruleName\x12\x17\n" +
+	"\agame_id\x18\v \x01(\x05R\x06gameId\x12\x1b\n" +
+	"\tgame_name\x18\f \x01(\tR\bgameName\x12\x1b\n" +
+	"\tgame_type\x18\r \x01(\x05R\bgameType\x12(\n" +
+	"\x10valid_bet_amount\x18\x0e \x01(\x01R\x0evalidBetAmount\x12\x18\n" +
+	"\apercent\x18\x0f \x01(\x01R\apercent\x12#\n" +
+	"\rgrade_percent\x18\x10 \x01(\x01R\fgradePercent\x12\x14\n" +
+	"\x05money\x18\x11 \x01(\x01R\x05money\x12\x16\n" +
+	"\x06status\x18\x12 \x01(\x05R\x06status\x12\x19\n" +
+	"\btrade_no\x18\x13 \x01(\tR\atradeNo\"j\n" +
+	"\x10PreviewRebateReq\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\"\xe9\x01\n" +
+	"\x10PreviewRebateRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"user_count\x18\x03 \x01(\x05R\tuserCount\x12(\n" +
+	"\x10valid_bet_amount\x18\x04 \x01(\x01R\x0evalidBetAmount\x12\x14\n" +
+	"\x05money\x18\x05 \x01(\x01R\x05money\x12,\n" +
+	"\x04list\x18\x06 \x03(\v2\x18.rebate.RebateDetailInfoR\x04list\x12\x14\n" +
+	"\x05count\x18\a \x01(\x05R\x05count\"(\n" +
+	"\x12CalculateRebateReq\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\"\xa7\x01\n" +
+	"\x12CalculateRebateRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"user_count\x18\x03 \x01(\x05R\tuserCount\x12(\n" +
+	"\x10valid_bet_amount\x18\x04 \x01(\x01R\x0evalidBetAmount\x12\x14\n" +
+	"\x05money\x18\x05 \x01(\x01R\x05money\">\n" +
+	"\x10ApproveRebateReq\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x16\n" +
+	"\x06remark\x18\x02 \x01(\tR\x06remark\"\x88\x01\n" +
+	"\x10ApproveRebateRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04paid\x18\x03 \x01(\x05R\x04paid\x12\x14\n" +
+	"\x05money\x18\x04 \x01(\x01R\x05money\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\"=\n" +
+	"\x0fRejectRebateReq\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x16\n" +
+	"\x06remark\x18\x02 \x01(\tR\x06remark\"E\n" +
+	"\x0fRejectRebateRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x91\x01\n" +
+	"\x15GetRebateHistoriesReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\x05R\x06status\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x05 \x01(\tR\aendDate\"\xcd\x02\n" +
+	"\x11RebateHistoryInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vrebate_date\x18\x02 \x01(\tR\n" +
+	"rebateDate\x12\x1d\n" +
+	"\n" +
+	"user_count\x18\x03 \x01(\x05R\tuserCount\x12(\n" +
+	"\x10valid_bet_amount\x18\x04 \x01(\x01R\x0evalidBetAmount\x12\x14\n" +
+	"\x05money\x18\x05 \x01(\x01R\x05money\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\x05R\x06status\x12\x19\n" +
+	"\badmin_id\x18\a \x01(\x05R\aadminId\x12\x1f\n" +
+	"\vapproved_at\x18\b \x01(\tR\n" +
+	"approvedAt\x12\x16\n" +
+	"\x06remark\x18\t \x01(\tR\x06remark\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAt\"\\\n" +
+	"\x15GetRebateHistoriesRes\x12-\n" +
+	"\x04list\x18\x01 \x03(\v2\x19.rebate.RebateHistoryInfoR\x04list\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\x85\x01\n" +
+	"\x13GetRebateDetailsReq\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\x05R\x06status\"o\n" +
+	"\x13GetRebateDetailsRes\x12,\n" +
+	"\x04list\x18\x01 \x03(\v2\x18.rebate.RebateDetailInfoR\x04list\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x14\n" +
+	"\x05money\x18\x03 \x01(\x01R\x05money\"`\n" +
+	"\x0eRebateRuleGame\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x05R\x06gameId\x12\x1b\n" +
+	"\tgame_name\x18\x02 \x01(\tR\bgameName\x12\x18\n" +
+	"\apercent\x18\x03 \x01(\x01R\apercent\"\xac\x01\n" +
+	"\x0eRebateRuleTier\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12-\n" +
+	"\x13daily_valid_bet_min\x18\x02 \x01(\x01R\x10dailyValidBetMin\x12-\n" +
+	"\x13daily_valid_bet_max\x18\x03 \x01(\x01R\x10dailyValidBetMax\x12,\n" +
+	"\x05games\x18\x04 \x03(\v2\x16.rebate.RebateRuleGameR\x05games\"\xd9\x01\n" +
+	"\x0eRebateRuleInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\x05R\x06status\x12,\n" +
+	"\x05tiers\x18\x04 \x03(\v2\x16.rebate.RebateRuleTierR\x05tiers\x12\x1f\n" +
+	"\vlevel_count\x18\x05 \x01(\x05R\n" +
+	"levelCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\"+\n" +
+	"\x11GetRebateRulesReq\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\"?\n" +
+	"\x11GetRebateRulesRes\x12*\n" +
+	"\x04list\x18\x01 \x03(\v2\x16.rebate.RebateRuleInfoR\x04list\"\"\n" +
+	"\x10GetRebateRuleReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\">\n" +
+	"\x10GetRebateRuleRes\x12*\n" +
+	"\x04rule\x18\x01 \x01(\v2\x16.rebate.RebateRuleInfoR\x04rule\"o\n" +
+	"\x13CreateRebateRuleReq\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12,\n" +
+	"\x05tiers\x18\x03 \x03(\v2\x16.rebate.RebateRuleTierR\x05tiers\"Y\n" +
+	"\x13CreateRebateRuleRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\x05R\x02id\"\x7f\n" +
+	"\x13UpdateRebateRuleReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\x05R\x06status\x12,\n" +
+	"\x05tiers\x18\x04 \x03(\v2\x16.rebate.RebateRuleTierR\x05tiers\"I\n" +
+	"\x13UpdateRebateRuleRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"%\n" +
+	"\x13DeleteRebateRuleReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"I\n" +
+	"\x13DeleteRebateRuleRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage2\xce\x06\n" +
+	"\x06Rebate\x12E\n" +
+	"\rPreviewRebate\x12\x18.rebate.PreviewRebateReq\x1a\x18.rebate.PreviewRebateRes\"\x00\x12K\n" +
+	"\x0fCalculateRebate\x12\x1a.rebate.CalculateRebateReq\x1a\x1a.rebate.CalculateRebateRes\"\x00\x12E\n" +
+	"\rApproveRebate\x12\x18.rebate.ApproveRebateReq\x1a\x18.rebate.ApproveRebateRes\"\x00\x12B\n" +
+	"\fRejectRebate\x12\x17.rebate.RejectRebateReq\x1a\x17.rebate.RejectRebateRes\"\x00\x12T\n" +
+	"\x12GetRebateHistories\x12\x1d.rebate.GetRebateHistoriesReq\x1a\x1d.rebate.GetRebateHistoriesRes\"\x00\x12N\n" +
+	"\x10GetRebateDetails\x12\x1b.rebate.GetRebateDetailsReq\x1a\x1b.rebate.GetRebateDetailsRes\"\x00\x12H\n" +
+	"\x0eGetRebateRules\x12\x19.rebate.GetRebateRulesReq\x1a\x19.rebate.GetRebateRulesRes\"\x00\x12E\n" +
+	"\rGetRebateRule\x12\x18.rebate.GetRebateRuleReq\x1a\x18.rebate.GetRebateRuleRes\"\x00\x12N\n" +
+	"\x10CreateRebateRule\x12\x1b.rebate.CreateRebateRuleReq\x1a\x1b.rebate.CreateRebateRuleRes\"\x00\x12N\n" +
+	"\x10UpdateRebateRule\x12\x1b.rebate.UpdateRebateRuleReq\x1a\x1b.rebate.UpdateRebateRuleRes\"\x00\x12N\n" +
+	"\x10DeleteRebateRule\x12\x1b.rebate.DeleteRebateRuleReq\x1a\x1b.rebate.DeleteRebateRuleRes\"\x00B&Z$jh_app_service/api/backend/rebate/v1b\x06proto3"
+
+var (
+	file_backend_rebate_v1_rebate_proto_rawDescOnce sync.Once
+	file_backend_rebate_v1_rebate_proto_rawDescData []byte
+)
+
+func file_backend_rebate_v1_rebate_proto_rawDescGZIP() []byte {
+	file_backend_rebate_v1_rebate_proto_rawDescOnce.Do(func() {
+		file_backend_rebate_v1_rebate_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_backend_rebate_v1_rebate_proto_rawDesc), len(file_backend_rebate_v1_rebate_proto_rawDesc)))
+	})
+	return file_backend_rebate_v1_rebate_proto_rawDescData
+}
+
+var file_backend_rebate_v1_rebate_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_backend_rebate_v1_rebate_proto_goTypes = []any{
+	(*RebateDetailInfo)(nil),      // 0: rebate.RebateDetailInfo
+	(*PreviewRebateReq)(nil),      // 1: rebate.PreviewRebateReq
+	(*PreviewRebateRes)(nil),      // 2: rebate.PreviewRebateRes
+	(*CalculateRebateReq)(nil),    // 3: rebate.CalculateRebateReq
+	(*CalculateRebateRes)(nil),    // 4: rebate.CalculateRebateRes
+	(*ApproveRebateReq)(nil),      // 5: rebate.ApproveRebateReq
+	(*ApproveRebateRes)(nil),      // 6: rebate.ApproveRebateRes
+	(*RejectRebateReq)(nil),       // 7: rebate.RejectRebateReq
+	(*RejectRebateRes)(nil),       // 8: rebate.RejectRebateRes
+	(*GetRebateHistoriesReq)(nil), // 9: rebate.GetRebateHistoriesReq
+	(*RebateHistoryInfo)(nil),     // 10: rebate.RebateHistoryInfo
+	(*GetRebateHistoriesRes)(nil), // 11: rebate.GetRebateHistoriesRes
+	(*GetRebateDetailsReq)(nil),   // 12: rebate.GetRebateDetailsReq
+	(*GetRebateDetailsRes)(nil),   // 13: rebate.GetRebateDetailsRes
+	(*RebateRuleGame)(nil),        // 14: rebate.RebateRuleGame
+	(*RebateRuleTier)(nil),        // 15: rebate.RebateRuleTier
+	(*RebateRuleInfo)(nil),        // 16: rebate.RebateRuleInfo
+	(*GetRebateRulesReq)(nil),     // 17: rebate.GetRebateRulesReq
+	(*GetRebateRulesRes)(nil),     // 18: rebate.GetRebateRulesRes
+	(*GetRebateRuleReq)(nil),      // 19: rebate.GetRebateRuleReq
+	(*GetRebateRuleRes)(nil),      // 20: rebate.GetRebateRuleRes
+	(*CreateRebateRuleReq)(nil),   // 21: rebate.CreateRebateRuleReq
+	(*CreateRebateRuleRes)(nil),   // 22: rebate.CreateRebateRuleRes
+	(*UpdateRebateRuleReq)(nil),   // 23: rebate.UpdateRebateRuleReq
+	(*UpdateRebateRuleRes)(nil),   // 24: rebate.UpdateRebateRuleRes
+	(*DeleteRebateRuleReq)(nil),   // 25: rebate.DeleteRebateRuleReq
+	(*DeleteRebateRuleRes)(nil),   // 26: rebate.DeleteRebateRuleRes
+}
+var file_backend_rebate_v1_rebate_proto_depIdxs = []int32{
+	0,  // 0: rebate.PreviewRebateRes.list:type_name -> rebate.RebateDetailInfo
+	10, // 1: rebate.GetRebateHistoriesRes.list:type_name -> rebate.RebateHistoryInfo
+	0,  // 2: rebate.GetRebateDetailsRes.list:type_name -> rebate.RebateDetailInfo
+	14, // 3: rebate.RebateRuleTier.games:type_name -> rebate.RebateRuleGame
+	15, // 4: rebate.RebateRuleInfo.tiers:type_name -> rebate.RebateRuleTier
+	16, // 5: rebate.GetRebateRulesRes.list:type_name -> rebate.RebateRuleInfo
+	16, // 6: rebate.GetRebateRuleRes.rule:type_name -> rebate.RebateRuleInfo
+	15, // 7: rebate.CreateRebateRuleReq.tiers:type_name -> rebate.RebateRuleTier
+	15, // 8: rebate.UpdateRebateRuleReq.tiers:type_name -> rebate.RebateRuleTier
+	1,  // 9: rebate.Rebate.PreviewRebate:input_type -> rebate.PreviewRebateReq
+	3,  // 10: rebate.Rebate.CalculateRebate:input_type -> rebate.CalculateRebateReq
+	5,  // 11: rebate.Rebate.ApproveRebate:input_type -> rebate.ApproveRebateReq
+	7,  // 12: rebate.Rebate.RejectRebate:input_type -> rebate.RejectRebateReq
+	9,  // 13: rebate.Rebate.GetRebateHistories:input_type -> rebate.GetRebateHistoriesReq
+	12, // 14: rebate.Rebate.GetRebateDetails:input_type -> rebate.GetRebateDetailsReq
+	17, // 15: rebate.Rebate.GetRebateRules:input_type -> rebate.GetRebateRulesReq
+	19, // 16: rebate.Rebate.GetRebateRule:input_type -> rebate.GetRebateRuleReq
+	21, // 17: rebate.Rebate.CreateRebateRule:input_type -> rebate.CreateRebateRuleReq
+	23, // 18: rebate.Rebate.UpdateRebateRule:input_type -> rebate.UpdateRebateRuleReq
+	25, // 19: rebate.Rebate.DeleteRebateRule:input_type -> rebate.DeleteRebateRuleReq
+	2,  // 20: rebate.Rebate.PreviewRebate:output_type -> rebate.PreviewRebateRes
+	4,  // 21: rebate.Rebate.CalculateRebate:output_type -> rebate.CalculateRebateRes
+	6,  // 22: rebate.Rebate.ApproveRebate:output_type -> rebate.ApproveRebateRes
+	8,  // 23: rebate.Rebate.RejectRebate:output_type -> rebate.RejectRebateRes
+	11, // 24: rebate.Rebate.GetRebateHistories:output_type -> rebate.GetRebateHistoriesRes
+	13, // 25: rebate.Rebate.GetRebateDetails:output_type -> rebate.GetRebateDetailsRes
+	18, // 26: rebate.Rebate.GetRebateRules:output_type -> rebate.GetRebateRulesRes
+	20, // 27: rebate.Rebate.GetRebateRule:output_type -> rebate.GetRebateRuleRes
+	22, // 28: rebate.Rebate.CreateRebateRule:output_type -> rebate.CreateRebateRuleRes
+	24, // 29: rebate.Rebate.UpdateRebateRule:output_type -> rebate.UpdateRebateRuleRes
+	26, // 30: rebate.Rebate.DeleteRebateRule:output_type -> rebate.DeleteRebateRuleRes
+	20, // [20:31] is the sub-list for method output_type
+	9,  // [9:20] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_backend_rebate_v1_rebate_proto_init() }
+func file_backend_rebate_v1_rebate_proto_init() {
+	if File_backend_rebate_v1_rebate_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backend_rebate_v1_rebate_proto_rawDesc), len(file_backend_rebate_v1_rebate_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   27,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_backend_rebate_v1_rebate_proto_goTypes,
+		DependencyIndexes: file_backend_rebate_v1_rebate_proto_depIdxs,
+		MessageInfos:      file_backend_rebate_v1_rebate_proto_msgTypes,
+	}.Build()
+	File_backend_rebate_v1_rebate_proto = out.File
+	file_backend_rebate_v1_rebate_proto_goTypes = nil
+	file_backend_rebate_v1_rebate_proto_depIdxs = nil
+}
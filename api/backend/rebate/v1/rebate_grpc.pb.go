@@ -0,0 +1,506 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.1
+// source: backend/rebate/v1/rebate.proto
+
+package v1
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Rebate_PreviewRebate_FullMethodName      = "/rebate.Rebate/PreviewRebate"
+	Rebate_CalculateRebate_FullMethodName    = "/rebate.Rebate/CalculateRebate"
+	Rebate_ApproveRebate_FullMethodName      = "/rebate.Rebate/ApproveRebate"
+	Rebate_RejectRebate_FullMethodName       = "/rebate.Rebate/RejectRebate"
+	Rebate_GetRebateHistories_FullMethodName = "/rebate.Rebate/GetRebateHistories"
+	Rebate_GetRebateDetails_FullMethodName   = "/rebate.Rebate/GetRebateDetails"
+	Rebate_GetRebateRules_FullMethodName     = "/rebate.Rebate/GetRebateRules"
+	Rebate_GetRebateRule_FullMethodName      = "/rebate.Rebate/GetRebateRule"
+	Rebate_CreateRebateRule_FullMethodName   = "/rebate.Rebate/CreateRebateRule"
+	Rebate_UpdateRebateRule_FullMethodName   = "/rebate.Rebate/UpdateRebateRule"
+	Rebate_DeleteRebateRule_FullMethodName   = "/rebate.Rebate/DeleteRebateRule"
+)
+
+// RebateClient is the client API for Rebate service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RebateClient interface {
+	// 返水计算接口
+	PreviewRebate(ctx context.Context, in *PreviewRebateReq, opts ...grpc.CallOption) (*PreviewRebateRes, error)
+	CalculateRebate(ctx context.Context, in *CalculateRebateReq, opts ...grpc.CallOption) (*CalculateRebateRes, error)
+	ApproveRebate(ctx context.Context, in *ApproveRebateReq, opts ...grpc.CallOption) (*ApproveRebateRes, error)
+	RejectRebate(ctx context.Context, in *RejectRebateReq, opts ...grpc.CallOption) (*RejectRebateRes, error)
+	GetRebateHistories(ctx context.Context, in *GetRebateHistoriesReq, opts ...grpc.CallOption) (*GetRebateHistoriesRes, error)
+	GetRebateDetails(ctx context.Context, in *GetRebateDetailsReq, opts ...grpc.CallOption) (*GetRebateDetailsRes, error)
+	// 返水规则接口
+	GetRebateRules(ctx context.Context, in *GetRebateRulesReq, opts ...grpc.CallOption) (*GetRebateRulesRes, error)
+	GetRebateRule(ctx context.Context, in *GetRebateRuleReq, opts ...grpc.CallOption) (*GetRebateRuleRes, error)
+	CreateRebateRule(ctx context.Context, in *CreateRebateRuleReq, opts ...grpc.CallOption) (*CreateRebateRuleRes, error)
+	UpdateRebateRule(ctx context.Context, in *UpdateRebateRuleReq, opts ...grpc.CallOption) (*UpdateRebateRuleRes, error)
+	DeleteRebateRule(ctx context.Context, in *DeleteRebateRuleReq, opts ...grpc.CallOption) (*DeleteRebateRuleRes, error)
+}
+
+type rebateClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRebateClient(cc grpc.ClientConnInterface) RebateClient {
+	return &rebateClient{cc}
+}
+
+func (c *rebateClient) PreviewRebate(ctx context.Context, in *PreviewRebateReq, opts ...grpc.CallOption) (*PreviewRebateRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewRebateRes)
+	err := c.cc.Invoke(ctx, Rebate_PreviewRebate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rebateClient) CalculateRebate(ctx context.Context, in *CalculateRebateReq, opts ...grpc.CallOption) (*CalculateRebateRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalculateRebateRes)
+	err := c.cc.Invoke(ctx, Rebate_CalculateRebate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rebateClient) ApproveRebate(ctx context.Context, in *ApproveRebateReq, opts ...grpc.CallOption) (*ApproveRebateRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveRebateRes)
+	err := c.cc.Invoke(ctx, Rebate_ApproveRebate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rebateClient) RejectRebate(ctx context.Context, in *RejectRebateReq, opts ...grpc.CallOption) (*RejectRebateRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectRebateRes)
+	err := c.cc.Invoke(ctx, Rebate_RejectRebate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rebateClient) GetRebateHistories(ctx context.Context, in *GetRebateHistoriesReq, opts ...grpc.CallOption) (*GetRebateHistoriesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRebateHistoriesRes)
+	err := c.cc.Invoke(ctx, Rebate_GetRebateHistories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rebateClient) GetRebateDetails(ctx context.Context, in *GetRebateDetailsReq, opts ...grpc.CallOption) (*GetRebateDetailsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRebateDetailsRes)
+	err := c.cc.Invoke(ctx, Rebate_GetRebateDetails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rebateClient) GetRebateRules(ctx context.Context, in *GetRebateRulesReq, opts ...grpc.CallOption) (*GetRebateRulesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRebateRulesRes)
+	err := c.cc.Invoke(ctx, Rebate_GetRebateRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rebateClient) GetRebateRule(ctx context.Context, in *GetRebateRuleReq, opts ...grpc.CallOption) (*GetRebateRuleRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRebateRuleRes)
+	err := c.cc.Invoke(ctx, Rebate_GetRebateRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rebateClient) CreateRebateRule(ctx context.Context, in *CreateRebateRuleReq, opts ...grpc.CallOption) (*CreateRebateRuleRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRebateRuleRes)
+	err := c.cc.Invoke(ctx, Rebate_CreateRebateRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rebateClient) UpdateRebateRule(ctx context.Context, in *UpdateRebateRuleReq, opts ...grpc.CallOption) (*UpdateRebateRuleRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRebateRuleRes)
+	err := c.cc.Invoke(ctx, Rebate_UpdateRebateRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rebateClient) DeleteRebateRule(ctx context.Context, in *DeleteRebateRuleReq, opts ...grpc.CallOption) (*DeleteRebateRuleRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRebateRuleRes)
+	err := c.cc.Invoke(ctx, Rebate_DeleteRebateRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RebateServer is the server API for Rebate service.
+// All implementations must embed UnimplementedRebateServer
+// for forward compatibility.
+type RebateServer interface {
+	// 返水计算接口
+	PreviewRebate(context.Context, *PreviewRebateReq) (*PreviewRebateRes, error)
+	CalculateRebate(context.Context, *CalculateRebateReq) (*CalculateRebateRes, error)
+	ApproveRebate(context.Context, *ApproveRebateReq) (*ApproveRebateRes, error)
+	RejectRebate(context.Context, *RejectRebateReq) (*RejectRebateRes, error)
+	GetRebateHistories(context.Context, *GetRebateHistoriesReq) (*GetRebateHistoriesRes, error)
+	GetRebateDetails(context.Context, *GetRebateDetailsReq) (*GetRebateDetailsRes, error)
+	// 返水规则接口
+	GetRebateRules(context.Context, *GetRebateRulesReq) (*GetRebateRulesRes, error)
+	GetRebateRule(context.Context, *GetRebateRuleReq) (*GetRebateRuleRes, error)
+	CreateRebateRule(context.Context, *CreateRebateRuleReq) (*CreateRebateRuleRes, error)
+	UpdateRebateRule(context.Context, *UpdateRebateRuleReq) (*UpdateRebateRuleRes, error)
+	DeleteRebateRule(context.Context, *DeleteRebateRuleReq) (*DeleteRebateRuleRes, error)
+	mustEmbedUnimplementedRebateServer()
+}
+
+// UnimplementedRebateServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRebateServer struct{}
+
+func (UnimplementedRebateServer) PreviewRebate(context.Context, *PreviewRebateReq) (*PreviewRebateRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewRebate not implemented")
+}
+func (UnimplementedRebateServer) CalculateRebate(context.Context, *CalculateRebateReq) (*CalculateRebateRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method CalculateRebate not implemented")
+}
+func (UnimplementedRebateServer) ApproveRebate(context.Context, *ApproveRebateReq) (*ApproveRebateRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveRebate not implemented")
+}
+func (UnimplementedRebateServer) RejectRebate(context.Context, *RejectRebateReq) (*RejectRebateRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectRebate not implemented")
+}
+func (UnimplementedRebateServer) GetRebateHistories(context.Context, *GetRebateHistoriesReq) (*GetRebateHistoriesRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRebateHistories not implemented")
+}
+func (UnimplementedRebateServer) GetRebateDetails(context.Context, *GetRebateDetailsReq) (*GetRebateDetailsRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRebateDetails not implemented")
+}
+func (UnimplementedRebateServer) GetRebateRules(context.Context, *GetRebateRulesReq) (*GetRebateRulesRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRebateRules not implemented")
+}
+func (UnimplementedRebateServer) GetRebateRule(context.Context, *GetRebateRuleReq) (*GetRebateRuleRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRebateRule not implemented")
+}
+func (UnimplementedRebateServer) CreateRebateRule(context.Context, *CreateRebateRuleReq) (*CreateRebateRuleRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRebateRule not implemented")
+}
+func (UnimplementedRebateServer) UpdateRebateRule(context.Context, *UpdateRebateRuleReq) (*UpdateRebateRuleRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRebateRule not implemented")
+}
+func (UnimplementedRebateServer) DeleteRebateRule(context.Context, *DeleteRebateRuleReq) (*DeleteRebateRuleRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRebateRule not implemented")
+}
+func (UnimplementedRebateServer) mustEmbedUnimplementedRebateServer() {}
+func (UnimplementedRebateServer) testEmbeddedByValue()                {}
+
+// UnsafeRebateServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RebateServer will
+// result in compilation errors.
+type UnsafeRebateServer interface {
+	mustEmbedUnimplementedRebateServer()
+}
+
+func RegisterRebateServer(s grpc.ServiceRegistrar, srv RebateServer) {
+	// If the following call panics, it indicates UnimplementedRebateServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Rebate_ServiceDesc, srv)
+}
+
+func _Rebate_PreviewRebate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewRebateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RebateServer).PreviewRebate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Rebate_PreviewRebate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RebateServer).PreviewRebate(ctx, req.(*PreviewRebateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rebate_CalculateRebate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateRebateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RebateServer).CalculateRebate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Rebate_CalculateRebate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RebateServer).CalculateRebate(ctx, req.(*CalculateRebateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rebate_ApproveRebate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRebateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RebateServer).ApproveRebate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Rebate_ApproveRebate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RebateServer).ApproveRebate(ctx, req.(*ApproveRebateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rebate_RejectRebate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectRebateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RebateServer).RejectRebate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Rebate_RejectRebate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RebateServer).RejectRebate(ctx, req.(*RejectRebateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rebate_GetRebateHistories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRebateHistoriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RebateServer).GetRebateHistories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Rebate_GetRebateHistories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RebateServer).GetRebateHistories(ctx, req.(*GetRebateHistoriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rebate_GetRebateDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRebateDetailsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RebateServer).GetRebateDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Rebate_GetRebateDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RebateServer).GetRebateDetails(ctx, req.(*GetRebateDetailsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rebate_GetRebateRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRebateRulesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RebateServer).GetRebateRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Rebate_GetRebateRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RebateServer).GetRebateRules(ctx, req.(*GetRebateRulesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rebate_GetRebateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRebateRuleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RebateServer).GetRebateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Rebate_GetRebateRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RebateServer).GetRebateRule(ctx, req.(*GetRebateRuleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rebate_CreateRebateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRebateRuleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RebateServer).CreateRebateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Rebate_CreateRebateRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RebateServer).CreateRebateRule(ctx, req.(*CreateRebateRuleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rebate_UpdateRebateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRebateRuleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RebateServer).UpdateRebateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Rebate_UpdateRebateRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RebateServer).UpdateRebateRule(ctx, req.(*UpdateRebateRuleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rebate_DeleteRebateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRebateRuleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RebateServer).DeleteRebateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Rebate_DeleteRebateRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RebateServer).DeleteRebateRule(ctx, req.(*DeleteRebateRuleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Rebate_ServiceDesc is the grpc.ServiceDesc for Rebate service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Rebate_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rebate.Rebate",
+	HandlerType: (*RebateServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PreviewRebate",
+			Handler:    _Rebate_PreviewRebate_Handler,
+		},
+		{
+			MethodName: "CalculateRebate",
+			Handler:    _Rebate_CalculateRebate_Handler,
+		},
+		{
+			MethodName: "ApproveRebate",
+			Handler:    _Rebate_ApproveRebate_Handler,
+		},
+		{
+			MethodName: "RejectRebate",
+			Handler:    _Rebate_RejectRebate_Handler,
+		},
+		{
+			MethodName: "GetRebateHistories",
+			Handler:    _Rebate_GetRebateHistories_Handler,
+		},
+		{
+			MethodName: "GetRebateDetails",
+			Handler:    _Rebate_GetRebateDetails_Handler,
+		},
+		{
+			MethodName: "GetRebateRules",
+			Handler:    _Rebate_GetRebateRules_Handler,
+		},
+		{
+			MethodName: "GetRebateRule",
+			Handler:    _Rebate_GetRebateRule_Handler,
+		},
+		{
+			MethodName: "CreateRebateRule",
+			Handler:    _Rebate_CreateRebateRule_Handler,
+		},
+		{
+			MethodName: "UpdateRebateRule",
+			Handler:    _Rebate_UpdateRebateRule_Handler,
+		},
+		{
+			MethodName: "DeleteRebateRule",
+			Handler:    _Rebate_DeleteRebateRule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backend/rebate/v1/rebate.proto",
+}
@@ -0,0 +1,1436 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: backend/risk/v1/risk.proto
+
+package v1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 风险预警配置，新预警通过后台实时推送 (Feed.Subscribe) 的 risk 主题接收
+type ForewarnConfig struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	AccountToGame         float64                `protobuf:"fixed64,1,opt,name=account_to_game,json=accountToGame,proto3" json:"account_to_game" dc:"账户转入游戏单笔金额预警，0=不提示"`                       // 账户转入游戏单笔金额预警，0=不提示
+	GameToAccount         float64                `protobuf:"fixed64,2,opt,name=game_to_account,json=gameToAccount,proto3" json:"game_to_account" dc:"游戏转出到账户单笔金额预警，0=不提示"`                      // 游戏转出到账户单笔金额预警，0=不提示
+	WinMoney              float64                `protobuf:"fixed64,3,opt,name=win_money,json=winMoney,proto3" json:"win_money" dc:"会员单日游戏赢得金额 (输赢合计) 预警，0=不提示"`                                // 会员单日游戏赢得金额 (输赢合计) 预警，0=不提示
+	BetAmount             float64                `protobuf:"fixed64,4,opt,name=bet_amount,json=betAmount,proto3" json:"bet_amount" dc:"会员单日游戏投注金额预警，0=不提示"`                                     // 会员单日游戏投注金额预警，0=不提示
+	AlterBankCard         bool                   `protobuf:"varint,5,opt,name=alter_bank_card,json=alterBankCard,proto3" json:"alter_bank_card" dc:"会员银行卡号被修改时提示"`                              // 会员银行卡号被修改时提示
+	LoginAreaDifference   bool                   `protobuf:"varint,6,opt,name=login_area_difference,json=loginAreaDifference,proto3" json:"login_area_difference" dc:"本次和上次登录地区不同时提示"`          // 本次和上次登录地区不同时提示
+	SameipRegisterTime    int32                  `protobuf:"varint,7,opt,name=sameip_register_time,json=sameipRegisterTime,proto3" json:"sameip_register_time" dc:"同一IP注册统计时间，单位：分钟，0=不限时间"`    // 同一IP注册统计时间，单位：分钟，0=不限时间
+	SameipRegisterCount   int32                  `protobuf:"varint,8,opt,name=sameip_register_count,json=sameipRegisterCount,proto3" json:"sameip_register_count" dc:"同一IP注册次数达到该值时提示，0=不提示"`   // 同一IP注册次数达到该值时提示，0=不提示
+	IsAccountToGame       bool                   `protobuf:"varint,9,opt,name=is_account_to_game,json=isAccountToGame,proto3" json:"is_account_to_game" dc:"是否开启账户转入游戏预警"`                      // 是否开启账户转入游戏预警
+	IsGameToAccount       bool                   `protobuf:"varint,10,opt,name=is_game_to_account,json=isGameToAccount,proto3" json:"is_game_to_account" dc:"是否开启游戏转出到账户预警"`                    // 是否开启游戏转出到账户预警
+	IsWinMoney            bool                   `protobuf:"varint,11,opt,name=is_win_money,json=isWinMoney,proto3" json:"is_win_money" dc:"是否开启游戏赢得金额预警，每日按前一天的投注汇总检查"`                        // 是否开启游戏赢得金额预警，每日按前一天的投注汇总检查
+	IsBetAmount           bool                   `protobuf:"varint,12,opt,name=is_bet_amount,json=isBetAmount,proto3" json:"is_bet_amount" dc:"是否开启游戏投注金额预警，每日按前一天的投注汇总检查"`                     // 是否开启游戏投注金额预警，每日按前一天的投注汇总检查
+	IsAlterBankCard       bool                   `protobuf:"varint,13,opt,name=is_alter_bank_card,json=isAlterBankCard,proto3" json:"is_alter_bank_card" dc:"是否开启修改银行卡预警"`                      // 是否开启修改银行卡预警
+	IsLoginAreaDifference bool                   `protobuf:"varint,14,opt,name=is_login_area_difference,json=isLoginAreaDifference,proto3" json:"is_login_area_difference" dc:"是否开启登录地区不同预警"`   // 是否开启登录地区不同预警
+	IsSameipRegisterCount bool                   `protobuf:"varint,15,opt,name=is_sameip_register_count,json=isSameipRegisterCount,proto3" json:"is_sameip_register_count" dc:"是否开启相同IP注册次数预警"` // 是否开启相同IP注册次数预警
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ForewarnConfig) Reset() {
+	*x = ForewarnConfig{}
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForewarnConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForewarnConfig) ProtoMessage() {}
+
+func (x *ForewarnConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForewarnConfig.ProtoReflect.Descriptor instead.
+func (*ForewarnConfig) Descriptor() ([]byte, []int) {
+	return file_backend_risk_v1_risk_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ForewarnConfig) GetAccountToGame() float64 {
+	if x != nil {
+		return x.AccountToGame
+	}
+	return 0
+}
+
+func (x *ForewarnConfig) GetGameToAccount() float64 {
+	if x != nil {
+		return x.GameToAccount
+	}
+	return 0
+}
+
+func (x *ForewarnConfig) GetWinMoney() float64 {
+	if x != nil {
+		return x.WinMoney
+	}
+	return 0
+}
+
+func (x *ForewarnConfig) GetBetAmount() float64 {
+	if x != nil {
+		return x.BetAmount
+	}
+	return 0
+}
+
+func (x *ForewarnConfig) GetAlterBankCard() bool {
+	if x != nil {
+		return x.AlterBankCard
+	}
+	return false
+}
+
+func (x *ForewarnConfig) GetLoginAreaDifference() bool {
+	if x != nil {
+		return x.LoginAreaDifference
+	}
+	return false
+}
+
+func (x *ForewarnConfig) GetSameipRegisterTime() int32 {
+	if x != nil {
+		return x.SameipRegisterTime
+	}
+	return 0
+}
+
+func (x *ForewarnConfig) GetSameipRegisterCount() int32 {
+	if x != nil {
+		return x.SameipRegisterCount
+	}
+	return 0
+}
+
+func (x *ForewarnConfig) GetIsAccountToGame() bool {
+	if x != nil {
+		return x.IsAccountToGame
+	}
+	return false
+}
+
+func (x *ForewarnConfig) GetIsGameToAccount() bool {
+	if x != nil {
+		return x.IsGameToAccount
+	}
+	return false
+}
+
+func (x *ForewarnConfig) GetIsWinMoney() bool {
+	if x != nil {
+		return x.IsWinMoney
+	}
+	return false
+}
+
+func (x *ForewarnConfig) GetIsBetAmount() bool {
+	if x != nil {
+		return x.IsBetAmount
+	}
+	return false
+}
+
+func (x *ForewarnConfig) GetIsAlterBankCard() bool {
+	if x != nil {
+		return x.IsAlterBankCard
+	}
+	return false
+}
+
+func (x *ForewarnConfig) GetIsLoginAreaDifference() bool {
+	if x != nil {
+		return x.IsLoginAreaDifference
+	}
+	return false
+}
+
+func (x *ForewarnConfig) GetIsSameipRegisterCount() bool {
+	if x != nil {
+		return x.IsSameipRegisterCount
+	}
+	return false
+}
+
+type GetForewarnConfigReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetForewarnConfigReq) Reset() {
+	*x = GetForewarnConfigReq{}
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForewarnConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForewarnConfigReq) ProtoMessage() {}
+
+func (x *GetForewarnConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForewarnConfigReq.ProtoReflect.Descriptor instead.
+func (*GetForewarnConfigReq) Descriptor() ([]byte, []int) {
+	return file_backend_risk_v1_risk_proto_rawDescGZIP(), []int{1}
+}
+
+type GetForewarnConfigRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *ForewarnConfig        `protobuf:"bytes,1,opt,name=config,proto3" json:"config" dc:"预警配置"`                        // 预警配置
+	UpdatedAt     string                 `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at" dc:"更新时间"` // 更新时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetForewarnConfigRes) Reset() {
+	*x = GetForewarnConfigRes{}
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForewarnConfigRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForewarnConfigRes) ProtoMessage() {}
+
+func (x *GetForewarnConfigRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForewarnConfigRes.ProtoReflect.Descriptor instead.
+func (*GetForewarnConfigRes) Descriptor() ([]byte, []int) {
+	return file_backend_risk_v1_risk_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetForewarnConfigRes) GetConfig() *ForewarnConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *GetForewarnConfigRes) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type UpdateForewarnConfigReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *ForewarnConfig        `protobuf:"bytes,1,opt,name=config,proto3" json:"config" dc:"预警配置"` // 预警配置
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateForewarnConfigReq) Reset() {
+	*x = UpdateForewarnConfigReq{}
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateForewarnConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateForewarnConfigReq) ProtoMessage() {}
+
+func (x *UpdateForewarnConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateForewarnConfigReq.ProtoReflect.Descriptor instead.
+func (*UpdateForewarnConfigReq) Descriptor() ([]byte, []int) {
+	return file_backend_risk_v1_risk_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateForewarnConfigReq) GetConfig() *ForewarnConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type UpdateForewarnConfigRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateForewarnConfigRes) Reset() {
+	*x = UpdateForewarnConfigRes{}
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateForewarnConfigRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateForewarnConfigRes) ProtoMessage() {}
+
+func (x *UpdateForewarnConfigRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateForewarnConfigRes.ProtoReflect.Descriptor instead.
+func (*UpdateForewarnConfigRes) Descriptor() ([]byte, []int) {
+	return file_backend_risk_v1_risk_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateForewarnConfigRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateForewarnConfigRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetForewarnLogsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page" dc:"页码"`                             // 页码
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size" dc:"每页数量"`                           // 每页数量
+	Type          int32                  `protobuf:"varint,3,opt,name=type,proto3" json:"type" dc:"预警类型，0=全部"`                      // 预警类型，0=全部
+	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status" dc:"处理状态 -1=全部 0=未处理 1=已处理"`     // 处理状态 -1=全部 0=未处理 1=已处理
+	Username      string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username" dc:"会员账号"`                    // 会员账号
+	StartTime     string                 `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间"` // 开始时间
+	EndTime       string                 `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间"`       // 结束时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetForewarnLogsReq) Reset() {
+	*x = GetForewarnLogsReq{}
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForewarnLogsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForewarnLogsReq) ProtoMessage() {}
+
+func (x *GetForewarnLogsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForewarnLogsReq.ProtoReflect.Descriptor instead.
+func (*GetForewarnLogsReq) Descriptor() ([]byte, []int) {
+	return file_backend_risk_v1_risk_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetForewarnLogsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetForewarnLogsReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetForewarnLogsReq) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *GetForewarnLogsReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetForewarnLogsReq) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetForewarnLogsReq) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *GetForewarnLogsReq) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+type ForewarnLogInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"预警ID"`                                       // 预警ID
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"会员ID"`                 // 会员ID
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username" dc:"会员账号"`                            // 会员账号
+	Type          int32                  `protobuf:"varint,4,opt,name=type,proto3" json:"type" dc:"预警类型"`                                   // 预警类型
+	TypeName      string                 `protobuf:"bytes,5,opt,name=type_name,json=typeName,proto3" json:"type_name" dc:"预警类型名称"`          // 预警类型名称
+	Content       string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content" dc:"预警内容"`                              // 预警内容
+	Ip            string                 `protobuf:"bytes,7,opt,name=ip,proto3" json:"ip" dc:"会员IP"`                                        // 会员IP
+	Device        int32                  `protobuf:"varint,8,opt,name=device,proto3" json:"device" dc:"终端 1=电脑 2=手机 3=平板"`                  // 终端 1=电脑 2=手机 3=平板
+	Status        int32                  `protobuf:"varint,9,opt,name=status,proto3" json:"status" dc:"处理状态 0=未处理 1=已处理"`                   // 处理状态 0=未处理 1=已处理
+	HandleAdmin   string                 `protobuf:"bytes,10,opt,name=handle_admin,json=handleAdmin,proto3" json:"handle_admin" dc:"处理管理员"` // 处理管理员
+	HandledAt     string                 `protobuf:"bytes,11,opt,name=handled_at,json=handledAt,proto3" json:"handled_at" dc:"处理时间"`        // 处理时间
+	CreatedAt     string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"预警时间"`        // 预警时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForewarnLogInfo) Reset() {
+	*x = ForewarnLogInfo{}
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForewarnLogInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForewarnLogInfo) ProtoMessage() {}
+
+func (x *ForewarnLogInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForewarnLogInfo.ProtoReflect.Descriptor instead.
+func (*ForewarnLogInfo) Descriptor() ([]byte, []int) {
+	return file_backend_risk_v1_risk_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ForewarnLogInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ForewarnLogInfo) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ForewarnLogInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ForewarnLogInfo) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *ForewarnLogInfo) GetTypeName() string {
+	if x != nil {
+		return x.TypeName
+	}
+	return ""
+}
+
+func (x *ForewarnLogInfo) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ForewarnLogInfo) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *ForewarnLogInfo) GetDevice() int32 {
+	if x != nil {
+		return x.Device
+	}
+	return 0
+}
+
+func (x *ForewarnLogInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ForewarnLogInfo) GetHandleAdmin() string {
+	if x != nil {
+		return x.HandleAdmin
+	}
+	return ""
+}
+
+func (x *ForewarnLogInfo) GetHandledAt() string {
+	if x != nil {
+		return x.HandledAt
+	}
+	return ""
+}
+
+func (x *ForewarnLogInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 预警类型项
+type ForewarnTypeItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int32                  `protobuf:"varint,1,opt,name=value,proto3" json:"value"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForewarnTypeItem) Reset() {
+	*x = ForewarnTypeItem{}
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForewarnTypeItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForewarnTypeItem) ProtoMessage() {}
+
+func (x *ForewarnTypeItem) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForewarnTypeItem.ProtoReflect.Descriptor instead.
+func (*ForewarnTypeItem) Descriptor() ([]byte, []int) {
+	return file_backend_risk_v1_risk_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ForewarnTypeItem) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ForewarnTypeItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetForewarnLogsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*ForewarnLogInfo     `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"预警列表"`                           // 预警列表
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count" dc:"总数量"`                         // 总数量
+	Pending       int32                  `protobuf:"varint,3,opt,name=pending,proto3" json:"pending" dc:"未处理数量"`                   // 未处理数量
+	TypeList      []*ForewarnTypeItem    `protobuf:"bytes,4,rep,name=type_list,json=typeList,proto3" json:"type_list" dc:"预警类型列表"` // 预警类型列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetForewarnLogsRes) Reset() {
+	*x = GetForewarnLogsRes{}
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForewarnLogsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForewarnLogsRes) ProtoMessage() {}
+
+func (x *GetForewarnLogsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForewarnLogsRes.ProtoReflect.Descriptor instead.
+func (*GetForewarnLogsRes) Descriptor() ([]byte, []int) {
+	return file_backend_risk_v1_risk_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetForewarnLogsRes) GetList() []*ForewarnLogInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *GetForewarnLogsRes) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetForewarnLogsRes) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *GetForewarnLogsRes) GetTypeList() []*ForewarnTypeItem {
+	if x != nil {
+		return x.TypeList
+	}
+	return nil
+}
+
+type AckForewarnLogsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids" dc:"预警ID列表"` // 预警ID列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AckForewarnLogsReq) Reset() {
+	*x = AckForewarnLogsReq{}
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AckForewarnLogsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckForewarnLogsReq) ProtoMessage() {}
+
+func (x *AckForewarnLogsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckForewarnLogsReq.ProtoReflect.Descriptor instead.
+func (*AckForewarnLogsReq) Descriptor() ([]byte, []int) {
+	return file_backend_risk_v1_risk_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AckForewarnLogsReq) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type AckForewarnLogsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count" dc:"本次处理数量"`   // 本次处理数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AckForewarnLogsRes) Reset() {
+	*x = AckForewarnLogsRes{}
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AckForewarnLogsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckForewarnLogsRes) ProtoMessage() {}
+
+func (x *AckForewarnLogsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckForewarnLogsRes.ProtoReflect.Descriptor instead.
+func (*AckForewarnLogsRes) Descriptor() ([]byte, []int) {
+	return file_backend_risk_v1_risk_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AckForewarnLogsRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AckForewarnLogsRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AckForewarnLogsRes) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetAccountClustersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          int32                  `protobuf:"varint,1,opt,name=type,proto3" json:"type" dc:"关联类型 1=注册IP 2=登录IP 3=设备指纹 (操作系统+浏览器+分辨率，同型号设备也会关联，不参与自动标记)"` // 关联类型 1=注册IP 2=登录IP 3=设备指纹 (操作系统+浏览器+分辨率，同型号设备也会关联，不参与自动标记)
+	StartTime     string                 `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间，默认最近7天"`                      // 开始时间，默认最近7天
+	EndTime       string                 `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间"`                                   // 结束时间
+	MinMembers    int32                  `protobuf:"varint,4,opt,name=min_members,json=minMembers,proto3" json:"min_members" dc:"关联会员数达到该值才返回，默认2"`             // 关联会员数达到该值才返回，默认2
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page" dc:"页码"`                                                         // 页码
+	Size          int32                  `protobuf:"varint,6,opt,name=size,proto3" json:"size" dc:"每页数量"`                                                       // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountClustersReq) Reset() {
+	*x = GetAccountClustersReq{}
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountClustersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountClustersReq) ProtoMessage() {}
+
+func (x *GetAccountClustersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountClustersReq.ProtoReflect.Descriptor instead.
+func (*GetAccountClustersReq) Descriptor() ([]byte, []int) {
+	return file_backend_risk_v1_risk_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetAccountClustersReq) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *GetAccountClustersReq) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *GetAccountClustersReq) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *GetAccountClustersReq) GetMinMembers() int32 {
+	if x != nil {
+		return x.MinMembers
+	}
+	return 0
+}
+
+func (x *GetAccountClustersReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetAccountClustersReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type AccountClusterMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"会员ID"`                            // 会员ID
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username" dc:"会员账号"`                                       // 会员账号
+	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status" dc:"会员状态"`                                          // 会员状态
+	FocusLevel    int32                  `protobuf:"varint,4,opt,name=focus_level,json=focusLevel,proto3" json:"focus_level" dc:"关注级别 1=正常 2=可疑 3=危险"` // 关注级别 1=正常 2=可疑 3=危险
+	RegisterIp    string                 `protobuf:"bytes,5,opt,name=register_ip,json=registerIp,proto3" json:"register_ip" dc:"注册IP"`                 // 注册IP
+	RegisterTime  string                 `protobuf:"bytes,6,opt,name=register_time,json=registerTime,proto3" json:"register_time" dc:"注册时间"`           // 注册时间
+	LastLoginIp   string                 `protobuf:"bytes,7,opt,name=last_login_ip,json=lastLoginIp,proto3" json:"last_login_ip" dc:"最后登录IP"`          // 最后登录IP
+	LastLoginTime string                 `protobuf:"bytes,8,opt,name=last_login_time,json=lastLoginTime,proto3" json:"last_login_time" dc:"最后登录时间"`    // 最后登录时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountClusterMember) Reset() {
+	*x = AccountClusterMember{}
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountClusterMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountClusterMember) ProtoMessage() {}
+
+func (x *AccountClusterMember) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountClusterMember.ProtoReflect.Descriptor instead.
+func (*AccountClusterMember) Descriptor() ([]byte, []int) {
+	return file_backend_risk_v1_risk_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AccountClusterMember) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AccountClusterMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AccountClusterMember) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AccountClusterMember) GetFocusLevel() int32 {
+	if x != nil {
+		return x.FocusLevel
+	}
+	return 0
+}
+
+func (x *AccountClusterMember) GetRegisterIp() string {
+	if x != nil {
+		return x.RegisterIp
+	}
+	return ""
+}
+
+func (x *AccountClusterMember) GetRegisterTime() string {
+	if x != nil {
+		return x.RegisterTime
+	}
+	return ""
+}
+
+func (x *AccountClusterMember) GetLastLoginIp() string {
+	if x != nil {
+		return x.LastLoginIp
+	}
+	return ""
+}
+
+func (x *AccountClusterMember) GetLastLoginTime() string {
+	if x != nil {
+		return x.LastLoginTime
+	}
+	return ""
+}
+
+type AccountCluster struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Key           string                  `protobuf:"bytes,1,opt,name=key,proto3" json:"key" dc:"关联值，IP或设备指纹"`                                                         // 关联值，IP或设备指纹
+	MemberCount   int32                   `protobuf:"varint,2,opt,name=member_count,json=memberCount,proto3" json:"member_count" dc:"关联会员数"`                           // 关联会员数
+	Members       []*AccountClusterMember `protobuf:"bytes,3,rep,name=members,proto3" json:"members" dc:"关联会员，最多返回100个"`                                               // 关联会员，最多返回100个
+	ReviewId      int64                   `protobuf:"varint,4,opt,name=review_id,json=reviewId,proto3" json:"review_id" dc:"审核记录ID，0=未标记"`                             // 审核记录ID，0=未标记
+	ReviewStatus  int32                   `protobuf:"varint,5,opt,name=review_status,json=reviewStatus,proto3" json:"review_status" dc:"审核状态 0=未标记 1=待审核 2=已确认 3=已忽略"` // 审核状态 0=未标记 1=待审核 2=已确认 3=已忽略
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountCluster) Reset() {
+	*x = AccountCluster{}
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountCluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountCluster) ProtoMessage() {}
+
+func (x *AccountCluster) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountCluster.ProtoReflect.Descriptor instead.
+func (*AccountCluster) Descriptor() ([]byte, []int) {
+	return file_backend_risk_v1_risk_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AccountCluster) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AccountCluster) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *AccountCluster) GetMembers() []*AccountClusterMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *AccountCluster) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *AccountCluster) GetReviewStatus() int32 {
+	if x != nil {
+		return x.ReviewStatus
+	}
+	return 0
+}
+
+type GetAccountClustersRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*AccountCluster      `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"关联列表，按关联会员数倒序"` // 关联列表，按关联会员数倒序
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count" dc:"总数量"`        // 总数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountClustersRes) Reset() {
+	*x = GetAccountClustersRes{}
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountClustersRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountClustersRes) ProtoMessage() {}
+
+func (x *GetAccountClustersRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountClustersRes.ProtoReflect.Descriptor instead.
+func (*GetAccountClustersRes) Descriptor() ([]byte, []int) {
+	return file_backend_risk_v1_risk_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetAccountClustersRes) GetList() []*AccountCluster {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *GetAccountClustersRes) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ReviewAccountClusterReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"审核记录ID"`                               // 审核记录ID
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status" dc:"审核结果 2=已确认 (关联会员标记为危险) 3=已忽略"` // 审核结果 2=已确认 (关联会员标记为危险) 3=已忽略
+	Remark        string                 `protobuf:"bytes,3,opt,name=remark,proto3" json:"remark" dc:"审核备注"`                          // 审核备注
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewAccountClusterReq) Reset() {
+	*x = ReviewAccountClusterReq{}
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewAccountClusterReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewAccountClusterReq) ProtoMessage() {}
+
+func (x *ReviewAccountClusterReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewAccountClusterReq.ProtoReflect.Descriptor instead.
+func (*ReviewAccountClusterReq) Descriptor() ([]byte, []int) {
+	return file_backend_risk_v1_risk_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReviewAccountClusterReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReviewAccountClusterReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ReviewAccountClusterReq) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+type ReviewAccountClusterRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewAccountClusterRes) Reset() {
+	*x = ReviewAccountClusterRes{}
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewAccountClusterRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewAccountClusterRes) ProtoMessage() {}
+
+func (x *ReviewAccountClusterRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_risk_v1_risk_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewAccountClusterRes.ProtoReflect.Descriptor instead.
+func (*ReviewAccountClusterRes) Descriptor() ([]byte, []int) {
+	return file_backend_risk_v1_risk_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReviewAccountClusterRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReviewAccountClusterRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_backend_risk_v1_risk_proto protoreflect.FileDescriptor
+
+const file_backend_risk_v1_risk_proto_rawDesc = "" +
+	"\n" +
+	"\x1abackend/risk/v1/risk.proto\x12\x04risk\"\x9d\x05\n" +
+	"\x0eForewarnConfig\x12&\n" +
+	"\x0faccount_to_game\x18\x01 \x01(\x01R\raccountToGame\x12&\n" +
+	"\x0fgame_to_account\x18\x02 \x01(\x01R\rgameToAccount\x12\x1b\n" +
+	"\twin_money\x18\x03 \x01(\x01R\bwinMoney\x12\x1d\n" +
+	"\n" +
+	"bet_amount\x18\x04 \x01(\x01R\tbetAmount\x12&\n" +
+	"\x0falter_bank_card\x18\x05 \x01(\bR\ralterBankCard\x122\n" +
+	"\x15login_area_difference\x18\x06 \x01(\bR\x13loginAreaDifference\x120\n" +
+	"\x14sameip_register_time\x18\a \x01(\x05R\x12sameipRegisterTime\x122\n" +
+	"\x15sameip_register_count\x18\b \x01(\x05R\x13sameipRegisterCount\x12+\n" +
+	"\x12is_account_to_game\x18\t \x01(\bR\x0fisAccountToGame\x12+\n" +
+	"\x12is_game_to_account\x18\n" +
+	" \x01(\bR\x0fisGameToAccount\x12 \n" +
+	"\fis_win_money\x18\v \x01(\bR\n" +
+	"isWinMoney\x12\"\n" +
+	"\ris_bet_amount\x18\f \x01(\bR\visBetAmount\x12+\n" +
+	"\x12is_alter_bank_card\x18\r \x01(\bR\x0fisAlterBankCard\x127\n" +
+	"\x18is_login_area_difference\x18\x0e \x01(\bR\x15isLoginAreaDifference\x127\n" +
+	"\x18is_sameip_register_count\x18\x0f \x01(\bR\x15isSameipRegisterCount\"\x16\n" +
+	"\x14GetForewarnConfigReq\"c\n" +
+	"\x14GetForewarnConfigRes\x12,\n" +
+	"\x06config\x18\x01 \x01(\v2\x14.risk.ForewarnConfigR\x06config\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x02 \x01(\tR\tupdatedAt\"G\n" +
+	"\x17UpdateForewarnConfigReq\x12,\n" +
+	"\x06config\x18\x01 \x01(\v2\x14.risk.ForewarnConfigR\x06config\"M\n" +
+	"\x17UpdateForewarnConfigRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xbe\x01\n" +
+	"\x12GetForewarnLogsReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\x05R\x04type\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x1a\n" +
+	"\busername\x18\x05 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\a \x01(\tR\aendTime\"\xc2\x02\n" +
+	"\x0fForewarnLogInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\x05R\x04type\x12\x1b\n" +
+	"\ttype_name\x18\x05 \x01(\tR\btypeName\x12\x18\n" +
+	"\acontent\x18\x06 \x01(\tR\acontent\x12\x0e\n" +
+	"\x02ip\x18\a \x01(\tR\x02ip\x12\x16\n" +
+	"\x06device\x18\b \x01(\x05R\x06device\x12\x16\n" +
+	"\x06status\x18\t \x01(\x05R\x06status\x12!\n" +
+	"\fhandle_admin\x18\n" +
+	" \x01(\tR\vhandleAdmin\x12\x1d\n" +
+	"\n" +
+	"handled_at\x18\v \x01(\tR\thandledAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\tR\tcreatedAt\"<\n" +
+	"\x10ForewarnTypeItem\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x05R\x05value\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xa4\x01\n" +
+	"\x12GetForewarnLogsRes\x12)\n" +
+	"\x04list\x18\x01 \x03(\v2\x15.risk.ForewarnLogInfoR\x04list\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x18\n" +
+	"\apending\x18\x03 \x01(\x05R\apending\x123\n" +
+	"\ttype_list\x18\x04 \x03(\v2\x16.risk.ForewarnTypeItemR\btypeList\"&\n" +
+	"\x12AckForewarnLogsReq\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\"^\n" +
+	"\x12AckForewarnLogsRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\"\xae\x01\n" +
+	"\x15GetAccountClustersReq\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\x05R\x04type\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\tR\aendTime\x12\x1f\n" +
+	"\vmin_members\x18\x04 \x01(\x05R\n" +
+	"minMembers\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x05R\x04size\"\x96\x02\n" +
+	"\x14AccountClusterMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\x05R\x06status\x12\x1f\n" +
+	"\vfocus_level\x18\x04 \x01(\x05R\n" +
+	"focusLevel\x12\x1f\n" +
+	"\vregister_ip\x18\x05 \x01(\tR\n" +
+	"registerIp\x12#\n" +
+	"\rregister_time\x18\x06 \x01(\tR\fregisterTime\x12\"\n" +
+	"\rlast_login_ip\x18\a \x01(\tR\vlastLoginIp\x12&\n" +
+	"\x0flast_login_time\x18\b \x01(\tR\rlastLoginTime\"\xbd\x01\n" +
+	"\x0eAccountCluster\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12!\n" +
+	"\fmember_count\x18\x02 \x01(\x05R\vmemberCount\x124\n" +
+	"\amembers\x18\x03 \x03(\v2\x1a.risk.AccountClusterMemberR\amembers\x12\x1b\n" +
+	"\treview_id\x18\x04 \x01(\x03R\breviewId\x12#\n" +
+	"\rreview_status\x18\x05 \x01(\x05R\freviewStatus\"W\n" +
+	"\x15GetAccountClustersRes\x12(\n" +
+	"\x04list\x18\x01 \x03(\v2\x14.risk.AccountClusterR\x04list\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"Y\n" +
+	"\x17ReviewAccountClusterReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x16\n" +
+	"\x06remark\x18\x03 \x01(\tR\x06remark\"M\n" +
+	"\x17ReviewAccountClusterRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage2\xe9\x03\n" +
+	"\x04Risk\x12M\n" +
+	"\x11GetForewarnConfig\x12\x1a.risk.GetForewarnConfigReq\x1a\x1a.risk.GetForewarnConfigRes\"\x00\x12V\n" +
+	"\x14UpdateForewarnConfig\x12\x1d.risk.UpdateForewarnConfigReq\x1a\x1d.risk.UpdateForewarnConfigRes\"\x00\x12G\n" +
+	"\x0fGetForewarnLogs\x12\x18.risk.GetForewarnLogsReq\x1a\x18.risk.GetForewarnLogsRes\"\x00\x12G\n" +
+	"\x0fAckForewarnLogs\x12\x18.risk.AckForewarnLogsReq\x1a\x18.risk.AckForewarnLogsRes\"\x00\x12P\n" +
+	"\x12GetAccountClusters\x12\x1b.risk.GetAccountClustersReq\x1a\x1b.risk.GetAccountClustersRes\"\x00\x12V\n" +
+	"\x14ReviewAccountCluster\x12\x1d.risk.ReviewAccountClusterReq\x1a\x1d.risk.ReviewAccountClusterRes\"\x00B$Z\"jh_app_service/api/backend/risk/v1b\x06proto3"
+
+var (
+	file_backend_risk_v1_risk_proto_rawDescOnce sync.Once
+	file_backend_risk_v1_risk_proto_rawDescData []byte
+)
+
+func file_backend_risk_v1_risk_proto_rawDescGZIP() []byte {
+	file_backend_risk_v1_risk_proto_rawDescOnce.Do(func() {
+		file_backend_risk_v1_risk_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_backend_risk_v1_risk_proto_rawDesc), len(file_backend_risk_v1_risk_proto_rawDesc)))
+	})
+	return file_backend_risk_v1_risk_proto_rawDescData
+}
+
+var file_backend_risk_v1_risk_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_backend_risk_v1_risk_proto_goTypes = []any{
+	(*ForewarnConfig)(nil),          // 0: risk.ForewarnConfig
+	(*GetForewarnConfigReq)(nil),    // 1: risk.GetForewarnConfigReq
+	(*GetForewarnConfigRes)(nil),    // 2: risk.GetForewarnConfigRes
+	(*UpdateForewarnConfigReq)(nil), // 3: risk.UpdateForewarnConfigReq
+	(*UpdateForewarnConfigRes)(nil), // 4: risk.UpdateForewarnConfigRes
+	(*GetForewarnLogsReq)(nil),      // 5: risk.GetForewarnLogsReq
+	(*ForewarnLogInfo)(nil),         // 6: risk.ForewarnLogInfo
+	(*ForewarnTypeItem)(nil),        // 7: risk.ForewarnTypeItem
+	(*GetForewarnLogsRes)(nil),      // 8: risk.GetForewarnLogsRes
+	(*AckForewarnLogsReq)(nil),      // 9: risk.AckForewarnLogsReq
+	(*AckForewarnLogsRes)(nil),      // 10: risk.AckForewarnLogsRes
+	(*GetAccountClustersReq)(nil),   // 11: risk.GetAccountClustersReq
+	(*AccountClusterMember)(nil),    // 12: risk.AccountClusterMember
+	(*AccountCluster)(nil),          // 13: risk.AccountCluster
+	(*GetAccountClustersRes)(nil),   // 14: risk.GetAccountClustersRes
+	(*ReviewAccountClusterReq)(nil), // 15: risk.ReviewAccountClusterReq
+	(*ReviewAccountClusterRes)(nil), // 16: risk.ReviewAccountClusterRes
+}
+var file_backend_risk_v1_risk_proto_depIdxs = []int32{
+	0,  // 0: risk.GetForewarnConfigRes.config:type_name -> risk.ForewarnConfig
+	0,  // 1: risk.UpdateForewarnConfigReq.config:type_name -> risk.ForewarnConfig
+	6,  // 2: risk.GetForewarnLogsRes.list:type_name -> risk.ForewarnLogInfo
+	7,  // 3: risk.GetForewarnLogsRes.type_list:type_name -> risk.ForewarnTypeItem
+	12, // 4: risk.AccountCluster.members:type_name -> risk.AccountClusterMember
+	13, // 5: risk.GetAccountClustersRes.list:type_name -> risk.AccountCluster
+	1,  // 6: risk.Risk.GetForewarnConfig:input_type -> risk.GetForewarnConfigReq
+	3,  // 7: risk.Risk.UpdateForewarnConfig:input_type -> risk.UpdateForewarnConfigReq
+	5,  // 8: risk.Risk.GetForewarnLogs:input_type -> risk.GetForewarnLogsReq
+	9,  // 9: risk.Risk.AckForewarnLogs:input_type -> risk.AckForewarnLogsReq
+	11, // 10: risk.Risk.GetAccountClusters:input_type -> risk.GetAccountClustersReq
+	15, // 11: risk.Risk.ReviewAccountCluster:input_type -> risk.ReviewAccountClusterReq
+	2,  // 12: risk.Risk.GetForewarnConfig:output_type -> risk.GetForewarnConfigRes
+	4,  // 13: risk.Risk.UpdateForewarnConfig:output_type -> risk.UpdateForewarnConfigRes
+	8,  // 14: risk.Risk.GetForewarnLogs:output_type -> risk.GetForewarnLogsRes
+	10, // 15: risk.Risk.AckForewarnLogs:output_type -> risk.AckForewarnLogsRes
+	14, // 16: risk.Risk.GetAccountClusters:output_type -> risk.GetAccountClustersRes
+	16, // 17: risk.Risk.ReviewAccountCluster:output_type -> risk.ReviewAccountClusterRes
+	12, // [12:18] is the sub-list for method output_type
+	6,  // [6:12] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_backend_risk_v1_risk_proto_init() }
+func file_backend_risk_v1_risk_proto_init() {
+	if File_backend_risk_v1_risk_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backend_risk_v1_risk_proto_rawDesc), len(file_backend_risk_v1_risk_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_backend_risk_v1_risk_proto_goTypes,
+		DependencyIndexes: file_backend_risk_v1_risk_proto_depIdxs,
+		MessageInfos:      file_backend_risk_v1_risk_proto_msgTypes,
+	}.Build()
+	File_backend_risk_v1_risk_proto = out.File
+	file_backend_risk_v1_risk_proto_goTypes = nil
+	file_backend_risk_v1_risk_proto_depIdxs = nil
+}
@@ -0,0 +1,312 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.1
+// source: backend/risk/v1/risk.proto
+
+package v1
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Risk_GetForewarnConfig_FullMethodName    = "/risk.Risk/GetForewarnConfig"
+	Risk_UpdateForewarnConfig_FullMethodName = "/risk.Risk/UpdateForewarnConfig"
+	Risk_GetForewarnLogs_FullMethodName      = "/risk.Risk/GetForewarnLogs"
+	Risk_AckForewarnLogs_FullMethodName      = "/risk.Risk/AckForewarnLogs"
+	Risk_GetAccountClusters_FullMethodName   = "/risk.Risk/GetAccountClusters"
+	Risk_ReviewAccountCluster_FullMethodName = "/risk.Risk/ReviewAccountCluster"
+)
+
+// RiskClient is the client API for Risk service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RiskClient interface {
+	GetForewarnConfig(ctx context.Context, in *GetForewarnConfigReq, opts ...grpc.CallOption) (*GetForewarnConfigRes, error)
+	UpdateForewarnConfig(ctx context.Context, in *UpdateForewarnConfigReq, opts ...grpc.CallOption) (*UpdateForewarnConfigRes, error)
+	GetForewarnLogs(ctx context.Context, in *GetForewarnLogsReq, opts ...grpc.CallOption) (*GetForewarnLogsRes, error)
+	AckForewarnLogs(ctx context.Context, in *AckForewarnLogsReq, opts ...grpc.CallOption) (*AckForewarnLogsRes, error)
+	GetAccountClusters(ctx context.Context, in *GetAccountClustersReq, opts ...grpc.CallOption) (*GetAccountClustersRes, error)
+	ReviewAccountCluster(ctx context.Context, in *ReviewAccountClusterReq, opts ...grpc.CallOption) (*ReviewAccountClusterRes, error)
+}
+
+type riskClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRiskClient(cc grpc.ClientConnInterface) RiskClient {
+	return &riskClient{cc}
+}
+
+func (c *riskClient) GetForewarnConfig(ctx context.Context, in *GetForewarnConfigReq, opts ...grpc.CallOption) (*GetForewarnConfigRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetForewarnConfigRes)
+	err := c.cc.Invoke(ctx, Risk_GetForewarnConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *riskClient) UpdateForewarnConfig(ctx context.Context, in *UpdateForewarnConfigReq, opts ...grpc.CallOption) (*UpdateForewarnConfigRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateForewarnConfigRes)
+	err := c.cc.Invoke(ctx, Risk_UpdateForewarnConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *riskClient) GetForewarnLogs(ctx context.Context, in *GetForewarnLogsReq, opts ...grpc.CallOption) (*GetForewarnLogsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetForewarnLogsRes)
+	err := c.cc.Invoke(ctx, Risk_GetForewarnLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *riskClient) AckForewarnLogs(ctx context.Context, in *AckForewarnLogsReq, opts ...grpc.CallOption) (*AckForewarnLogsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AckForewarnLogsRes)
+	err := c.cc.Invoke(ctx, Risk_AckForewarnLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *riskClient) GetAccountClusters(ctx context.Context, in *GetAccountClustersReq, opts ...grpc.CallOption) (*GetAccountClustersRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountClustersRes)
+	err := c.cc.Invoke(ctx, Risk_GetAccountClusters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *riskClient) ReviewAccountCluster(ctx context.Context, in *ReviewAccountClusterReq, opts ...grpc.CallOption) (*ReviewAccountClusterRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewAccountClusterRes)
+	err := c.cc.Invoke(ctx, Risk_ReviewAccountCluster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RiskServer is the server API for Risk service.
+// All implementations must embed UnimplementedRiskServer
+// for forward compatibility.
+type RiskServer interface {
+	GetForewarnConfig(context.Context, *GetForewarnConfigReq) (*GetForewarnConfigRes, error)
+	UpdateForewarnConfig(context.Context, *UpdateForewarnConfigReq) (*UpdateForewarnConfigRes, error)
+	GetForewarnLogs(context.Context, *GetForewarnLogsReq) (*GetForewarnLogsRes, error)
+	AckForewarnLogs(context.Context, *AckForewarnLogsReq) (*AckForewarnLogsRes, error)
+	GetAccountClusters(context.Context, *GetAccountClustersReq) (*GetAccountClustersRes, error)
+	ReviewAccountCluster(context.Context, *ReviewAccountClusterReq) (*ReviewAccountClusterRes, error)
+	mustEmbedUnimplementedRiskServer()
+}
+
+// UnimplementedRiskServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRiskServer struct{}
+
+func (UnimplementedRiskServer) GetForewarnConfig(context.Context, *GetForewarnConfigReq) (*GetForewarnConfigRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetForewarnConfig not implemented")
+}
+func (UnimplementedRiskServer) UpdateForewarnConfig(context.Context, *UpdateForewarnConfigReq) (*UpdateForewarnConfigRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateForewarnConfig not implemented")
+}
+func (UnimplementedRiskServer) GetForewarnLogs(context.Context, *GetForewarnLogsReq) (*GetForewarnLogsRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetForewarnLogs not implemented")
+}
+func (UnimplementedRiskServer) AckForewarnLogs(context.Context, *AckForewarnLogsReq) (*AckForewarnLogsRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method AckForewarnLogs not implemented")
+}
+func (UnimplementedRiskServer) GetAccountClusters(context.Context, *GetAccountClustersReq) (*GetAccountClustersRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccountClusters not implemented")
+}
+func (UnimplementedRiskServer) ReviewAccountCluster(context.Context, *ReviewAccountClusterReq) (*ReviewAccountClusterRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReviewAccountCluster not implemented")
+}
+func (UnimplementedRiskServer) mustEmbedUnimplementedRiskServer() {}
+func (UnimplementedRiskServer) testEmbeddedByValue()              {}
+
+// UnsafeRiskServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RiskServer will
+// result in compilation errors.
+type UnsafeRiskServer interface {
+	mustEmbedUnimplementedRiskServer()
+}
+
+func RegisterRiskServer(s grpc.ServiceRegistrar, srv RiskServer) {
+	// If the following call panics, it indicates UnimplementedRiskServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Risk_ServiceDesc, srv)
+}
+
+func _Risk_GetForewarnConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForewarnConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RiskServer).GetForewarnConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Risk_GetForewarnConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RiskServer).GetForewarnConfig(ctx, req.(*GetForewarnConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Risk_UpdateForewarnConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateForewarnConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RiskServer).UpdateForewarnConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Risk_UpdateForewarnConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RiskServer).UpdateForewarnConfig(ctx, req.(*UpdateForewarnConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Risk_GetForewarnLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForewarnLogsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RiskServer).GetForewarnLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Risk_GetForewarnLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RiskServer).GetForewarnLogs(ctx, req.(*GetForewarnLogsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Risk_AckForewarnLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckForewarnLogsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RiskServer).AckForewarnLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Risk_AckForewarnLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RiskServer).AckForewarnLogs(ctx, req.(*AckForewarnLogsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Risk_GetAccountClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountClustersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RiskServer).GetAccountClusters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Risk_GetAccountClusters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RiskServer).GetAccountClusters(ctx, req.(*GetAccountClustersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Risk_ReviewAccountCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewAccountClusterReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RiskServer).ReviewAccountCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Risk_ReviewAccountCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RiskServer).ReviewAccountCluster(ctx, req.(*ReviewAccountClusterReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Risk_ServiceDesc is the grpc.ServiceDesc for Risk service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Risk_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "risk.Risk",
+	HandlerType: (*RiskServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetForewarnConfig",
+			Handler:    _Risk_GetForewarnConfig_Handler,
+		},
+		{
+			MethodName: "UpdateForewarnConfig",
+			Handler:    _Risk_UpdateForewarnConfig_Handler,
+		},
+		{
+			MethodName: "GetForewarnLogs",
+			Handler:    _Risk_GetForewarnLogs_Handler,
+		},
+		{
+			MethodName: "AckForewarnLogs",
+			Handler:    _Risk_AckForewarnLogs_Handler,
+		},
+		{
+			MethodName: "GetAccountClusters",
+			Handler:    _Risk_GetAccountClusters_Handler,
+		},
+		{
+			MethodName: "ReviewAccountCluster",
+			Handler:    _Risk_ReviewAccountCluster_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backend/risk/v1/risk.proto",
+}
@@ -417,6 +417,1002 @@ func (x *UpdateBasicSettingRes) GetMessage() string {
 	return ""
 }
 
+type GetRegisterFieldsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        int32                  `protobuf:"varint,1,opt,name=site_id,json=siteId,proto3" json:"site_id" dc:"站点ID，可选"` // 站点ID，可选
+	Type          int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type" dc:"注册表单类型 1=会员注册 2=代理注册"`      // 注册表单类型 1=会员注册 2=代理注册
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRegisterFieldsReq) Reset() {
+	*x = GetRegisterFieldsReq{}
+	mi := &file_backend_site_v1_site_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRegisterFieldsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegisterFieldsReq) ProtoMessage() {}
+
+func (x *GetRegisterFieldsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_site_v1_site_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegisterFieldsReq.ProtoReflect.Descriptor instead.
+func (*GetRegisterFieldsReq) Descriptor() ([]byte, []int) {
+	return file_backend_site_v1_site_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRegisterFieldsReq) GetSiteId() int32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *GetRegisterFieldsReq) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+type RegisterFieldInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"注册项ID"`                                    // 注册项ID
+	Type          int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type" dc:"注册表单类型 1=会员注册 2=代理注册"`                 // 注册表单类型 1=会员注册 2=代理注册
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name" dc:"名称"`                                    // 名称
+	FieldName     string                 `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name" dc:"字段标识"`       // 字段标识
+	Display       bool                   `protobuf:"varint,5,opt,name=display,proto3" json:"display" dc:"是否显示"`                           // 是否显示
+	Required      bool                   `protobuf:"varint,6,opt,name=required,proto3" json:"required" dc:"是否必填"`                         // 是否必填
+	IsCore        bool                   `protobuf:"varint,7,opt,name=is_core,json=isCore,proto3" json:"is_core" dc:"是否核心字段，核心字段必须显示且必填"` // 是否核心字段，核心字段必须显示且必填
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterFieldInfo) Reset() {
+	*x = RegisterFieldInfo{}
+	mi := &file_backend_site_v1_site_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterFieldInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterFieldInfo) ProtoMessage() {}
+
+func (x *RegisterFieldInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_site_v1_site_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterFieldInfo.ProtoReflect.Descriptor instead.
+func (*RegisterFieldInfo) Descriptor() ([]byte, []int) {
+	return file_backend_site_v1_site_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RegisterFieldInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RegisterFieldInfo) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *RegisterFieldInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegisterFieldInfo) GetFieldName() string {
+	if x != nil {
+		return x.FieldName
+	}
+	return ""
+}
+
+func (x *RegisterFieldInfo) GetDisplay() bool {
+	if x != nil {
+		return x.Display
+	}
+	return false
+}
+
+func (x *RegisterFieldInfo) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *RegisterFieldInfo) GetIsCore() bool {
+	if x != nil {
+		return x.IsCore
+	}
+	return false
+}
+
+type GetRegisterFieldsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*RegisterFieldInfo   `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"注册项列表"` // 注册项列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRegisterFieldsRes) Reset() {
+	*x = GetRegisterFieldsRes{}
+	mi := &file_backend_site_v1_site_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRegisterFieldsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegisterFieldsRes) ProtoMessage() {}
+
+func (x *GetRegisterFieldsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_site_v1_site_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegisterFieldsRes.ProtoReflect.Descriptor instead.
+func (*GetRegisterFieldsRes) Descriptor() ([]byte, []int) {
+	return file_backend_site_v1_site_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetRegisterFieldsRes) GetList() []*RegisterFieldInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type RegisterFieldSetting struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"注册项ID"`            // 注册项ID
+	Display       bool                   `protobuf:"varint,2,opt,name=display,proto3" json:"display" dc:"是否显示"`   // 是否显示
+	Required      bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required" dc:"是否必填"` // 是否必填
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterFieldSetting) Reset() {
+	*x = RegisterFieldSetting{}
+	mi := &file_backend_site_v1_site_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterFieldSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterFieldSetting) ProtoMessage() {}
+
+func (x *RegisterFieldSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_site_v1_site_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterFieldSetting.ProtoReflect.Descriptor instead.
+func (*RegisterFieldSetting) Descriptor() ([]byte, []int) {
+	return file_backend_site_v1_site_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RegisterFieldSetting) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RegisterFieldSetting) GetDisplay() bool {
+	if x != nil {
+		return x.Display
+	}
+	return false
+}
+
+func (x *RegisterFieldSetting) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type UpdateRegisterFieldsReq struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	SiteId        int32                   `protobuf:"varint,1,opt,name=site_id,json=siteId,proto3" json:"site_id" dc:"站点ID，可选"` // 站点ID，可选
+	Type          int32                   `protobuf:"varint,2,opt,name=type,proto3" json:"type" dc:"注册表单类型 1=会员注册 2=代理注册"`      // 注册表单类型 1=会员注册 2=代理注册
+	Fields        []*RegisterFieldSetting `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields" dc:"注册项设置"`                  // 注册项设置
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRegisterFieldsReq) Reset() {
+	*x = UpdateRegisterFieldsReq{}
+	mi := &file_backend_site_v1_site_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRegisterFieldsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRegisterFieldsReq) ProtoMessage() {}
+
+func (x *UpdateRegisterFieldsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_site_v1_site_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRegisterFieldsReq.ProtoReflect.Descriptor instead.
+func (*UpdateRegisterFieldsReq) Descriptor() ([]byte, []int) {
+	return file_backend_site_v1_site_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateRegisterFieldsReq) GetSiteId() int32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *UpdateRegisterFieldsReq) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *UpdateRegisterFieldsReq) GetFields() []*RegisterFieldSetting {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type UpdateRegisterFieldsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRegisterFieldsRes) Reset() {
+	*x = UpdateRegisterFieldsRes{}
+	mi := &file_backend_site_v1_site_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRegisterFieldsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRegisterFieldsRes) ProtoMessage() {}
+
+func (x *UpdateRegisterFieldsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_site_v1_site_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRegisterFieldsRes.ProtoReflect.Descriptor instead.
+func (*UpdateRegisterFieldsRes) Descriptor() ([]byte, []int) {
+	return file_backend_site_v1_site_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateRegisterFieldsRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateRegisterFieldsRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetSiteDeniesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          int32                  `protobuf:"varint,1,opt,name=type,proto3" json:"type" dc:"屏蔽类型 (可选) 1=IP 2=地区"` // 屏蔽类型 (可选) 1=IP 2=地区
+	Keyword       string                 `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword" dc:"IP或地区关键字 (可选)"`  // IP或地区关键字 (可选)
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page" dc:"页码"`                  // 页码
+	Size          int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size" dc:"每页数量"`                // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSiteDeniesReq) Reset() {
+	*x = GetSiteDeniesReq{}
+	mi := &file_backend_site_v1_site_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSiteDeniesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSiteDeniesReq) ProtoMessage() {}
+
+func (x *GetSiteDeniesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_site_v1_site_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSiteDeniesReq.ProtoReflect.Descriptor instead.
+func (*GetSiteDeniesReq) Descriptor() ([]byte, []int) {
+	return file_backend_site_v1_site_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetSiteDeniesReq) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *GetSiteDeniesReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *GetSiteDeniesReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetSiteDeniesReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type SiteDenyInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"屏蔽规则ID"`                             // 屏蔽规则ID
+	Type          int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type" dc:"屏蔽类型 1=IP 2=地区"`                 // 屏蔽类型 1=IP 2=地区
+	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip" dc:"IP地址或网段，如 1.2.3.4 或 1.2.3.0/24"`      // IP地址或网段，如 1.2.3.4 或 1.2.3.0/24
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address" dc:"地区名称，如 菲律宾、广东"`             // 地区名称，如 菲律宾、广东
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间"` // 创建时间
+	UpdatedAt     string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at" dc:"更新时间"` // 更新时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SiteDenyInfo) Reset() {
+	*x = SiteDenyInfo{}
+	mi := &file_backend_site_v1_site_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SiteDenyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteDenyInfo) ProtoMessage() {}
+
+func (x *SiteDenyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_site_v1_site_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteDenyInfo.ProtoReflect.Descriptor instead.
+func (*SiteDenyInfo) Descriptor() ([]byte, []int) {
+	return file_backend_site_v1_site_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SiteDenyInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SiteDenyInfo) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *SiteDenyInfo) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *SiteDenyInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SiteDenyInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SiteDenyInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type GetSiteDeniesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*SiteDenyInfo        `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"屏蔽规则列表"` // 屏蔽规则列表
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count" dc:"总数量"` // 总数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSiteDeniesRes) Reset() {
+	*x = GetSiteDeniesRes{}
+	mi := &file_backend_site_v1_site_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSiteDeniesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSiteDeniesRes) ProtoMessage() {}
+
+func (x *GetSiteDeniesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_site_v1_site_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSiteDeniesRes.ProtoReflect.Descriptor instead.
+func (*GetSiteDeniesRes) Descriptor() ([]byte, []int) {
+	return file_backend_site_v1_site_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetSiteDeniesRes) GetList() []*SiteDenyInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *GetSiteDeniesRes) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type CreateSiteDenyReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          int32                  `protobuf:"varint,1,opt,name=type,proto3" json:"type" dc:"屏蔽类型 1=IP 2=地区"`     // 屏蔽类型 1=IP 2=地区
+	Ip            string                 `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip" dc:"IP地址或网段，类型为IP时必填"`        // IP地址或网段，类型为IP时必填
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address" dc:"地区名称，类型为地区时必填"` // 地区名称，类型为地区时必填
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSiteDenyReq) Reset() {
+	*x = CreateSiteDenyReq{}
+	mi := &file_backend_site_v1_site_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSiteDenyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSiteDenyReq) ProtoMessage() {}
+
+func (x *CreateSiteDenyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_site_v1_site_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSiteDenyReq.ProtoReflect.Descriptor instead.
+func (*CreateSiteDenyReq) Descriptor() ([]byte, []int) {
+	return file_backend_site_v1_site_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateSiteDenyReq) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *CreateSiteDenyReq) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *CreateSiteDenyReq) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type CreateSiteDenyRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	Id            int32                  `protobuf:"varint,3,opt,name=id,proto3" json:"id" dc:"屏蔽规则ID"`         // 屏蔽规则ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSiteDenyRes) Reset() {
+	*x = CreateSiteDenyRes{}
+	mi := &file_backend_site_v1_site_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSiteDenyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSiteDenyRes) ProtoMessage() {}
+
+func (x *CreateSiteDenyRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_site_v1_site_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSiteDenyRes.ProtoReflect.Descriptor instead.
+func (*CreateSiteDenyRes) Descriptor() ([]byte, []int) {
+	return file_backend_site_v1_site_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateSiteDenyRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateSiteDenyRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateSiteDenyRes) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateSiteDenyReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"屏蔽规则ID"`                 // 屏蔽规则ID
+	Type          int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type" dc:"屏蔽类型 1=IP 2=地区"`     // 屏蔽类型 1=IP 2=地区
+	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip" dc:"IP地址或网段，类型为IP时必填"`        // IP地址或网段，类型为IP时必填
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address" dc:"地区名称，类型为地区时必填"` // 地区名称，类型为地区时必填
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSiteDenyReq) Reset() {
+	*x = UpdateSiteDenyReq{}
+	mi := &file_backend_site_v1_site_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSiteDenyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSiteDenyReq) ProtoMessage() {}
+
+func (x *UpdateSiteDenyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_site_v1_site_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSiteDenyReq.ProtoReflect.Descriptor instead.
+func (*UpdateSiteDenyReq) Descriptor() ([]byte, []int) {
+	return file_backend_site_v1_site_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateSiteDenyReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateSiteDenyReq) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *UpdateSiteDenyReq) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *UpdateSiteDenyReq) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type UpdateSiteDenyRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSiteDenyRes) Reset() {
+	*x = UpdateSiteDenyRes{}
+	mi := &file_backend_site_v1_site_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSiteDenyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSiteDenyRes) ProtoMessage() {}
+
+func (x *UpdateSiteDenyRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_site_v1_site_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSiteDenyRes.ProtoReflect.Descriptor instead.
+func (*UpdateSiteDenyRes) Descriptor() ([]byte, []int) {
+	return file_backend_site_v1_site_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateSiteDenyRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateSiteDenyRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteSiteDenyReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int32                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids" dc:"屏蔽规则ID列表"` // 屏蔽规则ID列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSiteDenyReq) Reset() {
+	*x = DeleteSiteDenyReq{}
+	mi := &file_backend_site_v1_site_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSiteDenyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSiteDenyReq) ProtoMessage() {}
+
+func (x *DeleteSiteDenyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_site_v1_site_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSiteDenyReq.ProtoReflect.Descriptor instead.
+func (*DeleteSiteDenyReq) Descriptor() ([]byte, []int) {
+	return file_backend_site_v1_site_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteSiteDenyReq) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type DeleteSiteDenyRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSiteDenyRes) Reset() {
+	*x = DeleteSiteDenyRes{}
+	mi := &file_backend_site_v1_site_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSiteDenyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSiteDenyRes) ProtoMessage() {}
+
+func (x *DeleteSiteDenyRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_site_v1_site_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSiteDenyRes.ProtoReflect.Descriptor instead.
+func (*DeleteSiteDenyRes) Descriptor() ([]byte, []int) {
+	return file_backend_site_v1_site_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteSiteDenyRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteSiteDenyRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CheckSiteAccessReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ip            string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip" dc:"客户端IP (可选)，为空时使用请求元数据中的IP"` // 客户端IP (可选)，为空时使用请求元数据中的IP
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckSiteAccessReq) Reset() {
+	*x = CheckSiteAccessReq{}
+	mi := &file_backend_site_v1_site_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckSiteAccessReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSiteAccessReq) ProtoMessage() {}
+
+func (x *CheckSiteAccessReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_site_v1_site_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSiteAccessReq.ProtoReflect.Descriptor instead.
+func (*CheckSiteAccessReq) Descriptor() ([]byte, []int) {
+	return file_backend_site_v1_site_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CheckSiteAccessReq) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type CheckSiteAccessRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed" dc:"是否允许访问"` // 是否允许访问
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"拒绝原因"`    // 拒绝原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckSiteAccessRes) Reset() {
+	*x = CheckSiteAccessRes{}
+	mi := &file_backend_site_v1_site_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckSiteAccessRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSiteAccessRes) ProtoMessage() {}
+
+func (x *CheckSiteAccessRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_site_v1_site_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSiteAccessRes.ProtoReflect.Descriptor instead.
+func (*CheckSiteAccessRes) Descriptor() ([]byte, []int) {
+	return file_backend_site_v1_site_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CheckSiteAccessRes) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *CheckSiteAccessRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_backend_site_v1_site_proto protoreflect.FileDescriptor
 
 const file_backend_site_v1_site_proto_rawDesc = "" +
@@ -466,10 +1462,85 @@ const file_backend_site_v1_site_proto_rawDesc = "" +
 	"\vurl_service\x18\f \x01(\tR\n" +
 	"urlService\"1\n" +
 	"\x15UpdateBasicSettingRes\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage2\xa1\x01\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"C\n" +
+	"\x14GetRegisterFieldsReq\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\x05R\x06siteId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\x05R\x04type\"\xb9\x01\n" +
+	"\x11RegisterFieldInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\x05R\x04type\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"field_name\x18\x04 \x01(\tR\tfieldName\x12\x18\n" +
+	"\adisplay\x18\x05 \x01(\bR\adisplay\x12\x1a\n" +
+	"\brequired\x18\x06 \x01(\bR\brequired\x12\x17\n" +
+	"\ais_core\x18\a \x01(\bR\x06isCore\"C\n" +
+	"\x14GetRegisterFieldsRes\x12+\n" +
+	"\x04list\x18\x01 \x03(\v2\x17.site.RegisterFieldInfoR\x04list\"\\\n" +
+	"\x14RegisterFieldSetting\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
+	"\adisplay\x18\x02 \x01(\bR\adisplay\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\"z\n" +
+	"\x17UpdateRegisterFieldsReq\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\x05R\x06siteId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\x05R\x04type\x122\n" +
+	"\x06fields\x18\x03 \x03(\v2\x1a.site.RegisterFieldSettingR\x06fields\"M\n" +
+	"\x17UpdateRegisterFieldsRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"h\n" +
+	"\x10GetSiteDeniesReq\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\x05R\x04type\x12\x18\n" +
+	"\akeyword\x18\x02 \x01(\tR\akeyword\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x05R\x04size\"\x9a\x01\n" +
+	"\fSiteDenyInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\x05R\x04type\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"P\n" +
+	"\x10GetSiteDeniesRes\x12&\n" +
+	"\x04list\x18\x01 \x03(\v2\x12.site.SiteDenyInfoR\x04list\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"Q\n" +
+	"\x11CreateSiteDenyReq\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\x05R\x04type\x12\x0e\n" +
+	"\x02ip\x18\x02 \x01(\tR\x02ip\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\"W\n" +
+	"\x11CreateSiteDenyRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\x05R\x02id\"a\n" +
+	"\x11UpdateSiteDenyReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\x05R\x04type\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\"G\n" +
+	"\x11UpdateSiteDenyRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"%\n" +
+	"\x11DeleteSiteDenyReq\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x05R\x03ids\"G\n" +
+	"\x11DeleteSiteDenyRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"$\n" +
+	"\x12CheckSiteAccessReq\x12\x0e\n" +
+	"\x02ip\x18\x01 \x01(\tR\x02ip\"H\n" +
+	"\x12CheckSiteAccessRes\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage2\xa6\x05\n" +
 	"\x04Site\x12G\n" +
 	"\x0fGetBasicSetting\x12\x18.site.GetBasicSettingReq\x1a\x18.site.GetBasicSettingRes\"\x00\x12P\n" +
-	"\x12UpdateBasicSetting\x12\x1b.site.UpdateBasicSettingReq\x1a\x1b.site.UpdateBasicSettingRes\"\x00B$Z\"jh_app_service/api/backend/site/v1b\x06proto3"
+	"\x12UpdateBasicSetting\x12\x1b.site.UpdateBasicSettingReq\x1a\x1b.site.UpdateBasicSettingRes\"\x00\x12M\n" +
+	"\x11GetRegisterFields\x12\x1a.site.GetRegisterFieldsReq\x1a\x1a.site.GetRegisterFieldsRes\"\x00\x12V\n" +
+	"\x14UpdateRegisterFields\x12\x1d.site.UpdateRegisterFieldsReq\x1a\x1d.site.UpdateRegisterFieldsRes\"\x00\x12A\n" +
+	"\rGetSiteDenies\x12\x16.site.GetSiteDeniesReq\x1a\x16.site.GetSiteDeniesRes\"\x00\x12D\n" +
+	"\x0eCreateSiteDeny\x12\x17.site.CreateSiteDenyReq\x1a\x17.site.CreateSiteDenyRes\"\x00\x12D\n" +
+	"\x0eUpdateSiteDeny\x12\x17.site.UpdateSiteDenyReq\x1a\x17.site.UpdateSiteDenyRes\"\x00\x12D\n" +
+	"\x0eDeleteSiteDeny\x12\x17.site.DeleteSiteDenyReq\x1a\x17.site.DeleteSiteDenyRes\"\x00\x12G\n" +
+	"\x0fCheckSiteAccess\x12\x18.site.CheckSiteAccessReq\x1a\x18.site.CheckSiteAccessRes\"\x00B$Z\"jh_app_service/api/backend/site/v1b\x06proto3"
 
 var (
 	file_backend_site_v1_site_proto_rawDescOnce sync.Once
@@ -483,23 +1554,57 @@ func file_backend_site_v1_site_proto_rawDescGZIP() []byte {
 	return file_backend_site_v1_site_proto_rawDescData
 }
 
-var file_backend_site_v1_site_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_backend_site_v1_site_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_backend_site_v1_site_proto_goTypes = []any{
-	(*GetBasicSettingReq)(nil),    // 0: site.GetBasicSettingReq
-	(*GetBasicSettingRes)(nil),    // 1: site.GetBasicSettingRes
-	(*UpdateBasicSettingReq)(nil), // 2: site.UpdateBasicSettingReq
-	(*UpdateBasicSettingRes)(nil), // 3: site.UpdateBasicSettingRes
+	(*GetBasicSettingReq)(nil),      // 0: site.GetBasicSettingReq
+	(*GetBasicSettingRes)(nil),      // 1: site.GetBasicSettingRes
+	(*UpdateBasicSettingReq)(nil),   // 2: site.UpdateBasicSettingReq
+	(*UpdateBasicSettingRes)(nil),   // 3: site.UpdateBasicSettingRes
+	(*GetRegisterFieldsReq)(nil),    // 4: site.GetRegisterFieldsReq
+	(*RegisterFieldInfo)(nil),       // 5: site.RegisterFieldInfo
+	(*GetRegisterFieldsRes)(nil),    // 6: site.GetRegisterFieldsRes
+	(*RegisterFieldSetting)(nil),    // 7: site.RegisterFieldSetting
+	(*UpdateRegisterFieldsReq)(nil), // 8: site.UpdateRegisterFieldsReq
+	(*UpdateRegisterFieldsRes)(nil), // 9: site.UpdateRegisterFieldsRes
+	(*GetSiteDeniesReq)(nil),        // 10: site.GetSiteDeniesReq
+	(*SiteDenyInfo)(nil),            // 11: site.SiteDenyInfo
+	(*GetSiteDeniesRes)(nil),        // 12: site.GetSiteDeniesRes
+	(*CreateSiteDenyReq)(nil),       // 13: site.CreateSiteDenyReq
+	(*CreateSiteDenyRes)(nil),       // 14: site.CreateSiteDenyRes
+	(*UpdateSiteDenyReq)(nil),       // 15: site.UpdateSiteDenyReq
+	(*UpdateSiteDenyRes)(nil),       // 16: site.UpdateSiteDenyRes
+	(*DeleteSiteDenyReq)(nil),       // 17: site.DeleteSiteDenyReq
+	(*DeleteSiteDenyRes)(nil),       // 18: site.DeleteSiteDenyRes
+	(*CheckSiteAccessReq)(nil),      // 19: site.CheckSiteAccessReq
+	(*CheckSiteAccessRes)(nil),      // 20: site.CheckSiteAccessRes
 }
 var file_backend_site_v1_site_proto_depIdxs = []int32{
-	0, // 0: site.Site.GetBasicSetting:input_type -> site.GetBasicSettingReq
-	2, // 1: site.Site.UpdateBasicSetting:input_type -> site.UpdateBasicSettingReq
-	1, // 2: site.Site.GetBasicSetting:output_type -> site.GetBasicSettingRes
-	3, // 3: site.Site.UpdateBasicSetting:output_type -> site.UpdateBasicSettingRes
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	5,  // 0: site.GetRegisterFieldsRes.list:type_name -> site.RegisterFieldInfo
+	7,  // 1: site.UpdateRegisterFieldsReq.fields:type_name -> site.RegisterFieldSetting
+	11, // 2: site.GetSiteDeniesRes.list:type_name -> site.SiteDenyInfo
+	0,  // 3: site.Site.GetBasicSetting:input_type -> site.GetBasicSettingReq
+	2,  // 4: site.Site.UpdateBasicSetting:input_type -> site.UpdateBasicSettingReq
+	4,  // 5: site.Site.GetRegisterFields:input_type -> site.GetRegisterFieldsReq
+	8,  // 6: site.Site.UpdateRegisterFields:input_type -> site.UpdateRegisterFieldsReq
+	10, // 7: site.Site.GetSiteDenies:input_type -> site.GetSiteDeniesReq
+	13, // 8: site.Site.CreateSiteDeny:input_type -> site.CreateSiteDenyReq
+	15, // 9: site.Site.UpdateSiteDeny:input_type -> site.UpdateSiteDenyReq
+	17, // 10: site.Site.DeleteSiteDeny:input_type -> site.DeleteSiteDenyReq
+	19, // 11: site.Site.CheckSiteAccess:input_type -> site.CheckSiteAccessReq
+	1,  // 12: site.Site.GetBasicSetting:output_type -> site.GetBasicSettingRes
+	3,  // 13: site.Site.UpdateBasicSetting:output_type -> site.UpdateBasicSettingRes
+	6,  // 14: site.Site.GetRegisterFields:output_type -> site.GetRegisterFieldsRes
+	9,  // 15: site.Site.UpdateRegisterFields:output_type -> site.UpdateRegisterFieldsRes
+	12, // 16: site.Site.GetSiteDenies:output_type -> site.GetSiteDeniesRes
+	14, // 17: site.Site.CreateSiteDeny:output_type -> site.CreateSiteDenyRes
+	16, // 18: site.Site.UpdateSiteDeny:output_type -> site.UpdateSiteDenyRes
+	18, // 19: site.Site.DeleteSiteDeny:output_type -> site.DeleteSiteDenyRes
+	20, // 20: site.Site.CheckSiteAccess:output_type -> site.CheckSiteAccessRes
+	12, // [12:21] is the sub-list for method output_type
+	3,  // [3:12] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_backend_site_v1_site_proto_init() }
@@ -513,7 +1618,7 @@ func file_backend_site_v1_site_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backend_site_v1_site_proto_rawDesc), len(file_backend_site_v1_site_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,8 +22,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Site_GetBasicSetting_FullMethodName    = "/site.Site/GetBasicSetting"
-	Site_UpdateBasicSetting_FullMethodName = "/site.Site/UpdateBasicSetting"
+	Site_GetBasicSetting_FullMethodName      = "/site.Site/GetBasicSetting"
+	Site_UpdateBasicSetting_FullMethodName   = "/site.Site/UpdateBasicSetting"
+	Site_GetRegisterFields_FullMethodName    = "/site.Site/GetRegisterFields"
+	Site_UpdateRegisterFields_FullMethodName = "/site.Site/UpdateRegisterFields"
+	Site_GetSiteDenies_FullMethodName        = "/site.Site/GetSiteDenies"
+	Site_CreateSiteDeny_FullMethodName       = "/site.Site/CreateSiteDeny"
+	Site_UpdateSiteDeny_FullMethodName       = "/site.Site/UpdateSiteDeny"
+	Site_DeleteSiteDeny_FullMethodName       = "/site.Site/DeleteSiteDeny"
+	Site_CheckSiteAccess_FullMethodName      = "/site.Site/CheckSiteAccess"
 )
 
 // SiteClient is the client API for Site service.
@@ -32,6 +39,15 @@ const (
 type SiteClient interface {
 	GetBasicSetting(ctx context.Context, in *GetBasicSettingReq, opts ...grpc.CallOption) (*GetBasicSettingRes, error)
 	UpdateBasicSetting(ctx context.Context, in *UpdateBasicSettingReq, opts ...grpc.CallOption) (*UpdateBasicSettingRes, error)
+	// 注册项设置
+	GetRegisterFields(ctx context.Context, in *GetRegisterFieldsReq, opts ...grpc.CallOption) (*GetRegisterFieldsRes, error)
+	UpdateRegisterFields(ctx context.Context, in *UpdateRegisterFieldsReq, opts ...grpc.CallOption) (*UpdateRegisterFieldsRes, error)
+	// 访问屏蔽
+	GetSiteDenies(ctx context.Context, in *GetSiteDeniesReq, opts ...grpc.CallOption) (*GetSiteDeniesRes, error)
+	CreateSiteDeny(ctx context.Context, in *CreateSiteDenyReq, opts ...grpc.CallOption) (*CreateSiteDenyRes, error)
+	UpdateSiteDeny(ctx context.Context, in *UpdateSiteDenyReq, opts ...grpc.CallOption) (*UpdateSiteDenyRes, error)
+	DeleteSiteDeny(ctx context.Context, in *DeleteSiteDenyReq, opts ...grpc.CallOption) (*DeleteSiteDenyRes, error)
+	CheckSiteAccess(ctx context.Context, in *CheckSiteAccessReq, opts ...grpc.CallOption) (*CheckSiteAccessRes, error)
 }
 
 type siteClient struct {
@@ -62,12 +78,91 @@ func (c *siteClient) UpdateBasicSetting(ctx context.Context, in *UpdateBasicSett
 	return out, nil
 }
 
+func (c *siteClient) GetRegisterFields(ctx context.Context, in *GetRegisterFieldsReq, opts ...grpc.CallOption) (*GetRegisterFieldsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRegisterFieldsRes)
+	err := c.cc.Invoke(ctx, Site_GetRegisterFields_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) UpdateRegisterFields(ctx context.Context, in *UpdateRegisterFieldsReq, opts ...grpc.CallOption) (*UpdateRegisterFieldsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRegisterFieldsRes)
+	err := c.cc.Invoke(ctx, Site_UpdateRegisterFields_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) GetSiteDenies(ctx context.Context, in *GetSiteDeniesReq, opts ...grpc.CallOption) (*GetSiteDeniesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSiteDeniesRes)
+	err := c.cc.Invoke(ctx, Site_GetSiteDenies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) CreateSiteDeny(ctx context.Context, in *CreateSiteDenyReq, opts ...grpc.CallOption) (*CreateSiteDenyRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSiteDenyRes)
+	err := c.cc.Invoke(ctx, Site_CreateSiteDeny_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) UpdateSiteDeny(ctx context.Context, in *UpdateSiteDenyReq, opts ...grpc.CallOption) (*UpdateSiteDenyRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSiteDenyRes)
+	err := c.cc.Invoke(ctx, Site_UpdateSiteDeny_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) DeleteSiteDeny(ctx context.Context, in *DeleteSiteDenyReq, opts ...grpc.CallOption) (*DeleteSiteDenyRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSiteDenyRes)
+	err := c.cc.Invoke(ctx, Site_DeleteSiteDeny_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) CheckSiteAccess(ctx context.Context, in *CheckSiteAccessReq, opts ...grpc.CallOption) (*CheckSiteAccessRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckSiteAccessRes)
+	err := c.cc.Invoke(ctx, Site_CheckSiteAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SiteServer is the server API for Site service.
 // All implementations must embed UnimplementedSiteServer
 // for forward compatibility.
 type SiteServer interface {
 	GetBasicSetting(context.Context, *GetBasicSettingReq) (*GetBasicSettingRes, error)
 	UpdateBasicSetting(context.Context, *UpdateBasicSettingReq) (*UpdateBasicSettingRes, error)
+	// 注册项设置
+	GetRegisterFields(context.Context, *GetRegisterFieldsReq) (*GetRegisterFieldsRes, error)
+	UpdateRegisterFields(context.Context, *UpdateRegisterFieldsReq) (*UpdateRegisterFieldsRes, error)
+	// 访问屏蔽
+	GetSiteDenies(context.Context, *GetSiteDeniesReq) (*GetSiteDeniesRes, error)
+	CreateSiteDeny(context.Context, *CreateSiteDenyReq) (*CreateSiteDenyRes, error)
+	UpdateSiteDeny(context.Context, *UpdateSiteDenyReq) (*UpdateSiteDenyRes, error)
+	DeleteSiteDeny(context.Context, *DeleteSiteDenyReq) (*DeleteSiteDenyRes, error)
+	CheckSiteAccess(context.Context, *CheckSiteAccessReq) (*CheckSiteAccessRes, error)
 	mustEmbedUnimplementedSiteServer()
 }
 
@@ -84,6 +179,27 @@ func (UnimplementedSiteServer) GetBasicSetting(context.Context, *GetBasicSetting
 func (UnimplementedSiteServer) UpdateBasicSetting(context.Context, *UpdateBasicSettingReq) (*UpdateBasicSettingRes, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateBasicSetting not implemented")
 }
+func (UnimplementedSiteServer) GetRegisterFields(context.Context, *GetRegisterFieldsReq) (*GetRegisterFieldsRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRegisterFields not implemented")
+}
+func (UnimplementedSiteServer) UpdateRegisterFields(context.Context, *UpdateRegisterFieldsReq) (*UpdateRegisterFieldsRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRegisterFields not implemented")
+}
+func (UnimplementedSiteServer) GetSiteDenies(context.Context, *GetSiteDeniesReq) (*GetSiteDeniesRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSiteDenies not implemented")
+}
+func (UnimplementedSiteServer) CreateSiteDeny(context.Context, *CreateSiteDenyReq) (*CreateSiteDenyRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSiteDeny not implemented")
+}
+func (UnimplementedSiteServer) UpdateSiteDeny(context.Context, *UpdateSiteDenyReq) (*UpdateSiteDenyRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSiteDeny not implemented")
+}
+func (UnimplementedSiteServer) DeleteSiteDeny(context.Context, *DeleteSiteDenyReq) (*DeleteSiteDenyRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSiteDeny not implemented")
+}
+func (UnimplementedSiteServer) CheckSiteAccess(context.Context, *CheckSiteAccessReq) (*CheckSiteAccessRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckSiteAccess not implemented")
+}
 func (UnimplementedSiteServer) mustEmbedUnimplementedSiteServer() {}
 func (UnimplementedSiteServer) testEmbeddedByValue()              {}
 
@@ -141,6 +257,132 @@ func _Site_UpdateBasicSetting_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Site_GetRegisterFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegisterFieldsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).GetRegisterFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Site_GetRegisterFields_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).GetRegisterFields(ctx, req.(*GetRegisterFieldsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Site_UpdateRegisterFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRegisterFieldsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).UpdateRegisterFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Site_UpdateRegisterFields_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).UpdateRegisterFields(ctx, req.(*UpdateRegisterFieldsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Site_GetSiteDenies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSiteDeniesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).GetSiteDenies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Site_GetSiteDenies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).GetSiteDenies(ctx, req.(*GetSiteDeniesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Site_CreateSiteDeny_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSiteDenyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).CreateSiteDeny(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Site_CreateSiteDeny_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).CreateSiteDeny(ctx, req.(*CreateSiteDenyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Site_UpdateSiteDeny_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSiteDenyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).UpdateSiteDeny(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Site_UpdateSiteDeny_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).UpdateSiteDeny(ctx, req.(*UpdateSiteDenyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Site_DeleteSiteDeny_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSiteDenyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).DeleteSiteDeny(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Site_DeleteSiteDeny_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).DeleteSiteDeny(ctx, req.(*DeleteSiteDenyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Site_CheckSiteAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckSiteAccessReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).CheckSiteAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Site_CheckSiteAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).CheckSiteAccess(ctx, req.(*CheckSiteAccessReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Site_ServiceDesc is the grpc.ServiceDesc for Site service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -156,6 +398,34 @@ var Site_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateBasicSetting",
 			Handler:    _Site_UpdateBasicSetting_Handler,
 		},
+		{
+			MethodName: "GetRegisterFields",
+			Handler:    _Site_GetRegisterFields_Handler,
+		},
+		{
+			MethodName: "UpdateRegisterFields",
+			Handler:    _Site_UpdateRegisterFields_Handler,
+		},
+		{
+			MethodName: "GetSiteDenies",
+			Handler:    _Site_GetSiteDenies_Handler,
+		},
+		{
+			MethodName: "CreateSiteDeny",
+			Handler:    _Site_CreateSiteDeny_Handler,
+		},
+		{
+			MethodName: "UpdateSiteDeny",
+			Handler:    _Site_UpdateSiteDeny_Handler,
+		},
+		{
+			MethodName: "DeleteSiteDeny",
+			Handler:    _Site_DeleteSiteDeny_Handler,
+		},
+		{
+			MethodName: "CheckSiteAccess",
+			Handler:    _Site_CheckSiteAccess_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "backend/site/v1/site.proto",
@@ -40,7 +40,7 @@ type GetUserListReq struct {
 	Domain        string                 `protobuf:"bytes,13,opt,name=domain,proto3" json:"domain" dc:"注册域名 (可选)"`                                    // 注册域名 (可选)
 	StartDate     string                 `protobuf:"bytes,14,opt,name=start_date,json=startDate,proto3" json:"start_date" dc:"开始日期 (可选)"`             // 开始日期 (可选)
 	EndDate       string                 `protobuf:"bytes,15,opt,name=end_date,json=endDate,proto3" json:"end_date" dc:"结束日期 (可选)"`                   // 结束日期 (可选)
-	Charge        int32                  `protobuf:"varint,16,opt,name=charge,proto3" json:"charge" dc:"是否首存 1=是, 0=否 (可选)"`                          // 是否首存 1=是, 0=否 (可选)
+	Charge        int32                  `protobuf:"varint,16,opt,name=charge,proto3" json:"charge" dc:"是否首存 1=仅已充值会员, 0=不限 (可选)"`                    // 是否首存 1=仅已充值会员, 0=不限 (可选)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -719,6 +719,277 @@ func (x *UpdateUserRes) GetMessage() string {
 	return ""
 }
 
+// 批量修改会员请求，ids 为空时按 filter 筛选会员
+type BatchUpdateUsersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int32                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids" dc:"会员ID列表"`                                                         // 会员ID列表
+	Filter        *GetUserListReq        `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter" dc:"筛选条件，与会员列表相同，分页参数无效"`                                              // 筛选条件，与会员列表相同，分页参数无效
+	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status" dc:"状态，0=不修改 1=停用 2=正常 (保存时减1)"`                                      // 状态，0=不修改 1=停用 2=正常 (保存时减1)
+	GradeId       int32                  `protobuf:"varint,4,opt,name=grade_id,json=gradeId,proto3" json:"grade_id" dc:"等级ID，0=不修改"`                                     // 等级ID，0=不修改
+	LevelId       int32                  `protobuf:"varint,5,opt,name=level_id,json=levelId,proto3" json:"level_id" dc:"层级ID，0=不修改"`                                     // 层级ID，0=不修改
+	AgentUsername string                 `protobuf:"bytes,6,opt,name=agent_username,json=agentUsername,proto3" json:"agent_username" dc:"代理账号，为空时不修改"`                   // 代理账号，为空时不修改
+	FocusLevel    int32                  `protobuf:"varint,7,opt,name=focus_level,json=focusLevel,proto3" json:"focus_level" dc:"关注级别，0=不修改"`                            // 关注级别，0=不修改
+	BalanceStatus int32                  `protobuf:"varint,8,opt,name=balance_status,json=balanceStatus,proto3" json:"balance_status" dc:"资金状态，0=不修改 1=禁用 2=正常 (保存时减1)"` // 资金状态，0=不修改 1=禁用 2=正常 (保存时减1)
+	Reason        string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason" dc:"操作原因，记录在管理员日志中"`                                                   // 操作原因，记录在管理员日志中
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateUsersReq) Reset() {
+	*x = BatchUpdateUsersReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateUsersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateUsersReq) ProtoMessage() {}
+
+func (x *BatchUpdateUsersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateUsersReq.ProtoReflect.Descriptor instead.
+func (*BatchUpdateUsersReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchUpdateUsersReq) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchUpdateUsersReq) GetFilter() *GetUserListReq {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *BatchUpdateUsersReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *BatchUpdateUsersReq) GetGradeId() int32 {
+	if x != nil {
+		return x.GradeId
+	}
+	return 0
+}
+
+func (x *BatchUpdateUsersReq) GetLevelId() int32 {
+	if x != nil {
+		return x.LevelId
+	}
+	return 0
+}
+
+func (x *BatchUpdateUsersReq) GetAgentUsername() string {
+	if x != nil {
+		return x.AgentUsername
+	}
+	return ""
+}
+
+func (x *BatchUpdateUsersReq) GetFocusLevel() int32 {
+	if x != nil {
+		return x.FocusLevel
+	}
+	return 0
+}
+
+func (x *BatchUpdateUsersReq) GetBalanceStatus() int32 {
+	if x != nil {
+		return x.BalanceStatus
+	}
+	return 0
+}
+
+func (x *BatchUpdateUsersReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 单个会员的修改结果
+type BatchUpdateUserResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"会员ID"`            // 会员ID
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username" dc:"会员账号"` // 会员账号
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success" dc:"是否成功"`  // 是否成功
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message" dc:"结果说明"`   // 结果说明
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateUserResult) Reset() {
+	*x = BatchUpdateUserResult{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateUserResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateUserResult) ProtoMessage() {}
+
+func (x *BatchUpdateUserResult) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateUserResult.ProtoReflect.Descriptor instead.
+func (*BatchUpdateUserResult) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BatchUpdateUserResult) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BatchUpdateUserResult) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *BatchUpdateUserResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BatchUpdateUserResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 批量修改会员响应
+type BatchUpdateUsersRes struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Success       bool                     `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否执行"`      // 是否执行
+	Message       string                   `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`       // 响应消息
+	Total         int32                    `protobuf:"varint,3,opt,name=total,proto3" json:"total" dc:"匹配的会员数"`        // 匹配的会员数
+	Updated       int32                    `protobuf:"varint,4,opt,name=updated,proto3" json:"updated" dc:"修改成功数"`     // 修改成功数
+	Unchanged     int32                    `protobuf:"varint,5,opt,name=unchanged,proto3" json:"unchanged" dc:"无需修改数"` // 无需修改数
+	Failed        int32                    `protobuf:"varint,6,opt,name=failed,proto3" json:"failed" dc:"失败数"`         // 失败数
+	Results       []*BatchUpdateUserResult `protobuf:"bytes,7,rep,name=results,proto3" json:"results" dc:"每个会员的结果"`    // 每个会员的结果
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateUsersRes) Reset() {
+	*x = BatchUpdateUsersRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateUsersRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateUsersRes) ProtoMessage() {}
+
+func (x *BatchUpdateUsersRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateUsersRes.ProtoReflect.Descriptor instead.
+func (*BatchUpdateUsersRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BatchUpdateUsersRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BatchUpdateUsersRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BatchUpdateUsersRes) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BatchUpdateUsersRes) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *BatchUpdateUsersRes) GetUnchanged() int32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+func (x *BatchUpdateUsersRes) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BatchUpdateUsersRes) GetResults() []*BatchUpdateUserResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 // 获取用户基本信息请求
 type GetUserBasicInfoReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -729,7 +1000,7 @@ type GetUserBasicInfoReq struct {
 
 func (x *GetUserBasicInfoReq) Reset() {
 	*x = GetUserBasicInfoReq{}
-	mi := &file_backend_user_v1_user_proto_msgTypes[5]
+	mi := &file_backend_user_v1_user_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -741,7 +1012,7 @@ func (x *GetUserBasicInfoReq) String() string {
 func (*GetUserBasicInfoReq) ProtoMessage() {}
 
 func (x *GetUserBasicInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_user_v1_user_proto_msgTypes[5]
+	mi := &file_backend_user_v1_user_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,7 +1025,7 @@ func (x *GetUserBasicInfoReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserBasicInfoReq.ProtoReflect.Descriptor instead.
 func (*GetUserBasicInfoReq) Descriptor() ([]byte, []int) {
-	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{5}
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetUserBasicInfoReq) GetId() int32 {
@@ -798,7 +1069,7 @@ type UserBasicInfo struct {
 
 func (x *UserBasicInfo) Reset() {
 	*x = UserBasicInfo{}
-	mi := &file_backend_user_v1_user_proto_msgTypes[6]
+	mi := &file_backend_user_v1_user_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -810,7 +1081,7 @@ func (x *UserBasicInfo) String() string {
 func (*UserBasicInfo) ProtoMessage() {}
 
 func (x *UserBasicInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_user_v1_user_proto_msgTypes[6]
+	mi := &file_backend_user_v1_user_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -823,7 +1094,7 @@ func (x *UserBasicInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserBasicInfo.ProtoReflect.Descriptor instead.
 func (*UserBasicInfo) Descriptor() ([]byte, []int) {
-	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{6}
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UserBasicInfo) GetId() int32 {
@@ -1012,7 +1283,7 @@ type BankInfo struct {
 
 func (x *BankInfo) Reset() {
 	*x = BankInfo{}
-	mi := &file_backend_user_v1_user_proto_msgTypes[7]
+	mi := &file_backend_user_v1_user_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1024,7 +1295,7 @@ func (x *BankInfo) String() string {
 func (*BankInfo) ProtoMessage() {}
 
 func (x *BankInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_user_v1_user_proto_msgTypes[7]
+	mi := &file_backend_user_v1_user_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1037,7 +1308,7 @@ func (x *BankInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BankInfo.ProtoReflect.Descriptor instead.
 func (*BankInfo) Descriptor() ([]byte, []int) {
-	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{7}
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *BankInfo) GetBankName() string {
@@ -1064,7 +1335,7 @@ type GetUserBasicInfoRes struct {
 
 func (x *GetUserBasicInfoRes) Reset() {
 	*x = GetUserBasicInfoRes{}
-	mi := &file_backend_user_v1_user_proto_msgTypes[8]
+	mi := &file_backend_user_v1_user_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1076,7 +1347,7 @@ func (x *GetUserBasicInfoRes) String() string {
 func (*GetUserBasicInfoRes) ProtoMessage() {}
 
 func (x *GetUserBasicInfoRes) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_user_v1_user_proto_msgTypes[8]
+	mi := &file_backend_user_v1_user_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1089,7 +1360,7 @@ func (x *GetUserBasicInfoRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserBasicInfoRes.ProtoReflect.Descriptor instead.
 func (*GetUserBasicInfoRes) Descriptor() ([]byte, []int) {
-	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{8}
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetUserBasicInfoRes) GetUser() *UserBasicInfo {
@@ -1109,7 +1380,7 @@ type GetUserGradesReq struct {
 
 func (x *GetUserGradesReq) Reset() {
 	*x = GetUserGradesReq{}
-	mi := &file_backend_user_v1_user_proto_msgTypes[9]
+	mi := &file_backend_user_v1_user_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1121,7 +1392,7 @@ func (x *GetUserGradesReq) String() string {
 func (*GetUserGradesReq) ProtoMessage() {}
 
 func (x *GetUserGradesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_user_v1_user_proto_msgTypes[9]
+	mi := &file_backend_user_v1_user_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1134,7 +1405,7 @@ func (x *GetUserGradesReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserGradesReq.ProtoReflect.Descriptor instead.
 func (*GetUserGradesReq) Descriptor() ([]byte, []int) {
-	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{9}
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetUserGradesReq) GetSiteId() int32 {
@@ -1167,7 +1438,7 @@ type UserGradeInfo struct {
 
 func (x *UserGradeInfo) Reset() {
 	*x = UserGradeInfo{}
-	mi := &file_backend_user_v1_user_proto_msgTypes[10]
+	mi := &file_backend_user_v1_user_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1179,7 +1450,7 @@ func (x *UserGradeInfo) String() string {
 func (*UserGradeInfo) ProtoMessage() {}
 
 func (x *UserGradeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_user_v1_user_proto_msgTypes[10]
+	mi := &file_backend_user_v1_user_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1192,7 +1463,7 @@ func (x *UserGradeInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserGradeInfo.ProtoReflect.Descriptor instead.
 func (*UserGradeInfo) Descriptor() ([]byte, []int) {
-	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{10}
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UserGradeInfo) GetId() int32 {
@@ -1305,7 +1576,7 @@ type GetUserGradesRes struct {
 
 func (x *GetUserGradesRes) Reset() {
 	*x = GetUserGradesRes{}
-	mi := &file_backend_user_v1_user_proto_msgTypes[11]
+	mi := &file_backend_user_v1_user_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1317,7 +1588,7 @@ func (x *GetUserGradesRes) String() string {
 func (*GetUserGradesRes) ProtoMessage() {}
 
 func (x *GetUserGradesRes) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_user_v1_user_proto_msgTypes[11]
+	mi := &file_backend_user_v1_user_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1330,7 +1601,7 @@ func (x *GetUserGradesRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserGradesRes.ProtoReflect.Descriptor instead.
 func (*GetUserGradesRes) Descriptor() ([]byte, []int) {
-	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{11}
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetUserGradesRes) GetCode() int32 {
@@ -1367,7 +1638,7 @@ type SaveUserGradesReq struct {
 
 func (x *SaveUserGradesReq) Reset() {
 	*x = SaveUserGradesReq{}
-	mi := &file_backend_user_v1_user_proto_msgTypes[12]
+	mi := &file_backend_user_v1_user_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1379,7 +1650,7 @@ func (x *SaveUserGradesReq) String() string {
 func (*SaveUserGradesReq) ProtoMessage() {}
 
 func (x *SaveUserGradesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_user_v1_user_proto_msgTypes[12]
+	mi := &file_backend_user_v1_user_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1392,7 +1663,7 @@ func (x *SaveUserGradesReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveUserGradesReq.ProtoReflect.Descriptor instead.
 func (*SaveUserGradesReq) Descriptor() ([]byte, []int) {
-	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{12}
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SaveUserGradesReq) GetSiteId() int32 {
@@ -1434,7 +1705,7 @@ type SaveUserGradesRes struct {
 
 func (x *SaveUserGradesRes) Reset() {
 	*x = SaveUserGradesRes{}
-	mi := &file_backend_user_v1_user_proto_msgTypes[13]
+	mi := &file_backend_user_v1_user_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1446,7 +1717,7 @@ func (x *SaveUserGradesRes) String() string {
 func (*SaveUserGradesRes) ProtoMessage() {}
 
 func (x *SaveUserGradesRes) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_user_v1_user_proto_msgTypes[13]
+	mi := &file_backend_user_v1_user_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1459,7 +1730,7 @@ func (x *SaveUserGradesRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveUserGradesRes.ProtoReflect.Descriptor instead.
 func (*SaveUserGradesRes) Descriptor() ([]byte, []int) {
-	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{13}
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SaveUserGradesRes) GetCode() int32 {
@@ -1487,7 +1758,7 @@ type DeleteUserGradesReq struct {
 
 func (x *DeleteUserGradesReq) Reset() {
 	*x = DeleteUserGradesReq{}
-	mi := &file_backend_user_v1_user_proto_msgTypes[14]
+	mi := &file_backend_user_v1_user_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1499,7 +1770,7 @@ func (x *DeleteUserGradesReq) String() string {
 func (*DeleteUserGradesReq) ProtoMessage() {}
 
 func (x *DeleteUserGradesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_user_v1_user_proto_msgTypes[14]
+	mi := &file_backend_user_v1_user_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1512,7 +1783,7 @@ func (x *DeleteUserGradesReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserGradesReq.ProtoReflect.Descriptor instead.
 func (*DeleteUserGradesReq) Descriptor() ([]byte, []int) {
-	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{14}
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DeleteUserGradesReq) GetSiteId() int32 {
@@ -1540,7 +1811,7 @@ type DeleteUserGradesRes struct {
 
 func (x *DeleteUserGradesRes) Reset() {
 	*x = DeleteUserGradesRes{}
-	mi := &file_backend_user_v1_user_proto_msgTypes[15]
+	mi := &file_backend_user_v1_user_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1552,7 +1823,7 @@ func (x *DeleteUserGradesRes) String() string {
 func (*DeleteUserGradesRes) ProtoMessage() {}
 
 func (x *DeleteUserGradesRes) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_user_v1_user_proto_msgTypes[15]
+	mi := &file_backend_user_v1_user_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1565,7 +1836,7 @@ func (x *DeleteUserGradesRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserGradesRes.ProtoReflect.Descriptor instead.
 func (*DeleteUserGradesRes) Descriptor() ([]byte, []int) {
-	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{15}
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *DeleteUserGradesRes) GetCode() int32 {
@@ -1582,34 +1853,5002 @@ func (x *DeleteUserGradesRes) GetMessage() string {
 	return ""
 }
 
-// 获取用户登录日志请求
-type GetUserLoginLogsReq struct {
+// 预览等级升级请求
+type PreviewGradeUpgradesReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username" dc:"用户名 (可选)"`                     // 用户名 (可选)
-	Ip            string                 `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip" dc:"登录IP (可选)"`                                // 登录IP (可选)
-	StartTime     string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间 (可选)"` // 开始时间 (可选)
-	EndTime       string                 `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间 (可选)"`       // 结束时间 (可选)
-	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page" dc:"页码"`                                  // 页码
-	Size          int32                  `protobuf:"varint,6,opt,name=size,proto3" json:"size" dc:"每页数量"`                                // 每页数量
+	UserIds       []int32                `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids" dc:"只评估这些会员，为空时评估全部"` // 只评估这些会员，为空时评估全部
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserLoginLogsReq) Reset() {
-	*x = GetUserLoginLogsReq{}
-	mi := &file_backend_user_v1_user_proto_msgTypes[16]
+func (x *PreviewGradeUpgradesReq) Reset() {
+	*x = PreviewGradeUpgradesReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserLoginLogsReq) String() string {
+func (x *PreviewGradeUpgradesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserLoginLogsReq) ProtoMessage() {}
+func (*PreviewGradeUpgradesReq) ProtoMessage() {}
 
-func (x *GetUserLoginLogsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_user_v1_user_proto_msgTypes[16]
+func (x *PreviewGradeUpgradesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewGradeUpgradesReq.ProtoReflect.Descriptor instead.
+func (*PreviewGradeUpgradesReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PreviewGradeUpgradesReq) GetUserIds() []int32 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+// 待升级会员
+type GradeUpgradeItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"会员ID"`                         // 会员ID
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username" dc:"会员账号"`                                    // 会员账号
+	Points        int32                  `protobuf:"varint,3,opt,name=points,proto3" json:"points" dc:"当前积分"`                                       // 当前积分
+	FromGradeId   int32                  `protobuf:"varint,4,opt,name=from_grade_id,json=fromGradeId,proto3" json:"from_grade_id" dc:"当前等级ID"`      // 当前等级ID
+	FromGradeName string                 `protobuf:"bytes,5,opt,name=from_grade_name,json=fromGradeName,proto3" json:"from_grade_name" dc:"当前等级名称"` // 当前等级名称
+	ToGradeId     int32                  `protobuf:"varint,6,opt,name=to_grade_id,json=toGradeId,proto3" json:"to_grade_id" dc:"升级后等级ID"`           // 升级后等级ID
+	ToGradeName   string                 `protobuf:"bytes,7,opt,name=to_grade_name,json=toGradeName,proto3" json:"to_grade_name" dc:"升级后等级名称"`      // 升级后等级名称
+	Bonus         float64                `protobuf:"fixed64,8,opt,name=bonus,proto3" json:"bonus" dc:"将发放的升级彩金，跨多级时为各级彩金之和"`                        // 将发放的升级彩金，跨多级时为各级彩金之和
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeUpgradeItem) Reset() {
+	*x = GradeUpgradeItem{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeUpgradeItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeUpgradeItem) ProtoMessage() {}
+
+func (x *GradeUpgradeItem) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeUpgradeItem.ProtoReflect.Descriptor instead.
+func (*GradeUpgradeItem) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GradeUpgradeItem) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GradeUpgradeItem) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GradeUpgradeItem) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *GradeUpgradeItem) GetFromGradeId() int32 {
+	if x != nil {
+		return x.FromGradeId
+	}
+	return 0
+}
+
+func (x *GradeUpgradeItem) GetFromGradeName() string {
+	if x != nil {
+		return x.FromGradeName
+	}
+	return ""
+}
+
+func (x *GradeUpgradeItem) GetToGradeId() int32 {
+	if x != nil {
+		return x.ToGradeId
+	}
+	return 0
+}
+
+func (x *GradeUpgradeItem) GetToGradeName() string {
+	if x != nil {
+		return x.ToGradeName
+	}
+	return ""
+}
+
+func (x *GradeUpgradeItem) GetBonus() float64 {
+	if x != nil {
+		return x.Bonus
+	}
+	return 0
+}
+
+// 预览等级升级响应
+type PreviewGradeUpgradesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*GradeUpgradeItem    `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"待升级会员，最多返回1000个"`                          // 待升级会员，最多返回1000个
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count" dc:"待升级会员总数"`                               // 待升级会员总数
+	BonusTotal    float64                `protobuf:"fixed64,3,opt,name=bonus_total,json=bonusTotal,proto3" json:"bonus_total" dc:"将发放的彩金总额"` // 将发放的彩金总额
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewGradeUpgradesRes) Reset() {
+	*x = PreviewGradeUpgradesRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewGradeUpgradesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewGradeUpgradesRes) ProtoMessage() {}
+
+func (x *PreviewGradeUpgradesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewGradeUpgradesRes.ProtoReflect.Descriptor instead.
+func (*PreviewGradeUpgradesRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PreviewGradeUpgradesRes) GetList() []*GradeUpgradeItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *PreviewGradeUpgradesRes) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PreviewGradeUpgradesRes) GetBonusTotal() float64 {
+	if x != nil {
+		return x.BonusTotal
+	}
+	return 0
+}
+
+// 执行等级升级请求
+type RunGradeUpgradesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []int32                `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids" dc:"只评估这些会员，为空时评估全部"` // 只评估这些会员，为空时评估全部
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunGradeUpgradesReq) Reset() {
+	*x = RunGradeUpgradesReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunGradeUpgradesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunGradeUpgradesReq) ProtoMessage() {}
+
+func (x *RunGradeUpgradesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunGradeUpgradesReq.ProtoReflect.Descriptor instead.
+func (*RunGradeUpgradesReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RunGradeUpgradesReq) GetUserIds() []int32 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+// 执行等级升级响应
+type RunGradeUpgradesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"`                               // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`                                // 响应消息
+	Upgraded      int32                  `protobuf:"varint,3,opt,name=upgraded,proto3" json:"upgraded" dc:"升级的会员数"`                           // 升级的会员数
+	BonusTotal    float64                `protobuf:"fixed64,4,opt,name=bonus_total,json=bonusTotal,proto3" json:"bonus_total" dc:"本次发放的彩金总额"` // 本次发放的彩金总额
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunGradeUpgradesRes) Reset() {
+	*x = RunGradeUpgradesRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunGradeUpgradesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunGradeUpgradesRes) ProtoMessage() {}
+
+func (x *RunGradeUpgradesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunGradeUpgradesRes.ProtoReflect.Descriptor instead.
+func (*RunGradeUpgradesRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RunGradeUpgradesRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RunGradeUpgradesRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RunGradeUpgradesRes) GetUpgraded() int32 {
+	if x != nil {
+		return x.Upgraded
+	}
+	return 0
+}
+
+func (x *RunGradeUpgradesRes) GetBonusTotal() float64 {
+	if x != nil {
+		return x.BonusTotal
+	}
+	return 0
+}
+
+// 获取等级变更记录请求
+type GetUserGradeLogsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page" dc:"页码"`                                  // 页码
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size" dc:"每页数量"`                                // 每页数量
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username" dc:"会员账号 (可选)"`                    // 会员账号 (可选)
+	StartTime     string                 `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间 (可选)"` // 开始时间 (可选)
+	EndTime       string                 `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间 (可选)"`       // 结束时间 (可选)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserGradeLogsReq) Reset() {
+	*x = GetUserGradeLogsReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserGradeLogsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserGradeLogsReq) ProtoMessage() {}
+
+func (x *GetUserGradeLogsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserGradeLogsReq.ProtoReflect.Descriptor instead.
+func (*GetUserGradeLogsReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetUserGradeLogsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetUserGradeLogsReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetUserGradeLogsReq) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetUserGradeLogsReq) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *GetUserGradeLogsReq) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+// 等级变更记录
+type UserGradeLogInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"记录ID"`                                                                     // 记录ID
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"会员ID"`                                               // 会员ID
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username" dc:"会员账号"`                                                          // 会员账号
+	FromGradeId   int32                  `protobuf:"varint,4,opt,name=from_grade_id,json=fromGradeId,proto3" json:"from_grade_id" dc:"原等级ID"`                             // 原等级ID
+	FromGradeName string                 `protobuf:"bytes,5,opt,name=from_grade_name,json=fromGradeName,proto3" json:"from_grade_name" dc:"原等级名称"`                        // 原等级名称
+	ToGradeId     int32                  `protobuf:"varint,6,opt,name=to_grade_id,json=toGradeId,proto3" json:"to_grade_id" dc:"新等级ID"`                                   // 新等级ID
+	ToGradeName   string                 `protobuf:"bytes,7,opt,name=to_grade_name,json=toGradeName,proto3" json:"to_grade_name" dc:"新等级名称"`                              // 新等级名称
+	Points        int32                  `protobuf:"varint,8,opt,name=points,proto3" json:"points" dc:"升级时积分"`                                                            // 升级时积分
+	Bonus         float64                `protobuf:"fixed64,9,opt,name=bonus,proto3" json:"bonus" dc:"升级彩金"`                                                              // 升级彩金
+	BonusStatus   int32                  `protobuf:"varint,10,opt,name=bonus_status,json=bonusStatus,proto3" json:"bonus_status" dc:"彩金状态 0=无彩金 1=已发放 2=未开启自动发放 3=此前已发放"` // 彩金状态 0=无彩金 1=已发放 2=未开启自动发放 3=此前已发放
+	TradeNo       string                 `protobuf:"bytes,11,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no" dc:"彩金流水号"`                                           // 彩金流水号
+	Remark        string                 `protobuf:"bytes,12,opt,name=remark,proto3" json:"remark" dc:"备注"`                                                               // 备注
+	CreatedAt     string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"升级时间"`                                      // 升级时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserGradeLogInfo) Reset() {
+	*x = UserGradeLogInfo{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGradeLogInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGradeLogInfo) ProtoMessage() {}
+
+func (x *UserGradeLogInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGradeLogInfo.ProtoReflect.Descriptor instead.
+func (*UserGradeLogInfo) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UserGradeLogInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserGradeLogInfo) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserGradeLogInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserGradeLogInfo) GetFromGradeId() int32 {
+	if x != nil {
+		return x.FromGradeId
+	}
+	return 0
+}
+
+func (x *UserGradeLogInfo) GetFromGradeName() string {
+	if x != nil {
+		return x.FromGradeName
+	}
+	return ""
+}
+
+func (x *UserGradeLogInfo) GetToGradeId() int32 {
+	if x != nil {
+		return x.ToGradeId
+	}
+	return 0
+}
+
+func (x *UserGradeLogInfo) GetToGradeName() string {
+	if x != nil {
+		return x.ToGradeName
+	}
+	return ""
+}
+
+func (x *UserGradeLogInfo) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *UserGradeLogInfo) GetBonus() float64 {
+	if x != nil {
+		return x.Bonus
+	}
+	return 0
+}
+
+func (x *UserGradeLogInfo) GetBonusStatus() int32 {
+	if x != nil {
+		return x.BonusStatus
+	}
+	return 0
+}
+
+func (x *UserGradeLogInfo) GetTradeNo() string {
+	if x != nil {
+		return x.TradeNo
+	}
+	return ""
+}
+
+func (x *UserGradeLogInfo) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *UserGradeLogInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 获取等级变更记录响应
+type GetUserGradeLogsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*UserGradeLogInfo    `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"变更记录"`   // 变更记录
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count" dc:"总数量"` // 总数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserGradeLogsRes) Reset() {
+	*x = GetUserGradeLogsRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserGradeLogsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserGradeLogsRes) ProtoMessage() {}
+
+func (x *GetUserGradeLogsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserGradeLogsRes.ProtoReflect.Descriptor instead.
+func (*GetUserGradeLogsRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetUserGradeLogsRes) GetList() []*UserGradeLogInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *GetUserGradeLogsRes) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 发放生日彩金请求
+type RunBirthdayBonusesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date" dc:"生日日期，格式 2006-01-02，默认今天，用于补发漏发的日期"` // 生日日期，格式 2006-01-02，默认今天，用于补发漏发的日期
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunBirthdayBonusesReq) Reset() {
+	*x = RunBirthdayBonusesReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunBirthdayBonusesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunBirthdayBonusesReq) ProtoMessage() {}
+
+func (x *RunBirthdayBonusesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunBirthdayBonusesReq.ProtoReflect.Descriptor instead.
+func (*RunBirthdayBonusesReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RunBirthdayBonusesReq) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// 发放生日彩金响应
+type RunBirthdayBonusesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"`                            // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`                             // 响应消息
+	Paid          int32                  `protobuf:"varint,3,opt,name=paid,proto3" json:"paid" dc:"本次发放人数"`                                // 本次发放人数
+	MoneyTotal    float64                `protobuf:"fixed64,4,opt,name=money_total,json=moneyTotal,proto3" json:"money_total" dc:"本次发放金额"` // 本次发放金额
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunBirthdayBonusesRes) Reset() {
+	*x = RunBirthdayBonusesRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunBirthdayBonusesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunBirthdayBonusesRes) ProtoMessage() {}
+
+func (x *RunBirthdayBonusesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunBirthdayBonusesRes.ProtoReflect.Descriptor instead.
+func (*RunBirthdayBonusesRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RunBirthdayBonusesRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RunBirthdayBonusesRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RunBirthdayBonusesRes) GetPaid() int32 {
+	if x != nil {
+		return x.Paid
+	}
+	return 0
+}
+
+func (x *RunBirthdayBonusesRes) GetMoneyTotal() float64 {
+	if x != nil {
+		return x.MoneyTotal
+	}
+	return 0
+}
+
+// 获取生日彩金发放记录请求
+type GetBirthdayBonusesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page" dc:"页码"`                                  // 页码
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size" dc:"每页数量"`                                // 每页数量
+	Year          int32                  `protobuf:"varint,3,opt,name=year,proto3" json:"year" dc:"发放年份，0=全部"`                           // 发放年份，0=全部
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username" dc:"会员账号 (可选)"`                    // 会员账号 (可选)
+	StartTime     string                 `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间 (可选)"` // 开始时间 (可选)
+	EndTime       string                 `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间 (可选)"`       // 结束时间 (可选)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBirthdayBonusesReq) Reset() {
+	*x = GetBirthdayBonusesReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBirthdayBonusesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBirthdayBonusesReq) ProtoMessage() {}
+
+func (x *GetBirthdayBonusesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBirthdayBonusesReq.ProtoReflect.Descriptor instead.
+func (*GetBirthdayBonusesReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetBirthdayBonusesReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetBirthdayBonusesReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetBirthdayBonusesReq) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GetBirthdayBonusesReq) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetBirthdayBonusesReq) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *GetBirthdayBonusesReq) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+// 生日彩金发放记录
+type BirthdayBonusInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"记录ID"`                                  // 记录ID
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"会员ID"`            // 会员ID
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username" dc:"会员账号"`                       // 会员账号
+	GradeId       int32                  `protobuf:"varint,4,opt,name=grade_id,json=gradeId,proto3" json:"grade_id" dc:"发放时等级ID"`      // 发放时等级ID
+	GradeName     string                 `protobuf:"bytes,5,opt,name=grade_name,json=gradeName,proto3" json:"grade_name" dc:"发放时等级名称"` // 发放时等级名称
+	Year          int32                  `protobuf:"varint,6,opt,name=year,proto3" json:"year" dc:"发放年份"`                              // 发放年份
+	Money         float64                `protobuf:"fixed64,7,opt,name=money,proto3" json:"money" dc:"彩金金额"`                           // 彩金金额
+	TradeNo       string                 `protobuf:"bytes,8,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no" dc:"彩金流水号"`         // 彩金流水号
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"发放时间"`    // 发放时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BirthdayBonusInfo) Reset() {
+	*x = BirthdayBonusInfo{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BirthdayBonusInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BirthdayBonusInfo) ProtoMessage() {}
+
+func (x *BirthdayBonusInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BirthdayBonusInfo.ProtoReflect.Descriptor instead.
+func (*BirthdayBonusInfo) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BirthdayBonusInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BirthdayBonusInfo) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BirthdayBonusInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *BirthdayBonusInfo) GetGradeId() int32 {
+	if x != nil {
+		return x.GradeId
+	}
+	return 0
+}
+
+func (x *BirthdayBonusInfo) GetGradeName() string {
+	if x != nil {
+		return x.GradeName
+	}
+	return ""
+}
+
+func (x *BirthdayBonusInfo) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *BirthdayBonusInfo) GetMoney() float64 {
+	if x != nil {
+		return x.Money
+	}
+	return 0
+}
+
+func (x *BirthdayBonusInfo) GetTradeNo() string {
+	if x != nil {
+		return x.TradeNo
+	}
+	return ""
+}
+
+func (x *BirthdayBonusInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 获取生日彩金发放记录响应
+type GetBirthdayBonusesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*BirthdayBonusInfo   `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"发放记录"`                                      // 发放记录
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count" dc:"总数量"`                                    // 总数量
+	MoneyTotal    float64                `protobuf:"fixed64,3,opt,name=money_total,json=moneyTotal,proto3" json:"money_total" dc:"符合条件的发放总额"` // 符合条件的发放总额
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBirthdayBonusesRes) Reset() {
+	*x = GetBirthdayBonusesRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBirthdayBonusesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBirthdayBonusesRes) ProtoMessage() {}
+
+func (x *GetBirthdayBonusesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBirthdayBonusesRes.ProtoReflect.Descriptor instead.
+func (*GetBirthdayBonusesRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetBirthdayBonusesRes) GetList() []*BirthdayBonusInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *GetBirthdayBonusesRes) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetBirthdayBonusesRes) GetMoneyTotal() float64 {
+	if x != nil {
+		return x.MoneyTotal
+	}
+	return 0
+}
+
+// 积分设置
+type PointsSetting struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	SwitchSitePoints     int32                  `protobuf:"varint,1,opt,name=switch_site_points,json=switchSitePoints,proto3" json:"switch_site_points" dc:"积分开关 0=关闭 1=开启"`               // 积分开关 0=关闭 1=开启
+	SwitchRechargePoints int32                  `protobuf:"varint,2,opt,name=switch_recharge_points,json=switchRechargePoints,proto3" json:"switch_recharge_points" dc:"充值积分开关 0=关闭 1=开启"` // 充值积分开关 0=关闭 1=开启
+	EachRechargeAmount   float64                `protobuf:"fixed64,3,opt,name=each_recharge_amount,json=eachRechargeAmount,proto3" json:"each_recharge_amount" dc:"每充值金额"`                 // 每充值金额
+	EachRechargePoints   int32                  `protobuf:"varint,4,opt,name=each_recharge_points,json=eachRechargePoints,proto3" json:"each_recharge_points" dc:"获得积分"`                   // 获得积分
+	SwitchBettingPoints  int32                  `protobuf:"varint,5,opt,name=switch_betting_points,json=switchBettingPoints,proto3" json:"switch_betting_points" dc:"投注积分开关 0=关闭 1=开启"`    // 投注积分开关 0=关闭 1=开启
+	EachBettingAmount    float64                `protobuf:"fixed64,6,opt,name=each_betting_amount,json=eachBettingAmount,proto3" json:"each_betting_amount" dc:"每有效投注金额"`                  // 每有效投注金额
+	EachBettingPoints    int32                  `protobuf:"varint,7,opt,name=each_betting_points,json=eachBettingPoints,proto3" json:"each_betting_points" dc:"获得积分"`                      // 获得积分
+	MaxDailyPoints       int32                  `protobuf:"varint,8,opt,name=max_daily_points,json=maxDailyPoints,proto3" json:"max_daily_points" dc:"每日充值和投注积分上限，0=不限"`                   // 每日充值和投注积分上限，0=不限
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *PointsSetting) Reset() {
+	*x = PointsSetting{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PointsSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PointsSetting) ProtoMessage() {}
+
+func (x *PointsSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PointsSetting.ProtoReflect.Descriptor instead.
+func (*PointsSetting) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PointsSetting) GetSwitchSitePoints() int32 {
+	if x != nil {
+		return x.SwitchSitePoints
+	}
+	return 0
+}
+
+func (x *PointsSetting) GetSwitchRechargePoints() int32 {
+	if x != nil {
+		return x.SwitchRechargePoints
+	}
+	return 0
+}
+
+func (x *PointsSetting) GetEachRechargeAmount() float64 {
+	if x != nil {
+		return x.EachRechargeAmount
+	}
+	return 0
+}
+
+func (x *PointsSetting) GetEachRechargePoints() int32 {
+	if x != nil {
+		return x.EachRechargePoints
+	}
+	return 0
+}
+
+func (x *PointsSetting) GetSwitchBettingPoints() int32 {
+	if x != nil {
+		return x.SwitchBettingPoints
+	}
+	return 0
+}
+
+func (x *PointsSetting) GetEachBettingAmount() float64 {
+	if x != nil {
+		return x.EachBettingAmount
+	}
+	return 0
+}
+
+func (x *PointsSetting) GetEachBettingPoints() int32 {
+	if x != nil {
+		return x.EachBettingPoints
+	}
+	return 0
+}
+
+func (x *PointsSetting) GetMaxDailyPoints() int32 {
+	if x != nil {
+		return x.MaxDailyPoints
+	}
+	return 0
+}
+
+// 获取积分设置请求
+type GetPointsSettingReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPointsSettingReq) Reset() {
+	*x = GetPointsSettingReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPointsSettingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPointsSettingReq) ProtoMessage() {}
+
+func (x *GetPointsSettingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPointsSettingReq.ProtoReflect.Descriptor instead.
+func (*GetPointsSettingReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{33}
+}
+
+// 获取积分设置响应
+type GetPointsSettingRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Setting       *PointsSetting         `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting" dc:"积分设置"` // 积分设置
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPointsSettingRes) Reset() {
+	*x = GetPointsSettingRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPointsSettingRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPointsSettingRes) ProtoMessage() {}
+
+func (x *GetPointsSettingRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPointsSettingRes.ProtoReflect.Descriptor instead.
+func (*GetPointsSettingRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetPointsSettingRes) GetSetting() *PointsSetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+// 修改积分设置请求
+type UpdatePointsSettingReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Setting       *PointsSetting         `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting" dc:"积分设置"` // 积分设置
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePointsSettingReq) Reset() {
+	*x = UpdatePointsSettingReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePointsSettingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePointsSettingReq) ProtoMessage() {}
+
+func (x *UpdatePointsSettingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePointsSettingReq.ProtoReflect.Descriptor instead.
+func (*UpdatePointsSettingReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdatePointsSettingReq) GetSetting() *PointsSetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+// 修改积分设置响应
+type UpdatePointsSettingRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePointsSettingRes) Reset() {
+	*x = UpdatePointsSettingRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePointsSettingRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePointsSettingRes) ProtoMessage() {}
+
+func (x *UpdatePointsSettingRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePointsSettingRes.ProtoReflect.Descriptor instead.
+func (*UpdatePointsSettingRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdatePointsSettingRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdatePointsSettingRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 获取会员积分记录请求
+type GetUserPointsLogsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page" dc:"页码"`                                    // 页码
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size" dc:"每页数量"`                                  // 每页数量
+	UserId        int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"会员ID (可选)"`           // 会员ID (可选)
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username" dc:"会员账号 (可选)"`                      // 会员账号 (可选)
+	Source        int32                  `protobuf:"varint,5,opt,name=source,proto3" json:"source" dc:"积分来源 0=全部 1=充值 2=投注 3=后台调整 4=签到奖励"` // 积分来源 0=全部 1=充值 2=投注 3=后台调整 4=签到奖励
+	StartTime     string                 `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间 (可选)"`   // 开始时间 (可选)
+	EndTime       string                 `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间 (可选)"`         // 结束时间 (可选)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserPointsLogsReq) Reset() {
+	*x = GetUserPointsLogsReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPointsLogsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPointsLogsReq) ProtoMessage() {}
+
+func (x *GetUserPointsLogsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPointsLogsReq.ProtoReflect.Descriptor instead.
+func (*GetUserPointsLogsReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetUserPointsLogsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetUserPointsLogsReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetUserPointsLogsReq) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserPointsLogsReq) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetUserPointsLogsReq) GetSource() int32 {
+	if x != nil {
+		return x.Source
+	}
+	return 0
+}
+
+func (x *GetUserPointsLogsReq) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *GetUserPointsLogsReq) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+// 会员积分记录
+type UserPointsLogInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"记录ID"`                                             // 记录ID
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"会员ID"`                       // 会员ID
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username" dc:"会员账号"`                                  // 会员账号
+	Source        int32                  `protobuf:"varint,4,opt,name=source,proto3" json:"source" dc:"积分来源 1=充值 2=投注 3=后台调整 4=签到奖励"`             // 积分来源 1=充值 2=投注 3=后台调整 4=签到奖励
+	ChangeType    int32                  `protobuf:"varint,5,opt,name=change_type,json=changeType,proto3" json:"change_type" dc:"变动类型 1=增加 2=扣除"` // 变动类型 1=增加 2=扣除
+	Points        int32                  `protobuf:"varint,6,opt,name=points,proto3" json:"points" dc:"变动积分"`                                     // 变动积分
+	PointsOld     int32                  `protobuf:"varint,7,opt,name=points_old,json=pointsOld,proto3" json:"points_old" dc:"变动前积分"`             // 变动前积分
+	PointsNew     int32                  `protobuf:"varint,8,opt,name=points_new,json=pointsNew,proto3" json:"points_new" dc:"变动后积分"`             // 变动后积分
+	BizNo         string                 `protobuf:"bytes,9,opt,name=biz_no,json=bizNo,proto3" json:"biz_no" dc:"业务单号"`                           // 业务单号
+	PointsDate    string                 `protobuf:"bytes,10,opt,name=points_date,json=pointsDate,proto3" json:"points_date" dc:"积分归属日期"`         // 积分归属日期
+	AdminId       int32                  `protobuf:"varint,11,opt,name=admin_id,json=adminId,proto3" json:"admin_id" dc:"操作管理员ID"`                // 操作管理员ID
+	Remark        string                 `protobuf:"bytes,12,opt,name=remark,proto3" json:"remark" dc:"备注"`                                       // 备注
+	CreatedAt     string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"变动时间"`              // 变动时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserPointsLogInfo) Reset() {
+	*x = UserPointsLogInfo{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPointsLogInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPointsLogInfo) ProtoMessage() {}
+
+func (x *UserPointsLogInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPointsLogInfo.ProtoReflect.Descriptor instead.
+func (*UserPointsLogInfo) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UserPointsLogInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserPointsLogInfo) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserPointsLogInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserPointsLogInfo) GetSource() int32 {
+	if x != nil {
+		return x.Source
+	}
+	return 0
+}
+
+func (x *UserPointsLogInfo) GetChangeType() int32 {
+	if x != nil {
+		return x.ChangeType
+	}
+	return 0
+}
+
+func (x *UserPointsLogInfo) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *UserPointsLogInfo) GetPointsOld() int32 {
+	if x != nil {
+		return x.PointsOld
+	}
+	return 0
+}
+
+func (x *UserPointsLogInfo) GetPointsNew() int32 {
+	if x != nil {
+		return x.PointsNew
+	}
+	return 0
+}
+
+func (x *UserPointsLogInfo) GetBizNo() string {
+	if x != nil {
+		return x.BizNo
+	}
+	return ""
+}
+
+func (x *UserPointsLogInfo) GetPointsDate() string {
+	if x != nil {
+		return x.PointsDate
+	}
+	return ""
+}
+
+func (x *UserPointsLogInfo) GetAdminId() int32 {
+	if x != nil {
+		return x.AdminId
+	}
+	return 0
+}
+
+func (x *UserPointsLogInfo) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *UserPointsLogInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 获取会员积分记录响应
+type GetUserPointsLogsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*UserPointsLogInfo   `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"积分记录"`   // 积分记录
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count" dc:"总数量"` // 总数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserPointsLogsRes) Reset() {
+	*x = GetUserPointsLogsRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPointsLogsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPointsLogsRes) ProtoMessage() {}
+
+func (x *GetUserPointsLogsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPointsLogsRes.ProtoReflect.Descriptor instead.
+func (*GetUserPointsLogsRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetUserPointsLogsRes) GetList() []*UserPointsLogInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *GetUserPointsLogsRes) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 调整会员积分请求
+type AdjustUserPointsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"会员ID"`                       // 会员ID
+	ChangeType    int32                  `protobuf:"varint,2,opt,name=change_type,json=changeType,proto3" json:"change_type" dc:"变动类型 1=增加 2=扣除"` // 变动类型 1=增加 2=扣除
+	Points        int32                  `protobuf:"varint,3,opt,name=points,proto3" json:"points" dc:"变动积分"`                                     // 变动积分
+	Remark        string                 `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark" dc:"调整原因"`                                      // 调整原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustUserPointsReq) Reset() {
+	*x = AdjustUserPointsReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustUserPointsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustUserPointsReq) ProtoMessage() {}
+
+func (x *AdjustUserPointsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustUserPointsReq.ProtoReflect.Descriptor instead.
+func (*AdjustUserPointsReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AdjustUserPointsReq) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdjustUserPointsReq) GetChangeType() int32 {
+	if x != nil {
+		return x.ChangeType
+	}
+	return 0
+}
+
+func (x *AdjustUserPointsReq) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *AdjustUserPointsReq) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+// 调整会员积分响应
+type AdjustUserPointsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	Points        int32                  `protobuf:"varint,3,opt,name=points,proto3" json:"points" dc:"调整后积分"`  // 调整后积分
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustUserPointsRes) Reset() {
+	*x = AdjustUserPointsRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustUserPointsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustUserPointsRes) ProtoMessage() {}
+
+func (x *AdjustUserPointsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustUserPointsRes.ProtoReflect.Descriptor instead.
+func (*AdjustUserPointsRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AdjustUserPointsRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AdjustUserPointsRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AdjustUserPointsRes) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+// 发放投注积分请求
+type RunBettingPointsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date" dc:"投注日期，格式 2006-01-02，默认昨天，用于补发漏发的日期"` // 投注日期，格式 2006-01-02，默认昨天，用于补发漏发的日期
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunBettingPointsReq) Reset() {
+	*x = RunBettingPointsReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunBettingPointsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunBettingPointsReq) ProtoMessage() {}
+
+func (x *RunBettingPointsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunBettingPointsReq.ProtoReflect.Descriptor instead.
+func (*RunBettingPointsReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RunBettingPointsReq) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// 发放投注积分响应
+type RunBettingPointsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"`                              // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`                               // 响应消息
+	Users         int32                  `protobuf:"varint,3,opt,name=users,proto3" json:"users" dc:"本次获得积分的会员数"`                            // 本次获得积分的会员数
+	PointsTotal   int32                  `protobuf:"varint,4,opt,name=points_total,json=pointsTotal,proto3" json:"points_total" dc:"本次发放积分"` // 本次发放积分
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunBettingPointsRes) Reset() {
+	*x = RunBettingPointsRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunBettingPointsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunBettingPointsRes) ProtoMessage() {}
+
+func (x *RunBettingPointsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunBettingPointsRes.ProtoReflect.Descriptor instead.
+func (*RunBettingPointsRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RunBettingPointsRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RunBettingPointsRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RunBettingPointsRes) GetUsers() int32 {
+	if x != nil {
+		return x.Users
+	}
+	return 0
+}
+
+func (x *RunBettingPointsRes) GetPointsTotal() int32 {
+	if x != nil {
+		return x.PointsTotal
+	}
+	return 0
+}
+
+// 连续签到奖励
+type SignReward struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days" dc:"连续签到天数"`   // 连续签到天数
+	Money         float64                `protobuf:"fixed64,2,opt,name=money,proto3" json:"money" dc:"奖励彩金"`  // 奖励彩金
+	Points        int32                  `protobuf:"varint,3,opt,name=points,proto3" json:"points" dc:"奖励积分"` // 奖励积分
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignReward) Reset() {
+	*x = SignReward{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignReward) ProtoMessage() {}
+
+func (x *SignReward) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignReward.ProtoReflect.Descriptor instead.
+func (*SignReward) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SignReward) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *SignReward) GetMoney() float64 {
+	if x != nil {
+		return x.Money
+	}
+	return 0
+}
+
+func (x *SignReward) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+// 签到活动信息
+type SignCampaignInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"活动ID"`                                             // 活动ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name" dc:"活动名称"`                                          // 活动名称
+	StartTime     string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间"`               // 开始时间
+	EndTime       string                 `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间"`                     // 结束时间
+	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status" dc:"状态 0=关闭 1=开启"`                             // 状态 0=关闭 1=开启
+	GradeIds      []int32                `protobuf:"varint,6,rep,packed,name=grade_ids,json=gradeIds,proto3" json:"grade_ids" dc:"参与的会员等级，为空时不限"` // 参与的会员等级，为空时不限
+	LevelIds      []int32                `protobuf:"varint,7,rep,packed,name=level_ids,json=levelIds,proto3" json:"level_ids" dc:"参与的会员层级，为空时不限"` // 参与的会员层级，为空时不限
+	Platform      int32                  `protobuf:"varint,8,opt,name=platform,proto3" json:"platform" dc:"签到终端 0=所有 1=网站 2=手机"`                  // 签到终端 0=所有 1=网站 2=手机
+	Remark        string                 `protobuf:"bytes,9,opt,name=remark,proto3" json:"remark" dc:"活动描述"`                                      // 活动描述
+	Rewards       []*SignReward          `protobuf:"bytes,10,rep,name=rewards,proto3" json:"rewards" dc:"连续签到奖励"`                                 // 连续签到奖励
+	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间"`              // 创建时间
+	UpdatedAt     string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at" dc:"更新时间"`              // 更新时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignCampaignInfo) Reset() {
+	*x = SignCampaignInfo{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignCampaignInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignCampaignInfo) ProtoMessage() {}
+
+func (x *SignCampaignInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignCampaignInfo.ProtoReflect.Descriptor instead.
+func (*SignCampaignInfo) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SignCampaignInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SignCampaignInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SignCampaignInfo) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *SignCampaignInfo) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *SignCampaignInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SignCampaignInfo) GetGradeIds() []int32 {
+	if x != nil {
+		return x.GradeIds
+	}
+	return nil
+}
+
+func (x *SignCampaignInfo) GetLevelIds() []int32 {
+	if x != nil {
+		return x.LevelIds
+	}
+	return nil
+}
+
+func (x *SignCampaignInfo) GetPlatform() int32 {
+	if x != nil {
+		return x.Platform
+	}
+	return 0
+}
+
+func (x *SignCampaignInfo) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *SignCampaignInfo) GetRewards() []*SignReward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *SignCampaignInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SignCampaignInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// 获取签到活动列表请求
+type GetSignCampaignsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status" dc:"状态 -1=全部 0=关闭 1=开启"` // 状态 -1=全部 0=关闭 1=开启
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSignCampaignsReq) Reset() {
+	*x = GetSignCampaignsReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSignCampaignsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSignCampaignsReq) ProtoMessage() {}
+
+func (x *GetSignCampaignsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSignCampaignsReq.ProtoReflect.Descriptor instead.
+func (*GetSignCampaignsReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetSignCampaignsReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 获取签到活动列表响应
+type GetSignCampaignsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*SignCampaignInfo    `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"活动列表"` // 活动列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSignCampaignsRes) Reset() {
+	*x = GetSignCampaignsRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSignCampaignsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSignCampaignsRes) ProtoMessage() {}
+
+func (x *GetSignCampaignsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSignCampaignsRes.ProtoReflect.Descriptor instead.
+func (*GetSignCampaignsRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetSignCampaignsRes) GetList() []*SignCampaignInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// 创建签到活动请求
+type CreateSignCampaignReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name" dc:"活动名称"`                                                   // 活动名称
+	StartTime     string                 `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间，格式 2006-01-02 15:04:05"` // 开始时间，格式 2006-01-02 15:04:05
+	EndTime       string                 `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间，格式 2006-01-02 15:04:05"`       // 结束时间，格式 2006-01-02 15:04:05
+	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status" dc:"状态 0=关闭 1=开启"`                                      // 状态 0=关闭 1=开启
+	GradeIds      []int32                `protobuf:"varint,5,rep,packed,name=grade_ids,json=gradeIds,proto3" json:"grade_ids" dc:"参与的会员等级，为空时不限"`          // 参与的会员等级，为空时不限
+	LevelIds      []int32                `protobuf:"varint,6,rep,packed,name=level_ids,json=levelIds,proto3" json:"level_ids" dc:"参与的会员层级，为空时不限"`          // 参与的会员层级，为空时不限
+	Platform      int32                  `protobuf:"varint,7,opt,name=platform,proto3" json:"platform" dc:"签到终端 0=所有 1=网站 2=手机"`                           // 签到终端 0=所有 1=网站 2=手机
+	Remark        string                 `protobuf:"bytes,8,opt,name=remark,proto3" json:"remark" dc:"活动描述"`                                               // 活动描述
+	Rewards       []*SignReward          `protobuf:"bytes,9,rep,name=rewards,proto3" json:"rewards" dc:"连续签到奖励"`                                           // 连续签到奖励
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSignCampaignReq) Reset() {
+	*x = CreateSignCampaignReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSignCampaignReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSignCampaignReq) ProtoMessage() {}
+
+func (x *CreateSignCampaignReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSignCampaignReq.ProtoReflect.Descriptor instead.
+func (*CreateSignCampaignReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateSignCampaignReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSignCampaignReq) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *CreateSignCampaignReq) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *CreateSignCampaignReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *CreateSignCampaignReq) GetGradeIds() []int32 {
+	if x != nil {
+		return x.GradeIds
+	}
+	return nil
+}
+
+func (x *CreateSignCampaignReq) GetLevelIds() []int32 {
+	if x != nil {
+		return x.LevelIds
+	}
+	return nil
+}
+
+func (x *CreateSignCampaignReq) GetPlatform() int32 {
+	if x != nil {
+		return x.Platform
+	}
+	return 0
+}
+
+func (x *CreateSignCampaignReq) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *CreateSignCampaignReq) GetRewards() []*SignReward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+// 创建签到活动响应
+type CreateSignCampaignRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	Id            int32                  `protobuf:"varint,3,opt,name=id,proto3" json:"id" dc:"活动ID"`           // 活动ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSignCampaignRes) Reset() {
+	*x = CreateSignCampaignRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSignCampaignRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSignCampaignRes) ProtoMessage() {}
+
+func (x *CreateSignCampaignRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSignCampaignRes.ProtoReflect.Descriptor instead.
+func (*CreateSignCampaignRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateSignCampaignRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateSignCampaignRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateSignCampaignRes) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 修改签到活动请求
+type UpdateSignCampaignReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"活动ID"`                                                      // 活动ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name" dc:"活动名称"`                                                   // 活动名称
+	StartTime     string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间，格式 2006-01-02 15:04:05"` // 开始时间，格式 2006-01-02 15:04:05
+	EndTime       string                 `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间，格式 2006-01-02 15:04:05"`       // 结束时间，格式 2006-01-02 15:04:05
+	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status" dc:"状态 0=关闭 1=开启"`                                      // 状态 0=关闭 1=开启
+	GradeIds      []int32                `protobuf:"varint,6,rep,packed,name=grade_ids,json=gradeIds,proto3" json:"grade_ids" dc:"参与的会员等级，为空时不限"`          // 参与的会员等级，为空时不限
+	LevelIds      []int32                `protobuf:"varint,7,rep,packed,name=level_ids,json=levelIds,proto3" json:"level_ids" dc:"参与的会员层级，为空时不限"`          // 参与的会员层级，为空时不限
+	Platform      int32                  `protobuf:"varint,8,opt,name=platform,proto3" json:"platform" dc:"签到终端 0=所有 1=网站 2=手机"`                           // 签到终端 0=所有 1=网站 2=手机
+	Remark        string                 `protobuf:"bytes,9,opt,name=remark,proto3" json:"remark" dc:"活动描述"`                                               // 活动描述
+	Rewards       []*SignReward          `protobuf:"bytes,10,rep,name=rewards,proto3" json:"rewards" dc:"连续签到奖励，整体替换"`                                     // 连续签到奖励，整体替换
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSignCampaignReq) Reset() {
+	*x = UpdateSignCampaignReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSignCampaignReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSignCampaignReq) ProtoMessage() {}
+
+func (x *UpdateSignCampaignReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSignCampaignReq.ProtoReflect.Descriptor instead.
+func (*UpdateSignCampaignReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateSignCampaignReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateSignCampaignReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSignCampaignReq) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *UpdateSignCampaignReq) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *UpdateSignCampaignReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *UpdateSignCampaignReq) GetGradeIds() []int32 {
+	if x != nil {
+		return x.GradeIds
+	}
+	return nil
+}
+
+func (x *UpdateSignCampaignReq) GetLevelIds() []int32 {
+	if x != nil {
+		return x.LevelIds
+	}
+	return nil
+}
+
+func (x *UpdateSignCampaignReq) GetPlatform() int32 {
+	if x != nil {
+		return x.Platform
+	}
+	return 0
+}
+
+func (x *UpdateSignCampaignReq) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *UpdateSignCampaignReq) GetRewards() []*SignReward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+// 修改签到活动响应
+type UpdateSignCampaignRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSignCampaignRes) Reset() {
+	*x = UpdateSignCampaignRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSignCampaignRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSignCampaignRes) ProtoMessage() {}
+
+func (x *UpdateSignCampaignRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSignCampaignRes.ProtoReflect.Descriptor instead.
+func (*UpdateSignCampaignRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateSignCampaignRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateSignCampaignRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 删除签到活动请求
+type DeleteSignCampaignReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"活动ID"` // 活动ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSignCampaignReq) Reset() {
+	*x = DeleteSignCampaignReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSignCampaignReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSignCampaignReq) ProtoMessage() {}
+
+func (x *DeleteSignCampaignReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSignCampaignReq.ProtoReflect.Descriptor instead.
+func (*DeleteSignCampaignReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteSignCampaignReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 删除签到活动响应
+type DeleteSignCampaignRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSignCampaignRes) Reset() {
+	*x = DeleteSignCampaignRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSignCampaignRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSignCampaignRes) ProtoMessage() {}
+
+func (x *DeleteSignCampaignRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSignCampaignRes.ProtoReflect.Descriptor instead.
+func (*DeleteSignCampaignRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteSignCampaignRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteSignCampaignRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 获取签到记录请求
+type GetSignLogsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page" dc:"页码"`                                  // 页码
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size" dc:"每页数量"`                                // 每页数量
+	SignId        int32                  `protobuf:"varint,3,opt,name=sign_id,json=signId,proto3" json:"sign_id" dc:"活动ID (可选)"`         // 活动ID (可选)
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username" dc:"会员账号 (可选)"`                    // 会员账号 (可选)
+	StartDate     string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date" dc:"开始日期 (可选)"` // 开始日期 (可选)
+	EndDate       string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date" dc:"结束日期 (可选)"`       // 结束日期 (可选)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSignLogsReq) Reset() {
+	*x = GetSignLogsReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSignLogsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSignLogsReq) ProtoMessage() {}
+
+func (x *GetSignLogsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSignLogsReq.ProtoReflect.Descriptor instead.
+func (*GetSignLogsReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetSignLogsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetSignLogsReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetSignLogsReq) GetSignId() int32 {
+	if x != nil {
+		return x.SignId
+	}
+	return 0
+}
+
+func (x *GetSignLogsReq) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetSignLogsReq) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetSignLogsReq) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// 签到记录
+type SignLogInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"记录ID"`                                          // 记录ID
+	SignId        int32                  `protobuf:"varint,2,opt,name=sign_id,json=signId,proto3" json:"sign_id" dc:"活动ID"`                    // 活动ID
+	SignName      string                 `protobuf:"bytes,3,opt,name=sign_name,json=signName,proto3" json:"sign_name" dc:"活动名称"`               // 活动名称
+	UserId        int32                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"会员ID"`                    // 会员ID
+	Username      string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username" dc:"会员账号"`                               // 会员账号
+	Platform      int32                  `protobuf:"varint,6,opt,name=platform,proto3" json:"platform" dc:"签到终端 1=网站 2=手机"`                    // 签到终端 1=网站 2=手机
+	SignDate      string                 `protobuf:"bytes,7,opt,name=sign_date,json=signDate,proto3" json:"sign_date" dc:"签到日期"`               // 签到日期
+	Streak        int32                  `protobuf:"varint,8,opt,name=streak,proto3" json:"streak" dc:"连续签到天数"`                                // 连续签到天数
+	RewardMoney   float64                `protobuf:"fixed64,9,opt,name=reward_money,json=rewardMoney,proto3" json:"reward_money" dc:"奖励彩金"`    // 奖励彩金
+	RewardPoints  int32                  `protobuf:"varint,10,opt,name=reward_points,json=rewardPoints,proto3" json:"reward_points" dc:"奖励积分"` // 奖励积分
+	TradeNo       string                 `protobuf:"bytes,11,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no" dc:"彩金流水号"`                // 彩金流水号
+	CreatedAt     string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"签到时间"`           // 签到时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignLogInfo) Reset() {
+	*x = SignLogInfo{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignLogInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignLogInfo) ProtoMessage() {}
+
+func (x *SignLogInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignLogInfo.ProtoReflect.Descriptor instead.
+func (*SignLogInfo) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SignLogInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SignLogInfo) GetSignId() int32 {
+	if x != nil {
+		return x.SignId
+	}
+	return 0
+}
+
+func (x *SignLogInfo) GetSignName() string {
+	if x != nil {
+		return x.SignName
+	}
+	return ""
+}
+
+func (x *SignLogInfo) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SignLogInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SignLogInfo) GetPlatform() int32 {
+	if x != nil {
+		return x.Platform
+	}
+	return 0
+}
+
+func (x *SignLogInfo) GetSignDate() string {
+	if x != nil {
+		return x.SignDate
+	}
+	return ""
+}
+
+func (x *SignLogInfo) GetStreak() int32 {
+	if x != nil {
+		return x.Streak
+	}
+	return 0
+}
+
+func (x *SignLogInfo) GetRewardMoney() float64 {
+	if x != nil {
+		return x.RewardMoney
+	}
+	return 0
+}
+
+func (x *SignLogInfo) GetRewardPoints() int32 {
+	if x != nil {
+		return x.RewardPoints
+	}
+	return 0
+}
+
+func (x *SignLogInfo) GetTradeNo() string {
+	if x != nil {
+		return x.TradeNo
+	}
+	return ""
+}
+
+func (x *SignLogInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 获取签到记录响应
+type GetSignLogsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*SignLogInfo         `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"签到记录"`                                          // 签到记录
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count" dc:"总数量"`                                        // 总数量
+	MoneyTotal    float64                `protobuf:"fixed64,3,opt,name=money_total,json=moneyTotal,proto3" json:"money_total" dc:"符合条件的奖励彩金总额"`   // 符合条件的奖励彩金总额
+	PointsTotal   int32                  `protobuf:"varint,4,opt,name=points_total,json=pointsTotal,proto3" json:"points_total" dc:"符合条件的奖励积分总数"` // 符合条件的奖励积分总数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSignLogsRes) Reset() {
+	*x = GetSignLogsRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSignLogsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSignLogsRes) ProtoMessage() {}
+
+func (x *GetSignLogsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSignLogsRes.ProtoReflect.Descriptor instead.
+func (*GetSignLogsRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetSignLogsRes) GetList() []*SignLogInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *GetSignLogsRes) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetSignLogsRes) GetMoneyTotal() float64 {
+	if x != nil {
+		return x.MoneyTotal
+	}
+	return 0
+}
+
+func (x *GetSignLogsRes) GetPointsTotal() int32 {
+	if x != nil {
+		return x.PointsTotal
+	}
+	return 0
+}
+
+// 获取会员签到状态请求
+type GetUserSignStatusReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"会员ID"` // 会员ID
+	Platform      int32                  `protobuf:"varint,2,opt,name=platform,proto3" json:"platform" dc:"签到终端 1=网站 2=手机"` // 签到终端 1=网站 2=手机
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserSignStatusReq) Reset() {
+	*x = GetUserSignStatusReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserSignStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserSignStatusReq) ProtoMessage() {}
+
+func (x *GetUserSignStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserSignStatusReq.ProtoReflect.Descriptor instead.
+func (*GetUserSignStatusReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetUserSignStatusReq) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserSignStatusReq) GetPlatform() int32 {
+	if x != nil {
+		return x.Platform
+	}
+	return 0
+}
+
+// 获取会员签到状态响应
+type GetUserSignStatusRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Available     bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available" dc:"是否有可参与的签到活动"`                    // 是否有可参与的签到活动
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"不可参与的原因"`                             // 不可参与的原因
+	SignId        int32                  `protobuf:"varint,3,opt,name=sign_id,json=signId,proto3" json:"sign_id" dc:"活动ID"`                   // 活动ID
+	SignName      string                 `protobuf:"bytes,4,opt,name=sign_name,json=signName,proto3" json:"sign_name" dc:"活动名称"`              // 活动名称
+	SignedToday   bool                   `protobuf:"varint,5,opt,name=signed_today,json=signedToday,proto3" json:"signed_today" dc:"今天是否已签到"` // 今天是否已签到
+	Streak        int32                  `protobuf:"varint,6,opt,name=streak,proto3" json:"streak" dc:"当前连续签到天数，未中断时计算"`                      // 当前连续签到天数，未中断时计算
+	Rewards       []*SignReward          `protobuf:"bytes,7,rep,name=rewards,proto3" json:"rewards" dc:"连续签到奖励"`                              // 连续签到奖励
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserSignStatusRes) Reset() {
+	*x = GetUserSignStatusRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserSignStatusRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserSignStatusRes) ProtoMessage() {}
+
+func (x *GetUserSignStatusRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserSignStatusRes.ProtoReflect.Descriptor instead.
+func (*GetUserSignStatusRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetUserSignStatusRes) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *GetUserSignStatusRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetUserSignStatusRes) GetSignId() int32 {
+	if x != nil {
+		return x.SignId
+	}
+	return 0
+}
+
+func (x *GetUserSignStatusRes) GetSignName() string {
+	if x != nil {
+		return x.SignName
+	}
+	return ""
+}
+
+func (x *GetUserSignStatusRes) GetSignedToday() bool {
+	if x != nil {
+		return x.SignedToday
+	}
+	return false
+}
+
+func (x *GetUserSignStatusRes) GetStreak() int32 {
+	if x != nil {
+		return x.Streak
+	}
+	return 0
+}
+
+func (x *GetUserSignStatusRes) GetRewards() []*SignReward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+// 会员签到请求
+type UserSignReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"会员ID"` // 会员ID
+	Platform      int32                  `protobuf:"varint,2,opt,name=platform,proto3" json:"platform" dc:"签到终端 1=网站 2=手机"` // 签到终端 1=网站 2=手机
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSignReq) Reset() {
+	*x = UserSignReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSignReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSignReq) ProtoMessage() {}
+
+func (x *UserSignReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSignReq.ProtoReflect.Descriptor instead.
+func (*UserSignReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UserSignReq) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserSignReq) GetPlatform() int32 {
+	if x != nil {
+		return x.Platform
+	}
+	return 0
+}
+
+// 会员签到响应
+type UserSignRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"`                                 // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`                                  // 响应消息
+	Streak        int32                  `protobuf:"varint,3,opt,name=streak,proto3" json:"streak" dc:"连续签到天数"`                                 // 连续签到天数
+	RewardMoney   float64                `protobuf:"fixed64,4,opt,name=reward_money,json=rewardMoney,proto3" json:"reward_money" dc:"本次奖励彩金"`   // 本次奖励彩金
+	RewardPoints  int32                  `protobuf:"varint,5,opt,name=reward_points,json=rewardPoints,proto3" json:"reward_points" dc:"本次奖励积分"` // 本次奖励积分
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSignRes) Reset() {
+	*x = UserSignRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSignRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSignRes) ProtoMessage() {}
+
+func (x *UserSignRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSignRes.ProtoReflect.Descriptor instead.
+func (*UserSignRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UserSignRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UserSignRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UserSignRes) GetStreak() int32 {
+	if x != nil {
+		return x.Streak
+	}
+	return 0
+}
+
+func (x *UserSignRes) GetRewardMoney() float64 {
+	if x != nil {
+		return x.RewardMoney
+	}
+	return 0
+}
+
+func (x *UserSignRes) GetRewardPoints() int32 {
+	if x != nil {
+		return x.RewardPoints
+	}
+	return 0
+}
+
+// 获取会员层级列表请求
+type GetUserLevelsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status" dc:"状态 -1=全部 0=禁用 1=可用"` // 状态 -1=全部 0=禁用 1=可用
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserLevelsReq) Reset() {
+	*x = GetUserLevelsReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserLevelsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserLevelsReq) ProtoMessage() {}
+
+func (x *GetUserLevelsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserLevelsReq.ProtoReflect.Descriptor instead.
+func (*GetUserLevelsReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetUserLevelsReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 会员层级信息
+type UserLevelInfo struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"层级ID"`                                                                      // 层级ID
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name" dc:"层级名称"`                                                                   // 层级名称
+	IsRebate           bool                   `protobuf:"varint,3,opt,name=is_rebate,json=isRebate,proto3" json:"is_rebate" dc:"是否返水"`                                          // 是否返水
+	RebateRuleId       int32                  `protobuf:"varint,4,opt,name=rebate_rule_id,json=rebateRuleId,proto3" json:"rebate_rule_id" dc:"返水规则ID"`                          // 返水规则ID
+	RebateRuleName     string                 `protobuf:"bytes,5,opt,name=rebate_rule_name,json=rebateRuleName,proto3" json:"rebate_rule_name" dc:"返水规则名称"`                     // 返水规则名称
+	DailyWithdrawTimes int32                  `protobuf:"varint,6,opt,name=daily_withdraw_times,json=dailyWithdrawTimes,proto3" json:"daily_withdraw_times" dc:"单日提款次数上限，0=不限"` // 单日提款次数上限，0=不限
+	LoginUrl           string                 `protobuf:"bytes,7,opt,name=login_url,json=loginUrl,proto3" json:"login_url" dc:"专用登录网址"`                                         // 专用登录网址
+	Status             int32                  `protobuf:"varint,8,opt,name=status,proto3" json:"status" dc:"状态 1=可用 0=禁用"`                                                      // 状态 1=可用 0=禁用
+	UserCount          int32                  `protobuf:"varint,9,opt,name=user_count,json=userCount,proto3" json:"user_count" dc:"该层级会员数量"`                                    // 该层级会员数量
+	CreatedAt          string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间"`                                       // 创建时间
+	UpdatedAt          string                 `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at" dc:"更新时间"`                                       // 更新时间
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UserLevelInfo) Reset() {
+	*x = UserLevelInfo{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserLevelInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserLevelInfo) ProtoMessage() {}
+
+func (x *UserLevelInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserLevelInfo.ProtoReflect.Descriptor instead.
+func (*UserLevelInfo) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *UserLevelInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserLevelInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserLevelInfo) GetIsRebate() bool {
+	if x != nil {
+		return x.IsRebate
+	}
+	return false
+}
+
+func (x *UserLevelInfo) GetRebateRuleId() int32 {
+	if x != nil {
+		return x.RebateRuleId
+	}
+	return 0
+}
+
+func (x *UserLevelInfo) GetRebateRuleName() string {
+	if x != nil {
+		return x.RebateRuleName
+	}
+	return ""
+}
+
+func (x *UserLevelInfo) GetDailyWithdrawTimes() int32 {
+	if x != nil {
+		return x.DailyWithdrawTimes
+	}
+	return 0
+}
+
+func (x *UserLevelInfo) GetLoginUrl() string {
+	if x != nil {
+		return x.LoginUrl
+	}
+	return ""
+}
+
+func (x *UserLevelInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *UserLevelInfo) GetUserCount() int32 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+func (x *UserLevelInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UserLevelInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// 获取会员层级列表响应
+type GetUserLevelsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*UserLevelInfo       `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"层级列表"` // 层级列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserLevelsRes) Reset() {
+	*x = GetUserLevelsRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserLevelsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserLevelsRes) ProtoMessage() {}
+
+func (x *GetUserLevelsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserLevelsRes.ProtoReflect.Descriptor instead.
+func (*GetUserLevelsRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetUserLevelsRes) GetList() []*UserLevelInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// 创建会员层级请求
+type CreateUserLevelReq struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name" dc:"层级名称"`                                                                   // 层级名称
+	IsRebate           bool                   `protobuf:"varint,2,opt,name=is_rebate,json=isRebate,proto3" json:"is_rebate" dc:"是否返水"`                                          // 是否返水
+	RebateRuleId       int32                  `protobuf:"varint,3,opt,name=rebate_rule_id,json=rebateRuleId,proto3" json:"rebate_rule_id" dc:"返水规则ID，返水时必填"`                    // 返水规则ID，返水时必填
+	DailyWithdrawTimes int32                  `protobuf:"varint,4,opt,name=daily_withdraw_times,json=dailyWithdrawTimes,proto3" json:"daily_withdraw_times" dc:"单日提款次数上限，0=不限"` // 单日提款次数上限，0=不限
+	LoginUrl           string                 `protobuf:"bytes,5,opt,name=login_url,json=loginUrl,proto3" json:"login_url" dc:"专用登录网址 (可选)"`                                    // 专用登录网址 (可选)
+	Status             int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status" dc:"状态 1=可用 0=禁用"`                                                      // 状态 1=可用 0=禁用
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateUserLevelReq) Reset() {
+	*x = CreateUserLevelReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserLevelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserLevelReq) ProtoMessage() {}
+
+func (x *CreateUserLevelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserLevelReq.ProtoReflect.Descriptor instead.
+func (*CreateUserLevelReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CreateUserLevelReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateUserLevelReq) GetIsRebate() bool {
+	if x != nil {
+		return x.IsRebate
+	}
+	return false
+}
+
+func (x *CreateUserLevelReq) GetRebateRuleId() int32 {
+	if x != nil {
+		return x.RebateRuleId
+	}
+	return 0
+}
+
+func (x *CreateUserLevelReq) GetDailyWithdrawTimes() int32 {
+	if x != nil {
+		return x.DailyWithdrawTimes
+	}
+	return 0
+}
+
+func (x *CreateUserLevelReq) GetLoginUrl() string {
+	if x != nil {
+		return x.LoginUrl
+	}
+	return ""
+}
+
+func (x *CreateUserLevelReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 创建会员层级响应
+type CreateUserLevelRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	Id            int32                  `protobuf:"varint,3,opt,name=id,proto3" json:"id" dc:"层级ID"`           // 层级ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserLevelRes) Reset() {
+	*x = CreateUserLevelRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserLevelRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserLevelRes) ProtoMessage() {}
+
+func (x *CreateUserLevelRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserLevelRes.ProtoReflect.Descriptor instead.
+func (*CreateUserLevelRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CreateUserLevelRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateUserLevelRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateUserLevelRes) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 修改会员层级请求
+type UpdateUserLevelReq struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"层级ID"`                                                                      // 层级ID
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name" dc:"层级名称"`                                                                   // 层级名称
+	IsRebate           bool                   `protobuf:"varint,3,opt,name=is_rebate,json=isRebate,proto3" json:"is_rebate" dc:"是否返水"`                                          // 是否返水
+	RebateRuleId       int32                  `protobuf:"varint,4,opt,name=rebate_rule_id,json=rebateRuleId,proto3" json:"rebate_rule_id" dc:"返水规则ID，返水时必填"`                    // 返水规则ID，返水时必填
+	DailyWithdrawTimes int32                  `protobuf:"varint,5,opt,name=daily_withdraw_times,json=dailyWithdrawTimes,proto3" json:"daily_withdraw_times" dc:"单日提款次数上限，0=不限"` // 单日提款次数上限，0=不限
+	LoginUrl           string                 `protobuf:"bytes,6,opt,name=login_url,json=loginUrl,proto3" json:"login_url" dc:"专用登录网址 (可选)"`                                    // 专用登录网址 (可选)
+	Status             int32                  `protobuf:"varint,7,opt,name=status,proto3" json:"status" dc:"状态 1=可用 0=禁用"`                                                      // 状态 1=可用 0=禁用
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateUserLevelReq) Reset() {
+	*x = UpdateUserLevelReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserLevelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserLevelReq) ProtoMessage() {}
+
+func (x *UpdateUserLevelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserLevelReq.ProtoReflect.Descriptor instead.
+func (*UpdateUserLevelReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UpdateUserLevelReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateUserLevelReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateUserLevelReq) GetIsRebate() bool {
+	if x != nil {
+		return x.IsRebate
+	}
+	return false
+}
+
+func (x *UpdateUserLevelReq) GetRebateRuleId() int32 {
+	if x != nil {
+		return x.RebateRuleId
+	}
+	return 0
+}
+
+func (x *UpdateUserLevelReq) GetDailyWithdrawTimes() int32 {
+	if x != nil {
+		return x.DailyWithdrawTimes
+	}
+	return 0
+}
+
+func (x *UpdateUserLevelReq) GetLoginUrl() string {
+	if x != nil {
+		return x.LoginUrl
+	}
+	return ""
+}
+
+func (x *UpdateUserLevelReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 修改会员层级响应
+type UpdateUserLevelRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserLevelRes) Reset() {
+	*x = UpdateUserLevelRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserLevelRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserLevelRes) ProtoMessage() {}
+
+func (x *UpdateUserLevelRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserLevelRes.ProtoReflect.Descriptor instead.
+func (*UpdateUserLevelRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UpdateUserLevelRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateUserLevelRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 删除会员层级请求
+type DeleteUserLevelReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"层级ID"`                                                      // 层级ID
+	MigrateToId   int32                  `protobuf:"varint,2,opt,name=migrate_to_id,json=migrateToId,proto3" json:"migrate_to_id" dc:"层级下还有会员时，将会员迁移到该层级"` // 层级下还有会员时，将会员迁移到该层级
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserLevelReq) Reset() {
+	*x = DeleteUserLevelReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserLevelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserLevelReq) ProtoMessage() {}
+
+func (x *DeleteUserLevelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserLevelReq.ProtoReflect.Descriptor instead.
+func (*DeleteUserLevelReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DeleteUserLevelReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteUserLevelReq) GetMigrateToId() int32 {
+	if x != nil {
+		return x.MigrateToId
+	}
+	return 0
+}
+
+// 删除会员层级响应
+type DeleteUserLevelRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"`     // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`      // 响应消息
+	Migrated      int32                  `protobuf:"varint,3,opt,name=migrated,proto3" json:"migrated" dc:"迁移的会员数"` // 迁移的会员数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserLevelRes) Reset() {
+	*x = DeleteUserLevelRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserLevelRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserLevelRes) ProtoMessage() {}
+
+func (x *DeleteUserLevelRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserLevelRes.ProtoReflect.Descriptor instead.
+func (*DeleteUserLevelRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DeleteUserLevelRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteUserLevelRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteUserLevelRes) GetMigrated() int32 {
+	if x != nil {
+		return x.Migrated
+	}
+	return 0
+}
+
+// 获取用户登录日志请求
+type GetUserLoginLogsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username" dc:"用户名 (可选)"`                     // 用户名 (可选)
+	Ip            string                 `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip" dc:"登录IP (可选)"`                                // 登录IP (可选)
+	StartTime     string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time" dc:"开始时间 (可选)"` // 开始时间 (可选)
+	EndTime       string                 `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time" dc:"结束时间 (可选)"`       // 结束时间 (可选)
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page" dc:"页码"`                                  // 页码
+	Size          int32                  `protobuf:"varint,6,opt,name=size,proto3" json:"size" dc:"每页数量"`                                // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserLoginLogsReq) Reset() {
+	*x = GetUserLoginLogsReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserLoginLogsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserLoginLogsReq) ProtoMessage() {}
+
+func (x *GetUserLoginLogsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserLoginLogsReq.ProtoReflect.Descriptor instead.
+func (*GetUserLoginLogsReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetUserLoginLogsReq) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetUserLoginLogsReq) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *GetUserLoginLogsReq) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *GetUserLoginLogsReq) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *GetUserLoginLogsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetUserLoginLogsReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// 用户登录日志信息
+type UserLoginLogInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"日志ID"`                                        // 日志ID
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"用户ID"`                  // 用户ID
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username" dc:"用户名"`                              // 用户名
+	RefererUrl    string                 `protobuf:"bytes,4,opt,name=referer_url,json=refererUrl,proto3" json:"referer_url" dc:"来源网址"`       // 来源网址
+	LoginUrl      string                 `protobuf:"bytes,5,opt,name=login_url,json=loginUrl,proto3" json:"login_url" dc:"登录网址"`             // 登录网址
+	LoginTime     string                 `protobuf:"bytes,6,opt,name=login_time,json=loginTime,proto3" json:"login_time" dc:"登录时间"`          // 登录时间
+	LoginIp       string                 `protobuf:"bytes,7,opt,name=login_ip,json=loginIp,proto3" json:"login_ip" dc:"登录IP"`                // 登录IP
+	LoginAddress  string                 `protobuf:"bytes,8,opt,name=login_address,json=loginAddress,proto3" json:"login_address" dc:"登录地址"` // 登录地址
+	Os            string                 `protobuf:"bytes,9,opt,name=os,proto3" json:"os" dc:"操作系统"`                                         // 操作系统
+	Network       string                 `protobuf:"bytes,10,opt,name=network,proto3" json:"network" dc:"网络"`                                // 网络
+	Screen        string                 `protobuf:"bytes,11,opt,name=screen,proto3" json:"screen" dc:"分辨率"`                                 // 分辨率
+	Browser       string                 `protobuf:"bytes,12,opt,name=browser,proto3" json:"browser" dc:"浏览器"`                               // 浏览器
+	Device        string                 `protobuf:"bytes,13,opt,name=device,proto3" json:"device" dc:"设备类型"`                                // 设备类型
+	IsRobot       int32                  `protobuf:"varint,14,opt,name=is_robot,json=isRobot,proto3" json:"is_robot" dc:"是否机器人"`             // 是否机器人
+	CreatedAt     string                 `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间"`         // 创建时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserLoginLogInfo) Reset() {
+	*x = UserLoginLogInfo{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserLoginLogInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserLoginLogInfo) ProtoMessage() {}
+
+func (x *UserLoginLogInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserLoginLogInfo.ProtoReflect.Descriptor instead.
+func (*UserLoginLogInfo) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UserLoginLogInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserLoginLogInfo) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserLoginLogInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserLoginLogInfo) GetRefererUrl() string {
+	if x != nil {
+		return x.RefererUrl
+	}
+	return ""
+}
+
+func (x *UserLoginLogInfo) GetLoginUrl() string {
+	if x != nil {
+		return x.LoginUrl
+	}
+	return ""
+}
+
+func (x *UserLoginLogInfo) GetLoginTime() string {
+	if x != nil {
+		return x.LoginTime
+	}
+	return ""
+}
+
+func (x *UserLoginLogInfo) GetLoginIp() string {
+	if x != nil {
+		return x.LoginIp
+	}
+	return ""
+}
+
+func (x *UserLoginLogInfo) GetLoginAddress() string {
+	if x != nil {
+		return x.LoginAddress
+	}
+	return ""
+}
+
+func (x *UserLoginLogInfo) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *UserLoginLogInfo) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *UserLoginLogInfo) GetScreen() string {
+	if x != nil {
+		return x.Screen
+	}
+	return ""
+}
+
+func (x *UserLoginLogInfo) GetBrowser() string {
+	if x != nil {
+		return x.Browser
+	}
+	return ""
+}
+
+func (x *UserLoginLogInfo) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *UserLoginLogInfo) GetIsRobot() int32 {
+	if x != nil {
+		return x.IsRobot
+	}
+	return 0
+}
+
+func (x *UserLoginLogInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 获取用户登录日志响应
+type GetUserLoginLogsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*UserLoginLogInfo    `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"登录日志列表"` // 登录日志列表
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count" dc:"总数量"` // 总数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserLoginLogsRes) Reset() {
+	*x = GetUserLoginLogsRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserLoginLogsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserLoginLogsRes) ProtoMessage() {}
+
+func (x *GetUserLoginLogsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserLoginLogsRes.ProtoReflect.Descriptor instead.
+func (*GetUserLoginLogsRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetUserLoginLogsRes) GetList() []*UserLoginLogInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *GetUserLoginLogsRes) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 会员注册请求
+type RegisterReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Username       string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username" dc:"会员账号"`                                                      // 会员账号
+	Password       string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password" dc:"登录密码"`                                                      // 登录密码
+	PayPassword    string                 `protobuf:"bytes,3,opt,name=pay_password,json=payPassword,proto3" json:"pay_password" dc:"资金密码 (按注册项设置)"`                    // 资金密码 (按注册项设置)
+	Realname       string                 `protobuf:"bytes,4,opt,name=realname,proto3" json:"realname" dc:"真实姓名 (按注册项设置)"`                                             // 真实姓名 (按注册项设置)
+	Mobile         string                 `protobuf:"bytes,5,opt,name=mobile,proto3" json:"mobile" dc:"手机号 (按注册项设置)"`                                                  // 手机号 (按注册项设置)
+	Email          string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email" dc:"邮箱 (按注册项设置)"`                                                     // 邮箱 (按注册项设置)
+	Qq             string                 `protobuf:"bytes,7,opt,name=qq,proto3" json:"qq" dc:"QQ号 (按注册项设置)"`                                                          // QQ号 (按注册项设置)
+	Birthday       string                 `protobuf:"bytes,8,opt,name=birthday,proto3" json:"birthday" dc:"生日 (按注册项设置) 格式 2006-01-02"`                                 // 生日 (按注册项设置) 格式 2006-01-02
+	Sex            int32                  `protobuf:"varint,9,opt,name=sex,proto3" json:"sex" dc:"性别 (按注册项设置) 1=男 2=女"`                                                // 性别 (按注册项设置) 1=男 2=女
+	SafeQuestion   string                 `protobuf:"bytes,10,opt,name=safe_question,json=safeQuestion,proto3" json:"safe_question" dc:"密保问题 (按注册项设置)"`                // 密保问题 (按注册项设置)
+	SafeAnswer     string                 `protobuf:"bytes,11,opt,name=safe_answer,json=safeAnswer,proto3" json:"safe_answer" dc:"密保答案 (按注册项设置)"`                      // 密保答案 (按注册项设置)
+	AgentUsername  string                 `protobuf:"bytes,12,opt,name=agent_username,json=agentUsername,proto3" json:"agent_username" dc:"推荐代理账号 (按注册项设置)，为空时使用默认代理"` // 推荐代理账号 (按注册项设置)，为空时使用默认代理
+	RegisterUrl    string                 `protobuf:"bytes,13,opt,name=register_url,json=registerUrl,proto3" json:"register_url" dc:"注册来源地址"`                          // 注册来源地址
+	RegisterDevice int32                  `protobuf:"varint,14,opt,name=register_device,json=registerDevice,proto3" json:"register_device" dc:"注册设备 1=电脑 2=手机 3=平板"`   // 注册设备 1=电脑 2=手机 3=平板
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RegisterReq) Reset() {
+	*x = RegisterReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterReq) ProtoMessage() {}
+
+func (x *RegisterReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterReq.ProtoReflect.Descriptor instead.
+func (*RegisterReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *RegisterReq) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RegisterReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *RegisterReq) GetPayPassword() string {
+	if x != nil {
+		return x.PayPassword
+	}
+	return ""
+}
+
+func (x *RegisterReq) GetRealname() string {
+	if x != nil {
+		return x.Realname
+	}
+	return ""
+}
+
+func (x *RegisterReq) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *RegisterReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RegisterReq) GetQq() string {
+	if x != nil {
+		return x.Qq
+	}
+	return ""
+}
+
+func (x *RegisterReq) GetBirthday() string {
+	if x != nil {
+		return x.Birthday
+	}
+	return ""
+}
+
+func (x *RegisterReq) GetSex() int32 {
+	if x != nil {
+		return x.Sex
+	}
+	return 0
+}
+
+func (x *RegisterReq) GetSafeQuestion() string {
+	if x != nil {
+		return x.SafeQuestion
+	}
+	return ""
+}
+
+func (x *RegisterReq) GetSafeAnswer() string {
+	if x != nil {
+		return x.SafeAnswer
+	}
+	return ""
+}
+
+func (x *RegisterReq) GetAgentUsername() string {
+	if x != nil {
+		return x.AgentUsername
+	}
+	return ""
+}
+
+func (x *RegisterReq) GetRegisterUrl() string {
+	if x != nil {
+		return x.RegisterUrl
+	}
+	return ""
+}
+
+func (x *RegisterReq) GetRegisterDevice() int32 {
+	if x != nil {
+		return x.RegisterDevice
+	}
+	return 0
+}
+
+// 会员注册响应
+type RegisterRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"`             // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`              // 响应消息
+	UserId        int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"会员ID"` // 会员ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterRes) Reset() {
+	*x = RegisterRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterRes) ProtoMessage() {}
+
+func (x *RegisterRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterRes.ProtoReflect.Descriptor instead.
+func (*RegisterRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RegisterRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RegisterRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RegisterRes) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 会员登录请求
+type LoginReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username" dc:"会员账号"`                       // 会员账号
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password" dc:"登录密码"`                       // 登录密码
+	LoginUrl      string                 `protobuf:"bytes,3,opt,name=login_url,json=loginUrl,proto3" json:"login_url" dc:"登录网址"`       // 登录网址
+	RefererUrl    string                 `protobuf:"bytes,4,opt,name=referer_url,json=refererUrl,proto3" json:"referer_url" dc:"来源网址"` // 来源网址
+	Os            string                 `protobuf:"bytes,5,opt,name=os,proto3" json:"os" dc:"操作系统"`                                   // 操作系统
+	Browser       string                 `protobuf:"bytes,6,opt,name=browser,proto3" json:"browser" dc:"浏览器"`                          // 浏览器
+	Screen        string                 `protobuf:"bytes,7,opt,name=screen,proto3" json:"screen" dc:"分辨率"`                            // 分辨率
+	Network       string                 `protobuf:"bytes,8,opt,name=network,proto3" json:"network" dc:"网络"`                           // 网络
+	Device        int32                  `protobuf:"varint,9,opt,name=device,proto3" json:"device" dc:"终端 1=电脑 2=手机 3=平板"`             // 终端 1=电脑 2=手机 3=平板
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginReq) Reset() {
+	*x = LoginReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginReq) ProtoMessage() {}
+
+func (x *LoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginReq.ProtoReflect.Descriptor instead.
+func (*LoginReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *LoginReq) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LoginReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *LoginReq) GetLoginUrl() string {
+	if x != nil {
+		return x.LoginUrl
+	}
+	return ""
+}
+
+func (x *LoginReq) GetRefererUrl() string {
+	if x != nil {
+		return x.RefererUrl
+	}
+	return ""
+}
+
+func (x *LoginReq) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *LoginReq) GetBrowser() string {
+	if x != nil {
+		return x.Browser
+	}
+	return ""
+}
+
+func (x *LoginReq) GetScreen() string {
+	if x != nil {
+		return x.Screen
+	}
+	return ""
+}
+
+func (x *LoginReq) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *LoginReq) GetDevice() int32 {
+	if x != nil {
+		return x.Device
+	}
+	return 0
+}
+
+// 会员登录响应
+type LoginRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"`             // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`              // 响应消息
+	UserId        int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"会员ID"` // 会员ID
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username" dc:"会员账号"`            // 会员账号
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginRes) Reset() {
+	*x = LoginRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRes) ProtoMessage() {}
+
+func (x *LoginRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRes.ProtoReflect.Descriptor instead.
+func (*LoginRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *LoginRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LoginRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LoginRes) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LoginRes) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// 获取会员银行卡请求
+type GetUserBanksReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"会员ID"` // 会员ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserBanksReq) Reset() {
+	*x = GetUserBanksReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserBanksReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserBanksReq) ProtoMessage() {}
+
+func (x *GetUserBanksReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserBanksReq.ProtoReflect.Descriptor instead.
+func (*GetUserBanksReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetUserBanksReq) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 会员银行卡信息
+type UserBankInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"银行卡ID"`                                   // 银行卡ID
+	BankName      string                 `protobuf:"bytes,2,opt,name=bank_name,json=bankName,proto3" json:"bank_name" dc:"银行名称"`         // 银行名称
+	CardAccount   string                 `protobuf:"bytes,3,opt,name=card_account,json=cardAccount,proto3" json:"card_account" dc:"开户人"` // 开户人
+	CardNo        string                 `protobuf:"bytes,4,opt,name=card_no,json=cardNo,proto3" json:"card_no" dc:"银行卡号 (脱敏)"`          // 银行卡号 (脱敏)
+	DepositBank   string                 `protobuf:"bytes,5,opt,name=deposit_bank,json=depositBank,proto3" json:"deposit_bank" dc:"开户行"` // 开户行
+	IsDefault     bool                   `protobuf:"varint,6,opt,name=is_default,json=isDefault,proto3" json:"is_default" dc:"是否默认"`     // 是否默认
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间"`      // 创建时间
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at" dc:"更新时间"`      // 更新时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserBankInfo) Reset() {
+	*x = UserBankInfo{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserBankInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserBankInfo) ProtoMessage() {}
+
+func (x *UserBankInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserBankInfo.ProtoReflect.Descriptor instead.
+func (*UserBankInfo) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *UserBankInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserBankInfo) GetBankName() string {
+	if x != nil {
+		return x.BankName
+	}
+	return ""
+}
+
+func (x *UserBankInfo) GetCardAccount() string {
+	if x != nil {
+		return x.CardAccount
+	}
+	return ""
+}
+
+func (x *UserBankInfo) GetCardNo() string {
+	if x != nil {
+		return x.CardNo
+	}
+	return ""
+}
+
+func (x *UserBankInfo) GetDepositBank() string {
+	if x != nil {
+		return x.DepositBank
+	}
+	return ""
+}
+
+func (x *UserBankInfo) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *UserBankInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UserBankInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// 获取会员银行卡响应
+type GetUserBanksRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*UserBankInfo        `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"银行卡列表，默认卡在前"` // 银行卡列表，默认卡在前
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserBanksRes) Reset() {
+	*x = GetUserBanksRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserBanksRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserBanksRes) ProtoMessage() {}
+
+func (x *GetUserBanksRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserBanksRes.ProtoReflect.Descriptor instead.
+func (*GetUserBanksRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetUserBanksRes) GetList() []*UserBankInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// 添加会员银行卡请求
+type CreateUserBankReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"会员ID"`                            // 会员ID
+	BankName      string                 `protobuf:"bytes,2,opt,name=bank_name,json=bankName,proto3" json:"bank_name" dc:"银行名称"`                       // 银行名称
+	CardAccount   string                 `protobuf:"bytes,3,opt,name=card_account,json=cardAccount,proto3" json:"card_account" dc:"开户人，会员已填写真实姓名时须一致"` // 开户人，会员已填写真实姓名时须一致
+	CardNo        string                 `protobuf:"bytes,4,opt,name=card_no,json=cardNo,proto3" json:"card_no" dc:"银行卡号"`                             // 银行卡号
+	DepositBank   string                 `protobuf:"bytes,5,opt,name=deposit_bank,json=depositBank,proto3" json:"deposit_bank" dc:"开户行"`               // 开户行
+	IsDefault     bool                   `protobuf:"varint,6,opt,name=is_default,json=isDefault,proto3" json:"is_default" dc:"是否设为默认，会员的第一张卡自动设为默认"`   // 是否设为默认，会员的第一张卡自动设为默认
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserBankReq) Reset() {
+	*x = CreateUserBankReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserBankReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserBankReq) ProtoMessage() {}
+
+func (x *CreateUserBankReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserBankReq.ProtoReflect.Descriptor instead.
+func (*CreateUserBankReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CreateUserBankReq) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateUserBankReq) GetBankName() string {
+	if x != nil {
+		return x.BankName
+	}
+	return ""
+}
+
+func (x *CreateUserBankReq) GetCardAccount() string {
+	if x != nil {
+		return x.CardAccount
+	}
+	return ""
+}
+
+func (x *CreateUserBankReq) GetCardNo() string {
+	if x != nil {
+		return x.CardNo
+	}
+	return ""
+}
+
+func (x *CreateUserBankReq) GetDepositBank() string {
+	if x != nil {
+		return x.DepositBank
+	}
+	return ""
+}
+
+func (x *CreateUserBankReq) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+// 添加会员银行卡响应
+type CreateUserBankRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	Id            int32                  `protobuf:"varint,3,opt,name=id,proto3" json:"id" dc:"银行卡ID"`          // 银行卡ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserBankRes) Reset() {
+	*x = CreateUserBankRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserBankRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserBankRes) ProtoMessage() {}
+
+func (x *CreateUserBankRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserBankRes.ProtoReflect.Descriptor instead.
+func (*CreateUserBankRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CreateUserBankRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateUserBankRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateUserBankRes) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 修改会员银行卡请求
+type UpdateUserBankReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"银行卡ID"`                                   // 银行卡ID
+	BankName      string                 `protobuf:"bytes,2,opt,name=bank_name,json=bankName,proto3" json:"bank_name" dc:"银行名称"`         // 银行名称
+	CardAccount   string                 `protobuf:"bytes,3,opt,name=card_account,json=cardAccount,proto3" json:"card_account" dc:"开户人"` // 开户人
+	CardNo        string                 `protobuf:"bytes,4,opt,name=card_no,json=cardNo,proto3" json:"card_no" dc:"银行卡号，为空或传入脱敏值时不修改"`  // 银行卡号，为空或传入脱敏值时不修改
+	DepositBank   string                 `protobuf:"bytes,5,opt,name=deposit_bank,json=depositBank,proto3" json:"deposit_bank" dc:"开户行"` // 开户行
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserBankReq) Reset() {
+	*x = UpdateUserBankReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserBankReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserBankReq) ProtoMessage() {}
+
+func (x *UpdateUserBankReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserBankReq.ProtoReflect.Descriptor instead.
+func (*UpdateUserBankReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *UpdateUserBankReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateUserBankReq) GetBankName() string {
+	if x != nil {
+		return x.BankName
+	}
+	return ""
+}
+
+func (x *UpdateUserBankReq) GetCardAccount() string {
+	if x != nil {
+		return x.CardAccount
+	}
+	return ""
+}
+
+func (x *UpdateUserBankReq) GetCardNo() string {
+	if x != nil {
+		return x.CardNo
+	}
+	return ""
+}
+
+func (x *UpdateUserBankReq) GetDepositBank() string {
+	if x != nil {
+		return x.DepositBank
+	}
+	return ""
+}
+
+// 修改会员银行卡响应
+type UpdateUserBankRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserBankRes) Reset() {
+	*x = UpdateUserBankRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserBankRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserBankRes) ProtoMessage() {}
+
+func (x *UpdateUserBankRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserBankRes.ProtoReflect.Descriptor instead.
+func (*UpdateUserBankRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UpdateUserBankRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateUserBankRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 设置默认银行卡请求
+type SetDefaultUserBankReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"银行卡ID"` // 银行卡ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDefaultUserBankReq) Reset() {
+	*x = SetDefaultUserBankReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultUserBankReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultUserBankReq) ProtoMessage() {}
+
+func (x *SetDefaultUserBankReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultUserBankReq.ProtoReflect.Descriptor instead.
+func (*SetDefaultUserBankReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SetDefaultUserBankReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 设置默认银行卡响应
+type SetDefaultUserBankRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDefaultUserBankRes) Reset() {
+	*x = SetDefaultUserBankRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultUserBankRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultUserBankRes) ProtoMessage() {}
+
+func (x *SetDefaultUserBankRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultUserBankRes.ProtoReflect.Descriptor instead.
+func (*SetDefaultUserBankRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SetDefaultUserBankRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetDefaultUserBankRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 删除会员银行卡请求
+type DeleteUserBankReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"银行卡ID"` // 银行卡ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserBankReq) Reset() {
+	*x = DeleteUserBankReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserBankReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserBankReq) ProtoMessage() {}
+
+func (x *DeleteUserBankReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserBankReq.ProtoReflect.Descriptor instead.
+func (*DeleteUserBankReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DeleteUserBankReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 删除会员银行卡响应
+type DeleteUserBankRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success" dc:"是否成功"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message" dc:"响应消息"`  // 响应消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserBankRes) Reset() {
+	*x = DeleteUserBankRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserBankRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserBankRes) ProtoMessage() {}
+
+func (x *DeleteUserBankRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1620,90 +6859,118 @@ func (x *GetUserLoginLogsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserLoginLogsReq.ProtoReflect.Descriptor instead.
-func (*GetUserLoginLogsReq) Descriptor() ([]byte, []int) {
-	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use DeleteUserBankRes.ProtoReflect.Descriptor instead.
+func (*DeleteUserBankRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *GetUserLoginLogsReq) GetUsername() string {
+func (x *DeleteUserBankRes) GetSuccess() bool {
 	if x != nil {
-		return x.Username
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *GetUserLoginLogsReq) GetIp() string {
+func (x *DeleteUserBankRes) GetMessage() string {
 	if x != nil {
-		return x.Ip
+		return x.Message
 	}
 	return ""
 }
 
-func (x *GetUserLoginLogsReq) GetStartTime() string {
+// 获取银行卡变更记录请求
+type GetUserBankLogsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"会员ID"` // 会员ID
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page" dc:"页码"`                     // 页码
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size" dc:"每页数量"`                   // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserBankLogsReq) Reset() {
+	*x = GetUserBankLogsReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserBankLogsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserBankLogsReq) ProtoMessage() {}
+
+func (x *GetUserBankLogsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[88]
 	if x != nil {
-		return x.StartTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetUserLoginLogsReq) GetEndTime() string {
+// Deprecated: Use GetUserBankLogsReq.ProtoReflect.Descriptor instead.
+func (*GetUserBankLogsReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetUserBankLogsReq) GetUserId() int32 {
 	if x != nil {
-		return x.EndTime
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetUserLoginLogsReq) GetPage() int32 {
+func (x *GetUserBankLogsReq) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *GetUserLoginLogsReq) GetSize() int32 {
+func (x *GetUserBankLogsReq) GetSize() int32 {
 	if x != nil {
 		return x.Size
 	}
 	return 0
 }
 
-// 用户登录日志信息
-type UserLoginLogInfo struct {
+// 银行卡变更记录
+type UserBankLogInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"日志ID"`                                        // 日志ID
-	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id" dc:"用户ID"`                  // 用户ID
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username" dc:"用户名"`                              // 用户名
-	RefererUrl    string                 `protobuf:"bytes,4,opt,name=referer_url,json=refererUrl,proto3" json:"referer_url" dc:"来源网址"`       // 来源网址
-	LoginUrl      string                 `protobuf:"bytes,5,opt,name=login_url,json=loginUrl,proto3" json:"login_url" dc:"登录网址"`             // 登录网址
-	LoginTime     string                 `protobuf:"bytes,6,opt,name=login_time,json=loginTime,proto3" json:"login_time" dc:"登录时间"`          // 登录时间
-	LoginIp       string                 `protobuf:"bytes,7,opt,name=login_ip,json=loginIp,proto3" json:"login_ip" dc:"登录IP"`                // 登录IP
-	LoginAddress  string                 `protobuf:"bytes,8,opt,name=login_address,json=loginAddress,proto3" json:"login_address" dc:"登录地址"` // 登录地址
-	Os            string                 `protobuf:"bytes,9,opt,name=os,proto3" json:"os" dc:"操作系统"`                                         // 操作系统
-	Network       string                 `protobuf:"bytes,10,opt,name=network,proto3" json:"network" dc:"网络"`                                // 网络
-	Screen        string                 `protobuf:"bytes,11,opt,name=screen,proto3" json:"screen" dc:"分辨率"`                                 // 分辨率
-	Browser       string                 `protobuf:"bytes,12,opt,name=browser,proto3" json:"browser" dc:"浏览器"`                               // 浏览器
-	Device        string                 `protobuf:"bytes,13,opt,name=device,proto3" json:"device" dc:"设备类型"`                                // 设备类型
-	IsRobot       int32                  `protobuf:"varint,14,opt,name=is_robot,json=isRobot,proto3" json:"is_robot" dc:"是否机器人"`             // 是否机器人
-	CreatedAt     string                 `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"创建时间"`         // 创建时间
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id" dc:"记录ID"`                                       // 记录ID
+	BankId        int32                  `protobuf:"varint,2,opt,name=bank_id,json=bankId,proto3" json:"bank_id" dc:"银行卡ID"`                // 银行卡ID
+	Action        int32                  `protobuf:"varint,3,opt,name=action,proto3" json:"action" dc:"操作 1=添加 2=修改 3=设为默认 4=删除"`           // 操作 1=添加 2=修改 3=设为默认 4=删除
+	ActionName    string                 `protobuf:"bytes,4,opt,name=action_name,json=actionName,proto3" json:"action_name" dc:"操作名称"`      // 操作名称
+	OldCardNo     string                 `protobuf:"bytes,5,opt,name=old_card_no,json=oldCardNo,proto3" json:"old_card_no" dc:"修改前卡号 (脱敏)"` // 修改前卡号 (脱敏)
+	NewCardNo     string                 `protobuf:"bytes,6,opt,name=new_card_no,json=newCardNo,proto3" json:"new_card_no" dc:"修改后卡号 (脱敏)"` // 修改后卡号 (脱敏)
+	Content       string                 `protobuf:"bytes,7,opt,name=content,proto3" json:"content" dc:"变更内容"`                              // 变更内容
+	AdminName     string                 `protobuf:"bytes,8,opt,name=admin_name,json=adminName,proto3" json:"admin_name" dc:"操作管理员"`        // 操作管理员
+	Ip            string                 `protobuf:"bytes,9,opt,name=ip,proto3" json:"ip" dc:"操作IP"`                                        // 操作IP
+	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at" dc:"操作时间"`        // 操作时间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserLoginLogInfo) Reset() {
-	*x = UserLoginLogInfo{}
-	mi := &file_backend_user_v1_user_proto_msgTypes[17]
+func (x *UserBankLogInfo) Reset() {
+	*x = UserBankLogInfo{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserLoginLogInfo) String() string {
+func (x *UserBankLogInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserLoginLogInfo) ProtoMessage() {}
+func (*UserBankLogInfo) ProtoMessage() {}
 
-func (x *UserLoginLogInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_user_v1_user_proto_msgTypes[17]
+func (x *UserBankLogInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1714,140 +6981,214 @@ func (x *UserLoginLogInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserLoginLogInfo.ProtoReflect.Descriptor instead.
-func (*UserLoginLogInfo) Descriptor() ([]byte, []int) {
-	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use UserBankLogInfo.ProtoReflect.Descriptor instead.
+func (*UserBankLogInfo) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *UserLoginLogInfo) GetId() int32 {
+func (x *UserBankLogInfo) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *UserLoginLogInfo) GetUserId() int32 {
+func (x *UserBankLogInfo) GetBankId() int32 {
 	if x != nil {
-		return x.UserId
+		return x.BankId
 	}
 	return 0
 }
 
-func (x *UserLoginLogInfo) GetUsername() string {
+func (x *UserBankLogInfo) GetAction() int32 {
 	if x != nil {
-		return x.Username
+		return x.Action
 	}
-	return ""
+	return 0
 }
 
-func (x *UserLoginLogInfo) GetRefererUrl() string {
+func (x *UserBankLogInfo) GetActionName() string {
 	if x != nil {
-		return x.RefererUrl
+		return x.ActionName
 	}
 	return ""
 }
 
-func (x *UserLoginLogInfo) GetLoginUrl() string {
+func (x *UserBankLogInfo) GetOldCardNo() string {
 	if x != nil {
-		return x.LoginUrl
+		return x.OldCardNo
 	}
 	return ""
 }
 
-func (x *UserLoginLogInfo) GetLoginTime() string {
+func (x *UserBankLogInfo) GetNewCardNo() string {
 	if x != nil {
-		return x.LoginTime
+		return x.NewCardNo
 	}
 	return ""
 }
 
-func (x *UserLoginLogInfo) GetLoginIp() string {
+func (x *UserBankLogInfo) GetContent() string {
 	if x != nil {
-		return x.LoginIp
+		return x.Content
 	}
 	return ""
 }
 
-func (x *UserLoginLogInfo) GetLoginAddress() string {
+func (x *UserBankLogInfo) GetAdminName() string {
 	if x != nil {
-		return x.LoginAddress
+		return x.AdminName
 	}
 	return ""
 }
 
-func (x *UserLoginLogInfo) GetOs() string {
+func (x *UserBankLogInfo) GetIp() string {
 	if x != nil {
-		return x.Os
+		return x.Ip
 	}
 	return ""
 }
 
-func (x *UserLoginLogInfo) GetNetwork() string {
+func (x *UserBankLogInfo) GetCreatedAt() string {
 	if x != nil {
-		return x.Network
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *UserLoginLogInfo) GetScreen() string {
+// 获取银行卡变更记录响应
+type GetUserBankLogsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*UserBankLogInfo     `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"变更记录"`   // 变更记录
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count" dc:"总数量"` // 总数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserBankLogsRes) Reset() {
+	*x = GetUserBankLogsRes{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserBankLogsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserBankLogsRes) ProtoMessage() {}
+
+func (x *GetUserBankLogsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[90]
 	if x != nil {
-		return x.Screen
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserLoginLogInfo) GetBrowser() string {
+// Deprecated: Use GetUserBankLogsRes.ProtoReflect.Descriptor instead.
+func (*GetUserBankLogsRes) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetUserBankLogsRes) GetList() []*UserBankLogInfo {
 	if x != nil {
-		return x.Browser
+		return x.List
 	}
-	return ""
+	return nil
 }
 
-func (x *UserLoginLogInfo) GetDevice() string {
+func (x *GetUserBankLogsRes) GetCount() int32 {
 	if x != nil {
-		return x.Device
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *UserLoginLogInfo) GetIsRobot() int32 {
+// 导出会员列表请求
+type ExportUserListReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *GetUserListReq        `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter" dc:"筛选条件，与会员列表相同，分页参数无效"` // 筛选条件，与会员列表相同，分页参数无效
+	Format        int32                  `protobuf:"varint,2,opt,name=format,proto3" json:"format" dc:"导出格式 1=CSV 2=XLSX"`  // 导出格式 1=CSV 2=XLSX
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserListReq) Reset() {
+	*x = ExportUserListReq{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserListReq) ProtoMessage() {}
+
+func (x *ExportUserListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[91]
 	if x != nil {
-		return x.IsRobot
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UserLoginLogInfo) GetCreatedAt() string {
+// Deprecated: Use ExportUserListReq.ProtoReflect.Descriptor instead.
+func (*ExportUserListReq) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ExportUserListReq) GetFilter() *GetUserListReq {
 	if x != nil {
-		return x.CreatedAt
+		return x.Filter
 	}
-	return ""
+	return nil
 }
 
-// 获取用户登录日志响应
-type GetUserLoginLogsRes struct {
+func (x *ExportUserListReq) GetFormat() int32 {
+	if x != nil {
+		return x.Format
+	}
+	return 0
+}
+
+// 导出文件分块，按顺序拼接即为完整文件
+type ExportUserListChunk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	List          []*UserLoginLogInfo    `protobuf:"bytes,1,rep,name=list,proto3" json:"list" dc:"登录日志列表"` // 登录日志列表
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count" dc:"总数量"` // 总数量
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename" dc:"文件名，仅第一块返回"`                           // 文件名，仅第一块返回
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type" dc:"文件类型，仅第一块返回"` // 文件类型，仅第一块返回
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data" dc:"文件内容"`                                         // 文件内容
+	Done          bool                   `protobuf:"varint,4,opt,name=done,proto3" json:"done" dc:"是否最后一块"`                                      // 是否最后一块
+	Count         int32                  `protobuf:"varint,5,opt,name=count,proto3" json:"count" dc:"导出的会员数，仅最后一块返回"`                            // 导出的会员数，仅最后一块返回
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserLoginLogsRes) Reset() {
-	*x = GetUserLoginLogsRes{}
-	mi := &file_backend_user_v1_user_proto_msgTypes[18]
+func (x *ExportUserListChunk) Reset() {
+	*x = ExportUserListChunk{}
+	mi := &file_backend_user_v1_user_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserLoginLogsRes) String() string {
+func (x *ExportUserListChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserLoginLogsRes) ProtoMessage() {}
+func (*ExportUserListChunk) ProtoMessage() {}
 
-func (x *GetUserLoginLogsRes) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_user_v1_user_proto_msgTypes[18]
+func (x *ExportUserListChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_user_v1_user_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1858,19 +7199,40 @@ func (x *GetUserLoginLogsRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserLoginLogsRes.ProtoReflect.Descriptor instead.
-func (*GetUserLoginLogsRes) Descriptor() ([]byte, []int) {
-	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ExportUserListChunk.ProtoReflect.Descriptor instead.
+func (*ExportUserListChunk) Descriptor() ([]byte, []int) {
+	return file_backend_user_v1_user_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *GetUserLoginLogsRes) GetList() []*UserLoginLogInfo {
+func (x *ExportUserListChunk) GetFilename() string {
 	if x != nil {
-		return x.List
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportUserListChunk) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportUserListChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
 	}
 	return nil
 }
 
-func (x *GetUserLoginLogsRes) GetCount() int32 {
+func (x *ExportUserListChunk) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *ExportUserListChunk) GetCount() int32 {
 	if x != nil {
 		return x.Count
 	}
@@ -1961,7 +7323,31 @@ const file_backend_user_v1_user_proto_rawDesc = "" +
 	"\x06remark\x18\x10 \x01(\tR\x06remark\"C\n" +
 	"\rUpdateUserRes\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"%\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xaa\x02\n" +
+	"\x13BatchUpdateUsersReq\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x05R\x03ids\x12,\n" +
+	"\x06filter\x18\x02 \x01(\v2\x14.user.GetUserListReqR\x06filter\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\x05R\x06status\x12\x19\n" +
+	"\bgrade_id\x18\x04 \x01(\x05R\agradeId\x12\x19\n" +
+	"\blevel_id\x18\x05 \x01(\x05R\alevelId\x12%\n" +
+	"\x0eagent_username\x18\x06 \x01(\tR\ragentUsername\x12\x1f\n" +
+	"\vfocus_level\x18\a \x01(\x05R\n" +
+	"focusLevel\x12%\n" +
+	"\x0ebalance_status\x18\b \x01(\x05R\rbalanceStatus\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\"w\n" +
+	"\x15BatchUpdateUserResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xe6\x01\n" +
+	"\x13BatchUpdateUsersRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x18\n" +
+	"\aupdated\x18\x04 \x01(\x05R\aupdated\x12\x1c\n" +
+	"\tunchanged\x18\x05 \x01(\x05R\tunchanged\x12\x16\n" +
+	"\x06failed\x18\x06 \x01(\x05R\x06failed\x125\n" +
+	"\aresults\x18\a \x03(\v2\x1b.user.BatchUpdateUserResultR\aresults\"%\n" +
 	"\x13GetUserBasicInfoReq\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"\xe8\x05\n" +
 	"\rUserBasicInfo\x12\x0e\n" +
@@ -2039,7 +7425,316 @@ const file_backend_user_v1_user_proto_rawDesc = "" +
 	"\x02id\x18\x02 \x01(\x05R\x02id\"C\n" +
 	"\x13DeleteUserGradesRes\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xa3\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"4\n" +
+	"\x17PreviewGradeUpgradesReq\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x05R\auserIds\"\x85\x02\n" +
+	"\x10GradeUpgradeItem\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x16\n" +
+	"\x06points\x18\x03 \x01(\x05R\x06points\x12\"\n" +
+	"\rfrom_grade_id\x18\x04 \x01(\x05R\vfromGradeId\x12&\n" +
+	"\x0ffrom_grade_name\x18\x05 \x01(\tR\rfromGradeName\x12\x1e\n" +
+	"\vto_grade_id\x18\x06 \x01(\x05R\ttoGradeId\x12\"\n" +
+	"\rto_grade_name\x18\a \x01(\tR\vtoGradeName\x12\x14\n" +
+	"\x05bonus\x18\b \x01(\x01R\x05bonus\"|\n" +
+	"\x17PreviewGradeUpgradesRes\x12*\n" +
+	"\x04list\x18\x01 \x03(\v2\x16.user.GradeUpgradeItemR\x04list\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x1f\n" +
+	"\vbonus_total\x18\x03 \x01(\x01R\n" +
+	"bonusTotal\"0\n" +
+	"\x13RunGradeUpgradesReq\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x05R\auserIds\"\x86\x01\n" +
+	"\x13RunGradeUpgradesRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
+	"\bupgraded\x18\x03 \x01(\x05R\bupgraded\x12\x1f\n" +
+	"\vbonus_total\x18\x04 \x01(\x01R\n" +
+	"bonusTotal\"\x93\x01\n" +
+	"\x13GetUserGradeLogsReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x05 \x01(\tR\aendTime\"\x8a\x03\n" +
+	"\x10UserGradeLogInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\"\n" +
+	"\rfrom_grade_id\x18\x04 \x01(\x05R\vfromGradeId\x12&\n" +
+	"\x0ffrom_grade_name\x18\x05 \x01(\tR\rfromGradeName\x12\x1e\n" +
+	"\vto_grade_id\x18\x06 \x01(\x05R\ttoGradeId\x12\"\n" +
+	"\rto_grade_name\x18\a \x01(\tR\vtoGradeName\x12\x16\n" +
+	"\x06points\x18\b \x01(\x05R\x06points\x12\x14\n" +
+	"\x05bonus\x18\t \x01(\x01R\x05bonus\x12!\n" +
+	"\fbonus_status\x18\n" +
+	" \x01(\x05R\vbonusStatus\x12\x19\n" +
+	"\btrade_no\x18\v \x01(\tR\atradeNo\x12\x16\n" +
+	"\x06remark\x18\f \x01(\tR\x06remark\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\tR\tcreatedAt\"W\n" +
+	"\x13GetUserGradeLogsRes\x12*\n" +
+	"\x04list\x18\x01 \x03(\v2\x16.user.UserGradeLogInfoR\x04list\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"+\n" +
+	"\x15RunBirthdayBonusesReq\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\"\x80\x01\n" +
+	"\x15RunBirthdayBonusesRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04paid\x18\x03 \x01(\x05R\x04paid\x12\x1f\n" +
+	"\vmoney_total\x18\x04 \x01(\x01R\n" +
+	"moneyTotal\"\xa9\x01\n" +
+	"\x15GetBirthdayBonusesReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x12\n" +
+	"\x04year\x18\x03 \x01(\x05R\x04year\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x06 \x01(\tR\aendTime\"\xf6\x01\n" +
+	"\x11BirthdayBonusInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x19\n" +
+	"\bgrade_id\x18\x04 \x01(\x05R\agradeId\x12\x1d\n" +
+	"\n" +
+	"grade_name\x18\x05 \x01(\tR\tgradeName\x12\x12\n" +
+	"\x04year\x18\x06 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05money\x18\a \x01(\x01R\x05money\x12\x19\n" +
+	"\btrade_no\x18\b \x01(\tR\atradeNo\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\"{\n" +
+	"\x15GetBirthdayBonusesRes\x12+\n" +
+	"\x04list\x18\x01 \x03(\v2\x17.user.BirthdayBonusInfoR\x04list\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x1f\n" +
+	"\vmoney_total\x18\x03 \x01(\x01R\n" +
+	"moneyTotal\"\x95\x03\n" +
+	"\rPointsSetting\x12,\n" +
+	"\x12switch_site_points\x18\x01 \x01(\x05R\x10switchSitePoints\x124\n" +
+	"\x16switch_recharge_points\x18\x02 \x01(\x05R\x14switchRechargePoints\x120\n" +
+	"\x14each_recharge_amount\x18\x03 \x01(\x01R\x12eachRechargeAmount\x120\n" +
+	"\x14each_recharge_points\x18\x04 \x01(\x05R\x12eachRechargePoints\x122\n" +
+	"\x15switch_betting_points\x18\x05 \x01(\x05R\x13switchBettingPoints\x12.\n" +
+	"\x13each_betting_amount\x18\x06 \x01(\x01R\x11eachBettingAmount\x12.\n" +
+	"\x13each_betting_points\x18\a \x01(\x05R\x11eachBettingPoints\x12(\n" +
+	"\x10max_daily_points\x18\b \x01(\x05R\x0emaxDailyPoints\"\x15\n" +
+	"\x13GetPointsSettingReq\"D\n" +
+	"\x13GetPointsSettingRes\x12-\n" +
+	"\asetting\x18\x01 \x01(\v2\x13.user.PointsSettingR\asetting\"G\n" +
+	"\x16UpdatePointsSettingReq\x12-\n" +
+	"\asetting\x18\x01 \x01(\v2\x13.user.PointsSettingR\asetting\"L\n" +
+	"\x16UpdatePointsSettingRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xc5\x01\n" +
+	"\x14GetUserPointsLogsReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x05R\x06userId\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\x05R\x06source\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\a \x01(\tR\aendTime\"\xf1\x02\n" +
+	"\x11UserPointsLogInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\x05R\x06source\x12\x1f\n" +
+	"\vchange_type\x18\x05 \x01(\x05R\n" +
+	"changeType\x12\x16\n" +
+	"\x06points\x18\x06 \x01(\x05R\x06points\x12\x1d\n" +
+	"\n" +
+	"points_old\x18\a \x01(\x05R\tpointsOld\x12\x1d\n" +
+	"\n" +
+	"points_new\x18\b \x01(\x05R\tpointsNew\x12\x15\n" +
+	"\x06biz_no\x18\t \x01(\tR\x05bizNo\x12\x1f\n" +
+	"\vpoints_date\x18\n" +
+	" \x01(\tR\n" +
+	"pointsDate\x12\x19\n" +
+	"\badmin_id\x18\v \x01(\x05R\aadminId\x12\x16\n" +
+	"\x06remark\x18\f \x01(\tR\x06remark\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\tR\tcreatedAt\"Y\n" +
+	"\x14GetUserPointsLogsRes\x12+\n" +
+	"\x04list\x18\x01 \x03(\v2\x17.user.UserPointsLogInfoR\x04list\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\x7f\n" +
+	"\x13AdjustUserPointsReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1f\n" +
+	"\vchange_type\x18\x02 \x01(\x05R\n" +
+	"changeType\x12\x16\n" +
+	"\x06points\x18\x03 \x01(\x05R\x06points\x12\x16\n" +
+	"\x06remark\x18\x04 \x01(\tR\x06remark\"a\n" +
+	"\x13AdjustUserPointsRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06points\x18\x03 \x01(\x05R\x06points\")\n" +
+	"\x13RunBettingPointsReq\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\"\x82\x01\n" +
+	"\x13RunBettingPointsRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05users\x18\x03 \x01(\x05R\x05users\x12!\n" +
+	"\fpoints_total\x18\x04 \x01(\x05R\vpointsTotal\"N\n" +
+	"\n" +
+	"SignReward\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\x12\x14\n" +
+	"\x05money\x18\x02 \x01(\x01R\x05money\x12\x16\n" +
+	"\x06points\x18\x03 \x01(\x05R\x06points\"\xe0\x02\n" +
+	"\x10SignCampaignInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x04 \x01(\tR\aendTime\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\x05R\x06status\x12\x1b\n" +
+	"\tgrade_ids\x18\x06 \x03(\x05R\bgradeIds\x12\x1b\n" +
+	"\tlevel_ids\x18\a \x03(\x05R\blevelIds\x12\x1a\n" +
+	"\bplatform\x18\b \x01(\x05R\bplatform\x12\x16\n" +
+	"\x06remark\x18\t \x01(\tR\x06remark\x12*\n" +
+	"\arewards\x18\n" +
+	" \x03(\v2\x10.user.SignRewardR\arewards\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\tR\tupdatedAt\"-\n" +
+	"\x13GetSignCampaignsReq\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\"A\n" +
+	"\x13GetSignCampaignsRes\x12*\n" +
+	"\x04list\x18\x01 \x03(\v2\x16.user.SignCampaignInfoR\x04list\"\x97\x02\n" +
+	"\x15CreateSignCampaignReq\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\tR\aendTime\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x1b\n" +
+	"\tgrade_ids\x18\x05 \x03(\x05R\bgradeIds\x12\x1b\n" +
+	"\tlevel_ids\x18\x06 \x03(\x05R\blevelIds\x12\x1a\n" +
+	"\bplatform\x18\a \x01(\x05R\bplatform\x12\x16\n" +
+	"\x06remark\x18\b \x01(\tR\x06remark\x12*\n" +
+	"\arewards\x18\t \x03(\v2\x10.user.SignRewardR\arewards\"[\n" +
+	"\x15CreateSignCampaignRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\x05R\x02id\"\xa7\x02\n" +
+	"\x15UpdateSignCampaignReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x04 \x01(\tR\aendTime\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\x05R\x06status\x12\x1b\n" +
+	"\tgrade_ids\x18\x06 \x03(\x05R\bgradeIds\x12\x1b\n" +
+	"\tlevel_ids\x18\a \x03(\x05R\blevelIds\x12\x1a\n" +
+	"\bplatform\x18\b \x01(\x05R\bplatform\x12\x16\n" +
+	"\x06remark\x18\t \x01(\tR\x06remark\x12*\n" +
+	"\arewards\x18\n" +
+	" \x03(\v2\x10.user.SignRewardR\arewards\"K\n" +
+	"\x15UpdateSignCampaignRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"'\n" +
+	"\x15DeleteSignCampaignReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"K\n" +
+	"\x15DeleteSignCampaignRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xa7\x01\n" +
+	"\x0eGetSignLogsReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x17\n" +
+	"\asign_id\x18\x03 \x01(\x05R\x06signId\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x06 \x01(\tR\aendDate\"\xdb\x02\n" +
+	"\vSignLogInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\asign_id\x18\x02 \x01(\x05R\x06signId\x12\x1b\n" +
+	"\tsign_name\x18\x03 \x01(\tR\bsignName\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x05R\x06userId\x12\x1a\n" +
+	"\busername\x18\x05 \x01(\tR\busername\x12\x1a\n" +
+	"\bplatform\x18\x06 \x01(\x05R\bplatform\x12\x1b\n" +
+	"\tsign_date\x18\a \x01(\tR\bsignDate\x12\x16\n" +
+	"\x06streak\x18\b \x01(\x05R\x06streak\x12!\n" +
+	"\freward_money\x18\t \x01(\x01R\vrewardMoney\x12#\n" +
+	"\rreward_points\x18\n" +
+	" \x01(\x05R\frewardPoints\x12\x19\n" +
+	"\btrade_no\x18\v \x01(\tR\atradeNo\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\tR\tcreatedAt\"\x91\x01\n" +
+	"\x0eGetSignLogsRes\x12%\n" +
+	"\x04list\x18\x01 \x03(\v2\x11.user.SignLogInfoR\x04list\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x1f\n" +
+	"\vmoney_total\x18\x03 \x01(\x01R\n" +
+	"moneyTotal\x12!\n" +
+	"\fpoints_total\x18\x04 \x01(\x05R\vpointsTotal\"K\n" +
+	"\x14GetUserSignStatusReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1a\n" +
+	"\bplatform\x18\x02 \x01(\x05R\bplatform\"\xeb\x01\n" +
+	"\x14GetUserSignStatusRes\x12\x1c\n" +
+	"\tavailable\x18\x01 \x01(\bR\tavailable\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x17\n" +
+	"\asign_id\x18\x03 \x01(\x05R\x06signId\x12\x1b\n" +
+	"\tsign_name\x18\x04 \x01(\tR\bsignName\x12!\n" +
+	"\fsigned_today\x18\x05 \x01(\bR\vsignedToday\x12\x16\n" +
+	"\x06streak\x18\x06 \x01(\x05R\x06streak\x12*\n" +
+	"\arewards\x18\a \x03(\v2\x10.user.SignRewardR\arewards\"B\n" +
+	"\vUserSignReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1a\n" +
+	"\bplatform\x18\x02 \x01(\x05R\bplatform\"\xa1\x01\n" +
+	"\vUserSignRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06streak\x18\x03 \x01(\x05R\x06streak\x12!\n" +
+	"\freward_money\x18\x04 \x01(\x01R\vrewardMoney\x12#\n" +
+	"\rreward_points\x18\x05 \x01(\x05R\frewardPoints\"*\n" +
+	"\x10GetUserLevelsReq\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\"\xe4\x02\n" +
+	"\rUserLevelInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tis_rebate\x18\x03 \x01(\bR\bisRebate\x12$\n" +
+	"\x0erebate_rule_id\x18\x04 \x01(\x05R\frebateRuleId\x12(\n" +
+	"\x10rebate_rule_name\x18\x05 \x01(\tR\x0erebateRuleName\x120\n" +
+	"\x14daily_withdraw_times\x18\x06 \x01(\x05R\x12dailyWithdrawTimes\x12\x1b\n" +
+	"\tlogin_url\x18\a \x01(\tR\bloginUrl\x12\x16\n" +
+	"\x06status\x18\b \x01(\x05R\x06status\x12\x1d\n" +
+	"\n" +
+	"user_count\x18\t \x01(\x05R\tuserCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAt\";\n" +
+	"\x10GetUserLevelsRes\x12'\n" +
+	"\x04list\x18\x01 \x03(\v2\x13.user.UserLevelInfoR\x04list\"\xd2\x01\n" +
+	"\x12CreateUserLevelReq\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tis_rebate\x18\x02 \x01(\bR\bisRebate\x12$\n" +
+	"\x0erebate_rule_id\x18\x03 \x01(\x05R\frebateRuleId\x120\n" +
+	"\x14daily_withdraw_times\x18\x04 \x01(\x05R\x12dailyWithdrawTimes\x12\x1b\n" +
+	"\tlogin_url\x18\x05 \x01(\tR\bloginUrl\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\x05R\x06status\"X\n" +
+	"\x12CreateUserLevelRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\x05R\x02id\"\xe2\x01\n" +
+	"\x12UpdateUserLevelReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tis_rebate\x18\x03 \x01(\bR\bisRebate\x12$\n" +
+	"\x0erebate_rule_id\x18\x04 \x01(\x05R\frebateRuleId\x120\n" +
+	"\x14daily_withdraw_times\x18\x05 \x01(\x05R\x12dailyWithdrawTimes\x12\x1b\n" +
+	"\tlogin_url\x18\x06 \x01(\tR\bloginUrl\x12\x16\n" +
+	"\x06status\x18\a \x01(\x05R\x06status\"H\n" +
+	"\x12UpdateUserLevelRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"H\n" +
+	"\x12DeleteUserLevelReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\"\n" +
+	"\rmigrate_to_id\x18\x02 \x01(\x05R\vmigrateToId\"d\n" +
+	"\x12DeleteUserLevelRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
+	"\bmigrated\x18\x03 \x01(\x05R\bmigrated\"\xa3\x01\n" +
 	"\x13GetUserLoginLogsReq\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x0e\n" +
 	"\x02ip\x18\x02 \x01(\tR\x02ip\x12\x1d\n" +
@@ -2070,16 +7765,162 @@ const file_backend_user_v1_user_proto_rawDesc = "" +
 	"created_at\x18\x0f \x01(\tR\tcreatedAt\"W\n" +
 	"\x13GetUserLoginLogsRes\x12*\n" +
 	"\x04list\x18\x01 \x03(\v2\x16.user.UserLoginLogInfoR\x04list\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x05R\x05count2\xea\x03\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\xa9\x03\n" +
+	"\vRegisterReq\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12!\n" +
+	"\fpay_password\x18\x03 \x01(\tR\vpayPassword\x12\x1a\n" +
+	"\brealname\x18\x04 \x01(\tR\brealname\x12\x16\n" +
+	"\x06mobile\x18\x05 \x01(\tR\x06mobile\x12\x14\n" +
+	"\x05email\x18\x06 \x01(\tR\x05email\x12\x0e\n" +
+	"\x02qq\x18\a \x01(\tR\x02qq\x12\x1a\n" +
+	"\bbirthday\x18\b \x01(\tR\bbirthday\x12\x10\n" +
+	"\x03sex\x18\t \x01(\x05R\x03sex\x12#\n" +
+	"\rsafe_question\x18\n" +
+	" \x01(\tR\fsafeQuestion\x12\x1f\n" +
+	"\vsafe_answer\x18\v \x01(\tR\n" +
+	"safeAnswer\x12%\n" +
+	"\x0eagent_username\x18\f \x01(\tR\ragentUsername\x12!\n" +
+	"\fregister_url\x18\r \x01(\tR\vregisterUrl\x12'\n" +
+	"\x0fregister_device\x18\x0e \x01(\x05R\x0eregisterDevice\"Z\n" +
+	"\vRegisterRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x05R\x06userId\"\xf4\x01\n" +
+	"\bLoginReq\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1b\n" +
+	"\tlogin_url\x18\x03 \x01(\tR\bloginUrl\x12\x1f\n" +
+	"\vreferer_url\x18\x04 \x01(\tR\n" +
+	"refererUrl\x12\x0e\n" +
+	"\x02os\x18\x05 \x01(\tR\x02os\x12\x18\n" +
+	"\abrowser\x18\x06 \x01(\tR\abrowser\x12\x16\n" +
+	"\x06screen\x18\a \x01(\tR\x06screen\x12\x18\n" +
+	"\anetwork\x18\b \x01(\tR\anetwork\x12\x16\n" +
+	"\x06device\x18\t \x01(\x05R\x06device\"s\n" +
+	"\bLoginRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x05R\x06userId\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\"*\n" +
+	"\x0fGetUserBanksReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"\xf7\x01\n" +
+	"\fUserBankInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
+	"\tbank_name\x18\x02 \x01(\tR\bbankName\x12!\n" +
+	"\fcard_account\x18\x03 \x01(\tR\vcardAccount\x12\x17\n" +
+	"\acard_no\x18\x04 \x01(\tR\x06cardNo\x12!\n" +
+	"\fdeposit_bank\x18\x05 \x01(\tR\vdepositBank\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x06 \x01(\bR\tisDefault\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\"9\n" +
+	"\x0fGetUserBanksRes\x12&\n" +
+	"\x04list\x18\x01 \x03(\v2\x12.user.UserBankInfoR\x04list\"\xc7\x01\n" +
+	"\x11CreateUserBankReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1b\n" +
+	"\tbank_name\x18\x02 \x01(\tR\bbankName\x12!\n" +
+	"\fcard_account\x18\x03 \x01(\tR\vcardAccount\x12\x17\n" +
+	"\acard_no\x18\x04 \x01(\tR\x06cardNo\x12!\n" +
+	"\fdeposit_bank\x18\x05 \x01(\tR\vdepositBank\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x06 \x01(\bR\tisDefault\"W\n" +
+	"\x11CreateUserBankRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\x05R\x02id\"\x9f\x01\n" +
+	"\x11UpdateUserBankReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
+	"\tbank_name\x18\x02 \x01(\tR\bbankName\x12!\n" +
+	"\fcard_account\x18\x03 \x01(\tR\vcardAccount\x12\x17\n" +
+	"\acard_no\x18\x04 \x01(\tR\x06cardNo\x12!\n" +
+	"\fdeposit_bank\x18\x05 \x01(\tR\vdepositBank\"G\n" +
+	"\x11UpdateUserBankRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"'\n" +
+	"\x15SetDefaultUserBankReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"K\n" +
+	"\x15SetDefaultUserBankRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"#\n" +
+	"\x11DeleteUserBankReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"G\n" +
+	"\x11DeleteUserBankRes\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"U\n" +
+	"\x12GetUserBankLogsReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\"\x9b\x02\n" +
+	"\x0fUserBankLogInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\abank_id\x18\x02 \x01(\x05R\x06bankId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\x05R\x06action\x12\x1f\n" +
+	"\vaction_name\x18\x04 \x01(\tR\n" +
+	"actionName\x12\x1e\n" +
+	"\vold_card_no\x18\x05 \x01(\tR\toldCardNo\x12\x1e\n" +
+	"\vnew_card_no\x18\x06 \x01(\tR\tnewCardNo\x12\x18\n" +
+	"\acontent\x18\a \x01(\tR\acontent\x12\x1d\n" +
+	"\n" +
+	"admin_name\x18\b \x01(\tR\tadminName\x12\x0e\n" +
+	"\x02ip\x18\t \x01(\tR\x02ip\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\"U\n" +
+	"\x12GetUserBankLogsRes\x12)\n" +
+	"\x04list\x18\x01 \x03(\v2\x15.user.UserBankLogInfoR\x04list\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"Y\n" +
+	"\x11ExportUserListReq\x12,\n" +
+	"\x06filter\x18\x01 \x01(\v2\x14.user.GetUserListReqR\x06filter\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\x05R\x06format\"\x92\x01\n" +
+	"\x13ExportUserListChunk\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\x12\x12\n" +
+	"\x04done\x18\x04 \x01(\bR\x04done\x12\x14\n" +
+	"\x05count\x18\x05 \x01(\x05R\x05count2\xc8\x15\n" +
 	"\x04User\x12;\n" +
 	"\vGetUserList\x12\x14.user.GetUserListReq\x1a\x14.user.GetUserListRes\"\x00\x128\n" +
 	"\n" +
 	"UpdateUser\x12\x13.user.UpdateUserReq\x1a\x13.user.UpdateUserRes\"\x00\x12J\n" +
-	"\x10GetUserBasicInfo\x12\x19.user.GetUserBasicInfoReq\x1a\x19.user.GetUserBasicInfoRes\"\x00\x12A\n" +
+	"\x10BatchUpdateUsers\x12\x19.user.BatchUpdateUsersReq\x1a\x19.user.BatchUpdateUsersRes\"\x00\x12J\n" +
+	"\x10GetUserBasicInfo\x12\x19.user.GetUserBasicInfoReq\x1a\x19.user.GetUserBasicInfoRes\"\x00\x122\n" +
+	"\bRegister\x12\x11.user.RegisterReq\x1a\x11.user.RegisterRes\"\x00\x12)\n" +
+	"\x05Login\x12\x0e.user.LoginReq\x1a\x0e.user.LoginRes\"\x00\x12A\n" +
 	"\rGetUserGrades\x12\x16.user.GetUserGradesReq\x1a\x16.user.GetUserGradesRes\"\x00\x12D\n" +
 	"\x0eSaveUserGrades\x12\x17.user.SaveUserGradesReq\x1a\x17.user.SaveUserGradesRes\"\x00\x12J\n" +
-	"\x10DeleteUserGrades\x12\x19.user.DeleteUserGradesReq\x1a\x19.user.DeleteUserGradesRes\"\x00\x12J\n" +
-	"\x10GetUserLoginLogs\x12\x19.user.GetUserLoginLogsReq\x1a\x19.user.GetUserLoginLogsRes\"\x00B$Z\"jh_app_service/api/backend/user/v1b\x06proto3"
+	"\x10DeleteUserGrades\x12\x19.user.DeleteUserGradesReq\x1a\x19.user.DeleteUserGradesRes\"\x00\x12V\n" +
+	"\x14PreviewGradeUpgrades\x12\x1d.user.PreviewGradeUpgradesReq\x1a\x1d.user.PreviewGradeUpgradesRes\"\x00\x12J\n" +
+	"\x10RunGradeUpgrades\x12\x19.user.RunGradeUpgradesReq\x1a\x19.user.RunGradeUpgradesRes\"\x00\x12J\n" +
+	"\x10GetUserGradeLogs\x12\x19.user.GetUserGradeLogsReq\x1a\x19.user.GetUserGradeLogsRes\"\x00\x12P\n" +
+	"\x12RunBirthdayBonuses\x12\x1b.user.RunBirthdayBonusesReq\x1a\x1b.user.RunBirthdayBonusesRes\"\x00\x12P\n" +
+	"\x12GetBirthdayBonuses\x12\x1b.user.GetBirthdayBonusesReq\x1a\x1b.user.GetBirthdayBonusesRes\"\x00\x12J\n" +
+	"\x10GetPointsSetting\x12\x19.user.GetPointsSettingReq\x1a\x19.user.GetPointsSettingRes\"\x00\x12S\n" +
+	"\x13UpdatePointsSetting\x12\x1c.user.UpdatePointsSettingReq\x1a\x1c.user.UpdatePointsSettingRes\"\x00\x12M\n" +
+	"\x11GetUserPointsLogs\x12\x1a.user.GetUserPointsLogsReq\x1a\x1a.user.GetUserPointsLogsRes\"\x00\x12J\n" +
+	"\x10AdjustUserPoints\x12\x19.user.AdjustUserPointsReq\x1a\x19.user.AdjustUserPointsRes\"\x00\x12J\n" +
+	"\x10RunBettingPoints\x12\x19.user.RunBettingPointsReq\x1a\x19.user.RunBettingPointsRes\"\x00\x12J\n" +
+	"\x10GetSignCampaigns\x12\x19.user.GetSignCampaignsReq\x1a\x19.user.GetSignCampaignsRes\"\x00\x12P\n" +
+	"\x12CreateSignCampaign\x12\x1b.user.CreateSignCampaignReq\x1a\x1b.user.CreateSignCampaignRes\"\x00\x12P\n" +
+	"\x12UpdateSignCampaign\x12\x1b.user.UpdateSignCampaignReq\x1a\x1b.user.UpdateSignCampaignRes\"\x00\x12P\n" +
+	"\x12DeleteSignCampaign\x12\x1b.user.DeleteSignCampaignReq\x1a\x1b.user.DeleteSignCampaignRes\"\x00\x12;\n" +
+	"\vGetSignLogs\x12\x14.user.GetSignLogsReq\x1a\x14.user.GetSignLogsRes\"\x00\x12M\n" +
+	"\x11GetUserSignStatus\x12\x1a.user.GetUserSignStatusReq\x1a\x1a.user.GetUserSignStatusRes\"\x00\x122\n" +
+	"\bUserSign\x12\x11.user.UserSignReq\x1a\x11.user.UserSignRes\"\x00\x12A\n" +
+	"\rGetUserLevels\x12\x16.user.GetUserLevelsReq\x1a\x16.user.GetUserLevelsRes\"\x00\x12G\n" +
+	"\x0fCreateUserLevel\x12\x18.user.CreateUserLevelReq\x1a\x18.user.CreateUserLevelRes\"\x00\x12G\n" +
+	"\x0fUpdateUserLevel\x12\x18.user.UpdateUserLevelReq\x1a\x18.user.UpdateUserLevelRes\"\x00\x12G\n" +
+	"\x0fDeleteUserLevel\x12\x18.user.DeleteUserLevelReq\x1a\x18.user.DeleteUserLevelRes\"\x00\x12J\n" +
+	"\x10GetUserLoginLogs\x12\x19.user.GetUserLoginLogsReq\x1a\x19.user.GetUserLoginLogsRes\"\x00\x12>\n" +
+	"\fGetUserBanks\x12\x15.user.GetUserBanksReq\x1a\x15.user.GetUserBanksRes\"\x00\x12D\n" +
+	"\x0eCreateUserBank\x12\x17.user.CreateUserBankReq\x1a\x17.user.CreateUserBankRes\"\x00\x12D\n" +
+	"\x0eUpdateUserBank\x12\x17.user.UpdateUserBankReq\x1a\x17.user.UpdateUserBankRes\"\x00\x12P\n" +
+	"\x12SetDefaultUserBank\x12\x1b.user.SetDefaultUserBankReq\x1a\x1b.user.SetDefaultUserBankRes\"\x00\x12D\n" +
+	"\x0eDeleteUserBank\x12\x17.user.DeleteUserBankReq\x1a\x17.user.DeleteUserBankRes\"\x00\x12G\n" +
+	"\x0fGetUserBankLogs\x12\x18.user.GetUserBankLogsReq\x1a\x18.user.GetUserBankLogsRes\"\x00\x12H\n" +
+	"\x0eExportUserList\x12\x17.user.ExportUserListReq\x1a\x19.user.ExportUserListChunk\"\x000\x01B$Z\"jh_app_service/api/backend/user/v1b\x06proto3"
 
 var (
 	file_backend_user_v1_user_proto_rawDescOnce sync.Once
@@ -2093,54 +7934,208 @@ func file_backend_user_v1_user_proto_rawDescGZIP() []byte {
 	return file_backend_user_v1_user_proto_rawDescData
 }
 
-var file_backend_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_backend_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 93)
 var file_backend_user_v1_user_proto_goTypes = []any{
-	(*GetUserListReq)(nil),      // 0: user.GetUserListReq
-	(*UserInfo)(nil),            // 1: user.UserInfo
-	(*GetUserListRes)(nil),      // 2: user.GetUserListRes
-	(*UpdateUserReq)(nil),       // 3: user.UpdateUserReq
-	(*UpdateUserRes)(nil),       // 4: user.UpdateUserRes
-	(*GetUserBasicInfoReq)(nil), // 5: user.GetUserBasicInfoReq
-	(*UserBasicInfo)(nil),       // 6: user.UserBasicInfo
-	(*BankInfo)(nil),            // 7: user.BankInfo
-	(*GetUserBasicInfoRes)(nil), // 8: user.GetUserBasicInfoRes
-	(*GetUserGradesReq)(nil),    // 9: user.GetUserGradesReq
-	(*UserGradeInfo)(nil),       // 10: user.UserGradeInfo
-	(*GetUserGradesRes)(nil),    // 11: user.GetUserGradesRes
-	(*SaveUserGradesReq)(nil),   // 12: user.SaveUserGradesReq
-	(*SaveUserGradesRes)(nil),   // 13: user.SaveUserGradesRes
-	(*DeleteUserGradesReq)(nil), // 14: user.DeleteUserGradesReq
-	(*DeleteUserGradesRes)(nil), // 15: user.DeleteUserGradesRes
-	(*GetUserLoginLogsReq)(nil), // 16: user.GetUserLoginLogsReq
-	(*UserLoginLogInfo)(nil),    // 17: user.UserLoginLogInfo
-	(*GetUserLoginLogsRes)(nil), // 18: user.GetUserLoginLogsRes
+	(*GetUserListReq)(nil),          // 0: user.GetUserListReq
+	(*UserInfo)(nil),                // 1: user.UserInfo
+	(*GetUserListRes)(nil),          // 2: user.GetUserListRes
+	(*UpdateUserReq)(nil),           // 3: user.UpdateUserReq
+	(*UpdateUserRes)(nil),           // 4: user.UpdateUserRes
+	(*BatchUpdateUsersReq)(nil),     // 5: user.BatchUpdateUsersReq
+	(*BatchUpdateUserResult)(nil),   // 6: user.BatchUpdateUserResult
+	(*BatchUpdateUsersRes)(nil),     // 7: user.BatchUpdateUsersRes
+	(*GetUserBasicInfoReq)(nil),     // 8: user.GetUserBasicInfoReq
+	(*UserBasicInfo)(nil),           // 9: user.UserBasicInfo
+	(*BankInfo)(nil),                // 10: user.BankInfo
+	(*GetUserBasicInfoRes)(nil),     // 11: user.GetUserBasicInfoRes
+	(*GetUserGradesReq)(nil),        // 12: user.GetUserGradesReq
+	(*UserGradeInfo)(nil),           // 13: user.UserGradeInfo
+	(*GetUserGradesRes)(nil),        // 14: user.GetUserGradesRes
+	(*SaveUserGradesReq)(nil),       // 15: user.SaveUserGradesReq
+	(*SaveUserGradesRes)(nil),       // 16: user.SaveUserGradesRes
+	(*DeleteUserGradesReq)(nil),     // 17: user.DeleteUserGradesReq
+	(*DeleteUserGradesRes)(nil),     // 18: user.DeleteUserGradesRes
+	(*PreviewGradeUpgradesReq)(nil), // 19: user.PreviewGradeUpgradesReq
+	(*GradeUpgradeItem)(nil),        // 20: user.GradeUpgradeItem
+	(*PreviewGradeUpgradesRes)(nil), // 21: user.PreviewGradeUpgradesRes
+	(*RunGradeUpgradesReq)(nil),     // 22: user.RunGradeUpgradesReq
+	(*RunGradeUpgradesRes)(nil),     // 23: user.RunGradeUpgradesRes
+	(*GetUserGradeLogsReq)(nil),     // 24: user.GetUserGradeLogsReq
+	(*UserGradeLogInfo)(nil),        // 25: user.UserGradeLogInfo
+	(*GetUserGradeLogsRes)(nil),     // 26: user.GetUserGradeLogsRes
+	(*RunBirthdayBonusesReq)(nil),   // 27: user.RunBirthdayBonusesReq
+	(*RunBirthdayBonusesRes)(nil),   // 28: user.RunBirthdayBonusesRes
+	(*GetBirthdayBonusesReq)(nil),   // 29: user.GetBirthdayBonusesReq
+	(*BirthdayBonusInfo)(nil),       // 30: user.BirthdayBonusInfo
+	(*GetBirthdayBonusesRes)(nil),   // 31: user.GetBirthdayBonusesRes
+	(*PointsSetting)(nil),           // 32: user.PointsSetting
+	(*GetPointsSettingReq)(nil),     // 33: user.GetPointsSettingReq
+	(*GetPointsSettingRes)(nil),     // 34: user.GetPointsSettingRes
+	(*UpdatePointsSettingReq)(nil),  // 35: user.UpdatePointsSettingReq
+	(*UpdatePointsSettingRes)(nil),  // 36: user.UpdatePointsSettingRes
+	(*GetUserPointsLogsReq)(nil),    // 37: user.GetUserPointsLogsReq
+	(*UserPointsLogInfo)(nil),       // 38: user.UserPointsLogInfo
+	(*GetUserPointsLogsRes)(nil),    // 39: user.GetUserPointsLogsRes
+	(*AdjustUserPointsReq)(nil),     // 40: user.AdjustUserPointsReq
+	(*AdjustUserPointsRes)(nil),     // 41: user.AdjustUserPointsRes
+	(*RunBettingPointsReq)(nil),     // 42: user.RunBettingPointsReq
+	(*RunBettingPointsRes)(nil),     // 43: user.RunBettingPointsRes
+	(*SignReward)(nil),              // 44: user.SignReward
+	(*SignCampaignInfo)(nil),        // 45: user.SignCampaignInfo
+	(*GetSignCampaignsReq)(nil),     // 46: user.GetSignCampaignsReq
+	(*GetSignCampaignsRes)(nil),     // 47: user.GetSignCampaignsRes
+	(*CreateSignCampaignReq)(nil),   // 48: user.CreateSignCampaignReq
+	(*CreateSignCampaignRes)(nil),   // 49: user.CreateSignCampaignRes
+	(*UpdateSignCampaignReq)(nil),   // 50: user.UpdateSignCampaignReq
+	(*UpdateSignCampaignRes)(nil),   // 51: user.UpdateSignCampaignRes
+	(*DeleteSignCampaignReq)(nil),   // 52: user.DeleteSignCampaignReq
+	(*DeleteSignCampaignRes)(nil),   // 53: user.DeleteSignCampaignRes
+	(*GetSignLogsReq)(nil),          // 54: user.GetSignLogsReq
+	(*SignLogInfo)(nil),             // 55: user.SignLogInfo
+	(*GetSignLogsRes)(nil),          // 56: user.GetSignLogsRes
+	(*GetUserSignStatusReq)(nil),    // 57: user.GetUserSignStatusReq
+	(*GetUserSignStatusRes)(nil),    // 58: user.GetUserSignStatusRes
+	(*UserSignReq)(nil),             // 59: user.UserSignReq
+	(*UserSignRes)(nil),             // 60: user.UserSignRes
+	(*GetUserLevelsReq)(nil),        // 61: user.GetUserLevelsReq
+	(*UserLevelInfo)(nil),           // 62: user.UserLevelInfo
+	(*GetUserLevelsRes)(nil),        // 63: user.GetUserLevelsRes
+	(*CreateUserLevelReq)(nil),      // 64: user.CreateUserLevelReq
+	(*CreateUserLevelRes)(nil),      // 65: user.CreateUserLevelRes
+	(*UpdateUserLevelReq)(nil),      // 66: user.UpdateUserLevelReq
+	(*UpdateUserLevelRes)(nil),      // 67: user.UpdateUserLevelRes
+	(*DeleteUserLevelReq)(nil),      // 68: user.DeleteUserLevelReq
+	(*DeleteUserLevelRes)(nil),      // 69: user.DeleteUserLevelRes
+	(*GetUserLoginLogsReq)(nil),     // 70: user.GetUserLoginLogsReq
+	(*UserLoginLogInfo)(nil),        // 71: user.UserLoginLogInfo
+	(*GetUserLoginLogsRes)(nil),     // 72: user.GetUserLoginLogsRes
+	(*RegisterReq)(nil),             // 73: user.RegisterReq
+	(*RegisterRes)(nil),             // 74: user.RegisterRes
+	(*LoginReq)(nil),                // 75: user.LoginReq
+	(*LoginRes)(nil),                // 76: user.LoginRes
+	(*GetUserBanksReq)(nil),         // 77: user.GetUserBanksReq
+	(*UserBankInfo)(nil),            // 78: user.UserBankInfo
+	(*GetUserBanksRes)(nil),         // 79: user.GetUserBanksRes
+	(*CreateUserBankReq)(nil),       // 80: user.CreateUserBankReq
+	(*CreateUserBankRes)(nil),       // 81: user.CreateUserBankRes
+	(*UpdateUserBankReq)(nil),       // 82: user.UpdateUserBankReq
+	(*UpdateUserBankRes)(nil),       // 83: user.UpdateUserBankRes
+	(*SetDefaultUserBankReq)(nil),   // 84: user.SetDefaultUserBankReq
+	(*SetDefaultUserBankRes)(nil),   // 85: user.SetDefaultUserBankRes
+	(*DeleteUserBankReq)(nil),       // 86: user.DeleteUserBankReq
+	(*DeleteUserBankRes)(nil),       // 87: user.DeleteUserBankRes
+	(*GetUserBankLogsReq)(nil),      // 88: user.GetUserBankLogsReq
+	(*UserBankLogInfo)(nil),         // 89: user.UserBankLogInfo
+	(*GetUserBankLogsRes)(nil),      // 90: user.GetUserBankLogsRes
+	(*ExportUserListReq)(nil),       // 91: user.ExportUserListReq
+	(*ExportUserListChunk)(nil),     // 92: user.ExportUserListChunk
 }
 var file_backend_user_v1_user_proto_depIdxs = []int32{
 	1,  // 0: user.GetUserListRes.list:type_name -> user.UserInfo
-	7,  // 1: user.UserBasicInfo.banks:type_name -> user.BankInfo
-	6,  // 2: user.GetUserBasicInfoRes.user:type_name -> user.UserBasicInfo
-	10, // 3: user.GetUserGradesRes.data:type_name -> user.UserGradeInfo
-	10, // 4: user.SaveUserGradesReq.data:type_name -> user.UserGradeInfo
-	17, // 5: user.GetUserLoginLogsRes.list:type_name -> user.UserLoginLogInfo
-	0,  // 6: user.User.GetUserList:input_type -> user.GetUserListReq
-	3,  // 7: user.User.UpdateUser:input_type -> user.UpdateUserReq
-	5,  // 8: user.User.GetUserBasicInfo:input_type -> user.GetUserBasicInfoReq
-	9,  // 9: user.User.GetUserGrades:input_type -> user.GetUserGradesReq
-	12, // 10: user.User.SaveUserGrades:input_type -> user.SaveUserGradesReq
-	14, // 11: user.User.DeleteUserGrades:input_type -> user.DeleteUserGradesReq
-	16, // 12: user.User.GetUserLoginLogs:input_type -> user.GetUserLoginLogsReq
-	2,  // 13: user.User.GetUserList:output_type -> user.GetUserListRes
-	4,  // 14: user.User.UpdateUser:output_type -> user.UpdateUserRes
-	8,  // 15: user.User.GetUserBasicInfo:output_type -> user.GetUserBasicInfoRes
-	11, // 16: user.User.GetUserGrades:output_type -> user.GetUserGradesRes
-	13, // 17: user.User.SaveUserGrades:output_type -> user.SaveUserGradesRes
-	15, // 18: user.User.DeleteUserGrades:output_type -> user.DeleteUserGradesRes
-	18, // 19: user.User.GetUserLoginLogs:output_type -> user.GetUserLoginLogsRes
-	13, // [13:20] is the sub-list for method output_type
-	6,  // [6:13] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	0,  // 1: user.BatchUpdateUsersReq.filter:type_name -> user.GetUserListReq
+	6,  // 2: user.BatchUpdateUsersRes.results:type_name -> user.BatchUpdateUserResult
+	10, // 3: user.UserBasicInfo.banks:type_name -> user.BankInfo
+	9,  // 4: user.GetUserBasicInfoRes.user:type_name -> user.UserBasicInfo
+	13, // 5: user.GetUserGradesRes.data:type_name -> user.UserGradeInfo
+	13, // 6: user.SaveUserGradesReq.data:type_name -> user.UserGradeInfo
+	20, // 7: user.PreviewGradeUpgradesRes.list:type_name -> user.GradeUpgradeItem
+	25, // 8: user.GetUserGradeLogsRes.list:type_name -> user.UserGradeLogInfo
+	30, // 9: user.GetBirthdayBonusesRes.list:type_name -> user.BirthdayBonusInfo
+	32, // 10: user.GetPointsSettingRes.setting:type_name -> user.PointsSetting
+	32, // 11: user.UpdatePointsSettingReq.setting:type_name -> user.PointsSetting
+	38, // 12: user.GetUserPointsLogsRes.list:type_name -> user.UserPointsLogInfo
+	44, // 13: user.SignCampaignInfo.rewards:type_name -> user.SignReward
+	45, // 14: user.GetSignCampaignsRes.list:type_name -> user.SignCampaignInfo
+	44, // 15: user.CreateSignCampaignReq.rewards:type_name -> user.SignReward
+	44, // 16: user.UpdateSignCampaignReq.rewards:type_name -> user.SignReward
+	55, // 17: user.GetSignLogsRes.list:type_name -> user.SignLogInfo
+	44, // 18: user.GetUserSignStatusRes.rewards:type_name -> user.SignReward
+	62, // 19: user.GetUserLevelsRes.list:type_name -> user.UserLevelInfo
+	71, // 20: user.GetUserLoginLogsRes.list:type_name -> user.UserLoginLogInfo
+	78, // 21: user.GetUserBanksRes.list:type_name -> user.UserBankInfo
+	89, // 22: user.GetUserBankLogsRes.list:type_name -> user.UserBankLogInfo
+	0,  // 23: user.ExportUserListReq.filter:type_name -> user.GetUserListReq
+	0,  // 24: user.User.GetUserList:input_type -> user.GetUserListReq
+	3,  // 25: user.User.UpdateUser:input_type -> user.UpdateUserReq
+	5,  // 26: user.User.BatchUpdateUsers:input_type -> user.BatchUpdateUsersReq
+	8,  // 27: user.User.GetUserBasicInfo:input_type -> user.GetUserBasicInfoReq
+	73, // 28: user.User.Register:input_type -> user.RegisterReq
+	75, // 29: user.User.Login:input_type -> user.LoginReq
+	12, // 30: user.User.GetUserGrades:input_type -> user.GetUserGradesReq
+	15, // 31: user.User.SaveUserGrades:input_type -> user.SaveUserGradesReq
+	17, // 32: user.User.DeleteUserGrades:input_type -> user.DeleteUserGradesReq
+	19, // 33: user.User.PreviewGradeUpgrades:input_type -> user.PreviewGradeUpgradesReq
+	22, // 34: user.User.RunGradeUpgrades:input_type -> user.RunGradeUpgradesReq
+	24, // 35: user.User.GetUserGradeLogs:input_type -> user.GetUserGradeLogsReq
+	27, // 36: user.User.RunBirthdayBonuses:input_type -> user.RunBirthdayBonusesReq
+	29, // 37: user.User.GetBirthdayBonuses:input_type -> user.GetBirthdayBonusesReq
+	33, // 38: user.User.GetPointsSetting:input_type -> user.GetPointsSettingReq
+	35, // 39: user.User.UpdatePointsSetting:input_type -> user.UpdatePointsSettingReq
+	37, // 40: user.User.GetUserPointsLogs:input_type -> user.GetUserPointsLogsReq
+	40, // 41: user.User.AdjustUserPoints:input_type -> user.AdjustUserPointsReq
+	42, // 42: user.User.RunBettingPoints:input_type -> user.RunBettingPointsReq
+	46, // 43: user.User.GetSignCampaigns:input_type -> user.GetSignCampaignsReq
+	48, // 44: user.User.CreateSignCampaign:input_type -> user.CreateSignCampaignReq
+	50, // 45: user.User.UpdateSignCampaign:input_type -> user.UpdateSignCampaignReq
+	52, // 46: user.User.DeleteSignCampaign:input_type -> user.DeleteSignCampaignReq
+	54, // 47: user.User.GetSignLogs:input_type -> user.GetSignLogsReq
+	57, // 48: user.User.GetUserSignStatus:input_type -> user.GetUserSignStatusReq
+	59, // 49: user.User.UserSign:input_type -> user.UserSignReq
+	61, // 50: user.User.GetUserLevels:input_type -> user.GetUserLevelsReq
+	64, // 51: user.User.CreateUserLevel:input_type -> user.CreateUserLevelReq
+	66, // 52: user.User.UpdateUserLevel:input_type -> user.UpdateUserLevelReq
+	68, // 53: user.User.DeleteUserLevel:input_type -> user.DeleteUserLevelReq
+	70, // 54: user.User.GetUserLoginLogs:input_type -> user.GetUserLoginLogsReq
+	77, // 55: user.User.GetUserBanks:input_type -> user.GetUserBanksReq
+	80, // 56: user.User.CreateUserBank:input_type -> user.CreateUserBankReq
+	82, // 57: user.User.UpdateUserBank:input_type -> user.UpdateUserBankReq
+	84, // 58: user.User.SetDefaultUserBank:input_type -> user.SetDefaultUserBankReq
+	86, // 59: user.User.DeleteUserBank:input_type -> user.DeleteUserBankReq
+	88, // 60: user.User.GetUserBankLogs:input_type -> user.GetUserBankLogsReq
+	91, // 61: user.User.ExportUserList:input_type -> user.ExportUserListReq
+	2,  // 62: user.User.GetUserList:output_type -> user.GetUserListRes
+	4,  // 63: user.User.UpdateUser:output_type -> user.UpdateUserRes
+	7,  // 64: user.User.BatchUpdateUsers:output_type -> user.BatchUpdateUsersRes
+	11, // 65: user.User.GetUserBasicInfo:output_type -> user.GetUserBasicInfoRes
+	74, // 66: user.User.Register:output_type -> user.RegisterRes
+	76, // 67: user.User.Login:output_type -> user.LoginRes
+	14, // 68: user.User.GetUserGrades:output_type -> user.GetUserGradesRes
+	16, // 69: user.User.SaveUserGrades:output_type -> user.SaveUserGradesRes
+	18, // 70: user.User.DeleteUserGrades:output_type -> user.DeleteUserGradesRes
+	21, // 71: user.User.PreviewGradeUpgrades:output_type -> user.PreviewGradeUpgradesRes
+	23, // 72: user.User.RunGradeUpgrades:output_type -> user.RunGradeUpgradesRes
+	26, // 73: user.User.GetUserGradeLogs:output_type -> user.GetUserGradeLogsRes
+	28, // 74: user.User.RunBirthdayBonuses:output_type -> user.RunBirthdayBonusesRes
+	31, // 75: user.User.GetBirthdayBonuses:output_type -> user.GetBirthdayBonusesRes
+	34, // 76: user.User.GetPointsSetting:output_type -> user.GetPointsSettingRes
+	36, // 77: user.User.UpdatePointsSetting:output_type -> user.UpdatePointsSettingRes
+	39, // 78: user.User.GetUserPointsLogs:output_type -> user.GetUserPointsLogsRes
+	41, // 79: user.User.AdjustUserPoints:output_type -> user.AdjustUserPointsRes
+	43, // 80: user.User.RunBettingPoints:output_type -> user.RunBettingPointsRes
+	47, // 81: user.User.GetSignCampaigns:output_type -> user.GetSignCampaignsRes
+	49, // 82: user.User.CreateSignCampaign:output_type -> user.CreateSignCampaignRes
+	51, // 83: user.User.UpdateSignCampaign:output_type -> user.UpdateSignCampaignRes
+	53, // 84: user.User.DeleteSignCampaign:output_type -> user.DeleteSignCampaignRes
+	56, // 85: user.User.GetSignLogs:output_type -> user.GetSignLogsRes
+	58, // 86: user.User.GetUserSignStatus:output_type -> user.GetUserSignStatusRes
+	60, // 87: user.User.UserSign:output_type -> user.UserSignRes
+	63, // 88: user.User.GetUserLevels:output_type -> user.GetUserLevelsRes
+	65, // 89: user.User.CreateUserLevel:output_type -> user.CreateUserLevelRes
+	67, // 90: user.User.UpdateUserLevel:output_type -> user.UpdateUserLevelRes
+	69, // 91: user.User.DeleteUserLevel:output_type -> user.DeleteUserLevelRes
+	72, // 92: user.User.GetUserLoginLogs:output_type -> user.GetUserLoginLogsRes
+	79, // 93: user.User.GetUserBanks:output_type -> user.GetUserBanksRes
+	81, // 94: user.User.CreateUserBank:output_type -> user.CreateUserBankRes
+	83, // 95: user.User.UpdateUserBank:output_type -> user.UpdateUserBankRes
+	85, // 96: user.User.SetDefaultUserBank:output_type -> user.SetDefaultUserBankRes
+	87, // 97: user.User.DeleteUserBank:output_type -> user.DeleteUserBankRes
+	90, // 98: user.User.GetUserBankLogs:output_type -> user.GetUserBankLogsRes
+	92, // 99: user.User.ExportUserList:output_type -> user.ExportUserListChunk
+	62, // [62:100] is the sub-list for method output_type
+	24, // [24:62] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_backend_user_v1_user_proto_init() }
@@ -2154,7 +8149,7 @@ func file_backend_user_v1_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backend_user_v1_user_proto_rawDesc), len(file_backend_user_v1_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   93,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,13 +20,44 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	User_GetUserList_FullMethodName      = "/user.User/GetUserList"
-	User_UpdateUser_FullMethodName       = "/user.User/UpdateUser"
-	User_GetUserBasicInfo_FullMethodName = "/user.User/GetUserBasicInfo"
-	User_GetUserGrades_FullMethodName    = "/user.User/GetUserGrades"
-	User_SaveUserGrades_FullMethodName   = "/user.User/SaveUserGrades"
-	User_DeleteUserGrades_FullMethodName = "/user.User/DeleteUserGrades"
-	User_GetUserLoginLogs_FullMethodName = "/user.User/GetUserLoginLogs"
+	User_GetUserList_FullMethodName          = "/user.User/GetUserList"
+	User_UpdateUser_FullMethodName           = "/user.User/UpdateUser"
+	User_BatchUpdateUsers_FullMethodName     = "/user.User/BatchUpdateUsers"
+	User_GetUserBasicInfo_FullMethodName     = "/user.User/GetUserBasicInfo"
+	User_Register_FullMethodName             = "/user.User/Register"
+	User_Login_FullMethodName                = "/user.User/Login"
+	User_GetUserGrades_FullMethodName        = "/user.User/GetUserGrades"
+	User_SaveUserGrades_FullMethodName       = "/user.User/SaveUserGrades"
+	User_DeleteUserGrades_FullMethodName     = "/user.User/DeleteUserGrades"
+	User_PreviewGradeUpgrades_FullMethodName = "/user.User/PreviewGradeUpgrades"
+	User_RunGradeUpgrades_FullMethodName     = "/user.User/RunGradeUpgrades"
+	User_GetUserGradeLogs_FullMethodName     = "/user.User/GetUserGradeLogs"
+	User_RunBirthdayBonuses_FullMethodName   = "/user.User/RunBirthdayBonuses"
+	User_GetBirthdayBonuses_FullMethodName   = "/user.User/GetBirthdayBonuses"
+	User_GetPointsSetting_FullMethodName     = "/user.User/GetPointsSetting"
+	User_UpdatePointsSetting_FullMethodName  = "/user.User/UpdatePointsSetting"
+	User_GetUserPointsLogs_FullMethodName    = "/user.User/GetUserPointsLogs"
+	User_AdjustUserPoints_FullMethodName     = "/user.User/AdjustUserPoints"
+	User_RunBettingPoints_FullMethodName     = "/user.User/RunBettingPoints"
+	User_GetSignCampaigns_FullMethodName     = "/user.User/GetSignCampaigns"
+	User_CreateSignCampaign_FullMethodName   = "/user.User/CreateSignCampaign"
+	User_UpdateSignCampaign_FullMethodName   = "/user.User/UpdateSignCampaign"
+	User_DeleteSignCampaign_FullMethodName   = "/user.User/DeleteSignCampaign"
+	User_GetSignLogs_FullMethodName          = "/user.User/GetSignLogs"
+	User_GetUserSignStatus_FullMethodName    = "/user.User/GetUserSignStatus"
+	User_UserSign_FullMethodName             = "/user.User/UserSign"
+	User_GetUserLevels_FullMethodName        = "/user.User/GetUserLevels"
+	User_CreateUserLevel_FullMethodName      = "/user.User/CreateUserLevel"
+	User_UpdateUserLevel_FullMethodName      = "/user.User/UpdateUserLevel"
+	User_DeleteUserLevel_FullMethodName      = "/user.User/DeleteUserLevel"
+	User_GetUserLoginLogs_FullMethodName     = "/user.User/GetUserLoginLogs"
+	User_GetUserBanks_FullMethodName         = "/user.User/GetUserBanks"
+	User_CreateUserBank_FullMethodName       = "/user.User/CreateUserBank"
+	User_UpdateUserBank_FullMethodName       = "/user.User/UpdateUserBank"
+	User_SetDefaultUserBank_FullMethodName   = "/user.User/SetDefaultUserBank"
+	User_DeleteUserBank_FullMethodName       = "/user.User/DeleteUserBank"
+	User_GetUserBankLogs_FullMethodName      = "/user.User/GetUserBankLogs"
+	User_ExportUserList_FullMethodName       = "/user.User/ExportUserList"
 )
 
 // UserClient is the client API for User service.
@@ -35,13 +66,49 @@ const (
 type UserClient interface {
 	GetUserList(ctx context.Context, in *GetUserListReq, opts ...grpc.CallOption) (*GetUserListRes, error)
 	UpdateUser(ctx context.Context, in *UpdateUserReq, opts ...grpc.CallOption) (*UpdateUserRes, error)
+	BatchUpdateUsers(ctx context.Context, in *BatchUpdateUsersReq, opts ...grpc.CallOption) (*BatchUpdateUsersRes, error)
 	GetUserBasicInfo(ctx context.Context, in *GetUserBasicInfoReq, opts ...grpc.CallOption) (*GetUserBasicInfoRes, error)
+	Register(ctx context.Context, in *RegisterReq, opts ...grpc.CallOption) (*RegisterRes, error)
+	Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginRes, error)
 	// 用户等级相关接口
 	GetUserGrades(ctx context.Context, in *GetUserGradesReq, opts ...grpc.CallOption) (*GetUserGradesRes, error)
 	SaveUserGrades(ctx context.Context, in *SaveUserGradesReq, opts ...grpc.CallOption) (*SaveUserGradesRes, error)
 	DeleteUserGrades(ctx context.Context, in *DeleteUserGradesReq, opts ...grpc.CallOption) (*DeleteUserGradesRes, error)
+	PreviewGradeUpgrades(ctx context.Context, in *PreviewGradeUpgradesReq, opts ...grpc.CallOption) (*PreviewGradeUpgradesRes, error)
+	RunGradeUpgrades(ctx context.Context, in *RunGradeUpgradesReq, opts ...grpc.CallOption) (*RunGradeUpgradesRes, error)
+	GetUserGradeLogs(ctx context.Context, in *GetUserGradeLogsReq, opts ...grpc.CallOption) (*GetUserGradeLogsRes, error)
+	RunBirthdayBonuses(ctx context.Context, in *RunBirthdayBonusesReq, opts ...grpc.CallOption) (*RunBirthdayBonusesRes, error)
+	GetBirthdayBonuses(ctx context.Context, in *GetBirthdayBonusesReq, opts ...grpc.CallOption) (*GetBirthdayBonusesRes, error)
+	// 会员积分接口
+	GetPointsSetting(ctx context.Context, in *GetPointsSettingReq, opts ...grpc.CallOption) (*GetPointsSettingRes, error)
+	UpdatePointsSetting(ctx context.Context, in *UpdatePointsSettingReq, opts ...grpc.CallOption) (*UpdatePointsSettingRes, error)
+	GetUserPointsLogs(ctx context.Context, in *GetUserPointsLogsReq, opts ...grpc.CallOption) (*GetUserPointsLogsRes, error)
+	AdjustUserPoints(ctx context.Context, in *AdjustUserPointsReq, opts ...grpc.CallOption) (*AdjustUserPointsRes, error)
+	RunBettingPoints(ctx context.Context, in *RunBettingPointsReq, opts ...grpc.CallOption) (*RunBettingPointsRes, error)
+	// 签到活动接口
+	GetSignCampaigns(ctx context.Context, in *GetSignCampaignsReq, opts ...grpc.CallOption) (*GetSignCampaignsRes, error)
+	CreateSignCampaign(ctx context.Context, in *CreateSignCampaignReq, opts ...grpc.CallOption) (*CreateSignCampaignRes, error)
+	UpdateSignCampaign(ctx context.Context, in *UpdateSignCampaignReq, opts ...grpc.CallOption) (*UpdateSignCampaignRes, error)
+	DeleteSignCampaign(ctx context.Context, in *DeleteSignCampaignReq, opts ...grpc.CallOption) (*DeleteSignCampaignRes, error)
+	GetSignLogs(ctx context.Context, in *GetSignLogsReq, opts ...grpc.CallOption) (*GetSignLogsRes, error)
+	GetUserSignStatus(ctx context.Context, in *GetUserSignStatusReq, opts ...grpc.CallOption) (*GetUserSignStatusRes, error)
+	UserSign(ctx context.Context, in *UserSignReq, opts ...grpc.CallOption) (*UserSignRes, error)
+	// 会员层级接口
+	GetUserLevels(ctx context.Context, in *GetUserLevelsReq, opts ...grpc.CallOption) (*GetUserLevelsRes, error)
+	CreateUserLevel(ctx context.Context, in *CreateUserLevelReq, opts ...grpc.CallOption) (*CreateUserLevelRes, error)
+	UpdateUserLevel(ctx context.Context, in *UpdateUserLevelReq, opts ...grpc.CallOption) (*UpdateUserLevelRes, error)
+	DeleteUserLevel(ctx context.Context, in *DeleteUserLevelReq, opts ...grpc.CallOption) (*DeleteUserLevelRes, error)
 	// 用户登录日志接口
 	GetUserLoginLogs(ctx context.Context, in *GetUserLoginLogsReq, opts ...grpc.CallOption) (*GetUserLoginLogsRes, error)
+	// 会员银行卡接口
+	GetUserBanks(ctx context.Context, in *GetUserBanksReq, opts ...grpc.CallOption) (*GetUserBanksRes, error)
+	CreateUserBank(ctx context.Context, in *CreateUserBankReq, opts ...grpc.CallOption) (*CreateUserBankRes, error)
+	UpdateUserBank(ctx context.Context, in *UpdateUserBankReq, opts ...grpc.CallOption) (*UpdateUserBankRes, error)
+	SetDefaultUserBank(ctx context.Context, in *SetDefaultUserBankReq, opts ...grpc.CallOption) (*SetDefaultUserBankRes, error)
+	DeleteUserBank(ctx context.Context, in *DeleteUserBankReq, opts ...grpc.CallOption) (*DeleteUserBankRes, error)
+	GetUserBankLogs(ctx context.Context, in *GetUserBankLogsReq, opts ...grpc.CallOption) (*GetUserBankLogsRes, error)
+	// 会员导出接口
+	ExportUserList(ctx context.Context, in *ExportUserListReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportUserListChunk], error)
 }
 
 type userClient struct {
@@ -72,6 +139,16 @@ func (c *userClient) UpdateUser(ctx context.Context, in *UpdateUserReq, opts ...
 	return out, nil
 }
 
+func (c *userClient) BatchUpdateUsers(ctx context.Context, in *BatchUpdateUsersReq, opts ...grpc.CallOption) (*BatchUpdateUsersRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpdateUsersRes)
+	err := c.cc.Invoke(ctx, User_BatchUpdateUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) GetUserBasicInfo(ctx context.Context, in *GetUserBasicInfoReq, opts ...grpc.CallOption) (*GetUserBasicInfoRes, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserBasicInfoRes)
@@ -82,6 +159,26 @@ func (c *userClient) GetUserBasicInfo(ctx context.Context, in *GetUserBasicInfoR
 	return out, nil
 }
 
+func (c *userClient) Register(ctx context.Context, in *RegisterReq, opts ...grpc.CallOption) (*RegisterRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterRes)
+	err := c.cc.Invoke(ctx, User_Register_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginRes)
+	err := c.cc.Invoke(ctx, User_Login_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) GetUserGrades(ctx context.Context, in *GetUserGradesReq, opts ...grpc.CallOption) (*GetUserGradesRes, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserGradesRes)
@@ -112,6 +209,216 @@ func (c *userClient) DeleteUserGrades(ctx context.Context, in *DeleteUserGradesR
 	return out, nil
 }
 
+func (c *userClient) PreviewGradeUpgrades(ctx context.Context, in *PreviewGradeUpgradesReq, opts ...grpc.CallOption) (*PreviewGradeUpgradesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewGradeUpgradesRes)
+	err := c.cc.Invoke(ctx, User_PreviewGradeUpgrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RunGradeUpgrades(ctx context.Context, in *RunGradeUpgradesReq, opts ...grpc.CallOption) (*RunGradeUpgradesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunGradeUpgradesRes)
+	err := c.cc.Invoke(ctx, User_RunGradeUpgrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetUserGradeLogs(ctx context.Context, in *GetUserGradeLogsReq, opts ...grpc.CallOption) (*GetUserGradeLogsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserGradeLogsRes)
+	err := c.cc.Invoke(ctx, User_GetUserGradeLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RunBirthdayBonuses(ctx context.Context, in *RunBirthdayBonusesReq, opts ...grpc.CallOption) (*RunBirthdayBonusesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunBirthdayBonusesRes)
+	err := c.cc.Invoke(ctx, User_RunBirthdayBonuses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetBirthdayBonuses(ctx context.Context, in *GetBirthdayBonusesReq, opts ...grpc.CallOption) (*GetBirthdayBonusesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBirthdayBonusesRes)
+	err := c.cc.Invoke(ctx, User_GetBirthdayBonuses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetPointsSetting(ctx context.Context, in *GetPointsSettingReq, opts ...grpc.CallOption) (*GetPointsSettingRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPointsSettingRes)
+	err := c.cc.Invoke(ctx, User_GetPointsSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UpdatePointsSetting(ctx context.Context, in *UpdatePointsSettingReq, opts ...grpc.CallOption) (*UpdatePointsSettingRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePointsSettingRes)
+	err := c.cc.Invoke(ctx, User_UpdatePointsSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetUserPointsLogs(ctx context.Context, in *GetUserPointsLogsReq, opts ...grpc.CallOption) (*GetUserPointsLogsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserPointsLogsRes)
+	err := c.cc.Invoke(ctx, User_GetUserPointsLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdjustUserPoints(ctx context.Context, in *AdjustUserPointsReq, opts ...grpc.CallOption) (*AdjustUserPointsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdjustUserPointsRes)
+	err := c.cc.Invoke(ctx, User_AdjustUserPoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RunBettingPoints(ctx context.Context, in *RunBettingPointsReq, opts ...grpc.CallOption) (*RunBettingPointsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunBettingPointsRes)
+	err := c.cc.Invoke(ctx, User_RunBettingPoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetSignCampaigns(ctx context.Context, in *GetSignCampaignsReq, opts ...grpc.CallOption) (*GetSignCampaignsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSignCampaignsRes)
+	err := c.cc.Invoke(ctx, User_GetSignCampaigns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) CreateSignCampaign(ctx context.Context, in *CreateSignCampaignReq, opts ...grpc.CallOption) (*CreateSignCampaignRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSignCampaignRes)
+	err := c.cc.Invoke(ctx, User_CreateSignCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UpdateSignCampaign(ctx context.Context, in *UpdateSignCampaignReq, opts ...grpc.CallOption) (*UpdateSignCampaignRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSignCampaignRes)
+	err := c.cc.Invoke(ctx, User_UpdateSignCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) DeleteSignCampaign(ctx context.Context, in *DeleteSignCampaignReq, opts ...grpc.CallOption) (*DeleteSignCampaignRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSignCampaignRes)
+	err := c.cc.Invoke(ctx, User_DeleteSignCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetSignLogs(ctx context.Context, in *GetSignLogsReq, opts ...grpc.CallOption) (*GetSignLogsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSignLogsRes)
+	err := c.cc.Invoke(ctx, User_GetSignLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetUserSignStatus(ctx context.Context, in *GetUserSignStatusReq, opts ...grpc.CallOption) (*GetUserSignStatusRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserSignStatusRes)
+	err := c.cc.Invoke(ctx, User_GetUserSignStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UserSign(ctx context.Context, in *UserSignReq, opts ...grpc.CallOption) (*UserSignRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserSignRes)
+	err := c.cc.Invoke(ctx, User_UserSign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetUserLevels(ctx context.Context, in *GetUserLevelsReq, opts ...grpc.CallOption) (*GetUserLevelsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserLevelsRes)
+	err := c.cc.Invoke(ctx, User_GetUserLevels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) CreateUserLevel(ctx context.Context, in *CreateUserLevelReq, opts ...grpc.CallOption) (*CreateUserLevelRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateUserLevelRes)
+	err := c.cc.Invoke(ctx, User_CreateUserLevel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UpdateUserLevel(ctx context.Context, in *UpdateUserLevelReq, opts ...grpc.CallOption) (*UpdateUserLevelRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUserLevelRes)
+	err := c.cc.Invoke(ctx, User_UpdateUserLevel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) DeleteUserLevel(ctx context.Context, in *DeleteUserLevelReq, opts ...grpc.CallOption) (*DeleteUserLevelRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserLevelRes)
+	err := c.cc.Invoke(ctx, User_DeleteUserLevel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) GetUserLoginLogs(ctx context.Context, in *GetUserLoginLogsReq, opts ...grpc.CallOption) (*GetUserLoginLogsRes, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserLoginLogsRes)
@@ -122,19 +429,134 @@ func (c *userClient) GetUserLoginLogs(ctx context.Context, in *GetUserLoginLogsR
 	return out, nil
 }
 
+func (c *userClient) GetUserBanks(ctx context.Context, in *GetUserBanksReq, opts ...grpc.CallOption) (*GetUserBanksRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserBanksRes)
+	err := c.cc.Invoke(ctx, User_GetUserBanks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) CreateUserBank(ctx context.Context, in *CreateUserBankReq, opts ...grpc.CallOption) (*CreateUserBankRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateUserBankRes)
+	err := c.cc.Invoke(ctx, User_CreateUserBank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UpdateUserBank(ctx context.Context, in *UpdateUserBankReq, opts ...grpc.CallOption) (*UpdateUserBankRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUserBankRes)
+	err := c.cc.Invoke(ctx, User_UpdateUserBank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SetDefaultUserBank(ctx context.Context, in *SetDefaultUserBankReq, opts ...grpc.CallOption) (*SetDefaultUserBankRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDefaultUserBankRes)
+	err := c.cc.Invoke(ctx, User_SetDefaultUserBank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) DeleteUserBank(ctx context.Context, in *DeleteUserBankReq, opts ...grpc.CallOption) (*DeleteUserBankRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserBankRes)
+	err := c.cc.Invoke(ctx, User_DeleteUserBank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetUserBankLogs(ctx context.Context, in *GetUserBankLogsReq, opts ...grpc.CallOption) (*GetUserBankLogsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserBankLogsRes)
+	err := c.cc.Invoke(ctx, User_GetUserBankLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ExportUserList(ctx context.Context, in *ExportUserListReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportUserListChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &User_ServiceDesc.Streams[0], User_ExportUserList_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportUserListReq, ExportUserListChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type User_ExportUserListClient = grpc.ServerStreamingClient[ExportUserListChunk]
+
 // UserServer is the server API for User service.
 // All implementations must embed UnimplementedUserServer
 // for forward compatibility.
 type UserServer interface {
 	GetUserList(context.Context, *GetUserListReq) (*GetUserListRes, error)
 	UpdateUser(context.Context, *UpdateUserReq) (*UpdateUserRes, error)
+	BatchUpdateUsers(context.Context, *BatchUpdateUsersReq) (*BatchUpdateUsersRes, error)
 	GetUserBasicInfo(context.Context, *GetUserBasicInfoReq) (*GetUserBasicInfoRes, error)
+	Register(context.Context, *RegisterReq) (*RegisterRes, error)
+	Login(context.Context, *LoginReq) (*LoginRes, error)
 	// 用户等级相关接口
 	GetUserGrades(context.Context, *GetUserGradesReq) (*GetUserGradesRes, error)
 	SaveUserGrades(context.Context, *SaveUserGradesReq) (*SaveUserGradesRes, error)
 	DeleteUserGrades(context.Context, *DeleteUserGradesReq) (*DeleteUserGradesRes, error)
+	PreviewGradeUpgrades(context.Context, *PreviewGradeUpgradesReq) (*PreviewGradeUpgradesRes, error)
+	RunGradeUpgrades(context.Context, *RunGradeUpgradesReq) (*RunGradeUpgradesRes, error)
+	GetUserGradeLogs(context.Context, *GetUserGradeLogsReq) (*GetUserGradeLogsRes, error)
+	RunBirthdayBonuses(context.Context, *RunBirthdayBonusesReq) (*RunBirthdayBonusesRes, error)
+	GetBirthdayBonuses(context.Context, *GetBirthdayBonusesReq) (*GetBirthdayBonusesRes, error)
+	// 会员积分接口
+	GetPointsSetting(context.Context, *GetPointsSettingReq) (*GetPointsSettingRes, error)
+	UpdatePointsSetting(context.Context, *UpdatePointsSettingReq) (*UpdatePointsSettingRes, error)
+	GetUserPointsLogs(context.Context, *GetUserPointsLogsReq) (*GetUserPointsLogsRes, error)
+	AdjustUserPoints(context.Context, *AdjustUserPointsReq) (*AdjustUserPointsRes, error)
+	RunBettingPoints(context.Context, *RunBettingPointsReq) (*RunBettingPointsRes, error)
+	// 签到活动接口
+	GetSignCampaigns(context.Context, *GetSignCampaignsReq) (*GetSignCampaignsRes, error)
+	CreateSignCampaign(context.Context, *CreateSignCampaignReq) (*CreateSignCampaignRes, error)
+	UpdateSignCampaign(context.Context, *UpdateSignCampaignReq) (*UpdateSignCampaignRes, error)
+	DeleteSignCampaign(context.Context, *DeleteSignCampaignReq) (*DeleteSignCampaignRes, error)
+	GetSignLogs(context.Context, *GetSignLogsReq) (*GetSignLogsRes, error)
+	GetUserSignStatus(context.Context, *GetUserSignStatusReq) (*GetUserSignStatusRes, error)
+	UserSign(context.Context, *UserSignReq) (*UserSignRes, error)
+	// 会员层级接口
+	GetUserLevels(context.Context, *GetUserLevelsReq) (*GetUserLevelsRes, error)
+	CreateUserLevel(context.Context, *CreateUserLevelReq) (*CreateUserLevelRes, error)
+	UpdateUserLevel(context.Context, *UpdateUserLevelReq) (*UpdateUserLevelRes, error)
+	DeleteUserLevel(context.Context, *DeleteUserLevelReq) (*DeleteUserLevelRes, error)
 	// 用户登录日志接口
 	GetUserLoginLogs(context.Context, *GetUserLoginLogsReq) (*GetUserLoginLogsRes, error)
+	// 会员银行卡接口
+	GetUserBanks(context.Context, *GetUserBanksReq) (*GetUserBanksRes, error)
+	CreateUserBank(context.Context, *CreateUserBankReq) (*CreateUserBankRes, error)
+	UpdateUserBank(context.Context, *UpdateUserBankReq) (*UpdateUserBankRes, error)
+	SetDefaultUserBank(context.Context, *SetDefaultUserBankReq) (*SetDefaultUserBankRes, error)
+	DeleteUserBank(context.Context, *DeleteUserBankReq) (*DeleteUserBankRes, error)
+	GetUserBankLogs(context.Context, *GetUserBankLogsReq) (*GetUserBankLogsRes, error)
+	// 会员导出接口
+	ExportUserList(*ExportUserListReq, grpc.ServerStreamingServer[ExportUserListChunk]) error
 	mustEmbedUnimplementedUserServer()
 }
 
@@ -151,9 +573,18 @@ func (UnimplementedUserServer) GetUserList(context.Context, *GetUserListReq) (*G
 func (UnimplementedUserServer) UpdateUser(context.Context, *UpdateUserReq) (*UpdateUserRes, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateUser not implemented")
 }
+func (UnimplementedUserServer) BatchUpdateUsers(context.Context, *BatchUpdateUsersReq) (*BatchUpdateUsersRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchUpdateUsers not implemented")
+}
 func (UnimplementedUserServer) GetUserBasicInfo(context.Context, *GetUserBasicInfoReq) (*GetUserBasicInfoRes, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetUserBasicInfo not implemented")
 }
+func (UnimplementedUserServer) Register(context.Context, *RegisterReq) (*RegisterRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedUserServer) Login(context.Context, *LoginReq) (*LoginRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method Login not implemented")
+}
 func (UnimplementedUserServer) GetUserGrades(context.Context, *GetUserGradesReq) (*GetUserGradesRes, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetUserGrades not implemented")
 }
@@ -163,9 +594,93 @@ func (UnimplementedUserServer) SaveUserGrades(context.Context, *SaveUserGradesRe
 func (UnimplementedUserServer) DeleteUserGrades(context.Context, *DeleteUserGradesReq) (*DeleteUserGradesRes, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteUserGrades not implemented")
 }
+func (UnimplementedUserServer) PreviewGradeUpgrades(context.Context, *PreviewGradeUpgradesReq) (*PreviewGradeUpgradesRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewGradeUpgrades not implemented")
+}
+func (UnimplementedUserServer) RunGradeUpgrades(context.Context, *RunGradeUpgradesReq) (*RunGradeUpgradesRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunGradeUpgrades not implemented")
+}
+func (UnimplementedUserServer) GetUserGradeLogs(context.Context, *GetUserGradeLogsReq) (*GetUserGradeLogsRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserGradeLogs not implemented")
+}
+func (UnimplementedUserServer) RunBirthdayBonuses(context.Context, *RunBirthdayBonusesReq) (*RunBirthdayBonusesRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunBirthdayBonuses not implemented")
+}
+func (UnimplementedUserServer) GetBirthdayBonuses(context.Context, *GetBirthdayBonusesReq) (*GetBirthdayBonusesRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBirthdayBonuses not implemented")
+}
+func (UnimplementedUserServer) GetPointsSetting(context.Context, *GetPointsSettingReq) (*GetPointsSettingRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPointsSetting not implemented")
+}
+func (UnimplementedUserServer) UpdatePointsSetting(context.Context, *UpdatePointsSettingReq) (*UpdatePointsSettingRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePointsSetting not implemented")
+}
+func (UnimplementedUserServer) GetUserPointsLogs(context.Context, *GetUserPointsLogsReq) (*GetUserPointsLogsRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserPointsLogs not implemented")
+}
+func (UnimplementedUserServer) AdjustUserPoints(context.Context, *AdjustUserPointsReq) (*AdjustUserPointsRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdjustUserPoints not implemented")
+}
+func (UnimplementedUserServer) RunBettingPoints(context.Context, *RunBettingPointsReq) (*RunBettingPointsRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunBettingPoints not implemented")
+}
+func (UnimplementedUserServer) GetSignCampaigns(context.Context, *GetSignCampaignsReq) (*GetSignCampaignsRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSignCampaigns not implemented")
+}
+func (UnimplementedUserServer) CreateSignCampaign(context.Context, *CreateSignCampaignReq) (*CreateSignCampaignRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSignCampaign not implemented")
+}
+func (UnimplementedUserServer) UpdateSignCampaign(context.Context, *UpdateSignCampaignReq) (*UpdateSignCampaignRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSignCampaign not implemented")
+}
+func (UnimplementedUserServer) DeleteSignCampaign(context.Context, *DeleteSignCampaignReq) (*DeleteSignCampaignRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSignCampaign not implemented")
+}
+func (UnimplementedUserServer) GetSignLogs(context.Context, *GetSignLogsReq) (*GetSignLogsRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSignLogs not implemented")
+}
+func (UnimplementedUserServer) GetUserSignStatus(context.Context, *GetUserSignStatusReq) (*GetUserSignStatusRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserSignStatus not implemented")
+}
+func (UnimplementedUserServer) UserSign(context.Context, *UserSignReq) (*UserSignRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method UserSign not implemented")
+}
+func (UnimplementedUserServer) GetUserLevels(context.Context, *GetUserLevelsReq) (*GetUserLevelsRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserLevels not implemented")
+}
+func (UnimplementedUserServer) CreateUserLevel(context.Context, *CreateUserLevelReq) (*CreateUserLevelRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateUserLevel not implemented")
+}
+func (UnimplementedUserServer) UpdateUserLevel(context.Context, *UpdateUserLevelReq) (*UpdateUserLevelRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateUserLevel not implemented")
+}
+func (UnimplementedUserServer) DeleteUserLevel(context.Context, *DeleteUserLevelReq) (*DeleteUserLevelRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteUserLevel not implemented")
+}
 func (UnimplementedUserServer) GetUserLoginLogs(context.Context, *GetUserLoginLogsReq) (*GetUserLoginLogsRes, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetUserLoginLogs not implemented")
 }
+func (UnimplementedUserServer) GetUserBanks(context.Context, *GetUserBanksReq) (*GetUserBanksRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserBanks not implemented")
+}
+func (UnimplementedUserServer) CreateUserBank(context.Context, *CreateUserBankReq) (*CreateUserBankRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateUserBank not implemented")
+}
+func (UnimplementedUserServer) UpdateUserBank(context.Context, *UpdateUserBankReq) (*UpdateUserBankRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateUserBank not implemented")
+}
+func (UnimplementedUserServer) SetDefaultUserBank(context.Context, *SetDefaultUserBankReq) (*SetDefaultUserBankRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetDefaultUserBank not implemented")
+}
+func (UnimplementedUserServer) DeleteUserBank(context.Context, *DeleteUserBankReq) (*DeleteUserBankRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteUserBank not implemented")
+}
+func (UnimplementedUserServer) GetUserBankLogs(context.Context, *GetUserBankLogsReq) (*GetUserBankLogsRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserBankLogs not implemented")
+}
+func (UnimplementedUserServer) ExportUserList(*ExportUserListReq, grpc.ServerStreamingServer[ExportUserListChunk]) error {
+	return status.Error(codes.Unimplemented, "method ExportUserList not implemented")
+}
 func (UnimplementedUserServer) mustEmbedUnimplementedUserServer() {}
 func (UnimplementedUserServer) testEmbeddedByValue()              {}
 
@@ -223,17 +738,35 @@ func _User_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_GetUserBasicInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserBasicInfoReq)
+func _User_BatchUpdateUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateUsersReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).GetUserBasicInfo(ctx, in)
+		return srv.(UserServer).BatchUpdateUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_GetUserBasicInfo_FullMethodName,
+		FullMethod: User_BatchUpdateUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).BatchUpdateUsers(ctx, req.(*BatchUpdateUsersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetUserBasicInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserBasicInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetUserBasicInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetUserBasicInfo_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(UserServer).GetUserBasicInfo(ctx, req.(*GetUserBasicInfoReq))
@@ -241,6 +774,42 @@ func _User_GetUserBasicInfo_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Register(ctx, req.(*RegisterReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Login(ctx, req.(*LoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _User_GetUserGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserGradesReq)
 	if err := dec(in); err != nil {
@@ -295,6 +864,384 @@ func _User_DeleteUserGrades_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_PreviewGradeUpgrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewGradeUpgradesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).PreviewGradeUpgrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_PreviewGradeUpgrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).PreviewGradeUpgrades(ctx, req.(*PreviewGradeUpgradesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RunGradeUpgrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunGradeUpgradesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RunGradeUpgrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RunGradeUpgrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RunGradeUpgrades(ctx, req.(*RunGradeUpgradesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetUserGradeLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserGradeLogsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetUserGradeLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetUserGradeLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetUserGradeLogs(ctx, req.(*GetUserGradeLogsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RunBirthdayBonuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunBirthdayBonusesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RunBirthdayBonuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RunBirthdayBonuses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RunBirthdayBonuses(ctx, req.(*RunBirthdayBonusesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetBirthdayBonuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBirthdayBonusesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetBirthdayBonuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetBirthdayBonuses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetBirthdayBonuses(ctx, req.(*GetBirthdayBonusesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetPointsSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPointsSettingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetPointsSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetPointsSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetPointsSetting(ctx, req.(*GetPointsSettingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UpdatePointsSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePointsSettingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UpdatePointsSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UpdatePointsSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UpdatePointsSetting(ctx, req.(*UpdatePointsSettingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetUserPointsLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserPointsLogsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetUserPointsLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetUserPointsLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetUserPointsLogs(ctx, req.(*GetUserPointsLogsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdjustUserPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustUserPointsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdjustUserPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdjustUserPoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdjustUserPoints(ctx, req.(*AdjustUserPointsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RunBettingPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunBettingPointsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RunBettingPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RunBettingPoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RunBettingPoints(ctx, req.(*RunBettingPointsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetSignCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSignCampaignsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetSignCampaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetSignCampaigns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetSignCampaigns(ctx, req.(*GetSignCampaignsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_CreateSignCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSignCampaignReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CreateSignCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_CreateSignCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CreateSignCampaign(ctx, req.(*CreateSignCampaignReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UpdateSignCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSignCampaignReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UpdateSignCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UpdateSignCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UpdateSignCampaign(ctx, req.(*UpdateSignCampaignReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_DeleteSignCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSignCampaignReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).DeleteSignCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_DeleteSignCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).DeleteSignCampaign(ctx, req.(*DeleteSignCampaignReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetSignLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSignLogsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetSignLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetSignLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetSignLogs(ctx, req.(*GetSignLogsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetUserSignStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserSignStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetUserSignStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetUserSignStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetUserSignStatus(ctx, req.(*GetUserSignStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UserSign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserSignReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UserSign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UserSign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UserSign(ctx, req.(*UserSignReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetUserLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserLevelsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetUserLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetUserLevels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetUserLevels(ctx, req.(*GetUserLevelsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_CreateUserLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserLevelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CreateUserLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_CreateUserLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CreateUserLevel(ctx, req.(*CreateUserLevelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UpdateUserLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserLevelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UpdateUserLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UpdateUserLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UpdateUserLevel(ctx, req.(*UpdateUserLevelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_DeleteUserLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserLevelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).DeleteUserLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_DeleteUserLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).DeleteUserLevel(ctx, req.(*DeleteUserLevelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _User_GetUserLoginLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserLoginLogsReq)
 	if err := dec(in); err != nil {
@@ -313,6 +1260,125 @@ func _User_GetUserLoginLogs_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_GetUserBanks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserBanksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetUserBanks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetUserBanks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetUserBanks(ctx, req.(*GetUserBanksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_CreateUserBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserBankReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CreateUserBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_CreateUserBank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CreateUserBank(ctx, req.(*CreateUserBankReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UpdateUserBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserBankReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UpdateUserBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UpdateUserBank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UpdateUserBank(ctx, req.(*UpdateUserBankReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SetDefaultUserBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDefaultUserBankReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SetDefaultUserBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_SetDefaultUserBank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SetDefaultUserBank(ctx, req.(*SetDefaultUserBankReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_DeleteUserBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserBankReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).DeleteUserBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_DeleteUserBank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).DeleteUserBank(ctx, req.(*DeleteUserBankReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetUserBankLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserBankLogsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetUserBankLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetUserBankLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetUserBankLogs(ctx, req.(*GetUserBankLogsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ExportUserList_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportUserListReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServer).ExportUserList(m, &grpc.GenericServerStream[ExportUserListReq, ExportUserListChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type User_ExportUserListServer = grpc.ServerStreamingServer[ExportUserListChunk]
+
 // User_ServiceDesc is the grpc.ServiceDesc for User service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -328,10 +1394,22 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUser",
 			Handler:    _User_UpdateUser_Handler,
 		},
+		{
+			MethodName: "BatchUpdateUsers",
+			Handler:    _User_BatchUpdateUsers_Handler,
+		},
 		{
 			MethodName: "GetUserBasicInfo",
 			Handler:    _User_GetUserBasicInfo_Handler,
 		},
+		{
+			MethodName: "Register",
+			Handler:    _User_Register_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _User_Login_Handler,
+		},
 		{
 			MethodName: "GetUserGrades",
 			Handler:    _User_GetUserGrades_Handler,
@@ -344,11 +1422,125 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUserGrades",
 			Handler:    _User_DeleteUserGrades_Handler,
 		},
+		{
+			MethodName: "PreviewGradeUpgrades",
+			Handler:    _User_PreviewGradeUpgrades_Handler,
+		},
+		{
+			MethodName: "RunGradeUpgrades",
+			Handler:    _User_RunGradeUpgrades_Handler,
+		},
+		{
+			MethodName: "GetUserGradeLogs",
+			Handler:    _User_GetUserGradeLogs_Handler,
+		},
+		{
+			MethodName: "RunBirthdayBonuses",
+			Handler:    _User_RunBirthdayBonuses_Handler,
+		},
+		{
+			MethodName: "GetBirthdayBonuses",
+			Handler:    _User_GetBirthdayBonuses_Handler,
+		},
+		{
+			MethodName: "GetPointsSetting",
+			Handler:    _User_GetPointsSetting_Handler,
+		},
+		{
+			MethodName: "UpdatePointsSetting",
+			Handler:    _User_UpdatePointsSetting_Handler,
+		},
+		{
+			MethodName: "GetUserPointsLogs",
+			Handler:    _User_GetUserPointsLogs_Handler,
+		},
+		{
+			MethodName: "AdjustUserPoints",
+			Handler:    _User_AdjustUserPoints_Handler,
+		},
+		{
+			MethodName: "RunBettingPoints",
+			Handler:    _User_RunBettingPoints_Handler,
+		},
+		{
+			MethodName: "GetSignCampaigns",
+			Handler:    _User_GetSignCampaigns_Handler,
+		},
+		{
+			MethodName: "CreateSignCampaign",
+			Handler:    _User_CreateSignCampaign_Handler,
+		},
+		{
+			MethodName: "UpdateSignCampaign",
+			Handler:    _User_UpdateSignCampaign_Handler,
+		},
+		{
+			MethodName: "DeleteSignCampaign",
+			Handler:    _User_DeleteSignCampaign_Handler,
+		},
+		{
+			MethodName: "GetSignLogs",
+			Handler:    _User_GetSignLogs_Handler,
+		},
+		{
+			MethodName: "GetUserSignStatus",
+			Handler:    _User_GetUserSignStatus_Handler,
+		},
+		{
+			MethodName: "UserSign",
+			Handler:    _User_UserSign_Handler,
+		},
+		{
+			MethodName: "GetUserLevels",
+			Handler:    _User_GetUserLevels_Handler,
+		},
+		{
+			MethodName: "CreateUserLevel",
+			Handler:    _User_CreateUserLevel_Handler,
+		},
+		{
+			MethodName: "UpdateUserLevel",
+			Handler:    _User_UpdateUserLevel_Handler,
+		},
+		{
+			MethodName: "DeleteUserLevel",
+			Handler:    _User_DeleteUserLevel_Handler,
+		},
 		{
 			MethodName: "GetUserLoginLogs",
 			Handler:    _User_GetUserLoginLogs_Handler,
 		},
+		{
+			MethodName: "GetUserBanks",
+			Handler:    _User_GetUserBanks_Handler,
+		},
+		{
+			MethodName: "CreateUserBank",
+			Handler:    _User_CreateUserBank_Handler,
+		},
+		{
+			MethodName: "UpdateUserBank",
+			Handler:    _User_UpdateUserBank_Handler,
+		},
+		{
+			MethodName: "SetDefaultUserBank",
+			Handler:    _User_SetDefaultUserBank_Handler,
+		},
+		{
+			MethodName: "DeleteUserBank",
+			Handler:    _User_DeleteUserBank_Handler,
+		},
+		{
+			MethodName: "GetUserBankLogs",
+			Handler:    _User_GetUserBankLogs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportUserList",
+			Handler:       _User_ExportUserList_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "backend/user/v1/user.proto",
 }
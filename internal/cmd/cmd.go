@@ -3,11 +3,17 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"jh_app_service/internal/controller/backend/activity"
 	"jh_app_service/internal/controller/backend/ad"
 	"jh_app_service/internal/controller/backend/admin"
+	"jh_app_service/internal/controller/backend/balance"
+	"jh_app_service/internal/controller/backend/feed"
 	"jh_app_service/internal/controller/backend/message"
 	"jh_app_service/internal/controller/backend/notice"
 	"jh_app_service/internal/controller/backend/option"
+	"jh_app_service/internal/controller/backend/payment"
+	"jh_app_service/internal/controller/backend/rebate"
+	"jh_app_service/internal/controller/backend/risk"
 	"jh_app_service/internal/controller/backend/role"
 	"jh_app_service/internal/controller/backend/site"
 	"jh_app_service/internal/controller/backend/upload"
@@ -20,8 +26,12 @@ import (
 	"github.com/gogf/gf/v2/os/gcmd"
 	"google.golang.org/grpc"
 
+	"jh_app_service/internal/game"
+	"jh_app_service/internal/geoip"
 	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/pubsub"
 	"jh_app_service/internal/registry"
+	"jh_app_service/internal/secret"
 	"jh_app_service/internal/tracing"
 )
 
@@ -38,6 +48,11 @@ var (
 			fmt.Printf("进程ID: %d\n", os.Getpid())
 			fmt.Println("==============================")
 
+			// 支付密钥和银行卡号依赖主密钥加解密，未配置时拒绝启动
+			if _, err = secret.ActiveKeyVersion(ctx); err != nil {
+				return fmt.Errorf("加载主密钥失败: %v", err)
+			}
+
 			// 初始化Jaeger追踪
 			cleanup, err := tracing.InitJaeger()
 			if err != nil {
@@ -62,6 +77,19 @@ var (
 
 			fmt.Println("Consul服务注册成功")
 
+			// 加载 GeoIP 离线库
+			if err := geoip.Init(ctx); err != nil {
+				g.Log().Errorf(ctx, "init geoip failed: %v", err)
+			}
+
+			// 后台实时推送使用进程内消息代理
+			pubsub.SetBroker(pubsub.NewMemoryBroker(g.Cfg().MustGet(ctx, "feed.replay", 1000).Int(), g.Cfg().MustGet(ctx, "feed.buffer", 64).Int()))
+
+			// 初始化游戏厂商钱包
+			if err := game.InitFromConfig(ctx); err != nil {
+				g.Log().Fatalf(ctx, "init game wallet providers failed: %v", err)
+			}
+
 			c := grpcx.Server.NewConfig()
 			c.Options = append(c.Options, []grpc.ServerOption{
 				// 使用 StatsHandler 替代 Interceptor 进行追踪和统计
@@ -81,6 +109,17 @@ var (
 			ad.Register(s)
 			notice.Register(s)
 			option.Register(s)
+			balance.Register(s)
+			payment.Register(s)
+			risk.Register(s)
+			feed.Register(s)
+			rebate.Register(s)
+			activity.Register(s)
+
+			// 注册定时任务
+			if err := registerCronJobs(ctx); err != nil {
+				g.Log().Fatalf(ctx, "register cron jobs failed: %v", err)
+			}
 
 			fmt.Println("gRPC服务器启动中...")
 
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gcron"
+	"github.com/gogf/gf/v2/os/gtime"
+
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/service/backend"
+)
+
+// registerCronJobs 注册定时任务
+// gcron 使用 time.Local，main 中已按配置 timezone 设置，因此零点即站点所在时区的零点
+func registerCronJobs(ctx context.Context) error {
+	// 每日零点保存并重置入款渠道今日统计，随后对账前一日入款
+	_, err := gcron.AddSingleton(ctx, "0 0 0 * * *", func(ctx context.Context) {
+		if err := backend.Payment().ResetDailyCounters(ctx); err != nil {
+			middleware.LogWithTrace(ctx, "error", "重置渠道今日统计失败: %v", err)
+			return
+		}
+		if _, err := backend.Payment().ReconcileDaily(ctx, gtime.Now().AddDate(0, 0, -1)); err != nil {
+			middleware.LogWithTrace(ctx, "error", "入款日对账失败: %v", err)
+		}
+	}, "payment.reset_daily_counters")
+	if err != nil {
+		return err
+	}
+
+	// 每5分钟对账结果未知的游戏转账订单
+	_, err = gcron.AddSingleton(ctx, "0 */5 * * * *", func(ctx context.Context) {
+		if err := backend.Balance().ReconcileGameTransfers(ctx); err != nil {
+			middleware.LogWithTrace(ctx, "error", "游戏转账对账失败: %v", err)
+		}
+	}, "balance.reconcile_game_transfers")
+	if err != nil {
+		return err
+	}
+
+	// 每小时标记可疑的多账号关联，默认关闭
+	if g.Cfg().MustGet(ctx, "risk.cluster.tagJob", false).Bool() {
+		_, err = gcron.AddSingleton(ctx, "0 30 * * * *", func(ctx context.Context) {
+			if err := backend.Risk().TagAccountClusters(ctx); err != nil {
+				middleware.LogWithTrace(ctx, "error", "标记多账号关联失败: %v", err)
+			}
+		}, "risk.tag_account_clusters")
+		if err != nil {
+			return err
+		}
+	}
+
+	// 每10分钟升级积分达到条件的会员，默认关闭
+	if g.Cfg().MustGet(ctx, "user.grade.upgradeJob", false).Bool() {
+		_, err = gcron.AddSingleton(ctx, "0 */10 * * * *", func(ctx context.Context) {
+			if err := backend.User().UpgradeGrades(ctx); err != nil {
+				middleware.LogWithTrace(ctx, "error", "会员等级升级失败: %v", err)
+			}
+		}, "user.upgrade_grades")
+		if err != nil {
+			return err
+		}
+	}
+
+	// 每日发放当天生日会员的彩金
+	if g.Cfg().MustGet(ctx, "user.birthday.bonusJob", false).Bool() {
+		_, err = gcron.AddSingleton(ctx, "0 10 0 * * *", func(ctx context.Context) {
+			if err := backend.User().PayBirthdayBonuses(ctx); err != nil {
+				middleware.LogWithTrace(ctx, "error", "发放生日彩金失败: %v", err)
+			}
+		}, "user.pay_birthday_bonuses")
+		if err != nil {
+			return err
+		}
+	}
+
+	// 每日按昨天的有效投注发放投注积分
+	if g.Cfg().MustGet(ctx, "user.points.bettingJob", false).Bool() {
+		_, err = gcron.AddSingleton(ctx, "0 30 2 * * *", func(ctx context.Context) {
+			if err := backend.User().AccrueBettingPoints(ctx); err != nil {
+				middleware.LogWithTrace(ctx, "error", "发放投注积分失败: %v", err)
+			}
+		}, "user.accrue_betting_points")
+		if err != nil {
+			return err
+		}
+	}
+
+	// 每日按昨天的投注汇总检查单日投注金额和赢得金额预警
+	if g.Cfg().MustGet(ctx, "risk.forewarn.betJob", false).Bool() {
+		_, err = gcron.AddSingleton(ctx, "0 40 2 * * *", func(ctx context.Context) {
+			if err := backend.Risk().EvaluateDailyBets(ctx); err != nil {
+				middleware.LogWithTrace(ctx, "error", "检查投注预警失败: %v", err)
+			}
+		}, "risk.evaluate_daily_bets")
+		if err != nil {
+			return err
+		}
+	}
+
+	// 每日计算昨天的返水，审核后发放
+	if g.Cfg().MustGet(ctx, "rebate.calculateJob", false).Bool() {
+		_, err = gcron.AddSingleton(ctx, "0 0 3 * * *", func(ctx context.Context) {
+			if err := backend.Rebate().CalculateRebates(ctx); err != nil {
+				middleware.LogWithTrace(ctx, "error", "计算返水失败: %v", err)
+			}
+		}, "rebate.calculate_rebates")
+	}
+	return err
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogf/gf/v2/os/gcmd"
+	"github.com/gogf/gf/v2/os/gtime"
+
+	"jh_app_service/internal/service/backend"
+)
+
+var (
+	// ReconcilePayment 重新对账指定日期的入款，默认对账前一日
+	ReconcilePayment = gcmd.Command{
+		Name:  "reconcile-payment",
+		Usage: "reconcile-payment [-date 2006-01-02]",
+		Brief: "re-run daily payment reconciliation for the given date",
+		Arguments: []gcmd.Argument{
+			{Name: "date", Short: "d", Brief: "date to reconcile, defaults to yesterday"},
+		},
+		Func: func(ctx context.Context, parser *gcmd.Parser) (err error) {
+			date := gtime.Now().AddDate(0, 0, -1)
+			if value := parser.GetOpt("date").String(); value != "" {
+				date, err = gtime.StrToTimeFormat(value, "Y-m-d")
+				if err != nil {
+					return fmt.Errorf("日期格式错误: %v", err)
+				}
+			}
+
+			discrepancies, err := backend.Payment().ReconcileDaily(ctx, date)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("对账日期: %s, 差异数: %d\n", date.Format("Y-m-d"), discrepancies)
+			return nil
+		},
+	}
+)
+
+func init() {
+	if err := Main.AddCommand(&ReconcilePayment); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gcmd"
+	"github.com/gogf/gf/v2/os/gtime"
+
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/secret"
+)
+
+// paymentSecretColumns 需要加密存储的支付接口字段
+var paymentSecretColumns = []string{"md5_key", "public_key", "private_key"}
+
+// secretTable 需要加密存储的表及字段
+type secretTable struct {
+	table      string
+	columns    []string
+	hasUpdated bool // 表中是否有 updated_at 字段
+}
+
+// secretTables 返回需要迁移的表，payment_account_copy 中保存着同样的密钥副本，需要一并处理
+func secretTables() []secretTable {
+	return []secretTable{
+		{table: dao.PaymentAccount.Table(), columns: paymentSecretColumns, hasUpdated: true},
+		{table: dao.PaymentAccountCopy.Table(), columns: paymentSecretColumns, hasUpdated: true},
+		{table: dao.UserBank.Table(), columns: []string{"card_no"}, hasUpdated: true},
+		{table: dao.UserBankLog.Table(), columns: []string{"old_card_no", "new_card_no"}},
+	}
+}
+
+var (
+	// EncryptSecrets 加密存量支付接口密钥和会员银行卡号，或在轮换主密钥后用当前主密钥重新加密
+	EncryptSecrets = gcmd.Command{
+		Name:  "encrypt-secrets",
+		Usage: "encrypt-secrets [-dry-run]",
+		Brief: "encrypt payment account secrets and member bank card numbers at rest and rewrap them with the active master key",
+		Arguments: []gcmd.Argument{
+			{Name: "dry-run", Short: "d", Brief: "only count rows that need migration", Orphan: true},
+		},
+		Func: func(ctx context.Context, parser *gcmd.Parser) (err error) {
+			dryRun := parser.GetOpt("dry-run") != nil
+
+			version, err := secret.ActiveKeyVersion(ctx)
+			if err != nil {
+				return fmt.Errorf("加载主密钥失败: %v", err)
+			}
+			fmt.Printf("当前主密钥版本: %s, dry-run: %t\n", version, dryRun)
+
+			for _, table := range secretTables() {
+				migrated, err := encryptTableSecrets(ctx, table, dryRun)
+				if err != nil {
+					return fmt.Errorf("迁移表 %s 失败: %v", table.table, err)
+				}
+				fmt.Printf("表 %s 需要迁移的记录数: %d\n", table.table, migrated)
+			}
+			return nil
+		},
+	}
+)
+
+func init() {
+	if err := Main.AddCommand(&EncryptSecrets); err != nil {
+		panic(err)
+	}
+}
+
+// encryptTableSecrets 逐行加密或重新加密指定表的密钥字段，返回需要迁移的记录数
+func encryptTableSecrets(ctx context.Context, table secretTable, dryRun bool) (int, error) {
+	records, err := g.DB().Model(table.table).Fields(append([]string{"id"}, table.columns...)).All()
+	if err != nil {
+		return 0, err
+	}
+
+	migrated := 0
+	for _, record := range records {
+		updateData := g.Map{}
+		for _, column := range table.columns {
+			value := record[column].String()
+			if !secret.NeedsRewrap(ctx, value) {
+				continue
+			}
+			rewrapped, err := secret.Rewrap(ctx, value)
+			if err != nil {
+				return migrated, fmt.Errorf("记录 %d 字段 %s: %v", record["id"].Int(), column, err)
+			}
+			updateData[column] = rewrapped
+		}
+		if len(updateData) == 0 {
+			continue
+		}
+
+		migrated++
+		if dryRun {
+			continue
+		}
+
+		if table.hasUpdated {
+			updateData["updated_at"] = gtime.Now()
+		}
+		if _, err = g.DB().Model(table.table).Where("id", record["id"].Int()).Data(updateData).Update(); err != nil {
+			return migrated, fmt.Errorf("更新记录 %d 失败: %v", record["id"].Int(), err)
+		}
+	}
+	return migrated, nil
+}
@@ -1 +1,215 @@
 package backend
+
+// 入款渠道类型
+const (
+	PaymentChannelOnline   = 1 // 在线支付 (payment_account)
+	PaymentChannelTransfer = 2 // 转账汇款 (transfer_account)
+)
+
+// 支付接口熔断状态
+const (
+	CircuitClosed   = 0 // 正常
+	CircuitOpen     = 1 // 已熔断，暂停分配入款
+	CircuitHalfOpen = 2 // 半开，放行一笔探测入款
+)
+
+// 账变类型
+const (
+	ChangeTypeIn  = 1 // 入款
+	ChangeTypeOut = 2 // 出款
+)
+
+// 交易类型
+const (
+	TradeTypeRechargeOnline = 1  // 在线入款
+	TradeTypeRechargeManual = 2  // 转账入款
+	TradeTypeManualAdd      = 3  // 后台加款
+	TradeTypeManualDeduct   = 4  // 后台扣款
+	TradeTypeGameIn         = 5  // 转入游戏
+	TradeTypeGameOut        = 6  // 游戏转出
+	TradeTypeGameRefund     = 7  // 转入游戏失败退回
+	TradeTypeGradeBonus     = 8  // 升级彩金
+	TradeTypeBirthdayBonus  = 9  // 生日彩金
+	TradeTypeSignBonus      = 10 // 签到奖励
+	TradeTypeRebate         = 11 // 返水
+)
+
+// 转账入款订单状态
+const (
+	RechargeManualPending   = 1 // 待确认
+	RechargeManualConfirmed = 2 // 已确认
+	RechargeManualCanceled  = 3 // 已取消
+)
+
+// 银行流水对账结果
+const (
+	StatementItemMatched  = 1 // 已自动匹配
+	StatementItemReview   = 2 // 待复核
+	StatementItemNoMatch  = 3 // 未匹配
+	StatementItemInvalid  = 4 // 无效行
+	StatementItemResolved = 5 // 复核已确认
+	StatementItemIgnored  = 6 // 复核已忽略
+)
+
+// 游戏转账方向
+const (
+	GameTransferIn  = 1 // 账户转入游戏
+	GameTransferOut = 2 // 游戏转出到账户
+)
+
+// 游戏转账订单状态
+const (
+	GameTransferProcessing = 0 // 处理中
+	GameTransferSuccess    = 1 // 成功
+	GameTransferFailed     = 2 // 失败
+	GameTransferReconcile  = 3 // 结果未知，待对账
+)
+
+// 在线支付订单状态
+const (
+	RechargePaymentPending = 1 // 待支付
+	RechargePaymentPaid    = 2 // 已支付
+	RechargePaymentFailed  = 3 // 已失败
+)
+
+// 入款日对账差异状态
+const (
+	ReconcilePending  = 1 // 待处理
+	ReconcileResolved = 2 // 已处理
+	ReconcileIgnored  = 3 // 已忽略
+)
+
+// 注册表单类型
+const (
+	SiteRegisterTypeUser  = 1 // 会员注册
+	SiteRegisterTypeAgent = 2 // 代理注册
+)
+
+// 访问屏蔽类型
+const (
+	SiteDenyTypeIp      = 1 // IP或网段
+	SiteDenyTypeAddress = 2 // 地区
+)
+
+// 风险预警类型
+const (
+	RiskAccountToGame  = 1 // 账户转入游戏
+	RiskGameToAccount  = 2 // 游戏转出到账户
+	RiskWinMoney       = 3 // 单日赢得金额
+	RiskBetAmount      = 4 // 单日投注金额
+	RiskAlterBankCard  = 5 // 修改银行卡
+	RiskLoginArea      = 6 // 登录地区变化
+	RiskSameIpRegister = 7 // 同IP注册
+)
+
+// 风险预警处理状态
+const (
+	RiskForewarnPending = 0 // 未处理
+	RiskForewarnHandled = 1 // 已处理
+)
+
+// 后台实时推送事件类别
+const (
+	FeedKindEvent     = 1 // 业务事件
+	FeedKindHeartbeat = 2 // 心跳
+	FeedKindReset     = 3 // 断线期间的事件已无法补发，需要重新加载
+)
+
+// 多账号关联类型
+const (
+	ClusterRegisterIp = 1 // 注册IP
+	ClusterLoginIp    = 2 // 登录IP
+	ClusterDevice     = 3 // 设备指纹
+)
+
+// 多账号关联审核状态
+const (
+	ClusterPending   = 1 // 待审核
+	ClusterConfirmed = 2 // 已确认
+	ClusterIgnored   = 3 // 已忽略
+)
+
+// 会员关注级别
+const (
+	FocusLevelNormal     = 1 // 正常
+	FocusLevelSuspicious = 2 // 可疑
+	FocusLevelDanger     = 3 // 危险
+)
+
+// 会员银行卡变更操作
+const (
+	UserBankCreate     = 1 // 添加
+	UserBankUpdate     = 2 // 修改
+	UserBankSetDefault = 3 // 设为默认
+	UserBankDelete     = 4 // 删除
+)
+
+// 导出格式
+const (
+	ExportFormatCsv  = 1 // CSV
+	ExportFormatXlsx = 2 // XLSX
+)
+
+// 管理员自定义字段页面 (admin_custom_field.page)
+const (
+	CustomFieldPageUser = 1 // 会员列表
+)
+
+// 等级升级彩金状态 (user_grade_log.bonus_status)
+const (
+	GradeBonusNone     = 0 // 无彩金
+	GradeBonusPaid     = 1 // 已发放
+	GradeBonusManual   = 2 // 未开启自动发放
+	GradeBonusPaidOnce = 3 // 此前已发放，不重复发放
+)
+
+// 积分来源 (user_points_log.source)
+const (
+	PointsSourceRecharge = 1 // 充值
+	PointsSourceBetting  = 2 // 投注
+	PointsSourceManual   = 3 // 后台调整
+	PointsSourceSign     = 4 // 签到奖励
+)
+
+// 游戏类型 (site_game.type, bet_log_daily.game_type)
+const (
+	GameTypeSports  = 1 // 体育
+	GameTypeLottery = 2 // 彩票
+	GameTypeLive    = 3 // 真人视讯
+	GameTypeEgame   = 4 // 电子游戏
+)
+
+// 返水状态 (rebate_history.status, rebate_detail.status)
+const (
+	RebateStatusPending  = 0 // 待审核
+	RebateStatusPaid     = 1 // 已发放
+	RebateStatusRejected = 2 // 已驳回
+)
+
+// 活动类型 (activity.activity_type)
+const (
+	ActivityTypeCustom   = 1 // 自定义活动，内容保存在 activity_custom
+	ActivityTypeRecharge = 2 // 充值活动，内容保存在 activity_recharge
+)
+
+// 活动模块类型 (activity_module.module_type)
+const (
+	ActivityModuleRecharge    = 1 // 充值
+	ActivityModuleTurntable   = 2 // 大转盘
+	ActivityModuleTreasureBox = 3 // 开宝箱
+	ActivityModuleGoldenEgg   = 4 // 砸金蛋
+	ActivityModuleRedPacket   = 5 // 抢红包
+)
+
+// 活动内容类型 (activity_custom.pc_type/mobile_type, activity_recharge.pc_type/mobile_type)
+const (
+	ActivityContentEditor = 1 // 编辑框内容
+	ActivityContentLink   = 2 // 内容链接
+)
+
+// 活动标签状态 (activity_tag.status)
+const (
+	ActivityTagDeleted  = -1 // 已删除
+	ActivityTagDisabled = 0  // 禁用
+	ActivityTagEnabled  = 1  // 启用
+)
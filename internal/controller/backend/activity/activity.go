@@ -0,0 +1,87 @@
+package activity
+
+import (
+	"context"
+	v1 "jh_app_service/api/backend/activity/v1"
+	"jh_app_service/internal/service/backend"
+
+	"github.com/gogf/gf/contrib/rpc/grpcx/v2"
+)
+
+type Controller struct {
+	v1.UnimplementedActivityServer
+}
+
+func Register(s *grpcx.GrpcServer) {
+	v1.RegisterActivityServer(s.Server, &Controller{})
+}
+
+// GetActivities 获取活动列表
+func (*Controller) GetActivities(ctx context.Context, req *v1.GetActivitiesReq) (res *v1.GetActivitiesRes, err error) {
+	return backend.Activity().GetActivities(ctx, req)
+}
+
+// GetActivity 获取活动详情
+func (*Controller) GetActivity(ctx context.Context, req *v1.GetActivityReq) (res *v1.GetActivityRes, err error) {
+	return backend.Activity().GetActivity(ctx, req)
+}
+
+// CreateActivity 创建活动
+func (*Controller) CreateActivity(ctx context.Context, req *v1.CreateActivityReq) (res *v1.CreateActivityRes, err error) {
+	return backend.Activity().CreateActivity(ctx, req)
+}
+
+// UpdateActivity 修改活动
+func (*Controller) UpdateActivity(ctx context.Context, req *v1.UpdateActivityReq) (res *v1.UpdateActivityRes, err error) {
+	return backend.Activity().UpdateActivity(ctx, req)
+}
+
+// DeleteActivity 删除活动
+func (*Controller) DeleteActivity(ctx context.Context, req *v1.DeleteActivityReq) (res *v1.DeleteActivityRes, err error) {
+	return backend.Activity().DeleteActivity(ctx, req)
+}
+
+// PublishActivity 发布活动
+func (*Controller) PublishActivity(ctx context.Context, req *v1.PublishActivityReq) (res *v1.PublishActivityRes, err error) {
+	return backend.Activity().PublishActivity(ctx, req)
+}
+
+// UnpublishActivity 取消发布活动
+func (*Controller) UnpublishActivity(ctx context.Context, req *v1.UnpublishActivityReq) (res *v1.UnpublishActivityRes, err error) {
+	return backend.Activity().UnpublishActivity(ctx, req)
+}
+
+// SortActivities 活动排序
+func (*Controller) SortActivities(ctx context.Context, req *v1.SortActivitiesReq) (res *v1.SortActivitiesRes, err error) {
+	return backend.Activity().SortActivities(ctx, req)
+}
+
+// SaveActivityModules 保存活动模块
+func (*Controller) SaveActivityModules(ctx context.Context, req *v1.SaveActivityModulesReq) (res *v1.SaveActivityModulesRes, err error) {
+	return backend.Activity().SaveActivityModules(ctx, req)
+}
+
+// UpdateActivityContent 修改活动内容
+func (*Controller) UpdateActivityContent(ctx context.Context, req *v1.UpdateActivityContentReq) (res *v1.UpdateActivityContentRes, err error) {
+	return backend.Activity().UpdateActivityContent(ctx, req)
+}
+
+// GetActivityTags 获取活动标签列表
+func (*Controller) GetActivityTags(ctx context.Context, req *v1.GetActivityTagsReq) (res *v1.GetActivityTagsRes, err error) {
+	return backend.Activity().GetActivityTags(ctx, req)
+}
+
+// CreateActivityTag 创建活动标签
+func (*Controller) CreateActivityTag(ctx context.Context, req *v1.CreateActivityTagReq) (res *v1.CreateActivityTagRes, err error) {
+	return backend.Activity().CreateActivityTag(ctx, req)
+}
+
+// UpdateActivityTag 修改活动标签
+func (*Controller) UpdateActivityTag(ctx context.Context, req *v1.UpdateActivityTagReq) (res *v1.UpdateActivityTagRes, err error) {
+	return backend.Activity().UpdateActivityTag(ctx, req)
+}
+
+// DeleteActivityTag 删除活动标签
+func (*Controller) DeleteActivityTag(ctx context.Context, req *v1.DeleteActivityTagReq) (res *v1.DeleteActivityTagRes, err error) {
+	return backend.Activity().DeleteActivityTag(ctx, req)
+}
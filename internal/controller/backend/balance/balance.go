@@ -0,0 +1,72 @@
+package balance
+
+import (
+	"context"
+	v1 "jh_app_service/api/backend/balance/v1"
+	"jh_app_service/internal/service/backend"
+
+	"github.com/gogf/gf/contrib/rpc/grpcx/v2"
+)
+
+type Controller struct {
+	v1.UnimplementedBalanceServer
+}
+
+func Register(s *grpcx.GrpcServer) {
+	v1.RegisterBalanceServer(s.Server, &Controller{})
+}
+
+// GetPaymentAccounts 获取支付接口列表
+func (*Controller) GetPaymentAccounts(ctx context.Context, req *v1.GetPaymentAccountsReq) (res *v1.GetPaymentAccountsRes, err error) {
+	return backend.Balance().GetPaymentAccounts(ctx, req)
+}
+
+// CreatePaymentAccount 创建支付接口
+func (*Controller) CreatePaymentAccount(ctx context.Context, req *v1.CreatePaymentAccountReq) (res *v1.CreatePaymentAccountRes, err error) {
+	return backend.Balance().CreatePaymentAccount(ctx, req)
+}
+
+// GetPaymentAccountUpdate 获取支付接口编辑信息
+func (*Controller) GetPaymentAccountUpdate(ctx context.Context, req *v1.GetPaymentAccountUpdateReq) (res *v1.GetPaymentAccountUpdateRes, err error) {
+	return backend.Balance().GetPaymentAccountUpdate(ctx, req)
+}
+
+// UpdatePaymentAccount 更新支付接口
+func (*Controller) UpdatePaymentAccount(ctx context.Context, req *v1.UpdatePaymentAccountReq) (res *v1.UpdatePaymentAccountRes, err error) {
+	return backend.Balance().UpdatePaymentAccount(ctx, req)
+}
+
+// DeletePaymentAccount 删除支付接口
+func (*Controller) DeletePaymentAccount(ctx context.Context, req *v1.DeletePaymentAccountReq) (res *v1.DeletePaymentAccountRes, err error) {
+	return backend.Balance().DeletePaymentAccount(ctx, req)
+}
+
+// GetRechargeManuals 获取转账入款订单列表
+func (*Controller) GetRechargeManuals(ctx context.Context, req *v1.GetRechargeManualsReq) (res *v1.GetRechargeManualsRes, err error) {
+	return backend.Balance().GetRechargeManuals(ctx, req)
+}
+
+// ConfirmPaymentOrder 人工确认转账入款订单
+func (*Controller) ConfirmPaymentOrder(ctx context.Context, req *v1.ConfirmPaymentOrderReq) (res *v1.ConfirmPaymentOrderRes, err error) {
+	return backend.Balance().ConfirmPaymentOrder(ctx, req)
+}
+
+// QueryGameBalance 查询会员在游戏厂商的余额
+func (*Controller) QueryGameBalance(ctx context.Context, req *v1.QueryGameBalanceReq) (res *v1.QueryGameBalanceRes, err error) {
+	return backend.Balance().QueryGameBalance(ctx, req)
+}
+
+// NotifyRechargeCreated 会员端创建入款订单后通知后台
+func (*Controller) NotifyRechargeCreated(ctx context.Context, req *v1.NotifyRechargeCreatedReq) (res *v1.NotifyRechargeCreatedRes, err error) {
+	return backend.Balance().NotifyRechargeCreated(ctx, req)
+}
+
+// NotifyWithdrawCreated 会员端创建出款申请后通知后台
+func (*Controller) NotifyWithdrawCreated(ctx context.Context, req *v1.NotifyWithdrawCreatedReq) (res *v1.NotifyWithdrawCreatedRes, err error) {
+	return backend.Balance().NotifyWithdrawCreated(ctx, req)
+}
+
+// TransferGame 会员转入或转出游戏
+func (*Controller) TransferGame(ctx context.Context, req *v1.TransferGameReq) (res *v1.TransferGameRes, err error) {
+	return backend.Balance().TransferGame(ctx, req)
+}
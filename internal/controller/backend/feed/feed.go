@@ -0,0 +1,21 @@
+package feed
+
+import (
+	v1 "jh_app_service/api/backend/feed/v1"
+	"jh_app_service/internal/service/backend"
+
+	"github.com/gogf/gf/contrib/rpc/grpcx/v2"
+)
+
+type Controller struct {
+	v1.UnimplementedFeedServer
+}
+
+func Register(s *grpcx.GrpcServer) {
+	v1.RegisterFeedServer(s.Server, &Controller{})
+}
+
+// Subscribe 订阅后台实时推送
+func (*Controller) Subscribe(req *v1.SubscribeReq, stream v1.Feed_SubscribeServer) error {
+	return backend.Feed().Subscribe(req, stream)
+}
@@ -0,0 +1,107 @@
+package payment
+
+import (
+	"context"
+	v1 "jh_app_service/api/backend/payment/v1"
+	"jh_app_service/internal/service/backend"
+
+	"github.com/gogf/gf/contrib/rpc/grpcx/v2"
+)
+
+type Controller struct {
+	v1.UnimplementedPaymentServer
+}
+
+func Register(s *grpcx.GrpcServer) {
+	v1.RegisterPaymentServer(s.Server, &Controller{})
+}
+
+// GetPaymentChannels 获取会员可用的入款渠道
+func (*Controller) GetPaymentChannels(ctx context.Context, req *v1.GetPaymentChannelsReq) (res *v1.GetPaymentChannelsRes, err error) {
+	return backend.Payment().GetPaymentChannels(ctx, req)
+}
+
+// ReportGatewayResult 上报支付网关调用结果
+func (*Controller) ReportGatewayResult(ctx context.Context, req *v1.ReportGatewayResultReq) (res *v1.ReportGatewayResultRes, err error) {
+	return backend.Payment().ReportGatewayResult(ctx, req)
+}
+
+// GetTransferAccounts 获取转账接口列表
+func (*Controller) GetTransferAccounts(ctx context.Context, req *v1.GetTransferAccountsReq) (res *v1.GetTransferAccountsRes, err error) {
+	return backend.Payment().GetTransferAccounts(ctx, req)
+}
+
+// CreateTransferAccount 创建转账接口
+func (*Controller) CreateTransferAccount(ctx context.Context, req *v1.CreateTransferAccountReq) (res *v1.CreateTransferAccountRes, err error) {
+	return backend.Payment().CreateTransferAccount(ctx, req)
+}
+
+// GetTransferAccountUpdate 获取转账接口编辑信息
+func (*Controller) GetTransferAccountUpdate(ctx context.Context, req *v1.GetTransferAccountUpdateReq) (res *v1.GetTransferAccountUpdateRes, err error) {
+	return backend.Payment().GetTransferAccountUpdate(ctx, req)
+}
+
+// UpdateTransferAccount 更新转账接口
+func (*Controller) UpdateTransferAccount(ctx context.Context, req *v1.UpdateTransferAccountReq) (res *v1.UpdateTransferAccountRes, err error) {
+	return backend.Payment().UpdateTransferAccount(ctx, req)
+}
+
+// DeleteTransferAccount 删除转账接口
+func (*Controller) DeleteTransferAccount(ctx context.Context, req *v1.DeleteTransferAccountReq) (res *v1.DeleteTransferAccountRes, err error) {
+	return backend.Payment().DeleteTransferAccount(ctx, req)
+}
+
+// SortTransferAccounts 批量更新转账接口排序
+func (*Controller) SortTransferAccounts(ctx context.Context, req *v1.SortTransferAccountsReq) (res *v1.SortTransferAccountsRes, err error) {
+	return backend.Payment().SortTransferAccounts(ctx, req)
+}
+
+// SetTransferAccountStatus 启用或禁用转账接口
+func (*Controller) SetTransferAccountStatus(ctx context.Context, req *v1.SetTransferAccountStatusReq) (res *v1.SetTransferAccountStatusRes, err error) {
+	return backend.Payment().SetTransferAccountStatus(ctx, req)
+}
+
+// GetTransferAccountLevels 获取转账接口适用的会员层级
+func (*Controller) GetTransferAccountLevels(ctx context.Context, req *v1.GetTransferAccountLevelsReq) (res *v1.GetTransferAccountLevelsRes, err error) {
+	return backend.Payment().GetTransferAccountLevels(ctx, req)
+}
+
+// SaveTransferAccountLevels 保存转账接口适用的会员层级
+func (*Controller) SaveTransferAccountLevels(ctx context.Context, req *v1.SaveTransferAccountLevelsReq) (res *v1.SaveTransferAccountLevelsRes, err error) {
+	return backend.Payment().SaveTransferAccountLevels(ctx, req)
+}
+
+// ImportBankStatement 导入银行流水并自动匹配转账入款订单
+func (*Controller) ImportBankStatement(ctx context.Context, req *v1.ImportBankStatementReq) (res *v1.ImportBankStatementRes, err error) {
+	return backend.Payment().ImportBankStatement(ctx, req)
+}
+
+// GetStatementBatches 获取对账批次列表
+func (*Controller) GetStatementBatches(ctx context.Context, req *v1.GetStatementBatchesReq) (res *v1.GetStatementBatchesRes, err error) {
+	return backend.Payment().GetStatementBatches(ctx, req)
+}
+
+// GetStatementItems 获取对账明细
+func (*Controller) GetStatementItems(ctx context.Context, req *v1.GetStatementItemsReq) (res *v1.GetStatementItemsRes, err error) {
+	return backend.Payment().GetStatementItems(ctx, req)
+}
+
+// ResolveStatementItem 复核对账明细
+func (*Controller) ResolveStatementItem(ctx context.Context, req *v1.ResolveStatementItemReq) (res *v1.ResolveStatementItemRes, err error) {
+	return backend.Payment().ResolveStatementItem(ctx, req)
+}
+
+// GetReconcileDiscrepancies 获取入款对账差异列表
+func (*Controller) GetReconcileDiscrepancies(ctx context.Context, req *v1.GetReconcileDiscrepanciesReq) (res *v1.GetReconcileDiscrepanciesRes, err error) {
+	return backend.Payment().GetReconcileDiscrepancies(ctx, req)
+}
+
+// ResolveReconcileDiscrepancy 处理入款对账差异
+func (*Controller) ResolveReconcileDiscrepancy(ctx context.Context, req *v1.ResolveReconcileDiscrepancyReq) (res *v1.ResolveReconcileDiscrepancyRes, err error) {
+	return backend.Payment().ResolveReconcileDiscrepancy(ctx, req)
+}
+
+// GetPaymentChannelHealth 获取支付接口健康状态
+func (*Controller) GetPaymentChannelHealth(ctx context.Context, req *v1.GetPaymentChannelHealthReq) (res *v1.GetPaymentChannelHealthRes, err error) {
+	return backend.Payment().GetPaymentChannelHealth(ctx, req)
+}
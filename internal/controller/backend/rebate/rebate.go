@@ -0,0 +1,72 @@
+package rebate
+
+import (
+	"context"
+	v1 "jh_app_service/api/backend/rebate/v1"
+	"jh_app_service/internal/service/backend"
+
+	"github.com/gogf/gf/contrib/rpc/grpcx/v2"
+)
+
+type Controller struct {
+	v1.UnimplementedRebateServer
+}
+
+func Register(s *grpcx.GrpcServer) {
+	v1.RegisterRebateServer(s.Server, &Controller{})
+}
+
+// PreviewRebate 预览返水
+func (*Controller) PreviewRebate(ctx context.Context, req *v1.PreviewRebateReq) (res *v1.PreviewRebateRes, err error) {
+	return backend.Rebate().PreviewRebate(ctx, req)
+}
+
+// CalculateRebate 计算返水
+func (*Controller) CalculateRebate(ctx context.Context, req *v1.CalculateRebateReq) (res *v1.CalculateRebateRes, err error) {
+	return backend.Rebate().CalculateRebate(ctx, req)
+}
+
+// ApproveRebate 审核发放返水
+func (*Controller) ApproveRebate(ctx context.Context, req *v1.ApproveRebateReq) (res *v1.ApproveRebateRes, err error) {
+	return backend.Rebate().ApproveRebate(ctx, req)
+}
+
+// RejectRebate 驳回返水
+func (*Controller) RejectRebate(ctx context.Context, req *v1.RejectRebateReq) (res *v1.RejectRebateRes, err error) {
+	return backend.Rebate().RejectRebate(ctx, req)
+}
+
+// GetRebateHistories 获取返水记录
+func (*Controller) GetRebateHistories(ctx context.Context, req *v1.GetRebateHistoriesReq) (res *v1.GetRebateHistoriesRes, err error) {
+	return backend.Rebate().GetRebateHistories(ctx, req)
+}
+
+// GetRebateDetails 获取返水明细
+func (*Controller) GetRebateDetails(ctx context.Context, req *v1.GetRebateDetailsReq) (res *v1.GetRebateDetailsRes, err error) {
+	return backend.Rebate().GetRebateDetails(ctx, req)
+}
+
+// GetRebateRules 获取返水规则列表
+func (*Controller) GetRebateRules(ctx context.Context, req *v1.GetRebateRulesReq) (res *v1.GetRebateRulesRes, err error) {
+	return backend.Rebate().GetRebateRules(ctx, req)
+}
+
+// GetRebateRule 获取返水规则详情
+func (*Controller) GetRebateRule(ctx context.Context, req *v1.GetRebateRuleReq) (res *v1.GetRebateRuleRes, err error) {
+	return backend.Rebate().GetRebateRule(ctx, req)
+}
+
+// CreateRebateRule 创建返水规则
+func (*Controller) CreateRebateRule(ctx context.Context, req *v1.CreateRebateRuleReq) (res *v1.CreateRebateRuleRes, err error) {
+	return backend.Rebate().CreateRebateRule(ctx, req)
+}
+
+// UpdateRebateRule 修改返水规则
+func (*Controller) UpdateRebateRule(ctx context.Context, req *v1.UpdateRebateRuleReq) (res *v1.UpdateRebateRuleRes, err error) {
+	return backend.Rebate().UpdateRebateRule(ctx, req)
+}
+
+// DeleteRebateRule 删除返水规则
+func (*Controller) DeleteRebateRule(ctx context.Context, req *v1.DeleteRebateRuleReq) (res *v1.DeleteRebateRuleRes, err error) {
+	return backend.Rebate().DeleteRebateRule(ctx, req)
+}
@@ -0,0 +1,47 @@
+package risk
+
+import (
+	"context"
+	v1 "jh_app_service/api/backend/risk/v1"
+	"jh_app_service/internal/service/backend"
+
+	"github.com/gogf/gf/contrib/rpc/grpcx/v2"
+)
+
+type Controller struct {
+	v1.UnimplementedRiskServer
+}
+
+func Register(s *grpcx.GrpcServer) {
+	v1.RegisterRiskServer(s.Server, &Controller{})
+}
+
+// GetForewarnConfig 获取风险预警配置
+func (*Controller) GetForewarnConfig(ctx context.Context, req *v1.GetForewarnConfigReq) (res *v1.GetForewarnConfigRes, err error) {
+	return backend.Risk().GetForewarnConfig(ctx, req)
+}
+
+// UpdateForewarnConfig 更新风险预警配置
+func (*Controller) UpdateForewarnConfig(ctx context.Context, req *v1.UpdateForewarnConfigReq) (res *v1.UpdateForewarnConfigRes, err error) {
+	return backend.Risk().UpdateForewarnConfig(ctx, req)
+}
+
+// GetForewarnLogs 获取风险预警列表
+func (*Controller) GetForewarnLogs(ctx context.Context, req *v1.GetForewarnLogsReq) (res *v1.GetForewarnLogsRes, err error) {
+	return backend.Risk().GetForewarnLogs(ctx, req)
+}
+
+// AckForewarnLogs 处理风险预警
+func (*Controller) AckForewarnLogs(ctx context.Context, req *v1.AckForewarnLogsReq) (res *v1.AckForewarnLogsRes, err error) {
+	return backend.Risk().AckForewarnLogs(ctx, req)
+}
+
+// GetAccountClusters 获取多账号关联
+func (*Controller) GetAccountClusters(ctx context.Context, req *v1.GetAccountClustersReq) (res *v1.GetAccountClustersRes, err error) {
+	return backend.Risk().GetAccountClusters(ctx, req)
+}
+
+// ReviewAccountCluster 审核多账号关联
+func (*Controller) ReviewAccountCluster(ctx context.Context, req *v1.ReviewAccountClusterReq) (res *v1.ReviewAccountClusterRes, err error) {
+	return backend.Risk().ReviewAccountCluster(ctx, req)
+}
@@ -25,3 +25,38 @@ func (*Controller) GetBasicSetting(ctx context.Context, req *v2.GetBasicSettingR
 func (*Controller) UpdateBasicSetting(ctx context.Context, req *v2.UpdateBasicSettingReq) (res *v2.UpdateBasicSettingRes, err error) {
 	return backend.Site().UpdateBasicSetting(ctx, req)
 }
+
+// GetRegisterFields 获取注册项设置 (gRPC)
+func (*Controller) GetRegisterFields(ctx context.Context, req *v2.GetRegisterFieldsReq) (res *v2.GetRegisterFieldsRes, err error) {
+	return backend.Site().GetRegisterFields(ctx, req)
+}
+
+// UpdateRegisterFields 更新注册项设置 (gRPC)
+func (*Controller) UpdateRegisterFields(ctx context.Context, req *v2.UpdateRegisterFieldsReq) (res *v2.UpdateRegisterFieldsRes, err error) {
+	return backend.Site().UpdateRegisterFields(ctx, req)
+}
+
+// GetSiteDenies 获取访问屏蔽规则列表 (gRPC)
+func (*Controller) GetSiteDenies(ctx context.Context, req *v2.GetSiteDeniesReq) (res *v2.GetSiteDeniesRes, err error) {
+	return backend.Site().GetSiteDenies(ctx, req)
+}
+
+// CreateSiteDeny 添加访问屏蔽规则 (gRPC)
+func (*Controller) CreateSiteDeny(ctx context.Context, req *v2.CreateSiteDenyReq) (res *v2.CreateSiteDenyRes, err error) {
+	return backend.Site().CreateSiteDeny(ctx, req)
+}
+
+// UpdateSiteDeny 修改访问屏蔽规则 (gRPC)
+func (*Controller) UpdateSiteDeny(ctx context.Context, req *v2.UpdateSiteDenyReq) (res *v2.UpdateSiteDenyRes, err error) {
+	return backend.Site().UpdateSiteDeny(ctx, req)
+}
+
+// DeleteSiteDeny 删除访问屏蔽规则 (gRPC)
+func (*Controller) DeleteSiteDeny(ctx context.Context, req *v2.DeleteSiteDenyReq) (res *v2.DeleteSiteDenyRes, err error) {
+	return backend.Site().DeleteSiteDeny(ctx, req)
+}
+
+// CheckSiteAccess 检查IP是否允许访问站点 (gRPC)
+func (*Controller) CheckSiteAccess(ctx context.Context, req *v2.CheckSiteAccessReq) (res *v2.CheckSiteAccessRes, err error) {
+	return backend.Site().CheckSiteAccess(ctx, req)
+}
@@ -26,11 +26,26 @@ func (*Controller) UpdateUser(ctx context.Context, req *v1.UpdateUserReq) (res *
 	return backend.User().UpdateUser(ctx, req)
 }
 
+// BatchUpdateUsers 批量修改会员
+func (*Controller) BatchUpdateUsers(ctx context.Context, req *v1.BatchUpdateUsersReq) (res *v1.BatchUpdateUsersRes, err error) {
+	return backend.User().BatchUpdateUsers(ctx, req)
+}
+
 // GetUserBasicInfo 获取用户基本信息
 func (*Controller) GetUserBasicInfo(ctx context.Context, req *v1.GetUserBasicInfoReq) (res *v1.GetUserBasicInfoRes, err error) {
 	return backend.User().GetUserBasicInfo(ctx, req)
 }
 
+// Register 会员注册
+func (*Controller) Register(ctx context.Context, req *v1.RegisterReq) (res *v1.RegisterRes, err error) {
+	return backend.User().Register(ctx, req)
+}
+
+// Login 会员登录
+func (*Controller) Login(ctx context.Context, req *v1.LoginReq) (res *v1.LoginRes, err error) {
+	return backend.User().Login(ctx, req)
+}
+
 // GetUserGrades 获取用户等级列表
 func (*Controller) GetUserGrades(ctx context.Context, req *v1.GetUserGradesReq) (res *v1.GetUserGradesRes, err error) {
 	return backend.User().GetUserGrades(ctx, req)
@@ -46,7 +61,147 @@ func (*Controller) DeleteUserGrades(ctx context.Context, req *v1.DeleteUserGrade
 	return backend.User().DeleteUserGrades(ctx, req)
 }
 
+// PreviewGradeUpgrades 预览等级升级
+func (*Controller) PreviewGradeUpgrades(ctx context.Context, req *v1.PreviewGradeUpgradesReq) (res *v1.PreviewGradeUpgradesRes, err error) {
+	return backend.User().PreviewGradeUpgrades(ctx, req)
+}
+
+// RunGradeUpgrades 执行等级升级
+func (*Controller) RunGradeUpgrades(ctx context.Context, req *v1.RunGradeUpgradesReq) (res *v1.RunGradeUpgradesRes, err error) {
+	return backend.User().RunGradeUpgrades(ctx, req)
+}
+
+// GetUserGradeLogs 获取会员等级变更记录
+func (*Controller) GetUserGradeLogs(ctx context.Context, req *v1.GetUserGradeLogsReq) (res *v1.GetUserGradeLogsRes, err error) {
+	return backend.User().GetUserGradeLogs(ctx, req)
+}
+
+// RunBirthdayBonuses 发放生日彩金
+func (*Controller) RunBirthdayBonuses(ctx context.Context, req *v1.RunBirthdayBonusesReq) (res *v1.RunBirthdayBonusesRes, err error) {
+	return backend.User().RunBirthdayBonuses(ctx, req)
+}
+
+// GetBirthdayBonuses 获取生日彩金发放记录
+func (*Controller) GetBirthdayBonuses(ctx context.Context, req *v1.GetBirthdayBonusesReq) (res *v1.GetBirthdayBonusesRes, err error) {
+	return backend.User().GetBirthdayBonuses(ctx, req)
+}
+
+// GetPointsSetting 获取积分设置
+func (*Controller) GetPointsSetting(ctx context.Context, req *v1.GetPointsSettingReq) (res *v1.GetPointsSettingRes, err error) {
+	return backend.User().GetPointsSetting(ctx, req)
+}
+
+// UpdatePointsSetting 修改积分设置
+func (*Controller) UpdatePointsSetting(ctx context.Context, req *v1.UpdatePointsSettingReq) (res *v1.UpdatePointsSettingRes, err error) {
+	return backend.User().UpdatePointsSetting(ctx, req)
+}
+
+// GetUserPointsLogs 获取会员积分记录
+func (*Controller) GetUserPointsLogs(ctx context.Context, req *v1.GetUserPointsLogsReq) (res *v1.GetUserPointsLogsRes, err error) {
+	return backend.User().GetUserPointsLogs(ctx, req)
+}
+
+// AdjustUserPoints 调整会员积分
+func (*Controller) AdjustUserPoints(ctx context.Context, req *v1.AdjustUserPointsReq) (res *v1.AdjustUserPointsRes, err error) {
+	return backend.User().AdjustUserPoints(ctx, req)
+}
+
+// RunBettingPoints 发放投注积分
+func (*Controller) RunBettingPoints(ctx context.Context, req *v1.RunBettingPointsReq) (res *v1.RunBettingPointsRes, err error) {
+	return backend.User().RunBettingPoints(ctx, req)
+}
+
+// GetSignCampaigns 获取签到活动列表
+func (*Controller) GetSignCampaigns(ctx context.Context, req *v1.GetSignCampaignsReq) (res *v1.GetSignCampaignsRes, err error) {
+	return backend.User().GetSignCampaigns(ctx, req)
+}
+
+// CreateSignCampaign 创建签到活动
+func (*Controller) CreateSignCampaign(ctx context.Context, req *v1.CreateSignCampaignReq) (res *v1.CreateSignCampaignRes, err error) {
+	return backend.User().CreateSignCampaign(ctx, req)
+}
+
+// UpdateSignCampaign 修改签到活动
+func (*Controller) UpdateSignCampaign(ctx context.Context, req *v1.UpdateSignCampaignReq) (res *v1.UpdateSignCampaignRes, err error) {
+	return backend.User().UpdateSignCampaign(ctx, req)
+}
+
+// DeleteSignCampaign 删除签到活动
+func (*Controller) DeleteSignCampaign(ctx context.Context, req *v1.DeleteSignCampaignReq) (res *v1.DeleteSignCampaignRes, err error) {
+	return backend.User().DeleteSignCampaign(ctx, req)
+}
+
+// GetSignLogs 获取签到记录
+func (*Controller) GetSignLogs(ctx context.Context, req *v1.GetSignLogsReq) (res *v1.GetSignLogsRes, err error) {
+	return backend.User().GetSignLogs(ctx, req)
+}
+
+// GetUserSignStatus 获取会员签到状态
+func (*Controller) GetUserSignStatus(ctx context.Context, req *v1.GetUserSignStatusReq) (res *v1.GetUserSignStatusRes, err error) {
+	return backend.User().GetUserSignStatus(ctx, req)
+}
+
+// UserSign 会员签到
+func (*Controller) UserSign(ctx context.Context, req *v1.UserSignReq) (res *v1.UserSignRes, err error) {
+	return backend.User().UserSign(ctx, req)
+}
+
+// GetUserLevels 获取会员层级列表
+func (*Controller) GetUserLevels(ctx context.Context, req *v1.GetUserLevelsReq) (res *v1.GetUserLevelsRes, err error) {
+	return backend.User().GetUserLevels(ctx, req)
+}
+
+// CreateUserLevel 创建会员层级
+func (*Controller) CreateUserLevel(ctx context.Context, req *v1.CreateUserLevelReq) (res *v1.CreateUserLevelRes, err error) {
+	return backend.User().CreateUserLevel(ctx, req)
+}
+
+// UpdateUserLevel 修改会员层级
+func (*Controller) UpdateUserLevel(ctx context.Context, req *v1.UpdateUserLevelReq) (res *v1.UpdateUserLevelRes, err error) {
+	return backend.User().UpdateUserLevel(ctx, req)
+}
+
+// DeleteUserLevel 删除会员层级
+func (*Controller) DeleteUserLevel(ctx context.Context, req *v1.DeleteUserLevelReq) (res *v1.DeleteUserLevelRes, err error) {
+	return backend.User().DeleteUserLevel(ctx, req)
+}
+
 // GetUserLoginLogs 获取用户登录日志
 func (*Controller) GetUserLoginLogs(ctx context.Context, req *v1.GetUserLoginLogsReq) (res *v1.GetUserLoginLogsRes, err error) {
 	return backend.User().GetUserLoginLogs(ctx, req)
 }
+
+// GetUserBanks 获取会员银行卡
+func (*Controller) GetUserBanks(ctx context.Context, req *v1.GetUserBanksReq) (res *v1.GetUserBanksRes, err error) {
+	return backend.User().GetUserBanks(ctx, req)
+}
+
+// CreateUserBank 添加会员银行卡
+func (*Controller) CreateUserBank(ctx context.Context, req *v1.CreateUserBankReq) (res *v1.CreateUserBankRes, err error) {
+	return backend.User().CreateUserBank(ctx, req)
+}
+
+// UpdateUserBank 修改会员银行卡
+func (*Controller) UpdateUserBank(ctx context.Context, req *v1.UpdateUserBankReq) (res *v1.UpdateUserBankRes, err error) {
+	return backend.User().UpdateUserBank(ctx, req)
+}
+
+// SetDefaultUserBank 设置会员默认银行卡
+func (*Controller) SetDefaultUserBank(ctx context.Context, req *v1.SetDefaultUserBankReq) (res *v1.SetDefaultUserBankRes, err error) {
+	return backend.User().SetDefaultUserBank(ctx, req)
+}
+
+// DeleteUserBank 删除会员银行卡
+func (*Controller) DeleteUserBank(ctx context.Context, req *v1.DeleteUserBankReq) (res *v1.DeleteUserBankRes, err error) {
+	return backend.User().DeleteUserBank(ctx, req)
+}
+
+// GetUserBankLogs 获取会员银行卡变更记录
+func (*Controller) GetUserBankLogs(ctx context.Context, req *v1.GetUserBankLogsReq) (res *v1.GetUserBankLogsRes, err error) {
+	return backend.User().GetUserBankLogs(ctx, req)
+}
+
+// ExportUserList 导出会员列表
+func (*Controller) ExportUserList(req *v1.ExportUserListReq, stream v1.User_ExportUserListServer) error {
+	return backend.User().ExportUserList(req, stream)
+}
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"jh_app_service/internal/dao/internal"
+)
+
+// bankStatementBatchDao is the data access object for the table bank_statement_batch.
+// You can define custom methods on it to extend its functionality as needed.
+type bankStatementBatchDao struct {
+	*internal.BankStatementBatchDao
+}
+
+var (
+	// BankStatementBatch is a globally accessible object for table bank_statement_batch operations.
+	BankStatementBatch = bankStatementBatchDao{internal.NewBankStatementBatchDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"jh_app_service/internal/dao/internal"
+)
+
+// bankStatementItemDao is the data access object for the table bank_statement_item.
+// You can define custom methods on it to extend its functionality as needed.
+type bankStatementItemDao struct {
+	*internal.BankStatementItemDao
+}
+
+var (
+	// BankStatementItem is a globally accessible object for table bank_statement_item operations.
+	BankStatementItem = bankStatementItemDao{internal.NewBankStatementItemDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"jh_app_service/internal/dao/internal"
+)
+
+// gameTransferDao is the data access object for the table game_transfer.
+// You can define custom methods on it to extend its functionality as needed.
+type gameTransferDao struct {
+	*internal.GameTransferDao
+}
+
+var (
+	// GameTransfer is a globally accessible object for table game_transfer operations.
+	GameTransfer = gameTransferDao{internal.NewGameTransferDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -26,6 +26,7 @@ type AdminLogColumns struct {
 	AdminId       string //
 	AdminUsername string //
 	Ip            string //
+	Address       string // IP所在地区
 	CreatedAt     string //
 	Remark        string //
 }
@@ -37,6 +38,7 @@ var adminLogColumns = AdminLogColumns{
 	AdminId:       "admin_id",
 	AdminUsername: "admin_username",
 	Ip:            "ip",
+	Address:       "address",
 	CreatedAt:     "created_at",
 	Remark:        "remark",
 }
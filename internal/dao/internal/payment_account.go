@@ -21,54 +21,62 @@ type PaymentAccountDao struct {
 
 // PaymentAccountColumns defines and stores column names for the table payment_account.
 type PaymentAccountColumns struct {
-	Id          string //
-	SiteId      string //
-	PaymentId   string // 第三方支付ID
-	Gateway     string // 支付网关
-	Name        string // 接口名称
-	Domain      string // 支付域名
-	MerchantNo  string // 商户号
-	Md5Key      string // MD5密钥
-	EachMin     string // 单笔最低。默认10
-	EachMax     string // 单笔最高。如果为0，表示没有限制。
-	DailyMax    string // 单日停用上限。如果为0，表示没有限制。
-	TodayCount  string // 今日入款次数
-	TodayAmount string // 今日总转账
-	Status      string // 状态。1=启用；0=禁用
-	Sort        string // 排序。值越小排名越靠前
-	CreatedAt   string //
-	UpdatedAt   string //
-	PublicKey   string // 公钥
-	PrivateKey  string // 私钥
-	IsDecimal   string // 是否携带小数，0为否，1为真
-	IsInt       string // 是否为规定整数数组，默认0，不需要 ，1需要
-	MoneyList   string // 可选的金额数组，is_int =1 的时候必填
+	Id              string //
+	SiteId          string //
+	PaymentId       string // 第三方支付ID
+	Gateway         string // 支付网关
+	Name            string // 接口名称
+	Domain          string // 支付域名
+	MerchantNo      string // 商户号
+	Md5Key          string // MD5密钥
+	EachMin         string // 单笔最低。默认10
+	EachMax         string // 单笔最高。如果为0，表示没有限制。
+	DailyMax        string // 单日停用上限。如果为0，表示没有限制。
+	TodayCount      string // 今日入款次数
+	TodayAmount     string // 今日总转账
+	Status          string // 状态。1=启用；0=禁用
+	Sort            string // 排序。值越小排名越靠前
+	Weight          string // 权重。按权重轮询时使用，值越大被选中概率越高
+	CircuitState    string // 熔断状态。0=正常；1=已熔断；2=半开探测
+	CircuitOpenedAt string // 熔断或开始探测时间
+	CircuitReason   string // 熔断原因
+	CreatedAt       string //
+	UpdatedAt       string //
+	PublicKey       string // 公钥
+	PrivateKey      string // 私钥
+	IsDecimal       string // 是否携带小数，0为否，1为真
+	IsInt           string // 是否为规定整数数组，默认0，不需要 ，1需要
+	MoneyList       string // 可选的金额数组，is_int =1 的时候必填
 }
 
 // paymentAccountColumns holds the columns for the table payment_account.
 var paymentAccountColumns = PaymentAccountColumns{
-	Id:          "id",
-	SiteId:      "site_id",
-	PaymentId:   "payment_id",
-	Gateway:     "gateway",
-	Name:        "name",
-	Domain:      "domain",
-	MerchantNo:  "merchant_no",
-	Md5Key:      "md5_key",
-	EachMin:     "each_min",
-	EachMax:     "each_max",
-	DailyMax:    "daily_max",
-	TodayCount:  "today_count",
-	TodayAmount: "today_amount",
-	Status:      "status",
-	Sort:        "sort",
-	CreatedAt:   "created_at",
-	UpdatedAt:   "updated_at",
-	PublicKey:   "public_key",
-	PrivateKey:  "private_key",
-	IsDecimal:   "is_decimal",
-	IsInt:       "is_int",
-	MoneyList:   "moneyList",
+	Id:              "id",
+	SiteId:          "site_id",
+	PaymentId:       "payment_id",
+	Gateway:         "gateway",
+	Name:            "name",
+	Domain:          "domain",
+	MerchantNo:      "merchant_no",
+	Md5Key:          "md5_key",
+	EachMin:         "each_min",
+	EachMax:         "each_max",
+	DailyMax:        "daily_max",
+	TodayCount:      "today_count",
+	TodayAmount:     "today_amount",
+	Status:          "status",
+	Sort:            "sort",
+	Weight:          "weight",
+	CircuitState:    "circuit_state",
+	CircuitOpenedAt: "circuit_opened_at",
+	CircuitReason:   "circuit_reason",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+	PublicKey:       "public_key",
+	PrivateKey:      "private_key",
+	IsDecimal:       "is_decimal",
+	IsInt:           "is_int",
+	MoneyList:       "moneyList",
 }
 
 // NewPaymentAccountDao creates and returns a new DAO object for table data access.
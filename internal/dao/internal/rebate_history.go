@@ -27,6 +27,10 @@ type RebateHistoryColumns struct {
 	UserCount      string // 返水人数
 	ValidBetAmount string // 有效投注金额
 	Money          string // 返水金额
+	Status         string // 状态。0=待审核；1=已发放；2=已驳回
+	AdminId        string // 审核管理员ID
+	ApprovedAt     string // 审核时间
+	Remark         string // 审核备注
 	CreatedAt      string //
 	UpdatedAt      string //
 }
@@ -39,6 +43,10 @@ var rebateHistoryColumns = RebateHistoryColumns{
 	UserCount:      "user_count",
 	ValidBetAmount: "valid_bet_amount",
 	Money:          "money",
+	Status:         "status",
+	AdminId:        "admin_id",
+	ApprovedAt:     "approved_at",
+	Remark:         "remark",
 	CreatedAt:      "created_at",
 	UpdatedAt:      "updated_at",
 }
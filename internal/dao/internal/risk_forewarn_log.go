@@ -21,30 +21,36 @@ type RiskForewarnLogDao struct {
 
 // RiskForewarnLogColumns defines and stores column names for the table risk_forewarn_log.
 type RiskForewarnLogColumns struct {
-	Id        string //
-	SiteId    string // 站点ID
-	UserId    string // 会员ID
-	Username  string // 用户名
-	Content   string // 预警提示内容
-	Ip        string // 用户IP
-	Device    string // 终端。1=电脑；2=手机；3=平板
-	Status    string //
-	CreatedAt string //
-	UpdatedAt string //
+	Id          string //
+	SiteId      string // 站点ID
+	UserId      string // 会员ID
+	Type        string // 预警类型。1=转入游戏；2=游戏转出；3=赢得金额；4=投注金额；5=修改银行卡；6=登录地区变化；7=同IP注册
+	Username    string // 用户名
+	Content     string // 预警提示内容
+	Ip          string // 用户IP
+	Device      string // 终端。1=电脑；2=手机；3=平板
+	Status      string //
+	HandleAdmin string // 处理管理员
+	HandledAt   string // 处理时间
+	CreatedAt   string //
+	UpdatedAt   string //
 }
 
 // riskForewarnLogColumns holds the columns for the table risk_forewarn_log.
 var riskForewarnLogColumns = RiskForewarnLogColumns{
-	Id:        "id",
-	SiteId:    "site_id",
-	UserId:    "user_id",
-	Username:  "username",
-	Content:   "content",
-	Ip:        "ip",
-	Device:    "device",
-	Status:    "status",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
+	Id:          "id",
+	SiteId:      "site_id",
+	UserId:      "user_id",
+	Type:        "type",
+	Username:    "username",
+	Content:     "content",
+	Ip:          "ip",
+	Device:      "device",
+	Status:      "status",
+	HandleAdmin: "handle_admin",
+	HandledAt:   "handled_at",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
 }
 
 // NewRiskForewarnLogDao creates and returns a new DAO object for table data access.
@@ -21,22 +21,36 @@ type SignLogDao struct {
 
 // SignLogColumns defines and stores column names for the table sign_log.
 type SignLogColumns struct {
-	Id        string //
-	SiteId    string // 站点ID
-	UserId    string // 会员ID
-	SignDate  string // 签到日期
-	CreatedAt string //
-	UpdatedAt string //
+	Id           string //
+	SiteId       string // 站点ID
+	UserId       string // 会员ID
+	SignId       string // 签到活动ID
+	Username     string // 会员账号
+	Platform     string // 签到终端。1=网站；2=手机
+	Streak       string // 连续签到天数
+	RewardMoney  string // 奖励彩金
+	RewardPoints string // 奖励积分
+	TradeNo      string // 彩金流水号
+	SignDate     string // 签到日期
+	CreatedAt    string //
+	UpdatedAt    string //
 }
 
 // signLogColumns holds the columns for the table sign_log.
 var signLogColumns = SignLogColumns{
-	Id:        "id",
-	SiteId:    "site_id",
-	UserId:    "user_id",
-	SignDate:  "sign_date",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
+	Id:           "id",
+	SiteId:       "site_id",
+	UserId:       "user_id",
+	SignId:       "sign_id",
+	Username:     "username",
+	Platform:     "platform",
+	Streak:       "streak",
+	RewardMoney:  "reward_money",
+	RewardPoints: "reward_points",
+	TradeNo:      "trade_no",
+	SignDate:     "sign_date",
+	CreatedAt:    "created_at",
+	UpdatedAt:    "updated_at",
 }
 
 // NewSignLogDao creates and returns a new DAO object for table data access.
@@ -38,6 +38,7 @@ type TransferAccountColumns struct {
 	TodayAmount string // 今日转账总额
 	Status      string // 状态。1=可用；0=禁用
 	Sort        string // 排序。值越小排名越靠前
+	Weight      string // 权重。按权重轮询时使用，值越大被选中概率越高
 	CreatedAt   string //
 	UpdatedAt   string //
 	Remark      string //
@@ -62,6 +63,7 @@ var transferAccountColumns = TransferAccountColumns{
 	TodayAmount: "today_amount",
 	Status:      "status",
 	Sort:        "sort",
+	Weight:      "weight",
 	CreatedAt:   "created_at",
 	UpdatedAt:   "updated_at",
 	Remark:      "remark",
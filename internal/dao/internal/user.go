@@ -46,6 +46,8 @@ type UserColumns struct {
 	IsOnline          string //
 	FocusLevel        string // 会员关注级别。1=正常；2=可疑；3=危险
 	BalanceStatus     string // 1=0=
+	Balance           string // 可用余额
+	BalanceFrozen     string // 冻结余额
 	SafeQuestion      string // 密保问题
 	SafeAnswer        string // 密保答案
 	ShowBeginnerGuide string // 是否显示新手引导。1=显示；0=不显示
@@ -53,6 +55,7 @@ type UserColumns struct {
 	CreatedAt         string //
 	UpdatedAt         string //
 	PayTimes          string // 充值次数
+	Points            string // 积分
 }
 
 // userColumns holds the columns for the table user.
@@ -82,6 +85,8 @@ var userColumns = UserColumns{
 	IsOnline:          "is_online",
 	FocusLevel:        "focus_level",
 	BalanceStatus:     "balance_status",
+	Balance:           "balance",
+	BalanceFrozen:     "balance_frozen",
 	SafeQuestion:      "safe_question",
 	SafeAnswer:        "safe_answer",
 	ShowBeginnerGuide: "show_beginner_guide",
@@ -89,6 +94,7 @@ var userColumns = UserColumns{
 	CreatedAt:         "created_at",
 	UpdatedAt:         "updated_at",
 	PayTimes:          "pay_times",
+	Points:            "points",
 }
 
 // NewUserDao creates and returns a new DAO object for table data access.
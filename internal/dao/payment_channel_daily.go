@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"jh_app_service/internal/dao/internal"
+)
+
+// paymentChannelDailyDao is the data access object for the table payment_channel_daily.
+// You can define custom methods on it to extend its functionality as needed.
+type paymentChannelDailyDao struct {
+	*internal.PaymentChannelDailyDao
+}
+
+var (
+	// PaymentChannelDaily is a globally accessible object for table payment_channel_daily operations.
+	PaymentChannelDaily = paymentChannelDailyDao{internal.NewPaymentChannelDailyDao()}
+)
+
+// Add your custom methods and functionality below.
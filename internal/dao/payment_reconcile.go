@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"jh_app_service/internal/dao/internal"
+)
+
+// paymentReconcileDao is the data access object for the table payment_reconcile.
+// You can define custom methods on it to extend its functionality as needed.
+type paymentReconcileDao struct {
+	*internal.PaymentReconcileDao
+}
+
+var (
+	// PaymentReconcile is a globally accessible object for table payment_reconcile operations.
+	PaymentReconcile = paymentReconcileDao{internal.NewPaymentReconcileDao()}
+)
+
+// Add your custom methods and functionality below.
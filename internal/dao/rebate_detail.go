@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"jh_app_service/internal/dao/internal"
+)
+
+// rebateDetailDao is the data access object for the table rebate_detail.
+// You can define custom methods on it to extend its functionality as needed.
+type rebateDetailDao struct {
+	*internal.RebateDetailDao
+}
+
+var (
+	// RebateDetail is a globally accessible object for table rebate_detail operations.
+	RebateDetail = rebateDetailDao{internal.NewRebateDetailDao()}
+)
+
+// Add your custom methods and functionality below.
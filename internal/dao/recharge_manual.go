@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"jh_app_service/internal/dao/internal"
+)
+
+// rechargeManualDao is the data access object for the table recharge_manual.
+// You can define custom methods on it to extend its functionality as needed.
+type rechargeManualDao struct {
+	*internal.RechargeManualDao
+}
+
+var (
+	// RechargeManual is a globally accessible object for table recharge_manual operations.
+	RechargeManual = rechargeManualDao{internal.NewRechargeManualDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"jh_app_service/internal/dao/internal"
+)
+
+// rechargePaymentDao is the data access object for the table recharge_payment.
+// You can define custom methods on it to extend its functionality as needed.
+type rechargePaymentDao struct {
+	*internal.RechargePaymentDao
+}
+
+var (
+	// RechargePayment is a globally accessible object for table recharge_payment operations.
+	RechargePayment = rechargePaymentDao{internal.NewRechargePaymentDao()}
+)
+
+// Add your custom methods and functionality below.
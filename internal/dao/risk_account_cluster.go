@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"jh_app_service/internal/dao/internal"
+)
+
+// riskAccountClusterDao is the data access object for the table risk_account_cluster.
+// You can define custom methods on it to extend its functionality as needed.
+type riskAccountClusterDao struct {
+	*internal.RiskAccountClusterDao
+}
+
+var (
+	// RiskAccountCluster is a globally accessible object for table risk_account_cluster operations.
+	RiskAccountCluster = riskAccountClusterDao{internal.NewRiskAccountClusterDao()}
+)
+
+// Add your custom methods and functionality below.
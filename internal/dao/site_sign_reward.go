@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"jh_app_service/internal/dao/internal"
+)
+
+// siteSignRewardDao is the data access object for the table site_sign_reward.
+// You can define custom methods on it to extend its functionality as needed.
+type siteSignRewardDao struct {
+	*internal.SiteSignRewardDao
+}
+
+var (
+	// SiteSignReward is a globally accessible object for table site_sign_reward operations.
+	SiteSignReward = siteSignRewardDao{internal.NewSiteSignRewardDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"jh_app_service/internal/dao/internal"
+)
+
+// userBankLogDao is the data access object for the table user_bank_log.
+// You can define custom methods on it to extend its functionality as needed.
+type userBankLogDao struct {
+	*internal.UserBankLogDao
+}
+
+var (
+	// UserBankLog is a globally accessible object for table user_bank_log operations.
+	UserBankLog = userBankLogDao{internal.NewUserBankLogDao()}
+)
+
+// Add your custom methods and functionality below.
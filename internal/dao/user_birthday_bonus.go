@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"jh_app_service/internal/dao/internal"
+)
+
+// userBirthdayBonusDao is the data access object for the table user_birthday_bonus.
+// You can define custom methods on it to extend its functionality as needed.
+type userBirthdayBonusDao struct {
+	*internal.UserBirthdayBonusDao
+}
+
+var (
+	// UserBirthdayBonus is a globally accessible object for table user_birthday_bonus operations.
+	UserBirthdayBonus = userBirthdayBonusDao{internal.NewUserBirthdayBonusDao()}
+)
+
+// Add your custom methods and functionality below.
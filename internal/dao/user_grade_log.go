@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"jh_app_service/internal/dao/internal"
+)
+
+// userGradeLogDao is the data access object for the table user_grade_log.
+// You can define custom methods on it to extend its functionality as needed.
+type userGradeLogDao struct {
+	*internal.UserGradeLogDao
+}
+
+var (
+	// UserGradeLog is a globally accessible object for table user_grade_log operations.
+	UserGradeLog = userGradeLogDao{internal.NewUserGradeLogDao()}
+)
+
+// Add your custom methods and functionality below.
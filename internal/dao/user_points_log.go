@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"jh_app_service/internal/dao/internal"
+)
+
+// userPointsLogDao is the data access object for the table user_points_log.
+// You can define custom methods on it to extend its functionality as needed.
+type userPointsLogDao struct {
+	*internal.UserPointsLogDao
+}
+
+var (
+	// UserPointsLog is a globally accessible object for table user_points_log operations.
+	UserPointsLog = userPointsLogDao{internal.NewUserPointsLogDao()}
+)
+
+// Add your custom methods and functionality below.
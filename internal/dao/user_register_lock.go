@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"jh_app_service/internal/dao/internal"
+)
+
+// userRegisterLockDao is the data access object for the table user_register_lock.
+// You can define custom methods on it to extend its functionality as needed.
+type userRegisterLockDao struct {
+	*internal.UserRegisterLockDao
+}
+
+var (
+	// UserRegisterLock is a globally accessible object for table user_register_lock operations.
+	UserRegisterLock = userRegisterLockDao{internal.NewUserRegisterLockDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,95 @@
+// Package export 提供后台列表导出用的 CSV/XLSX 流式写入
+//
+// 写入器只依赖 io.Writer，配合 ChunkWriter 可以边查询边通过 gRPC 流推送，
+// 导出大量数据时不需要在内存中保留整个文件。
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+// Writer 按行写入表格
+type Writer interface {
+	WriteRow(cells []string) error
+	// Close 写入文件结尾，不会关闭底层的 io.Writer
+	Close() error
+}
+
+// csvWriter CSV 写入器
+type csvWriter struct {
+	w *csv.Writer
+}
+
+// NewCSV 创建 CSV 写入器，文件以 UTF-8 BOM 开头，避免 Excel 打开时中文乱码
+func NewCSV(w io.Writer) (Writer, error) {
+	if _, err := w.Write([]byte("\xEF\xBB\xBF")); err != nil {
+		return nil, err
+	}
+	return &csvWriter{w: csv.NewWriter(w)}, nil
+}
+
+func (c *csvWriter) WriteRow(cells []string) error {
+	row := make([]string, len(cells))
+	for i, cell := range cells {
+		row[i] = escapeFormula(cell)
+	}
+	return c.w.Write(row)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// escapeFormula 以 = + - @ 开头的非数字内容前加单引号，防止在表格软件中被当作公式执行
+func escapeFormula(cell string) string {
+	if cell == "" {
+		return cell
+	}
+	switch cell[0] {
+	case '=', '+', '-', '@', '\t', '\r':
+		if _, err := strconv.ParseFloat(cell, 64); err == nil {
+			return cell
+		}
+		return "'" + cell
+	}
+	return cell
+}
+
+// ChunkWriter 将写入的数据按块大小缓冲后交给 send 发送
+type ChunkWriter struct {
+	size int
+	buf  bytes.Buffer
+	send func(chunk []byte) error
+}
+
+// NewChunkWriter 创建分块写入器，size 为每块的字节数
+func NewChunkWriter(size int, send func(chunk []byte) error) *ChunkWriter {
+	return &ChunkWriter{size: size, send: send}
+}
+
+func (c *ChunkWriter) Write(p []byte) (int, error) {
+	c.buf.Write(p)
+	for c.buf.Len() >= c.size {
+		chunk := make([]byte, c.size)
+		copy(chunk, c.buf.Next(c.size))
+		if err := c.send(chunk); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush 发送缓冲中剩余的数据
+func (c *ChunkWriter) Flush() error {
+	if c.buf.Len() == 0 {
+		return nil
+	}
+	chunk := make([]byte, c.buf.Len())
+	copy(chunk, c.buf.Bytes())
+	c.buf.Reset()
+	return c.send(chunk)
+}
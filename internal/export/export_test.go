@@ -0,0 +1,92 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestCSVEscapeFormula(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewCSV(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = w.WriteRow([]string{"=1+1", "-12.5", "@SUM(A1)", "张三"}); err != nil {
+		t.Fatal(err)
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	want := "\xEF\xBB\xBF'=1+1,-12.5,'@SUM(A1),张三\n"
+	if buf.String() != want {
+		t.Fatalf("CSV内容错误: %q", buf.String())
+	}
+}
+
+func TestXLSX(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewXLSX(&buf, "会员<列表>")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = w.WriteRow([]string{"账号", "备注"}); err != nil {
+		t.Fatal(err)
+	}
+	if err = w.WriteRow([]string{"test01", "a&b<c>"}); err != nil {
+		t.Fatal(err)
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, _ := io.ReadAll(rc)
+		rc.Close()
+		files[f.Name] = string(content)
+	}
+	if !strings.Contains(files["xl/workbook.xml"], `name="会员&lt;列表&gt;"`) {
+		t.Fatalf("工作表名称未转义: %s", files["xl/workbook.xml"])
+	}
+	sheet := files["xl/worksheets/sheet1.xml"]
+	for _, want := range []string{`<c r="B1" t="inlineStr">`, `<row r="2">`, "a&amp;b&lt;c&gt;"} {
+		if !strings.Contains(sheet, want) {
+			t.Fatalf("工作表缺少 %s: %s", want, sheet)
+		}
+	}
+}
+
+func TestColumnName(t *testing.T) {
+	for index, want := range map[int]string{0: "A", 25: "Z", 26: "AA", 51: "AZ", 52: "BA", 701: "ZZ", 702: "AAA"} {
+		if got := columnName(index); got != want {
+			t.Fatalf("columnName(%d) = %s, want %s", index, got, want)
+		}
+	}
+}
+
+func TestChunkWriter(t *testing.T) {
+	var chunks []string
+	w := NewChunkWriter(4, func(chunk []byte) error {
+		chunks = append(chunks, string(chunk))
+		return nil
+	})
+	w.Write([]byte("abcdefghij"))
+	w.Write([]byte("k"))
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(chunks, "|") != "abcd|efgh|ijk" {
+		t.Fatalf("分块错误: %v", chunks)
+	}
+}
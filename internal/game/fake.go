@@ -0,0 +1,129 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+)
+
+// FakeProvider 进程内的模拟厂商钱包，用于测试和本地联调
+// 可以通过 SetNextError / SetNextStatus 模拟厂商超时或失败
+type FakeProvider struct {
+	name string
+
+	mu         sync.Mutex
+	balances   map[string]float64
+	orders     map[string]*TransferResult
+	nextErr    error // 下一次转账返回的错误，转账本身仍会执行，模拟厂商已处理但响应丢失
+	nextStatus int   // 下一次转账返回的状态，为 TransferFailed 时不执行转账
+	hasStatus  bool  // 是否设置了 nextStatus
+	sequence   int
+}
+
+// NewFakeProvider 创建模拟厂商钱包
+func NewFakeProvider(name string) *FakeProvider {
+	return &FakeProvider{
+		name:     name,
+		balances: make(map[string]float64),
+		orders:   make(map[string]*TransferResult),
+	}
+}
+
+// SetBalance 设置会员在模拟厂商的余额
+func (p *FakeProvider) SetBalance(account string, balance float64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.balances[account] = balance
+}
+
+// SetNextError 下一次转账执行后返回该错误
+func (p *FakeProvider) SetNextError(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.nextErr = err
+}
+
+// SetNextStatus 下一次转账返回指定状态，TransferFailed 时不执行转账，TransferPending 时执行转账但返回处理中
+func (p *FakeProvider) SetNextStatus(status int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.nextStatus = status
+	p.hasStatus = true
+}
+
+// Name 厂商名称
+func (p *FakeProvider) Name() string {
+	return p.name
+}
+
+// Balance 查询余额
+func (p *FakeProvider) Balance(ctx context.Context, account string) (float64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.balances[account], nil
+}
+
+// TransferIn 转入游戏
+func (p *FakeProvider) TransferIn(ctx context.Context, req *TransferRequest) (*TransferResult, error) {
+	return p.transfer(req, req.Amount)
+}
+
+// TransferOut 转出游戏
+func (p *FakeProvider) TransferOut(ctx context.Context, req *TransferRequest) (*TransferResult, error) {
+	return p.transfer(req, -req.Amount)
+}
+
+// OrderStatus 查询订单状态
+func (p *FakeProvider) OrderStatus(ctx context.Context, orderNo string) (*TransferResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if order, ok := p.orders[orderNo]; ok {
+		result := *order
+		return &result, nil
+	}
+	return &TransferResult{OrderNo: orderNo, Status: TransferFailed, Message: "订单不存在"}, nil
+}
+
+// transfer 执行转账，同一订单号只处理一次
+func (p *FakeProvider) transfer(req *TransferRequest, delta float64) (*TransferResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if req.Amount <= 0 {
+		return &TransferResult{OrderNo: req.OrderNo, Status: TransferFailed, Message: "金额无效"}, nil
+	}
+	if order, ok := p.orders[req.OrderNo]; ok {
+		result := *order
+		return &result, nil
+	}
+
+	nextErr, nextStatus, hasStatus := p.nextErr, p.nextStatus, p.hasStatus
+	p.nextErr, p.hasStatus = nil, false
+
+	if hasStatus && nextStatus == TransferFailed {
+		return &TransferResult{OrderNo: req.OrderNo, Status: TransferFailed, Message: "厂商拒绝"}, nil
+	}
+	balance := p.balances[req.Account] + delta
+	if balance < 0 {
+		return &TransferResult{OrderNo: req.OrderNo, Status: TransferFailed, Message: "游戏余额不足"}, nil
+	}
+	p.balances[req.Account] = math.Round(balance*100) / 100
+
+	p.sequence++
+	order := &TransferResult{
+		OrderNo:       req.OrderNo,
+		VendorOrderNo: fmt.Sprintf("%s-%d", p.name, p.sequence),
+		Status:        TransferSuccess,
+	}
+	p.orders[req.OrderNo] = order
+
+	if nextErr != nil {
+		return nil, nextErr
+	}
+	result := *order
+	if hasStatus && nextStatus == TransferPending {
+		result.Status = TransferPending
+	}
+	return &result, nil
+}
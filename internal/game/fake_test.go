@@ -0,0 +1,84 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestFakeProviderTransfer(t *testing.T) {
+	ctx := context.Background()
+	p := NewFakeProvider("fake")
+	registerForTest(t, 101, p)
+
+	provider, err := Provider(101)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = Provider(102); err == nil {
+		t.Fatal("未注册的游戏应该返回错误")
+	}
+
+	result, err := provider.TransferIn(ctx, &TransferRequest{OrderNo: "T1", Account: "alice", Amount: 100})
+	if err != nil || result.Status != TransferSuccess {
+		t.Fatalf("转入失败: %+v, %v", result, err)
+	}
+	// 重复订单号不会重复转账
+	if _, err = provider.TransferIn(ctx, &TransferRequest{OrderNo: "T1", Account: "alice", Amount: 100}); err != nil {
+		t.Fatal(err)
+	}
+	if balance, _ := provider.Balance(ctx, "alice"); balance != 100 {
+		t.Fatalf("余额应为100, 实际 %v", balance)
+	}
+
+	result, _ = provider.TransferOut(ctx, &TransferRequest{OrderNo: "T2", Account: "alice", Amount: 150})
+	if result.Status != TransferFailed {
+		t.Fatalf("余额不足时应转出失败: %+v", result)
+	}
+}
+
+func TestFakeProviderAmbiguous(t *testing.T) {
+	ctx := context.Background()
+	p := NewFakeProvider("fake")
+
+	// 厂商已处理但响应丢失，对账时应查到成功
+	p.SetNextError(errors.New("timeout"))
+	if _, err := p.TransferIn(ctx, &TransferRequest{OrderNo: "T1", Account: "bob", Amount: 50}); err == nil {
+		t.Fatal("应该返回模拟的超时错误")
+	}
+	status, _ := p.OrderStatus(ctx, "T1")
+	if status.Status != TransferSuccess {
+		t.Fatalf("对账应为成功: %+v", status)
+	}
+
+	// 厂商拒绝的订单对账时不存在
+	p.SetNextStatus(TransferFailed)
+	result, _ := p.TransferIn(ctx, &TransferRequest{OrderNo: "T2", Account: "bob", Amount: 50})
+	if result.Status != TransferFailed {
+		t.Fatalf("应为失败: %+v", result)
+	}
+	if status, _ = p.OrderStatus(ctx, "T2"); status.Status != TransferFailed {
+		t.Fatalf("对账应为失败: %+v", status)
+	}
+	if balance, _ := p.Balance(ctx, "bob"); balance != 50 {
+		t.Fatalf("余额应为50, 实际 %v", balance)
+	}
+}
+
+// registerForTest 注册测试用的钱包，测试结束后恢复全局注册表
+func registerForTest(t *testing.T, gameId int, provider GameWalletProvider) {
+	t.Helper()
+	mu.RLock()
+	previous, existed := providers[gameId]
+	mu.RUnlock()
+	Register(gameId, provider)
+	t.Cleanup(func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if existed {
+			providers[gameId] = previous
+		} else {
+			delete(providers, gameId)
+		}
+	})
+}
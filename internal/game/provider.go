@@ -0,0 +1,43 @@
+package game
+
+import (
+	"context"
+)
+
+// 转账结果状态
+const (
+	TransferPending = 0 // 处理中或结果未知，需要对账
+	TransferSuccess = 1 // 成功
+	TransferFailed  = 2 // 失败，资金未变动
+)
+
+// TransferRequest 转账请求
+type TransferRequest struct {
+	OrderNo string  // 本站订单号，厂商以此保证幂等
+	Account string  // 会员在厂商的账号
+	Amount  float64 // 转账金额
+}
+
+// TransferResult 转账结果
+type TransferResult struct {
+	OrderNo       string
+	VendorOrderNo string // 厂商订单号
+	Status        int    // TransferPending / TransferSuccess / TransferFailed
+	Message       string
+}
+
+// GameWalletProvider 游戏厂商钱包接口
+// TransferIn/TransferOut 返回 error 表示调用结果不明确(超时、网络错误等)，此时资金可能已变动，
+// 调用方需要通过 OrderStatus 对账确认，不能直接当作失败处理
+type GameWalletProvider interface {
+	// Name 厂商名称
+	Name() string
+	// Balance 查询会员在厂商的余额
+	Balance(ctx context.Context, account string) (float64, error)
+	// TransferIn 从本站账户转入游戏
+	TransferIn(ctx context.Context, req *TransferRequest) (*TransferResult, error)
+	// TransferOut 从游戏转出到本站账户
+	TransferOut(ctx context.Context, req *TransferRequest) (*TransferResult, error)
+	// OrderStatus 按本站订单号查询转账状态，厂商不存在该订单时返回 TransferFailed
+	OrderStatus(ctx context.Context, orderNo string) (*TransferResult, error)
+}
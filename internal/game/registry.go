@@ -0,0 +1,81 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/gogf/gf/v2/frame/g"
+)
+
+// DriverFunc 根据配置创建厂商钱包
+type DriverFunc func(ctx context.Context, gameId int, config map[string]interface{}) (GameWalletProvider, error)
+
+var (
+	mu        sync.RWMutex
+	drivers   = make(map[string]DriverFunc)
+	providers = make(map[int]GameWalletProvider)
+)
+
+func init() {
+	RegisterDriver("fake", func(ctx context.Context, gameId int, config map[string]interface{}) (GameWalletProvider, error) {
+		return NewFakeProvider(fmt.Sprintf("fake-%d", gameId)), nil
+	})
+}
+
+// RegisterDriver 注册厂商驱动，驱动名对应配置 game.providers.<game_id>.driver
+func RegisterDriver(name string, fn DriverFunc) {
+	mu.Lock()
+	defer mu.Unlock()
+	drivers[name] = fn
+}
+
+// Register 为游戏注册钱包，gameId 对应 site_game.game_id
+func Register(gameId int, provider GameWalletProvider) {
+	mu.Lock()
+	defer mu.Unlock()
+	providers[gameId] = provider
+}
+
+// Provider 获取游戏对应的钱包
+func Provider(gameId int) (GameWalletProvider, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+	provider, ok := providers[gameId]
+	if !ok {
+		return nil, fmt.Errorf("游戏 %d 未接入钱包", gameId)
+	}
+	return provider, nil
+}
+
+// InitFromConfig 按配置创建并注册各游戏的钱包
+// 配置格式:
+//
+//	game:
+//	  providers:
+//	    "101":
+//	      driver: "fake"
+func InitFromConfig(ctx context.Context) error {
+	for key, value := range g.Cfg().MustGet(ctx, "game.providers").MapStrVar() {
+		gameId := g.NewVar(key).Int()
+		if gameId <= 0 {
+			return fmt.Errorf("游戏ID无效: %s", key)
+		}
+		config := value.Map()
+		driver := g.NewVar(config["driver"]).String()
+
+		mu.RLock()
+		fn, ok := drivers[driver]
+		mu.RUnlock()
+		if !ok {
+			return fmt.Errorf("游戏 %d 的钱包驱动 %s 不存在", gameId, driver)
+		}
+
+		provider, err := fn(ctx, gameId, config)
+		if err != nil {
+			return fmt.Errorf("创建游戏 %d 的钱包失败: %v", gameId, err)
+		}
+		Register(gameId, provider)
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package geoip
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gfsnotify"
+)
+
+// 默认的离线库路径，可通过配置 geoip.path 修改
+// 文件扩展名为 .mmdb 时按 MaxMind DB 格式读取，否则按 ip2region xdb 格式读取
+const defaultPath = "resource/geoip/ip2region.xdb"
+
+// Region IP 对应的地区
+type Region struct {
+	Country  string // 国家
+	Area     string // 区域
+	Province string // 省份
+	City     string // 城市
+	Isp      string // 运营商
+}
+
+// locator 离线库查询器
+type locator interface {
+	lookup(ip net.IP) (*Region, error)
+}
+
+var (
+	mu      sync.RWMutex
+	current locator
+	watched string // 已监听变更的文件路径
+)
+
+// Init 按配置加载离线库，并在配置 geoip.watch 开启时监听文件变更自动重新加载
+// 文件不存在时只记录警告，地区查询将返回 nil
+func Init(ctx context.Context) error {
+	if err := Reload(ctx); err != nil {
+		return err
+	}
+	if g.Cfg().MustGet(ctx, "geoip.watch", true).Bool() {
+		return watch(ctx)
+	}
+	return nil
+}
+
+// Reload 重新加载离线库，加载失败时继续使用已加载的库
+func Reload(ctx context.Context) error {
+	path := g.Cfg().MustGet(ctx, "geoip.path", defaultPath).String()
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			g.Log().Warningf(ctx, "GeoIP 离线库不存在，地区查询不可用: %s", path)
+			return nil
+		}
+		return fmt.Errorf("读取 GeoIP 离线库失败: %v", err)
+	}
+
+	var loaded locator
+	if strings.EqualFold(filepath.Ext(path), ".mmdb") {
+		language := g.Cfg().MustGet(ctx, "geoip.language", "zh-CN").String()
+		loaded, err = newMmdbReader(content, language)
+	} else {
+		loaded, err = newXdbSearcher(content)
+	}
+	if err != nil {
+		return fmt.Errorf("加载 GeoIP 离线库失败: %v", err)
+	}
+
+	mu.Lock()
+	current = loaded
+	mu.Unlock()
+	g.Log().Infof(ctx, "GeoIP 离线库加载成功: %s", path)
+	return nil
+}
+
+// watch 监听离线库所在目录，文件被覆盖或替换后重新加载
+func watch(ctx context.Context) error {
+	path := g.Cfg().MustGet(ctx, "geoip.path", defaultPath).String()
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+
+	mu.Lock()
+	if watched == absPath {
+		mu.Unlock()
+		return nil
+	}
+	watched = absPath
+	mu.Unlock()
+
+	_, err = gfsnotify.Add(filepath.Dir(absPath), func(event *gfsnotify.Event) {
+		if filepath.Clean(event.Path) != absPath || !(event.IsWrite() || event.IsCreate() || event.IsRename()) {
+			return
+		}
+		// 写入未完成时可能加载失败，保留旧库并等待下一次写入事件
+		if err := Reload(context.Background()); err != nil {
+			g.Log().Errorf(context.Background(), "重新加载 GeoIP 离线库失败: %v", err)
+		}
+	}, false)
+	if err != nil {
+		return fmt.Errorf("监听 GeoIP 离线库失败: %v", err)
+	}
+	return nil
+}
+
+// Enabled 离线库是否已加载
+func Enabled() bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current != nil
+}
+
+// Lookup 查询 IP 所属地区，离线库未加载或未收录时返回 nil
+func Lookup(ip string) (*Region, error) {
+	mu.RLock()
+	loaded := current
+	mu.RUnlock()
+	if loaded == nil {
+		return nil, nil
+	}
+
+	parsed := net.ParseIP(strings.TrimSpace(ip))
+	if parsed == nil {
+		return nil, fmt.Errorf("IP 地址格式错误: %s", ip)
+	}
+	return loaded.lookup(parsed)
+}
+
+// Address 查询 IP 的展示地址，如 "中国 广东省 深圳市"，查询失败或未收录时返回空字符串
+func Address(ip string) string {
+	region, err := Lookup(ip)
+	if err != nil {
+		return ""
+	}
+	return region.String()
+}
+
+// parseRegion 解析 国家|区域|省份|城市|ISP 格式的地区字符串，"0" 表示未知
+func parseRegion(value string) *Region {
+	parts := strings.Split(value, "|")
+	for len(parts) < 5 {
+		parts = append(parts, "")
+	}
+	for i, part := range parts {
+		if part == "0" {
+			parts[i] = ""
+		}
+	}
+	return &Region{
+		Country:  parts[0],
+		Area:     parts[1],
+		Province: parts[2],
+		City:     parts[3],
+		Isp:      parts[4],
+	}
+}
+
+// Matches 判断地区是否包含指定名称，名称可以是国家、省份或城市，如 "菲律宾"、"广东"、"深圳市"
+func (r *Region) Matches(name string) bool {
+	name = strings.TrimSpace(name)
+	if r == nil || name == "" {
+		return false
+	}
+	for _, part := range []string{r.Country, r.Province, r.City} {
+		if part != "" && strings.Contains(part, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// String 返回用于展示的地址，如 "中国 广东省 深圳市"
+func (r *Region) String() string {
+	if r == nil {
+		return ""
+	}
+	parts := make([]string, 0, 3)
+	for _, part := range []string{r.Country, r.Province, r.City} {
+		if part != "" && (len(parts) == 0 || parts[len(parts)-1] != part) {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,374 @@
+package geoip
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"net"
+)
+
+// MaxMind DB 文件结构: 搜索树 + 16字节分隔 + 数据区 + 元数据标记 + 元数据
+var mmdbMetadataMarker = []byte("\xab\xcd\xefMaxMind.com")
+
+// mmdb 数据类型
+const (
+	mmdbExtended = 0
+	mmdbPointer  = 1
+	mmdbString   = 2
+	mmdbDouble   = 3
+	mmdbBytes    = 4
+	mmdbUint16   = 5
+	mmdbUint32   = 6
+	mmdbMap      = 7
+	mmdbInt32    = 8
+	mmdbUint64   = 9
+	mmdbUint128  = 10
+	mmdbArray    = 11
+	mmdbBoolean  = 14
+	mmdbFloat    = 15
+)
+
+// mmdbReader 基于整个文件内存的 MaxMind DB 查询器，只读，可并发使用
+type mmdbReader struct {
+	content    []byte
+	data       []byte // 数据区
+	nodeCount  uint
+	recordSize uint
+	ipVersion  uint
+	ipv4Start  uint // IPv6 库中 IPv4 地址的起始节点
+	language   string
+}
+
+// newMmdbReader 解析元数据并创建查询器，language 为地区名称使用的语言，如 zh-CN
+func newMmdbReader(content []byte, language string) (*mmdbReader, error) {
+	markerAt := bytes.LastIndex(content, mmdbMetadataMarker)
+	if markerAt < 0 {
+		return nil, fmt.Errorf("未找到 mmdb 元数据")
+	}
+	metadataStart := markerAt + len(mmdbMetadataMarker)
+	value, _, err := (&mmdbDecoder{buf: content[metadataStart:]}).decode(0)
+	if err != nil {
+		return nil, fmt.Errorf("解析 mmdb 元数据失败: %v", err)
+	}
+	metadata, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("mmdb 元数据格式错误")
+	}
+
+	reader := &mmdbReader{
+		content:    content,
+		nodeCount:  uint(toUint64(metadata["node_count"])),
+		recordSize: uint(toUint64(metadata["record_size"])),
+		ipVersion:  uint(toUint64(metadata["ip_version"])),
+		language:   language,
+	}
+	if reader.recordSize != 24 && reader.recordSize != 28 && reader.recordSize != 32 {
+		return nil, fmt.Errorf("不支持的 mmdb 记录长度: %d", reader.recordSize)
+	}
+	treeSize := reader.nodeCount * reader.recordSize / 4
+	if treeSize+16 > uint(markerAt) {
+		return nil, fmt.Errorf("mmdb 搜索树损坏")
+	}
+	reader.data = content[treeSize+16 : markerAt]
+
+	if reader.ipVersion == 6 {
+		node := uint(0)
+		for i := 0; i < 96 && node < reader.nodeCount; i++ {
+			node = reader.readNode(node, 0)
+		}
+		reader.ipv4Start = node
+	}
+	return reader, nil
+}
+
+// readNode 读取节点的左(bit=0)或右(bit=1)记录
+func (r *mmdbReader) readNode(node uint, bit uint) uint {
+	offset := node * r.recordSize / 4
+	b := r.content[offset : offset+r.recordSize/4]
+	switch r.recordSize {
+	case 24:
+		if bit == 0 {
+			return uint(b[0])<<16 | uint(b[1])<<8 | uint(b[2])
+		}
+		return uint(b[3])<<16 | uint(b[4])<<8 | uint(b[5])
+	case 28:
+		if bit == 0 {
+			return uint(b[3]&0xf0)<<20 | uint(b[0])<<16 | uint(b[1])<<8 | uint(b[2])
+		}
+		return uint(b[3]&0x0f)<<24 | uint(b[4])<<16 | uint(b[5])<<8 | uint(b[6])
+	default:
+		if bit == 0 {
+			return uint(binary.BigEndian.Uint32(b))
+		}
+		return uint(binary.BigEndian.Uint32(b[4:]))
+	}
+}
+
+// search 查询 IP 对应的记录，未收录时返回 nil
+func (r *mmdbReader) search(ip net.IP) (map[string]interface{}, error) {
+	node := uint(0)
+	bits := 128
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+		bits = 32
+		if r.ipVersion == 6 {
+			node = r.ipv4Start
+		}
+	} else if r.ipVersion == 4 {
+		return nil, nil
+	}
+
+	for i := 0; i < bits && node < r.nodeCount; i++ {
+		bit := uint(ip[i>>3]>>(7-uint(i&7))) & 1
+		node = r.readNode(node, bit)
+	}
+	if node <= r.nodeCount {
+		return nil, nil
+	}
+
+	offset := node - r.nodeCount - 16
+	if offset >= uint(len(r.data)) {
+		return nil, fmt.Errorf("mmdb 数据指针越界")
+	}
+	value, _, err := (&mmdbDecoder{buf: r.data}).decode(offset)
+	if err != nil {
+		return nil, err
+	}
+	record, _ := value.(map[string]interface{})
+	return record, nil
+}
+
+// lookup 查询 IP 所属地区
+func (r *mmdbReader) lookup(ip net.IP) (*Region, error) {
+	record, err := r.search(ip)
+	if err != nil || record == nil {
+		return nil, err
+	}
+
+	region := &Region{
+		Country: r.localizedName(record["country"]),
+		City:    r.localizedName(record["city"]),
+	}
+	if subdivisions, ok := record["subdivisions"].([]interface{}); ok && len(subdivisions) > 0 {
+		region.Province = r.localizedName(subdivisions[0])
+	}
+	if region.Country == "" && region.Province == "" && region.City == "" {
+		return nil, nil
+	}
+	return region, nil
+}
+
+// localizedName 取记录中 names 的本地化名称，没有对应语言时使用英文
+func (r *mmdbReader) localizedName(value interface{}) string {
+	entry, ok := value.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	names, ok := entry["names"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	if name, ok := names[r.language].(string); ok && name != "" {
+		return name
+	}
+	name, _ := names["en"].(string)
+	return name
+}
+
+// mmdbMaxDepth map/array 的最大嵌套层数，防止损坏的文件导致无限递归
+const mmdbMaxDepth = 64
+
+// mmdbDecoder 数据区解码器
+type mmdbDecoder struct {
+	buf []byte
+}
+
+// decode 解码 offset 处的值，返回值和下一个值的偏移
+func (d *mmdbDecoder) decode(offset uint) (interface{}, uint, error) {
+	return d.decodeAt(offset, 0)
+}
+
+// decodeAt 解码 offset 处的值，depth 为当前嵌套层数
+// 指针只能指向数据区内的非指针值，长度超出数据区或类型长度的值视为文件损坏
+func (d *mmdbDecoder) decodeAt(offset uint, depth int) (interface{}, uint, error) {
+	if depth > mmdbMaxDepth {
+		return nil, 0, fmt.Errorf("mmdb 数据嵌套过深")
+	}
+	if offset >= uint(len(d.buf)) {
+		return nil, 0, fmt.Errorf("mmdb 数据越界")
+	}
+	ctrl := d.buf[offset]
+	offset++
+	typeNum := uint(ctrl >> 5)
+
+	if typeNum == mmdbPointer {
+		pointer, next, err := d.decodePointer(ctrl, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		if pointer >= uint(len(d.buf)) {
+			return nil, 0, fmt.Errorf("mmdb 数据指针越界")
+		}
+		if uint(d.buf[pointer]>>5) == mmdbPointer {
+			return nil, 0, fmt.Errorf("mmdb 指针不能指向指针")
+		}
+		value, _, err := d.decodeAt(pointer, depth)
+		return value, next, err
+	}
+
+	if typeNum == mmdbExtended {
+		if offset >= uint(len(d.buf)) {
+			return nil, 0, fmt.Errorf("mmdb 数据越界")
+		}
+		typeNum = 7 + uint(d.buf[offset])
+		offset++
+	}
+
+	size, offset, err := d.decodeSize(ctrl, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	// boolean 的值保存在长度中；map/array 每个元素至少占1个字节，长度同样不能超出数据区，防止按损坏的长度分配过大的内存
+	if typeNum == mmdbBoolean {
+		if size > 1 {
+			return nil, 0, fmt.Errorf("mmdb boolean 值错误")
+		}
+	} else if size > uint(len(d.buf))-offset {
+		return nil, 0, fmt.Errorf("mmdb 数据越界")
+	}
+
+	switch typeNum {
+	case mmdbString:
+		return string(d.buf[offset : offset+size]), offset + size, nil
+	case mmdbBytes:
+		return append([]byte(nil), d.buf[offset:offset+size]...), offset + size, nil
+	case mmdbDouble:
+		if size != 8 {
+			return nil, 0, fmt.Errorf("mmdb double 长度错误")
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(d.buf[offset:])), offset + size, nil
+	case mmdbFloat:
+		if size != 4 {
+			return nil, 0, fmt.Errorf("mmdb float 长度错误")
+		}
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(d.buf[offset:]))), offset + size, nil
+	case mmdbUint16, mmdbUint32, mmdbUint64:
+		if size > mmdbIntSize[typeNum] {
+			return nil, 0, fmt.Errorf("mmdb 整数长度错误")
+		}
+		var value uint64
+		for _, b := range d.buf[offset : offset+size] {
+			value = value<<8 | uint64(b)
+		}
+		return value, offset + size, nil
+	case mmdbInt32:
+		if size > 4 {
+			return nil, 0, fmt.Errorf("mmdb 整数长度错误")
+		}
+		var value uint32
+		for _, b := range d.buf[offset : offset+size] {
+			value = value<<8 | uint32(b)
+		}
+		// 不足4字节时高位补0，按32位补码解释符号
+		return int64(int32(value)), offset + size, nil
+	case mmdbUint128:
+		if size > 16 {
+			return nil, 0, fmt.Errorf("mmdb 整数长度错误")
+		}
+		return new(big.Int).SetBytes(d.buf[offset : offset+size]), offset + size, nil
+	case mmdbBoolean:
+		return size != 0, offset, nil
+	case mmdbMap:
+		result := make(map[string]interface{}, size)
+		for i := uint(0); i < size; i++ {
+			key, next, err := d.decodeAt(offset, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			name, ok := key.(string)
+			if !ok {
+				return nil, 0, fmt.Errorf("mmdb map 的键必须是字符串")
+			}
+			value, next, err := d.decodeAt(next, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			result[name] = value
+			offset = next
+		}
+		return result, offset, nil
+	case mmdbArray:
+		result := make([]interface{}, 0, size)
+		for i := uint(0); i < size; i++ {
+			value, next, err := d.decodeAt(offset, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			result = append(result, value)
+			offset = next
+		}
+		return result, offset, nil
+	}
+	return nil, 0, fmt.Errorf("不支持的 mmdb 数据类型: %d", typeNum)
+}
+
+// mmdbIntSize 无符号整数类型的最大字节数
+var mmdbIntSize = map[uint]uint{
+	mmdbUint16: 2,
+	mmdbUint32: 4,
+	mmdbUint64: 8,
+}
+
+// decodePointer 解码指针，返回指向的偏移和指针之后的偏移
+func (d *mmdbDecoder) decodePointer(ctrl byte, offset uint) (uint, uint, error) {
+	size := uint((ctrl>>3)&0x3) + 1
+	if offset+size > uint(len(d.buf)) {
+		return 0, 0, fmt.Errorf("mmdb 数据越界")
+	}
+	b := d.buf[offset : offset+size]
+	var pointer uint
+	switch size {
+	case 1:
+		pointer = uint(ctrl&0x7)<<8 | uint(b[0])
+	case 2:
+		pointer = (uint(ctrl&0x7)<<16 | uint(b[0])<<8 | uint(b[1])) + 2048
+	case 3:
+		pointer = (uint(ctrl&0x7)<<24 | uint(b[0])<<16 | uint(b[1])<<8 | uint(b[2])) + 526336
+	default:
+		pointer = uint(binary.BigEndian.Uint32(b))
+	}
+	return pointer, offset + size, nil
+}
+
+// decodeSize 解码值的长度
+func (d *mmdbDecoder) decodeSize(ctrl byte, offset uint) (uint, uint, error) {
+	size := uint(ctrl & 0x1f)
+	if size < 29 {
+		return size, offset, nil
+	}
+	extra := size - 28
+	if offset+extra > uint(len(d.buf)) {
+		return 0, 0, fmt.Errorf("mmdb 数据越界")
+	}
+	var value uint
+	for _, b := range d.buf[offset : offset+extra] {
+		value = value<<8 | uint(b)
+	}
+	switch size {
+	case 29:
+		size = 29 + value
+	case 30:
+		size = 285 + value
+	default:
+		size = 65821 + value
+	}
+	return size, offset + extra, nil
+}
+
+// toUint64 元数据中的整数统一解码为 uint64
+func toUint64(value interface{}) uint64 {
+	number, _ := value.(uint64)
+	return number
+}
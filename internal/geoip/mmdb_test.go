@@ -0,0 +1,131 @@
+package geoip
+
+import (
+	"fmt"
+	"net"
+	"testing"
+)
+
+// mmdbEncode 按 MaxMind DB 格式编码测试数据，只支持字符串、uint16、uint32 和 map
+func mmdbEncode(value interface{}) []byte {
+	switch v := value.(type) {
+	case string:
+		return append([]byte{byte(mmdbString<<5 | len(v))}, v...)
+	case uint16:
+		return []byte{byte(mmdbUint16<<5 | 2), byte(v >> 8), byte(v)}
+	case uint32:
+		return []byte{byte(mmdbUint32<<5 | 4), byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)}
+	case map[string]interface{}:
+		out := []byte{byte(mmdbMap<<5 | len(v))}
+		for key, item := range v {
+			out = append(out, mmdbEncode(key)...)
+			out = append(out, mmdbEncode(item)...)
+		}
+		return out
+	}
+	panic("unsupported type")
+}
+
+// buildMmdb 构造只有一个节点的 IPv4 库：0.0.0.0/1 指向菲律宾，128.0.0.0/1 未收录
+func buildMmdb() []byte {
+	const nodeCount = 1
+	record := map[string]interface{}{
+		"country": map[string]interface{}{
+			"names": map[string]interface{}{"en": "Philippines", "zh-CN": "菲律宾"},
+		},
+		"city": map[string]interface{}{
+			"names": map[string]interface{}{"en": "Manila"},
+		},
+	}
+
+	// 24 位记录：左记录指向数据区偏移 0，右记录等于节点数表示未收录
+	left := nodeCount + 16
+	tree := []byte{byte(left >> 16), byte(left >> 8), byte(left), 0, 0, nodeCount}
+
+	content := append(tree, make([]byte, 16)...)
+	content = append(content, mmdbEncode(record)...)
+	content = append(content, mmdbMetadataMarker...)
+	content = append(content, mmdbEncode(map[string]interface{}{
+		"node_count":  uint32(nodeCount),
+		"record_size": uint16(24),
+		"ip_version":  uint16(4),
+	})...)
+	return content
+}
+
+func TestMmdbLookup(t *testing.T) {
+	reader, err := newMmdbReader(buildMmdb(), "zh-CN")
+	if err != nil {
+		t.Fatalf("newMmdbReader: %v", err)
+	}
+
+	region, err := reader.lookup(net.ParseIP("1.2.3.4"))
+	if err != nil {
+		t.Fatalf("lookup: %v", err)
+	}
+	if region.String() != "菲律宾 Manila" {
+		t.Errorf("lookup 1.2.3.4 = %q, want %q", region.String(), "菲律宾 Manila")
+	}
+
+	region, err = reader.lookup(net.ParseIP("200.1.1.1"))
+	if err != nil {
+		t.Fatalf("lookup: %v", err)
+	}
+	if region != nil {
+		t.Errorf("lookup 200.1.1.1 = %q, want nil", region.String())
+	}
+}
+
+func TestMmdbDecodeIntegers(t *testing.T) {
+	cases := []struct {
+		name string
+		buf  []byte
+		want string
+	}{
+		// int32 为扩展类型 (8 = 7+1)
+		{"int32 负数", []byte{4, 1, 0xff, 0xff, 0xff, 0xfe}, "-2"},
+		{"int32 不足4字节为正数", []byte{2, 1, 0xff, 0xfe}, "65534"},
+		// uint128 为扩展类型 (10 = 7+3)
+		{"uint128 超过64位", []byte{9, 3, 1, 0, 0, 0, 0, 0, 0, 0, 0}, "18446744073709551616"},
+		{"uint16", []byte{mmdbUint16<<5 | 2, 0x01, 0x02}, "258"},
+	}
+	for _, c := range cases {
+		value, _, err := (&mmdbDecoder{buf: c.buf}).decode(0)
+		if err != nil {
+			t.Errorf("%s: %v", c.name, err)
+			continue
+		}
+		if got := fmt.Sprint(value); got != c.want {
+			t.Errorf("%s: got %s, want %s", c.name, got, c.want)
+		}
+	}
+}
+
+func TestMmdbDecodeCorrupt(t *testing.T) {
+	// 数组嵌套超过最大层数
+	nested := make([]byte, 0, mmdbMaxDepth+2)
+	for i := 0; i < mmdbMaxDepth+2; i++ {
+		nested = append(nested, 1, 4) // 扩展类型 array (11 = 7+4)，长度1
+	}
+	nested[len(nested)-2] = 0 // 最内层为长度0的数组
+	nested[len(nested)-1] = 4
+
+	cases := []struct {
+		name string
+		buf  []byte
+	}{
+		{"指针指向自己", []byte{mmdbPointer << 5, 0}},
+		{"指针越界", []byte{mmdbPointer << 5, 100}},
+		{"字符串越界", []byte{mmdbString<<5 | 10, 'a'}},
+		{"map 长度超出数据区", []byte{mmdbMap<<5 | 29, 0xff}},
+		{"map 键不是字符串", []byte{mmdbMap<<5 | 1, mmdbUint16<<5 | 1, 1, mmdbUint16<<5 | 1, 1}},
+		{"uint16 超过2字节", []byte{mmdbUint16<<5 | 3, 1, 2, 3}},
+		{"int32 超过4字节", []byte{5, 1, 1, 2, 3, 4, 5}},
+		{"嵌套过深", nested},
+	}
+	for _, c := range cases {
+		if _, _, err := (&mmdbDecoder{buf: c.buf}).decode(0); err == nil {
+			t.Errorf("%s: 应返回错误", c.name)
+		}
+	}
+}
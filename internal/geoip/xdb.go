@@ -0,0 +1,84 @@
+package geoip
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+)
+
+// ip2region xdb 文件结构:
+// 256 字节头部 + 256*256 个向量索引(起止偏移各4字节) + 14字节的段索引 + 地区数据
+const (
+	xdbHeaderLength      = 256
+	xdbVectorIndexCols   = 256
+	xdbVectorIndexSize   = 8
+	xdbSegmentIndexSize  = 14
+	xdbVectorIndexLength = xdbVectorIndexCols * xdbVectorIndexCols * xdbVectorIndexSize
+)
+
+// xdbSearcher 基于整个文件内存的 ip2region 查询器，只读，可并发使用
+type xdbSearcher struct {
+	content []byte
+}
+
+// newXdbSearcher 校验文件长度并创建查询器
+func newXdbSearcher(content []byte) (*xdbSearcher, error) {
+	if len(content) < xdbHeaderLength+xdbVectorIndexLength {
+		return nil, fmt.Errorf("xdb 文件长度不足")
+	}
+	return &xdbSearcher{content: content}, nil
+}
+
+// lookup 查询 IP 所属地区，xdb 只收录 IPv4 地址
+func (x *xdbSearcher) lookup(ip net.IP) (*Region, error) {
+	if ip.To4() == nil {
+		return nil, nil
+	}
+	value, err := x.search(ip)
+	if err != nil || value == "" {
+		return nil, err
+	}
+	return parseRegion(value), nil
+}
+
+// search 查询 IPv4 地址对应的地区字符串，格式为 国家|区域|省份|城市|ISP，未收录时返回空字符串
+func (x *xdbSearcher) search(ip net.IP) (string, error) {
+	ip4 := ip.To4()
+	if ip4 == nil {
+		return "", fmt.Errorf("仅支持 IPv4 地址")
+	}
+	value := binary.BigEndian.Uint32(ip4)
+
+	offset := xdbHeaderLength + (int(ip4[0])*xdbVectorIndexCols+int(ip4[1]))*xdbVectorIndexSize
+	startPtr := int(binary.LittleEndian.Uint32(x.content[offset:]))
+	endPtr := int(binary.LittleEndian.Uint32(x.content[offset+4:]))
+	if endPtr > len(x.content) || startPtr > endPtr {
+		return "", fmt.Errorf("xdb 索引损坏")
+	}
+
+	low, high := 0, (endPtr-startPtr)/xdbSegmentIndexSize
+	for low <= high {
+		middle := (low + high) >> 1
+		pos := startPtr + middle*xdbSegmentIndexSize
+		if pos+xdbSegmentIndexSize > len(x.content) {
+			return "", fmt.Errorf("xdb 索引损坏")
+		}
+		segment := x.content[pos : pos+xdbSegmentIndexSize]
+		startIp := binary.LittleEndian.Uint32(segment)
+		endIp := binary.LittleEndian.Uint32(segment[4:])
+		switch {
+		case value < startIp:
+			high = middle - 1
+		case value > endIp:
+			low = middle + 1
+		default:
+			dataLen := int(binary.LittleEndian.Uint16(segment[8:]))
+			dataPtr := int(binary.LittleEndian.Uint32(segment[10:]))
+			if dataPtr+dataLen > len(x.content) {
+				return "", fmt.Errorf("xdb 数据损坏")
+			}
+			return string(x.content[dataPtr : dataPtr+dataLen]), nil
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,75 @@
+package geoip
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+)
+
+// buildXdb 构造只包含 1.2.3.0-1.2.3.255 与 1.2.4.0-1.2.4.255 两段的 xdb 文件
+func buildXdb(t *testing.T) []byte {
+	t.Helper()
+
+	regions := []string{"中国|0|广东省|深圳市|电信", "菲律宾|0|0|0|0"}
+	segmentsStart := xdbHeaderLength + xdbVectorIndexLength
+	dataStart := segmentsStart + len(regions)*xdbSegmentIndexSize
+
+	content := make([]byte, dataStart)
+	segments := [][2]string{{"1.2.3.0", "1.2.3.255"}, {"1.2.4.0", "1.2.4.255"}}
+	dataPtr := dataStart
+	for i, segment := range segments {
+		pos := segmentsStart + i*xdbSegmentIndexSize
+		binary.LittleEndian.PutUint32(content[pos:], binary.BigEndian.Uint32(net.ParseIP(segment[0]).To4()))
+		binary.LittleEndian.PutUint32(content[pos+4:], binary.BigEndian.Uint32(net.ParseIP(segment[1]).To4()))
+		binary.LittleEndian.PutUint16(content[pos+8:], uint16(len(regions[i])))
+		binary.LittleEndian.PutUint32(content[pos+10:], uint32(dataPtr))
+		content = append(content, regions[i]...)
+		dataPtr += len(regions[i])
+	}
+
+	// 1.2.x.x 的向量索引指向两个段
+	offset := xdbHeaderLength + (1*xdbVectorIndexCols+2)*xdbVectorIndexSize
+	binary.LittleEndian.PutUint32(content[offset:], uint32(segmentsStart))
+	binary.LittleEndian.PutUint32(content[offset+4:], uint32(segmentsStart+xdbSegmentIndexSize))
+	return content
+}
+
+func TestXdbSearch(t *testing.T) {
+	x, err := newXdbSearcher(buildXdb(t))
+	if err != nil {
+		t.Fatalf("newXdbSearcher: %v", err)
+	}
+
+	cases := map[string]string{
+		"1.2.3.4":   "中国|0|广东省|深圳市|电信",
+		"1.2.4.200": "菲律宾|0|0|0|0",
+		"1.2.5.1":   "",
+		"8.8.8.8":   "",
+	}
+	for ip, want := range cases {
+		got, err := x.search(net.ParseIP(ip))
+		if err != nil {
+			t.Fatalf("search %s: %v", ip, err)
+		}
+		if got != want {
+			t.Errorf("search %s = %q, want %q", ip, got, want)
+		}
+	}
+}
+
+func TestRegionMatches(t *testing.T) {
+	region := parseRegion("中国|0|广东省|深圳市|电信")
+	if region.String() != "中国 广东省 深圳市" {
+		t.Errorf("String() = %q", region.String())
+	}
+	for _, name := range []string{"中国", "广东", "深圳市"} {
+		if !region.Matches(name) {
+			t.Errorf("Matches(%q) = false, want true", name)
+		}
+	}
+	for _, name := range []string{"菲律宾", "北京", ""} {
+		if region.Matches(name) {
+			t.Errorf("Matches(%q) = true, want false", name)
+		}
+	}
+}
@@ -0,0 +1,703 @@
+package activity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "jh_app_service/api/backend/activity/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+	"jh_app_service/internal/util"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+type (
+	sActivity struct{}
+)
+
+func init() {
+	backend.RegisterActivity(&sActivity{})
+}
+
+// activityCoverUploadCode 活动封面的上传标识，对应配置 upload.activity_cover
+const activityCoverUploadCode = "activity_cover"
+
+// 单次排序最多调整的活动数
+const activitySortLimit = 200
+
+// activityForm 创建和修改活动共用的字段
+type activityForm struct {
+	name            string
+	activityType    int
+	describe        string
+	intro           string
+	pcCover         string
+	wapCover        string
+	mobileCover     string
+	startTime       string
+	endTime         string
+	status          int
+	isRelateGame    int
+	relateGameIds   []int32
+	templateId      int
+	activityDetails string
+	sort            int
+	tagIds          []int32
+
+	// 校验后填充
+	start *gtime.Time
+	end   *gtime.Time
+}
+
+// GetActivities 获取活动列表
+func (s *sActivity) GetActivities(ctx context.Context, req *v1.GetActivitiesReq) (*v1.GetActivitiesRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取活动列表请求 - Page: %d, Size: %d, Name: %s, Type: %d, Status: %d, IsPublish: %d, TagId: %d",
+		req.Page, req.Size, req.ActivityName, req.ActivityType, req.Status, req.IsPublish, req.TagId)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	size := req.Size
+	if size <= 0 {
+		size = 50
+	}
+
+	query := dao.Activity.Ctx(ctx).Where(do.Activity{SiteId: siteId})
+	if name := strings.TrimSpace(req.ActivityName); name != "" {
+		query = query.WhereLike("activity_name", "%"+name+"%")
+	}
+	if req.ActivityType > 0 {
+		query = query.Where("activity_type", req.ActivityType)
+	}
+	if req.Status >= 0 {
+		query = query.Where("status", req.Status)
+	}
+	if req.IsPublish >= 0 {
+		query = query.Where("is_publish", req.IsPublish)
+	}
+	if req.TagId > 0 {
+		activityIds, err := dao.ActivityTag.Ctx(ctx).
+			Fields("activity_id").
+			Where(do.ActivityTag{SiteId: siteId, TagId: req.TagId}).
+			WhereGT("activity_id", 0).
+			Array()
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "查询标签活动失败: %v", err)
+			return nil, err
+		}
+		if len(activityIds) == 0 {
+			return &v1.GetActivitiesRes{List: []*v1.ActivityInfo{}}, nil
+		}
+		query = query.WhereIn("id", activityIds)
+	}
+
+	total, err := query.Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取活动总数失败: %v", err)
+		return nil, err
+	}
+
+	var activities []*entity.Activity
+	err = query.Page(int(page), int(size)).OrderAsc("sort").OrderDesc("id").Scan(&activities)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取活动列表失败: %v", err)
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(activities))
+	for _, activity := range activities {
+		ids = append(ids, int(activity.Id))
+	}
+	tags, err := s.activityTags(ctx, siteId, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*v1.ActivityInfo, 0, len(activities))
+	for _, activity := range activities {
+		info := toActivityInfo(activity)
+		info.Tags = tags[int(activity.Id)]
+		list = append(list, info)
+	}
+
+	return &v1.GetActivitiesRes{List: list, Count: int32(total)}, nil
+}
+
+// GetActivity 获取活动详情，包含标签、模块和活动内容
+func (s *sActivity) GetActivity(ctx context.Context, req *v1.GetActivityReq) (*v1.GetActivityRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取活动详情请求 - ID: %d", req.Id)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	activity, err := s.getActivity(ctx, siteId, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	if activity == nil {
+		return &v1.GetActivityRes{}, nil
+	}
+
+	info := toActivityInfo(activity)
+	tags, err := s.activityTags(ctx, siteId, []int{int(activity.Id)})
+	if err != nil {
+		return nil, err
+	}
+	info.Tags = tags[int(activity.Id)]
+	if info.Modules, err = s.activityModules(ctx, siteId, int(activity.Id)); err != nil {
+		return nil, err
+	}
+	content, err := s.activityContent(ctx, siteId, activity)
+	if err != nil {
+		return nil, err
+	}
+	info.Content = toActivityContent(content)
+
+	return &v1.GetActivityRes{Activity: info}, nil
+}
+
+// CreateActivity 创建活动，新活动默认未发布
+func (s *sActivity) CreateActivity(ctx context.Context, req *v1.CreateActivityReq) (*v1.CreateActivityRes, error) {
+	middleware.LogWithTrace(ctx, "info", "创建活动请求 - Name: %s, Type: %d", req.ActivityName, req.ActivityType)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	form := &activityForm{
+		name:            strings.TrimSpace(req.ActivityName),
+		activityType:    int(req.ActivityType),
+		describe:        req.Describe,
+		intro:           req.Intro,
+		pcCover:         req.PcCover,
+		wapCover:        req.WapCover,
+		mobileCover:     req.MobileCover,
+		startTime:       req.StartTime,
+		endTime:         req.EndTime,
+		status:          int(req.Status),
+		isRelateGame:    int(req.IsRelateGame),
+		relateGameIds:   req.RelateGameIds,
+		templateId:      int(req.TemplateId),
+		activityDetails: req.ActivityDetails,
+		sort:            int(req.Sort),
+		tagIds:          req.TagIds,
+	}
+	message, err := s.validateActivity(ctx, siteId, form, nil)
+	if err != nil {
+		return nil, err
+	}
+	if message != "" {
+		return &v1.CreateActivityRes{Success: false, Message: message}, nil
+	}
+
+	var id int64
+	err = dao.Activity.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		var err error
+		id, err = dao.Activity.Ctx(ctx).Data(do.Activity{
+			SiteId:           siteId,
+			ActivityName:     form.name,
+			ActivityType:     form.activityType,
+			Describe:         form.describe,
+			Intro:            form.intro,
+			PcCover:          form.pcCover,
+			WapCover:         form.wapCover,
+			MobileCover:      form.mobileCover,
+			StartTime:        form.start,
+			EndTime:          form.end,
+			Status:           form.status,
+			IsRelateGame:     form.isRelateGame,
+			RelateGame:       util.JoinIds(form.relateGameIds),
+			TemplateId:       form.templateId,
+			ActivityDetails:  form.activityDetails,
+			ActivityModuleId: "",
+			IsPublish:        0,
+			Sort:             form.sort,
+			CreatedAt:        gtime.Now(),
+			UpdatedAt:        gtime.Now(),
+		}).InsertAndGetId()
+		if err != nil {
+			return err
+		}
+		return s.saveActivityTags(ctx, siteId, int(id), form.tagIds)
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "创建活动失败: %v", err)
+		return nil, fmt.Errorf("创建活动失败: %v", err)
+	}
+
+	logMessage := fmt.Sprintf("创建活动 %s [ID:%d]，活动时间: %s ~ %s", form.name, id, form.start.Format("Y-m-d H:i:s"), form.end.Format("Y-m-d H:i:s"))
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "创建活动成功 - ID: %d", id)
+	return &v1.CreateActivityRes{Success: true, Message: "创建成功", Id: int32(id)}, nil
+}
+
+// UpdateActivity 修改活动
+func (s *sActivity) UpdateActivity(ctx context.Context, req *v1.UpdateActivityReq) (*v1.UpdateActivityRes, error) {
+	middleware.LogWithTrace(ctx, "info", "修改活动请求 - ID: %d, Name: %s, Type: %d", req.Id, req.ActivityName, req.ActivityType)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	existing, err := s.getActivity(ctx, siteId, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return &v1.UpdateActivityRes{Success: false, Message: "活动不存在"}, nil
+	}
+
+	form := &activityForm{
+		name:            strings.TrimSpace(req.ActivityName),
+		activityType:    int(req.ActivityType),
+		describe:        req.Describe,
+		intro:           req.Intro,
+		pcCover:         req.PcCover,
+		wapCover:        req.WapCover,
+		mobileCover:     req.MobileCover,
+		startTime:       req.StartTime,
+		endTime:         req.EndTime,
+		status:          int(req.Status),
+		isRelateGame:    int(req.IsRelateGame),
+		relateGameIds:   req.RelateGameIds,
+		templateId:      int(req.TemplateId),
+		activityDetails: req.ActivityDetails,
+		sort:            int(req.Sort),
+		tagIds:          req.TagIds,
+	}
+	message, err := s.validateActivity(ctx, siteId, form, existing)
+	if err != nil {
+		return nil, err
+	}
+	if message != "" {
+		return &v1.UpdateActivityRes{Success: false, Message: message}, nil
+	}
+	if existing.IsPublish == 1 && form.status == 0 {
+		return &v1.UpdateActivityRes{Success: false, Message: "活动已发布，请先取消发布再关闭"}, nil
+	}
+	// 活动内容按活动类型分表保存，已保存内容后不能再修改类型
+	if form.activityType != existing.ActivityType {
+		content, err := s.activityContent(ctx, siteId, existing)
+		if err != nil {
+			return nil, err
+		}
+		if content != nil {
+			return &v1.UpdateActivityRes{Success: false, Message: "活动内容已保存，不能修改活动类型"}, nil
+		}
+	}
+	// 已保存的模块需在新的活动时间内，且与活动类型相符
+	modules, err := s.activityModules(ctx, siteId, int(existing.Id))
+	if err != nil {
+		return nil, err
+	}
+	if len(modules) > 0 {
+		updated := *existing
+		updated.ActivityType = form.activityType
+		updated.StartTime = form.start
+		updated.EndTime = form.end
+		if _, message = s.validateActivityModules(&updated, modules); message != "" {
+			return &v1.UpdateActivityRes{Success: false, Message: "请先修改活动模块: " + message}, nil
+		}
+	}
+
+	err = dao.Activity.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		_, err := dao.Activity.Ctx(ctx).Where("id", existing.Id).Data(g.Map{
+			"activity_name":    form.name,
+			"activity_type":    form.activityType,
+			"describe":         form.describe,
+			"intro":            form.intro,
+			"pc_cover":         form.pcCover,
+			"wap_cover":        form.wapCover,
+			"mobile_cover":     form.mobileCover,
+			"start_time":       form.start,
+			"end_time":         form.end,
+			"status":           form.status,
+			"is_relate_game":   form.isRelateGame,
+			"relate_game":      util.JoinIds(form.relateGameIds),
+			"template_id":      form.templateId,
+			"activity_details": form.activityDetails,
+			"sort":             form.sort,
+			"updated_at":       gtime.Now(),
+		}).Update()
+		if err != nil {
+			return err
+		}
+		return s.saveActivityTags(ctx, siteId, int(existing.Id), form.tagIds)
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "修改活动失败: %v", err)
+		return nil, fmt.Errorf("修改活动失败: %v", err)
+	}
+
+	logMessage := fmt.Sprintf("修改活动 [ID:%d] 名称: %s→%s，状态: %d→%d，活动时间: %s ~ %s",
+		existing.Id, existing.ActivityName, form.name, existing.Status, form.status, form.start.Format("Y-m-d H:i:s"), form.end.Format("Y-m-d H:i:s"))
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "修改活动成功 - ID: %d", existing.Id)
+	return &v1.UpdateActivityRes{Success: true, Message: "修改成功"}, nil
+}
+
+// DeleteActivity 删除活动及其模块、标签和内容，已发布的活动需先取消发布
+func (s *sActivity) DeleteActivity(ctx context.Context, req *v1.DeleteActivityReq) (*v1.DeleteActivityRes, error) {
+	middleware.LogWithTrace(ctx, "info", "删除活动请求 - ID: %d", req.Id)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	activity, err := s.getActivity(ctx, siteId, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	if activity == nil {
+		return &v1.DeleteActivityRes{Success: false, Message: "活动不存在"}, nil
+	}
+	if activity.IsPublish == 1 {
+		return &v1.DeleteActivityRes{Success: false, Message: "活动已发布，请先取消发布"}, nil
+	}
+
+	err = dao.Activity.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		where := g.Map{"site_id": siteId, "activity_id": activity.Id}
+		if _, err := dao.ActivityModule.Ctx(ctx).Where(where).Delete(); err != nil {
+			return err
+		}
+		if _, err := dao.ActivityTag.Ctx(ctx).Where(where).Delete(); err != nil {
+			return err
+		}
+		if _, err := dao.ActivityCustom.Ctx(ctx).Where(where).Delete(); err != nil {
+			return err
+		}
+		if _, err := dao.ActivityRecharge.Ctx(ctx).Where(where).Delete(); err != nil {
+			return err
+		}
+		_, err := dao.Activity.Ctx(ctx).Where("id", activity.Id).Delete()
+		return err
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "删除活动失败: %v", err)
+		return nil, fmt.Errorf("删除活动失败: %v", err)
+	}
+
+	logMessage := fmt.Sprintf("删除活动 %s [ID:%d]", activity.ActivityName, activity.Id)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	return &v1.DeleteActivityRes{Success: true, Message: "删除成功"}, nil
+}
+
+// PublishActivity 发布活动，发布前检查活动状态、时间、封面和内容
+func (s *sActivity) PublishActivity(ctx context.Context, req *v1.PublishActivityReq) (*v1.PublishActivityRes, error) {
+	middleware.LogWithTrace(ctx, "info", "发布活动请求 - ID: %d", req.Id)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	activity, err := s.getActivity(ctx, siteId, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	if activity == nil {
+		return &v1.PublishActivityRes{Success: false, Message: "活动不存在"}, nil
+	}
+	if activity.IsPublish == 1 {
+		return &v1.PublishActivityRes{Success: false, Message: "活动已发布"}, nil
+	}
+	message, err := s.checkPublishable(ctx, siteId, activity)
+	if err != nil {
+		return nil, err
+	}
+	if message != "" {
+		return &v1.PublishActivityRes{Success: false, Message: message}, nil
+	}
+
+	_, err = dao.Activity.Ctx(ctx).Where("id", activity.Id).Data(g.Map{
+		"is_publish": 1,
+		"updated_at": gtime.Now(),
+	}).Update()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "发布活动失败: %v", err)
+		return nil, fmt.Errorf("发布活动失败: %v", err)
+	}
+
+	logMessage := fmt.Sprintf("发布活动 %s [ID:%d]", activity.ActivityName, activity.Id)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	return &v1.PublishActivityRes{Success: true, Message: "发布成功"}, nil
+}
+
+// UnpublishActivity 取消发布活动
+func (s *sActivity) UnpublishActivity(ctx context.Context, req *v1.UnpublishActivityReq) (*v1.UnpublishActivityRes, error) {
+	middleware.LogWithTrace(ctx, "info", "取消发布活动请求 - ID: %d", req.Id)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	activity, err := s.getActivity(ctx, siteId, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	if activity == nil {
+		return &v1.UnpublishActivityRes{Success: false, Message: "活动不存在"}, nil
+	}
+	if activity.IsPublish != 1 {
+		return &v1.UnpublishActivityRes{Success: false, Message: "活动未发布"}, nil
+	}
+
+	_, err = dao.Activity.Ctx(ctx).Where("id", activity.Id).Data(g.Map{
+		"is_publish": 0,
+		"updated_at": gtime.Now(),
+	}).Update()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "取消发布活动失败: %v", err)
+		return nil, fmt.Errorf("取消发布活动失败: %v", err)
+	}
+
+	logMessage := fmt.Sprintf("取消发布活动 %s [ID:%d]", activity.ActivityName, activity.Id)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	return &v1.UnpublishActivityRes{Success: true, Message: "取消发布成功"}, nil
+}
+
+// SortActivities 批量调整活动排序
+func (s *sActivity) SortActivities(ctx context.Context, req *v1.SortActivitiesReq) (*v1.SortActivitiesRes, error) {
+	middleware.LogWithTrace(ctx, "info", "活动排序请求 - Count: %d", len(req.Items))
+
+	// 默认站点ID为1
+	siteId := 1
+
+	if len(req.Items) == 0 {
+		return &v1.SortActivitiesRes{Success: false, Message: "请选择需要排序的活动"}, nil
+	}
+	if len(req.Items) > activitySortLimit {
+		return &v1.SortActivitiesRes{Success: false, Message: fmt.Sprintf("单次最多调整 %d 个活动", activitySortLimit)}, nil
+	}
+	ids := make([]int32, 0, len(req.Items))
+	for _, item := range req.Items {
+		if item.Sort < 0 {
+			return &v1.SortActivitiesRes{Success: false, Message: "排序不能小于0"}, nil
+		}
+		ids = append(ids, item.Id)
+	}
+	ids = util.UniqueIds(ids)
+	if len(ids) != len(req.Items) {
+		return &v1.SortActivitiesRes{Success: false, Message: "活动重复"}, nil
+	}
+	count, err := dao.Activity.Ctx(ctx).Where(do.Activity{SiteId: siteId}).WhereIn("id", ids).Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询活动失败: %v", err)
+		return nil, err
+	}
+	if count != len(ids) {
+		return &v1.SortActivitiesRes{Success: false, Message: "活动不存在"}, nil
+	}
+
+	err = dao.Activity.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		for _, item := range req.Items {
+			_, err := dao.Activity.Ctx(ctx).Where("id", item.Id).Data(g.Map{
+				"sort":       item.Sort,
+				"updated_at": gtime.Now(),
+			}).Update()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "活动排序失败: %v", err)
+		return nil, fmt.Errorf("活动排序失败: %v", err)
+	}
+
+	parts := make([]string, 0, len(req.Items))
+	for _, item := range req.Items {
+		parts = append(parts, fmt.Sprintf("%d:%d", item.Id, item.Sort))
+	}
+	logMessage := fmt.Sprintf("调整活动排序 [活动ID:排序] %s", strings.Join(parts, ","))
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	return &v1.SortActivitiesRes{Success: true, Message: "排序成功"}, nil
+}
+
+// validateActivity 校验活动字段并解析活动时间，校验失败时返回提示信息
+// 封面只在新增或变更时通过上传服务校验
+func (s *sActivity) validateActivity(ctx context.Context, siteId int, form *activityForm, existing *entity.Activity) (string, error) {
+	if form.name == "" {
+		return "活动名称不能为空", nil
+	}
+	if len([]rune(form.name)) > 100 {
+		return "活动名称不能超过100个字符", nil
+	}
+	if form.activityType != consts.ActivityTypeCustom && form.activityType != consts.ActivityTypeRecharge {
+		return "活动类型错误", nil
+	}
+	if form.status != 0 && form.status != 1 {
+		return "状态错误", nil
+	}
+	if form.sort < 0 || form.templateId < 0 {
+		return "排序和模板ID不能小于0", nil
+	}
+
+	var err error
+	if form.start, err = gtime.StrToTime(form.startTime); err != nil {
+		return "开始时间格式错误", nil
+	}
+	if form.end, err = gtime.StrToTime(form.endTime); err != nil {
+		return "结束时间格式错误", nil
+	}
+	if !form.end.After(form.start) {
+		return "结束时间必须大于开始时间", nil
+	}
+
+	covers := []struct {
+		name    string
+		value   string
+		current string
+	}{
+		{"PC端封面", form.pcCover, ""},
+		{"WAP端封面", form.wapCover, ""},
+		{"手机端封面", form.mobileCover, ""},
+	}
+	if existing != nil {
+		covers[0].current = existing.PcCover
+		covers[1].current = existing.WapCover
+		covers[2].current = existing.MobileCover
+	}
+	for _, cover := range covers {
+		if cover.value == "" || cover.value == cover.current {
+			continue
+		}
+		if err := backend.Upload().ValidateImageUrl(ctx, cover.value, activityCoverUploadCode); err != nil {
+			middleware.LogWithTrace(ctx, "error", "活动封面校验失败: %v", err)
+			return fmt.Sprintf("%s: %s", cover.name, err.Error()), nil
+		}
+	}
+
+	form.relateGameIds = util.UniqueIds(form.relateGameIds)
+	if form.isRelateGame != 0 && form.isRelateGame != 1 {
+		return "是否关联游戏错误", nil
+	}
+	if form.isRelateGame == 0 {
+		form.relateGameIds = nil
+	} else {
+		if len(form.relateGameIds) == 0 {
+			return "请选择关联游戏", nil
+		}
+		count, err := dao.SiteGame.Ctx(ctx).Where(do.SiteGame{SiteId: siteId}).WhereIn("game_id", form.relateGameIds).Count()
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "查询游戏失败: %v", err)
+			return "", err
+		}
+		if count != len(form.relateGameIds) {
+			return "关联游戏不存在", nil
+		}
+	}
+
+	form.tagIds = util.UniqueIds(form.tagIds)
+	activityId := 0
+	if existing != nil {
+		activityId = int(existing.Id)
+	}
+	return s.validateActivityTags(ctx, siteId, activityId, form.tagIds)
+}
+
+// checkPublishable 检查活动是否可以发布，不可发布时返回原因
+func (s *sActivity) checkPublishable(ctx context.Context, siteId int, activity *entity.Activity) (string, error) {
+	if activity.Status != 1 {
+		return "活动已关闭，请先开启活动", nil
+	}
+	if activity.EndTime == nil || !activity.EndTime.After(gtime.Now()) {
+		return "活动已结束，不能发布", nil
+	}
+	if activity.PcCover == "" {
+		return "请先上传PC端封面", nil
+	}
+	if activity.MobileCover == "" && activity.WapCover == "" {
+		return "请先上传手机端或WAP端封面", nil
+	}
+
+	content, err := s.activityContent(ctx, siteId, activity)
+	if err != nil {
+		return "", err
+	}
+	if content == nil {
+		return "请先设置活动内容", nil
+	}
+	if message := validateActivityContent(toActivityContent(content)); message != "" {
+		return message, nil
+	}
+
+	if activity.ActivityType == consts.ActivityTypeRecharge {
+		count, err := dao.ActivityModule.Ctx(ctx).Where(do.ActivityModule{
+			SiteId:     siteId,
+			ActivityId: activity.Id,
+			ModuleType: consts.ActivityModuleRecharge,
+			Status:     1,
+		}).Count()
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "查询活动模块失败: %v", err)
+			return "", err
+		}
+		if count == 0 {
+			return "充值活动至少需要一个开启的充值模块", nil
+		}
+	}
+	return "", nil
+}
+
+// getActivity 获取站点的活动
+func (s *sActivity) getActivity(ctx context.Context, siteId, id int) (*entity.Activity, error) {
+	var activity *entity.Activity
+	err := dao.Activity.Ctx(ctx).Where(do.Activity{SiteId: siteId, Id: id}).Scan(&activity)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询活动失败: %v", err)
+		return nil, err
+	}
+	return activity, nil
+}
+
+// toActivityInfo 活动基本信息，不含标签、模块和内容
+func toActivityInfo(activity *entity.Activity) *v1.ActivityInfo {
+	return &v1.ActivityInfo{
+		Id:              int32(activity.Id),
+		ActivityName:    activity.ActivityName,
+		ActivityType:    int32(activity.ActivityType),
+		Describe:        activity.Describe,
+		Intro:           activity.Intro,
+		PcCover:         activity.PcCover,
+		WapCover:        activity.WapCover,
+		MobileCover:     activity.MobileCover,
+		StartTime:       util.FormatTime(activity.StartTime),
+		EndTime:         util.FormatTime(activity.EndTime),
+		Status:          int32(activity.Status),
+		IsRelateGame:    int32(activity.IsRelateGame),
+		RelateGameIds:   util.SplitIds(activity.RelateGame),
+		TemplateId:      int32(activity.TemplateId),
+		ActivityDetails: activity.ActivityDetails,
+		IsPublish:       int32(activity.IsPublish),
+		Sort:            int32(activity.Sort),
+		Tags:            []*v1.ActivityTagInfo{},
+		CreatedAt:       util.FormatTime(activity.CreatedAt),
+		UpdatedAt:       util.FormatTime(activity.UpdatedAt),
+	}
+}
@@ -0,0 +1,342 @@
+package activity
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	v1 "jh_app_service/api/backend/activity/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+	"jh_app_service/internal/util"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// 单个活动最多设置的模块数
+const activityModuleLimit = 20
+
+// 活动模块类型名称
+var activityModuleNames = map[int]string{
+	consts.ActivityModuleRecharge:    "充值",
+	consts.ActivityModuleTurntable:   "大转盘",
+	consts.ActivityModuleTreasureBox: "开宝箱",
+	consts.ActivityModuleGoldenEgg:   "砸金蛋",
+	consts.ActivityModuleRedPacket:   "抢红包",
+}
+
+// SaveActivityModules 保存活动模块，整体替换活动原有的模块
+func (s *sActivity) SaveActivityModules(ctx context.Context, req *v1.SaveActivityModulesReq) (*v1.SaveActivityModulesRes, error) {
+	middleware.LogWithTrace(ctx, "info", "保存活动模块请求 - ActivityID: %d, Modules: %d", req.ActivityId, len(req.Modules))
+
+	// 默认站点ID为1
+	siteId := 1
+
+	activity, err := s.getActivity(ctx, siteId, int(req.ActivityId))
+	if err != nil {
+		return nil, err
+	}
+	if activity == nil {
+		return &v1.SaveActivityModulesRes{Success: false, Message: "活动不存在"}, nil
+	}
+
+	modules, message := s.validateActivityModules(activity, req.Modules)
+	if message != "" {
+		return &v1.SaveActivityModulesRes{Success: false, Message: message}, nil
+	}
+	// 已发布的充值活动不能去掉全部开启的充值模块
+	if activity.IsPublish == 1 && activity.ActivityType == consts.ActivityTypeRecharge {
+		hasRecharge := false
+		for _, item := range req.Modules {
+			if item.ModuleType == consts.ActivityModuleRecharge && item.Status == 1 {
+				hasRecharge = true
+			}
+		}
+		if !hasRecharge {
+			return &v1.SaveActivityModulesRes{Success: false, Message: "已发布的充值活动至少需要一个开启的充值模块"}, nil
+		}
+	}
+
+	oldModules, err := s.activityModules(ctx, siteId, int(activity.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	err = dao.Activity.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		_, err := dao.ActivityModule.Ctx(ctx).Where(do.ActivityModule{SiteId: siteId, ActivityId: activity.Id}).Delete()
+		if err != nil {
+			return err
+		}
+		ids := make([]string, 0, len(modules))
+		for _, module := range modules {
+			id, err := dao.ActivityModule.Ctx(ctx).Data(module).InsertAndGetId()
+			if err != nil {
+				return err
+			}
+			ids = append(ids, strconv.FormatInt(id, 10))
+		}
+		// 活动表中同步保存模块记录ID
+		_, err = dao.Activity.Ctx(ctx).Where("id", activity.Id).Data(g.Map{
+			"activity_module_id": strings.Join(ids, ","),
+			"updated_at":         gtime.Now(),
+		}).Update()
+		return err
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "保存活动模块失败: %v", err)
+		return nil, fmt.Errorf("保存活动模块失败: %v", err)
+	}
+
+	logMessage := fmt.Sprintf("修改活动模块 %s [ID:%d]，模块: %s → %s",
+		activity.ActivityName, activity.Id, describeActivityModules(oldModules), describeActivityModules(req.Modules))
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	return &v1.SaveActivityModulesRes{Success: true, Message: "保存成功"}, nil
+}
+
+// UpdateActivityContent 修改活动内容，自定义活动保存在 activity_custom，充值活动保存在 activity_recharge
+func (s *sActivity) UpdateActivityContent(ctx context.Context, req *v1.UpdateActivityContentReq) (*v1.UpdateActivityContentRes, error) {
+	middleware.LogWithTrace(ctx, "info", "修改活动内容请求 - ActivityID: %d", req.ActivityId)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	activity, err := s.getActivity(ctx, siteId, int(req.ActivityId))
+	if err != nil {
+		return nil, err
+	}
+	if activity == nil {
+		return &v1.UpdateActivityContentRes{Success: false, Message: "活动不存在"}, nil
+	}
+	if req.Content == nil {
+		return &v1.UpdateActivityContentRes{Success: false, Message: "请设置活动内容"}, nil
+	}
+	content := req.Content
+	content.PcLink = strings.TrimSpace(content.PcLink)
+	content.MobileLink = strings.TrimSpace(content.MobileLink)
+	if message := validateActivityContent(content); message != "" {
+		return &v1.UpdateActivityContentRes{Success: false, Message: message}, nil
+	}
+
+	if _, ok := s.contentModel(ctx, activity.ActivityType); !ok {
+		return &v1.UpdateActivityContentRes{Success: false, Message: "活动类型错误"}, nil
+	}
+	data := g.Map{
+		"pc_type":        content.PcType,
+		"pc_content":     content.PcContent,
+		"pc_link":        content.PcLink,
+		"mobile_type":    content.MobileType,
+		"mobile_content": content.MobileContent,
+		"mobile_link":    content.MobileLink,
+		"updated_at":     gtime.Now(),
+	}
+
+	err = dao.Activity.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		// 每个活动只有一条内容记录，没有时新增
+		model, _ := s.contentModel(ctx, activity.ActivityType)
+		id, err := model.Clone().Fields("id").Where(g.Map{"site_id": siteId, "activity_id": activity.Id}).LockUpdate().Value()
+		if err != nil {
+			return err
+		}
+		if id.IsEmpty() {
+			data["site_id"] = siteId
+			data["activity_id"] = activity.Id
+			data["created_at"] = gtime.Now()
+			_, err = model.Clone().Data(data).Insert()
+			return err
+		}
+		_, err = model.Clone().Where("id", id.Int()).Data(data).Update()
+		return err
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "修改活动内容失败: %v", err)
+		return nil, fmt.Errorf("修改活动内容失败: %v", err)
+	}
+
+	logMessage := fmt.Sprintf("修改活动内容 %s [ID:%d]，PC端类型: %d，手机端类型: %d", activity.ActivityName, activity.Id, content.PcType, content.MobileType)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	return &v1.UpdateActivityContentRes{Success: true, Message: "保存成功"}, nil
+}
+
+// validateActivityModules 校验活动模块并转换为待保存的记录，校验失败时返回提示信息
+// 模块时间需在活动时间范围内，同一模块不能重复添加
+func (s *sActivity) validateActivityModules(activity *entity.Activity, items []*v1.ActivityModuleInfo) ([]*do.ActivityModule, string) {
+	if len(items) > activityModuleLimit {
+		return nil, fmt.Sprintf("最多设置 %d 个模块", activityModuleLimit)
+	}
+	modules := make([]*do.ActivityModule, 0, len(items))
+	seen := make(map[string]bool, len(items))
+	for _, item := range items {
+		typeName, ok := activityModuleNames[int(item.ModuleType)]
+		if !ok {
+			return nil, "模块类型错误"
+		}
+		if item.ModuleType == consts.ActivityModuleRecharge && activity.ActivityType != consts.ActivityTypeRecharge {
+			return nil, "只有充值活动可以添加充值模块"
+		}
+		if item.ModuleId <= 0 {
+			return nil, fmt.Sprintf("请选择%s模块", typeName)
+		}
+		key := fmt.Sprintf("%d_%d", item.ModuleType, item.ModuleId)
+		if seen[key] {
+			return nil, fmt.Sprintf("%s模块 %d 重复", typeName, item.ModuleId)
+		}
+		seen[key] = true
+		name := strings.TrimSpace(item.ModuleName)
+		if name == "" {
+			return nil, "模块名称不能为空"
+		}
+		if item.Status != 0 && item.Status != 1 {
+			return nil, "模块状态错误"
+		}
+		start, err := gtime.StrToTime(item.StartTime)
+		if err != nil {
+			return nil, fmt.Sprintf("模块 %s 的开始时间格式错误", name)
+		}
+		end, err := gtime.StrToTime(item.EndTime)
+		if err != nil {
+			return nil, fmt.Sprintf("模块 %s 的结束时间格式错误", name)
+		}
+		if !end.After(start) {
+			return nil, fmt.Sprintf("模块 %s 的结束时间必须大于开始时间", name)
+		}
+		if (activity.StartTime != nil && start.Before(activity.StartTime)) || (activity.EndTime != nil && end.After(activity.EndTime)) {
+			return nil, fmt.Sprintf("模块 %s 的时间必须在活动时间范围内", name)
+		}
+		modules = append(modules, &do.ActivityModule{
+			SiteId:     activity.SiteId,
+			ActivityId: activity.Id,
+			ModuleType: item.ModuleType,
+			ModuleId:   item.ModuleId,
+			ModuleName: name,
+			StartTime:  start,
+			EndTime:    end,
+			Status:     item.Status,
+			CreatedAt:  gtime.Now(),
+			UpdatedAt:  gtime.Now(),
+		})
+	}
+	return modules, ""
+}
+
+// validateActivityContent 校验PC端和手机端的活动内容，校验失败时返回提示信息
+func validateActivityContent(content *v1.ActivityContent) string {
+	platforms := []struct {
+		name        string
+		contentType int32
+		content     string
+		link        string
+	}{
+		{"PC端", content.PcType, content.PcContent, content.PcLink},
+		{"手机端", content.MobileType, content.MobileContent, content.MobileLink},
+	}
+	for _, platform := range platforms {
+		switch platform.contentType {
+		case consts.ActivityContentEditor:
+			if strings.TrimSpace(platform.content) == "" {
+				return fmt.Sprintf("请填写%s活动内容", platform.name)
+			}
+		case consts.ActivityContentLink:
+			if !strings.HasPrefix(platform.link, "http://") && !strings.HasPrefix(platform.link, "https://") {
+				return fmt.Sprintf("%s内容链接必须以 http:// 或 https:// 开头", platform.name)
+			}
+		default:
+			return fmt.Sprintf("%s内容类型错误", platform.name)
+		}
+	}
+	return ""
+}
+
+// contentModel 活动类型对应的内容表
+func (s *sActivity) contentModel(ctx context.Context, activityType int) (*gdb.Model, bool) {
+	switch activityType {
+	case consts.ActivityTypeCustom:
+		return dao.ActivityCustom.Ctx(ctx), true
+	case consts.ActivityTypeRecharge:
+		return dao.ActivityRecharge.Ctx(ctx), true
+	}
+	return nil, false
+}
+
+// activityContent 活动内容，未设置时返回 nil
+// activity_custom 和 activity_recharge 字段相同，统一按 entity.ActivityCustom 读取
+func (s *sActivity) activityContent(ctx context.Context, siteId int, activity *entity.Activity) (*entity.ActivityCustom, error) {
+	model, ok := s.contentModel(ctx, activity.ActivityType)
+	if !ok {
+		return nil, nil
+	}
+	var content *entity.ActivityCustom
+	err := model.Where(g.Map{"site_id": siteId, "activity_id": activity.Id}).Scan(&content)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询活动内容失败: %v", err)
+		return nil, err
+	}
+	return content, nil
+}
+
+// activityModules 活动模块，按开始时间排序
+func (s *sActivity) activityModules(ctx context.Context, siteId, activityId int) ([]*v1.ActivityModuleInfo, error) {
+	var modules []*entity.ActivityModule
+	err := dao.ActivityModule.Ctx(ctx).
+		Where(do.ActivityModule{SiteId: siteId, ActivityId: activityId}).
+		OrderAsc("start_time").
+		OrderAsc("id").
+		Scan(&modules)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询活动模块失败: %v", err)
+		return nil, err
+	}
+	list := make([]*v1.ActivityModuleInfo, 0, len(modules))
+	for _, module := range modules {
+		list = append(list, &v1.ActivityModuleInfo{
+			Id:         int32(module.Id),
+			ModuleType: int32(module.ModuleType),
+			ModuleId:   int32(module.ModuleId),
+			ModuleName: module.ModuleName,
+			StartTime:  util.FormatTime(module.StartTime),
+			EndTime:    util.FormatTime(module.EndTime),
+			Status:     int32(module.Status),
+		})
+	}
+	return list, nil
+}
+
+// toActivityContent 转换活动内容，未设置时返回 nil
+func toActivityContent(content *entity.ActivityCustom) *v1.ActivityContent {
+	if content == nil {
+		return nil
+	}
+	return &v1.ActivityContent{
+		PcType:        int32(content.PcType),
+		PcContent:     content.PcContent,
+		PcLink:        content.PcLink,
+		MobileType:    int32(content.MobileType),
+		MobileContent: content.MobileContent,
+		MobileLink:    content.MobileLink,
+		UpdatedAt:     util.FormatTime(content.UpdatedAt),
+	}
+}
+
+// describeActivityModules 模块说明，用于管理员日志
+func describeActivityModules(modules []*v1.ActivityModuleInfo) string {
+	if len(modules) == 0 {
+		return "无"
+	}
+	parts := make([]string, 0, len(modules))
+	for _, module := range modules {
+		parts = append(parts, fmt.Sprintf("%s:%d(%s,状态%d)", activityModuleNames[int(module.ModuleType)], module.ModuleId, module.ModuleName, module.Status))
+	}
+	return strings.Join(parts, " ")
+}
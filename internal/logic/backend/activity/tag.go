@@ -0,0 +1,362 @@
+package activity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "jh_app_service/api/backend/activity/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+	"jh_app_service/internal/util"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// activity_tag 同时保存标签和活动使用的标签:
+// activity_id 为0的记录是标签本身；activity_id 大于0的记录表示活动使用了 tag_id 对应的标签，名称、状态和排序与标签同步
+
+// 单个活动最多设置的标签数
+const activityTagLimit = 10
+
+// GetActivityTags 获取活动标签列表
+func (s *sActivity) GetActivityTags(ctx context.Context, req *v1.GetActivityTagsReq) (*v1.GetActivityTagsRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取活动标签列表请求 - Status: %d", req.Status)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	query := dao.ActivityTag.Ctx(ctx).
+		Where(do.ActivityTag{SiteId: siteId, ActivityId: 0}).
+		WhereNot("status", consts.ActivityTagDeleted)
+	if req.Status >= 0 {
+		query = query.Where("status", req.Status)
+	}
+	var tags []*entity.ActivityTag
+	if err := query.OrderAsc("sort").OrderAsc("id").Scan(&tags); err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取活动标签列表失败: %v", err)
+		return nil, err
+	}
+
+	result, err := dao.ActivityTag.Ctx(ctx).
+		Fields("tag_id, COUNT(*) AS total").
+		Where(do.ActivityTag{SiteId: siteId}).
+		WhereGT("activity_id", 0).
+		Group("tag_id").
+		All()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "统计标签活动数失败: %v", err)
+		return nil, err
+	}
+	counts := make(map[int]int, len(result))
+	for _, record := range result {
+		counts[record["tag_id"].Int()] = record["total"].Int()
+	}
+
+	list := make([]*v1.ActivityTagInfo, 0, len(tags))
+	for _, tag := range tags {
+		info := toActivityTagInfo(tag, int(tag.Id))
+		info.ActivityCount = int32(counts[int(tag.Id)])
+		list = append(list, info)
+	}
+	return &v1.GetActivityTagsRes{List: list}, nil
+}
+
+// CreateActivityTag 创建活动标签
+func (s *sActivity) CreateActivityTag(ctx context.Context, req *v1.CreateActivityTagReq) (*v1.CreateActivityTagRes, error) {
+	middleware.LogWithTrace(ctx, "info", "创建活动标签请求 - Name: %s, Status: %d, Sort: %d", req.Name, req.Status, req.Sort)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	name := strings.TrimSpace(req.Name)
+	message, err := s.validateTag(ctx, siteId, 0, name, int(req.Status), int(req.Sort))
+	if err != nil {
+		return nil, err
+	}
+	if message != "" {
+		return &v1.CreateActivityTagRes{Success: false, Message: message}, nil
+	}
+
+	id, err := dao.ActivityTag.Ctx(ctx).Data(do.ActivityTag{
+		SiteId:     siteId,
+		ActivityId: 0,
+		TagId:      0,
+		Name:       name,
+		Status:     req.Status,
+		Sort:       req.Sort,
+		CreatedAt:  gtime.Now(),
+		UpdatedAt:  gtime.Now(),
+	}).InsertAndGetId()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "创建活动标签失败: %v", err)
+		return nil, fmt.Errorf("创建活动标签失败: %v", err)
+	}
+
+	logMessage := fmt.Sprintf("创建活动标签 %s [ID:%d]", name, id)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	return &v1.CreateActivityTagRes{Success: true, Message: "创建成功", Id: int32(id)}, nil
+}
+
+// UpdateActivityTag 修改活动标签，同步更新活动上的标签
+func (s *sActivity) UpdateActivityTag(ctx context.Context, req *v1.UpdateActivityTagReq) (*v1.UpdateActivityTagRes, error) {
+	middleware.LogWithTrace(ctx, "info", "修改活动标签请求 - ID: %d, Name: %s, Status: %d, Sort: %d", req.Id, req.Name, req.Status, req.Sort)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	tag, err := s.getTag(ctx, siteId, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	if tag == nil {
+		return &v1.UpdateActivityTagRes{Success: false, Message: "标签不存在"}, nil
+	}
+
+	name := strings.TrimSpace(req.Name)
+	message, err := s.validateTag(ctx, siteId, int(tag.Id), name, int(req.Status), int(req.Sort))
+	if err != nil {
+		return nil, err
+	}
+	if message != "" {
+		return &v1.UpdateActivityTagRes{Success: false, Message: message}, nil
+	}
+
+	err = dao.ActivityTag.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		data := g.Map{
+			"name":       name,
+			"status":     req.Status,
+			"sort":       req.Sort,
+			"updated_at": gtime.Now(),
+		}
+		if _, err := dao.ActivityTag.Ctx(ctx).Where("id", tag.Id).Data(data).Update(); err != nil {
+			return err
+		}
+		_, err := dao.ActivityTag.Ctx(ctx).
+			Where(do.ActivityTag{SiteId: siteId, TagId: tag.Id}).
+			WhereGT("activity_id", 0).
+			Data(data).
+			Update()
+		return err
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "修改活动标签失败: %v", err)
+		return nil, fmt.Errorf("修改活动标签失败: %v", err)
+	}
+
+	logMessage := fmt.Sprintf("修改活动标签 [ID:%d] 名称: %s→%s，状态: %d→%d，排序: %d→%d",
+		tag.Id, tag.Name, name, tag.Status, req.Status, tag.Sort, req.Sort)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	return &v1.UpdateActivityTagRes{Success: true, Message: "修改成功"}, nil
+}
+
+// DeleteActivityTag 删除活动标签并从活动上移除
+func (s *sActivity) DeleteActivityTag(ctx context.Context, req *v1.DeleteActivityTagReq) (*v1.DeleteActivityTagRes, error) {
+	middleware.LogWithTrace(ctx, "info", "删除活动标签请求 - ID: %d", req.Id)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	tag, err := s.getTag(ctx, siteId, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	if tag == nil {
+		return &v1.DeleteActivityTagRes{Success: false, Message: "标签不存在"}, nil
+	}
+
+	var removed int64
+	err = dao.ActivityTag.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		_, err := dao.ActivityTag.Ctx(ctx).Where("id", tag.Id).Data(g.Map{
+			"status":     consts.ActivityTagDeleted,
+			"updated_at": gtime.Now(),
+		}).Update()
+		if err != nil {
+			return err
+		}
+		result, err := dao.ActivityTag.Ctx(ctx).
+			Where(do.ActivityTag{SiteId: siteId, TagId: tag.Id}).
+			WhereGT("activity_id", 0).
+			Delete()
+		if err != nil {
+			return err
+		}
+		removed, err = result.RowsAffected()
+		return err
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "删除活动标签失败: %v", err)
+		return nil, fmt.Errorf("删除活动标签失败: %v", err)
+	}
+
+	logMessage := fmt.Sprintf("删除活动标签 %s [ID:%d]，从 %d 个活动移除", tag.Name, tag.Id, removed)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	return &v1.DeleteActivityTagRes{Success: true, Message: "删除成功"}, nil
+}
+
+// validateTag 校验标签名称、状态和排序，校验失败时返回提示信息
+func (s *sActivity) validateTag(ctx context.Context, siteId, id int, name string, status, sort int) (string, error) {
+	if name == "" {
+		return "标签名称不能为空", nil
+	}
+	if len([]rune(name)) > 20 {
+		return "标签名称不能超过20个字符", nil
+	}
+	if status != consts.ActivityTagDisabled && status != consts.ActivityTagEnabled {
+		return "状态错误", nil
+	}
+	if sort < 0 {
+		return "排序不能小于0", nil
+	}
+	query := dao.ActivityTag.Ctx(ctx).
+		Where(do.ActivityTag{SiteId: siteId, ActivityId: 0, Name: name}).
+		WhereNot("status", consts.ActivityTagDeleted)
+	if id > 0 {
+		query = query.WhereNot("id", id)
+	}
+	count, err := query.Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询活动标签失败: %v", err)
+		return "", err
+	}
+	if count > 0 {
+		return "标签名称已存在", nil
+	}
+	return "", nil
+}
+
+// validateActivityTags 校验活动选择的标签，禁用的标签只能保留不能新增
+func (s *sActivity) validateActivityTags(ctx context.Context, siteId, activityId int, tagIds []int32) (string, error) {
+	if len(tagIds) == 0 {
+		return "", nil
+	}
+	if len(tagIds) > activityTagLimit {
+		return fmt.Sprintf("最多选择 %d 个标签", activityTagLimit), nil
+	}
+	var tags []*entity.ActivityTag
+	err := dao.ActivityTag.Ctx(ctx).
+		Where(do.ActivityTag{SiteId: siteId, ActivityId: 0}).
+		WhereIn("id", tagIds).
+		WhereNot("status", consts.ActivityTagDeleted).
+		Scan(&tags)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询活动标签失败: %v", err)
+		return "", err
+	}
+	if len(tags) != len(tagIds) {
+		return "标签不存在", nil
+	}
+
+	current := make(map[int]bool)
+	if activityId > 0 {
+		values, err := dao.ActivityTag.Ctx(ctx).Fields("tag_id").Where(do.ActivityTag{SiteId: siteId, ActivityId: activityId}).Array()
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "查询活动标签失败: %v", err)
+			return "", err
+		}
+		for _, value := range values {
+			current[value.Int()] = true
+		}
+	}
+	for _, tag := range tags {
+		if tag.Status != consts.ActivityTagEnabled && !current[int(tag.Id)] {
+			return fmt.Sprintf("标签 %s 已禁用", tag.Name), nil
+		}
+	}
+	return "", nil
+}
+
+// saveActivityTags 保存活动使用的标签，整体替换，需在事务中调用
+func (s *sActivity) saveActivityTags(ctx context.Context, siteId, activityId int, tagIds []int32) error {
+	_, err := dao.ActivityTag.Ctx(ctx).Where(do.ActivityTag{SiteId: siteId, ActivityId: activityId}).Delete()
+	if err != nil {
+		return err
+	}
+	if len(tagIds) == 0 {
+		return nil
+	}
+	var tags []*entity.ActivityTag
+	err = dao.ActivityTag.Ctx(ctx).Where(do.ActivityTag{SiteId: siteId, ActivityId: 0}).WhereIn("id", tagIds).Scan(&tags)
+	if err != nil {
+		return err
+	}
+	rows := make([]do.ActivityTag, 0, len(tags))
+	for _, tag := range tags {
+		rows = append(rows, do.ActivityTag{
+			SiteId:     siteId,
+			ActivityId: activityId,
+			TagId:      tag.Id,
+			Name:       tag.Name,
+			Status:     tag.Status,
+			Sort:       tag.Sort,
+			CreatedAt:  gtime.Now(),
+			UpdatedAt:  gtime.Now(),
+		})
+	}
+	_, err = dao.ActivityTag.Ctx(ctx).Data(rows).Insert()
+	return err
+}
+
+// activityTags 各活动使用的标签，按排序返回
+func (s *sActivity) activityTags(ctx context.Context, siteId int, activityIds []int) (map[int][]*v1.ActivityTagInfo, error) {
+	result := make(map[int][]*v1.ActivityTagInfo)
+	if len(activityIds) == 0 {
+		return result, nil
+	}
+	var tags []*entity.ActivityTag
+	err := dao.ActivityTag.Ctx(ctx).
+		Where(do.ActivityTag{SiteId: siteId}).
+		WhereIn("activity_id", activityIds).
+		OrderAsc("sort").
+		OrderAsc("tag_id").
+		Scan(&tags)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询活动标签失败: %v", err)
+		return nil, err
+	}
+	for _, tag := range tags {
+		result[tag.ActivityId] = append(result[tag.ActivityId], toActivityTagInfo(tag, tag.TagId))
+	}
+	return result, nil
+}
+
+// getTag 获取站点的活动标签，已删除的标签视为不存在
+func (s *sActivity) getTag(ctx context.Context, siteId, id int) (*entity.ActivityTag, error) {
+	var tag *entity.ActivityTag
+	err := dao.ActivityTag.Ctx(ctx).
+		Where(do.ActivityTag{SiteId: siteId, Id: id, ActivityId: 0}).
+		WhereNot("status", consts.ActivityTagDeleted).
+		Scan(&tag)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询活动标签失败: %v", err)
+		return nil, err
+	}
+	return tag, nil
+}
+
+// toActivityTagInfo 转换标签信息，id 为标签ID
+func toActivityTagInfo(tag *entity.ActivityTag, id int) *v1.ActivityTagInfo {
+	return &v1.ActivityTagInfo{
+		Id:        int32(id),
+		Name:      tag.Name,
+		Status:    int32(tag.Status),
+		Sort:      int32(tag.Sort),
+		CreatedAt: util.FormatTime(tag.CreatedAt),
+		UpdatedAt: util.FormatTime(tag.UpdatedAt),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	v1 "jh_app_service/api/backend/admin/v1"
+	"jh_app_service/internal/geoip"
 	"jh_app_service/internal/service/backend"
 	"jh_app_service/internal/util"
 	"strings"
@@ -44,6 +45,12 @@ func (s *sAdmin) Login(ctx context.Context, req *v1.LoginReq) (*v1.LoginRes, err
 	siteId := 1 // 临时硬编码，实际应该从请求中获取
 	tracing.SetSpanAttributes(span, attribute.Int("site_id", siteId))
 
+	// 访问屏蔽检查
+	if err := backend.Site().CheckAccess(ctx, siteId, ""); err != nil {
+		tracing.AddSpanEvent(span, "access_denied", attribute.String("username", req.Username))
+		return nil, err
+	}
+
 	// 数据库查询span
 	ctx, dbSpan := tracing.StartSpan(ctx, "db.query.admin", trace.WithAttributes(
 		attribute.String("db.operation", "select"),
@@ -1002,6 +1009,7 @@ func (s *sAdmin) Logout(ctx context.Context, req *v1.LogoutReq) (*v1.LogoutRes,
 			AdminId:       int(admin.Id),
 			AdminUsername: admin.Username,
 			Ip:            clientIP,
+			Address:       geoip.Address(clientIP),
 			Remark:        "管理员退出登录",
 		})
 		if err != nil {
@@ -1111,11 +1119,13 @@ func (s *sAdmin) ChangePassword(ctx context.Context, req *v1.ChangePasswordReq)
 	}
 
 	// 记录操作日志
+	clientIP := middleware.GetClientIPFromContext(ctx)
 	_, err = dao.AdminLog.Ctx(ctx).Insert(do.AdminLog{
 		SiteId:        admin.SiteId,
 		AdminId:       int(admin.Id),
 		AdminUsername: admin.Username,
-		Ip:            middleware.GetClientIPFromContext(ctx),
+		Ip:            clientIP,
+		Address:       geoip.Address(clientIP),
 		Remark:        "管理员修改密码",
 	})
 	if err != nil {
@@ -1171,11 +1181,51 @@ func (s *sAdmin) getClientIP(ctx context.Context) string {
 
 // addAdminLog 添加管理员日志
 func (s *sAdmin) addAdminLog(ctx context.Context, admin *entity.Admin, message string) error {
+	clientIP := s.getClientIP(ctx)
+	_, err := dao.AdminLog.Ctx(ctx).Insert(do.AdminLog{
+		SiteId:        admin.SiteId,
+		AdminId:       int(admin.Id),
+		AdminUsername: admin.Username,
+		Ip:            clientIP,
+		Address:       geoip.Address(clientIP),
+		Remark:        message,
+		CreatedAt:     gtime.Now(),
+	})
+	return err
+}
+
+// CurrentAdmin 获取当前请求的管理员，未登录时返回 nil
+func (s *sAdmin) CurrentAdmin(ctx context.Context) *entity.Admin {
+	adminId, exists := middleware.GetAdminIdFromContext(ctx)
+	if !exists {
+		return nil
+	}
+
+	var admin *entity.Admin
+	if err := dao.Admin.Ctx(ctx).Where(do.Admin{Id: adminId}).Scan(&admin); err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询管理员信息失败: %v", err)
+		return nil
+	}
+	return admin
+}
+
+// WriteLog 以当前管理员身份记录操作日志，未登录时(如定时任务)记为系统操作
+func (s *sAdmin) WriteLog(ctx context.Context, message string) error {
+	admin := s.CurrentAdmin(ctx)
+	if admin == nil {
+		admin = &entity.Admin{
+			SiteId:   1, // 默认站点ID为1
+			Username: "system",
+		}
+	}
+
+	clientIP := middleware.GetClientIPFromContext(ctx)
 	_, err := dao.AdminLog.Ctx(ctx).Insert(do.AdminLog{
 		SiteId:        admin.SiteId,
 		AdminId:       int(admin.Id),
 		AdminUsername: admin.Username,
-		Ip:            s.getClientIP(ctx),
+		Ip:            clientIP,
+		Address:       geoip.Address(clientIP),
 		Remark:        message,
 		CreatedAt:     gtime.Now(),
 	})
@@ -1254,6 +1304,7 @@ func (s *sAdmin) GetAdminLogs(ctx context.Context, req *v1.GetAdminLogsReq) (*v1
 	err = query.Fields(
 		"admin_username",
 		"ip",
+		"address",
 		"remark",
 		"DATE_FORMAT(created_at, '%Y-%m-%d %H:%i:%s') as created_at_formatted",
 		"UNIX_TIMESTAMP(created_at) as created_at_unix",
@@ -1281,6 +1332,7 @@ func (s *sAdmin) GetAdminLogs(ctx context.Context, req *v1.GetAdminLogsReq) (*v1
 			logList = append(logList, &v1.AdminLogInfo{
 				Username:  fmt.Sprintf("%v", result["admin_username"]),
 				Ip:        fmt.Sprintf("%v", result["ip"]),
+				Address:   fmt.Sprintf("%v", result["address"]),
 				Remark:    fmt.Sprintf("%v", result["remark"]),
 				CreatedAt: createdAtStr,
 			})
@@ -1301,7 +1353,7 @@ func (s *sAdmin) GetAdminLogs(ctx context.Context, req *v1.GetAdminLogsReq) (*v1
 
 	// 查询管理员日志数据
 	var logs []entity.AdminLog
-	err = query.Fields("admin_username", "ip", "remark", "created_at").
+	err = query.Fields("admin_username", "ip", "address", "remark", "created_at").
 		Page(int(page), int(size)).
 		OrderDesc("created_at").
 		Scan(&logs)
@@ -1321,6 +1373,7 @@ func (s *sAdmin) GetAdminLogs(ctx context.Context, req *v1.GetAdminLogsReq) (*v1
 		logList = append(logList, &v1.AdminLogInfo{
 			Username:  log.AdminUsername,
 			Ip:        log.Ip,
+			Address:   log.Address,
 			Remark:    log.Remark,
 			CreatedAt: createdAt,
 		})
@@ -0,0 +1,55 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+)
+
+// Permissions 获取管理员角色已启用权限的后端地址 (admin_permission.backend_url)
+func (s *sAdmin) Permissions(ctx context.Context, admin *entity.Admin) (map[string]bool, error) {
+	var role *entity.AdminRole
+	err := dao.AdminRole.Ctx(ctx).Where(do.AdminRole{Id: admin.AdminRoleId}).Scan(&role)
+	if err != nil {
+		return nil, fmt.Errorf("查询角色失败: %v", err)
+	}
+	permissions := make(map[string]bool)
+	if role == nil || role.Status != 1 || role.Permissions == "" {
+		return permissions, nil
+	}
+
+	var rolePermissions []*entity.AdminPermission
+	err = dao.AdminPermission.Ctx(ctx).
+		Fields("backend_url").
+		WhereIn("id", strings.Split(role.Permissions, ",")).
+		Where("status = ?", 1).
+		Scan(&rolePermissions)
+	if err != nil {
+		return nil, fmt.Errorf("查询角色权限失败: %v", err)
+	}
+	for _, permission := range rolePermissions {
+		if permission.BackendUrl != "" {
+			permissions[permission.BackendUrl] = true
+		}
+	}
+	return permissions, nil
+}
+
+// HasPermission 检查当前管理员是否拥有指定后端地址的权限，未登录或查询失败时视为没有权限
+func (s *sAdmin) HasPermission(ctx context.Context, backendUrl string) bool {
+	admin := s.CurrentAdmin(ctx)
+	if admin == nil {
+		return false
+	}
+	permissions, err := s.Permissions(ctx, admin)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询管理员权限失败: %v", err)
+		return false
+	}
+	return permissions[backendUrl]
+}
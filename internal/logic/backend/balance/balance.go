@@ -0,0 +1,327 @@
+package balance
+
+import (
+	"context"
+	"fmt"
+	v1 "jh_app_service/api/backend/balance/v1"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/secret"
+	"jh_app_service/internal/service/backend"
+	"jh_app_service/internal/util"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+type (
+	sBalance struct{}
+)
+
+func init() {
+	backend.RegisterBalance(&sBalance{})
+}
+
+// GetPaymentAccounts 获取支付接口列表
+func (s *sBalance) GetPaymentAccounts(ctx context.Context, req *v1.GetPaymentAccountsReq) (*v1.GetPaymentAccountsRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取支付接口列表请求 - PaymentId: %d, Page: %d, Size: %d", req.PaymentId, req.Page, req.Size)
+
+	// 默认站点ID为1
+	siteId := int32(1)
+
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	size := req.Size
+	if size <= 0 {
+		size = 50
+	}
+
+	query := dao.PaymentAccount.Ctx(ctx).Where(do.PaymentAccount{
+		SiteId: siteId,
+	})
+	if req.PaymentId > 0 {
+		query = query.Where("payment_id", req.PaymentId)
+	}
+	if req.Status > 0 {
+		query = query.Where("status", req.Status)
+	}
+
+	total, err := query.Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取支付接口总数失败: %v", err)
+		return nil, err
+	}
+
+	var accounts []*entity.PaymentAccount
+	err = query.Order("sort ASC, id DESC").Page(int(page), int(size)).Scan(&accounts)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取支付接口列表失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]*v1.PaymentAccountInfo, 0, len(accounts))
+	for _, account := range accounts {
+		list = append(list, s.toPaymentAccountInfo(ctx, account))
+	}
+
+	middleware.LogWithTrace(ctx, "info", "获取支付接口列表成功 - 总数: %d", total)
+
+	return &v1.GetPaymentAccountsRes{
+		List:  list,
+		Count: int32(total),
+	}, nil
+}
+
+// CreatePaymentAccount 创建支付接口
+func (s *sBalance) CreatePaymentAccount(ctx context.Context, req *v1.CreatePaymentAccountReq) (*v1.CreatePaymentAccountRes, error) {
+	middleware.LogWithTrace(ctx, "info", "创建支付接口请求 - Name: %s, PaymentId: %d", req.Name, req.PaymentId)
+
+	// 默认站点ID为1
+	siteId := int32(1)
+
+	if err := s.validatePaymentAccount(req.Name, req.EachMin, req.EachMax, req.IsInt, req.MoneyList); err != nil {
+		return &v1.CreatePaymentAccountRes{Success: false, Message: err.Error()}, nil
+	}
+
+	// 新建时密钥必须是完整明文，不能是脱敏值
+	for _, value := range []string{req.Md5Key, req.PublicKey, req.PrivateKey} {
+		if secret.IsMasked(value) {
+			return &v1.CreatePaymentAccountRes{Success: false, Message: "请输入完整的密钥"}, nil
+		}
+	}
+
+	md5Key, err := secret.Encrypt(ctx, req.Md5Key)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "加密MD5密钥失败: %v", err)
+		return nil, fmt.Errorf("加密密钥失败: %v", err)
+	}
+	publicKey, err := secret.Encrypt(ctx, req.PublicKey)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "加密公钥失败: %v", err)
+		return nil, fmt.Errorf("加密密钥失败: %v", err)
+	}
+	privateKey, err := secret.Encrypt(ctx, req.PrivateKey)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "加密私钥失败: %v", err)
+		return nil, fmt.Errorf("加密密钥失败: %v", err)
+	}
+
+	_, err = dao.PaymentAccount.Ctx(ctx).Data(do.PaymentAccount{
+		SiteId:     siteId,
+		PaymentId:  int(req.PaymentId),
+		Gateway:    int(req.Gateway),
+		Name:       req.Name,
+		Domain:     req.Domain,
+		MerchantNo: req.MerchantNo,
+		Md5Key:     md5Key,
+		EachMin:    req.EachMin,
+		EachMax:    req.EachMax,
+		DailyMax:   req.DailyMax,
+		Status:     int(req.Status),
+		Sort:       int(req.Sort),
+		PublicKey:  publicKey,
+		PrivateKey: privateKey,
+		IsDecimal:  int(req.IsDecimal),
+		IsInt:      int(req.IsInt),
+		MoneyList:  req.MoneyList,
+		CreatedAt:  gtime.Now(),
+		UpdatedAt:  gtime.Now(),
+	}).Insert()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "创建支付接口失败: %v", err)
+		return nil, err
+	}
+
+	middleware.LogWithTrace(ctx, "info", "创建支付接口成功 - Name: %s", req.Name)
+
+	return &v1.CreatePaymentAccountRes{Success: true, Message: "添加成功"}, nil
+}
+
+// GetPaymentAccountUpdate 获取支付接口编辑信息
+func (s *sBalance) GetPaymentAccountUpdate(ctx context.Context, req *v1.GetPaymentAccountUpdateReq) (*v1.GetPaymentAccountUpdateRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取支付接口编辑信息请求 - Id: %d", req.Id)
+
+	// 默认站点ID为1
+	siteId := int32(1)
+
+	var account *entity.PaymentAccount
+	err := dao.PaymentAccount.Ctx(ctx).Where(do.PaymentAccount{
+		Id:     req.Id,
+		SiteId: siteId,
+	}).Scan(&account)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询支付接口失败: %v", err)
+		return nil, err
+	}
+	if account == nil {
+		middleware.LogWithTrace(ctx, "error", "支付接口不存在 - Id: %d", req.Id)
+		return nil, fmt.Errorf("支付接口不存在")
+	}
+
+	return &v1.GetPaymentAccountUpdateRes{
+		Data: s.toPaymentAccountInfo(ctx, account),
+	}, nil
+}
+
+// UpdatePaymentAccount 更新支付接口
+// 密钥字段留空或提交脱敏值时保持原值不变，只有重新输入完整密钥才会修改
+func (s *sBalance) UpdatePaymentAccount(ctx context.Context, req *v1.UpdatePaymentAccountReq) (*v1.UpdatePaymentAccountRes, error) {
+	middleware.LogWithTrace(ctx, "info", "更新支付接口请求 - Id: %d, Name: %s", req.Id, req.Name)
+
+	// 默认站点ID为1
+	siteId := int32(1)
+
+	count, err := dao.PaymentAccount.Ctx(ctx).Where("id", req.Id).Where("site_id", siteId).Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询支付接口失败: %v", err)
+		return nil, err
+	}
+	if count == 0 {
+		middleware.LogWithTrace(ctx, "error", "支付接口不存在 - Id: %d", req.Id)
+		return &v1.UpdatePaymentAccountRes{Success: false, Message: "支付接口不存在"}, nil
+	}
+
+	if err = s.validatePaymentAccount(req.Name, req.EachMin, req.EachMax, req.IsInt, req.MoneyList); err != nil {
+		return &v1.UpdatePaymentAccountRes{Success: false, Message: err.Error()}, nil
+	}
+
+	updateData := g.Map{
+		"payment_id":  req.PaymentId,
+		"gateway":     req.Gateway,
+		"name":        req.Name,
+		"domain":      req.Domain,
+		"merchant_no": req.MerchantNo,
+		"each_min":    req.EachMin,
+		"each_max":    req.EachMax,
+		"daily_max":   req.DailyMax,
+		"status":      req.Status,
+		"sort":        req.Sort,
+		"is_decimal":  req.IsDecimal,
+		"is_int":      req.IsInt,
+		"moneyList":   req.MoneyList,
+		"updated_at":  gtime.Now(),
+	}
+
+	secrets := map[string]string{
+		"md5_key":     req.Md5Key,
+		"public_key":  req.PublicKey,
+		"private_key": req.PrivateKey,
+	}
+	for column, value := range secrets {
+		if value == "" || secret.IsMasked(value) {
+			continue
+		}
+		encrypted, err := secret.Encrypt(ctx, value)
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "加密密钥失败 - 字段: %s, 错误: %v", column, err)
+			return nil, fmt.Errorf("加密密钥失败: %v", err)
+		}
+		updateData[column] = encrypted
+		middleware.LogWithTrace(ctx, "info", "密钥已重新设置 - 字段: %s", column)
+	}
+
+	_, err = dao.PaymentAccount.Ctx(ctx).Where("id", req.Id).Where("site_id", siteId).Data(updateData).Update()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "更新支付接口失败: %v", err)
+		return nil, err
+	}
+
+	middleware.LogWithTrace(ctx, "info", "更新支付接口成功 - Id: %d", req.Id)
+
+	return &v1.UpdatePaymentAccountRes{Success: true, Message: "更新成功"}, nil
+}
+
+// DeletePaymentAccount 删除支付接口
+func (s *sBalance) DeletePaymentAccount(ctx context.Context, req *v1.DeletePaymentAccountReq) (*v1.DeletePaymentAccountRes, error) {
+	middleware.LogWithTrace(ctx, "info", "删除支付接口请求 - Id: %d", req.Id)
+
+	// 默认站点ID为1
+	siteId := int32(1)
+
+	count, err := dao.PaymentAccount.Ctx(ctx).Where("id", req.Id).Where("site_id", siteId).Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询支付接口失败: %v", err)
+		return nil, err
+	}
+	if count == 0 {
+		middleware.LogWithTrace(ctx, "error", "支付接口不存在 - Id: %d", req.Id)
+		return &v1.DeletePaymentAccountRes{Success: false, Message: "支付接口不存在"}, nil
+	}
+
+	// 支付接口和层级关联在同一事务中删除，避免留下指向已删除接口的关联
+	err = dao.PaymentAccount.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		_, err := dao.PaymentAccount.Ctx(ctx).Where("id", req.Id).Where("site_id", siteId).Delete()
+		if err != nil {
+			return err
+		}
+		_, err = dao.UserLevelPayment.Ctx(ctx).Where(do.UserLevelPayment{
+			SiteId:           siteId,
+			PaymentAccountId: req.Id,
+		}).Delete()
+		return err
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "删除支付接口失败: %v", err)
+		return nil, err
+	}
+
+	middleware.LogWithTrace(ctx, "info", "删除支付接口成功 - Id: %d", req.Id)
+
+	return &v1.DeletePaymentAccountRes{Success: true, Message: "删除成功"}, nil
+}
+
+// toPaymentAccountInfo 转换支付接口信息，密钥字段只返回脱敏值
+func (s *sBalance) toPaymentAccountInfo(ctx context.Context, account *entity.PaymentAccount) *v1.PaymentAccountInfo {
+	statusMap := map[int]string{
+		0: "禁用",
+		1: "启用",
+	}
+
+	return &v1.PaymentAccountInfo{
+		Id:          int32(account.Id),
+		SiteId:      int32(account.SiteId),
+		PaymentId:   int32(account.PaymentId),
+		Gateway:     int32(account.Gateway),
+		Name:        account.Name,
+		Domain:      account.Domain,
+		MerchantNo:  account.MerchantNo,
+		Md5Key:      secret.Mask(ctx, account.Md5Key),
+		EachMin:     account.EachMin,
+		EachMax:     account.EachMax,
+		DailyMax:    account.DailyMax,
+		TodayCount:  int32(account.TodayCount),
+		TodayAmount: account.TodayAmount,
+		Status:      int32(account.Status),
+		StatusName:  statusMap[account.Status],
+		Sort:        int32(account.Sort),
+		CreatedAt:   util.FormatTime(account.CreatedAt),
+		UpdatedAt:   util.FormatTime(account.UpdatedAt),
+		PublicKey:   secret.Mask(ctx, account.PublicKey),
+		PrivateKey:  secret.Mask(ctx, account.PrivateKey),
+		IsDecimal:   int32(account.IsDecimal),
+		IsInt:       int32(account.IsInt),
+		MoneyList:   account.MoneyList,
+	}
+}
+
+// validatePaymentAccount 验证支付接口参数
+func (s *sBalance) validatePaymentAccount(name string, eachMin, eachMax float64, isInt int32, moneyList string) error {
+	if name == "" {
+		return fmt.Errorf("接口名称不能为空")
+	}
+	if eachMin < 0 || eachMax < 0 {
+		return fmt.Errorf("单笔限额不能为负数")
+	}
+	if eachMax > 0 && eachMin > eachMax {
+		return fmt.Errorf("单笔最低不能大于单笔最高")
+	}
+	if isInt == 1 && moneyList == "" {
+		return fmt.Errorf("请填写可选的金额数组")
+	}
+	return nil
+}
@@ -0,0 +1,353 @@
+package balance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "jh_app_service/api/backend/balance/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/game"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/util/grand"
+)
+
+// 处理中的订单超过该时间仍未完成，视为结果未知并进入对账
+const gameTransferStaleAfter = 5 * time.Minute
+
+// gameTransferStatusNames 游戏转账订单状态说明
+var gameTransferStatusNames = map[int]string{
+	consts.GameTransferProcessing: "转账处理中",
+	consts.GameTransferSuccess:    "转账成功",
+	consts.GameTransferFailed:     "转账失败",
+	consts.GameTransferReconcile:  "转账结果待确认",
+}
+
+// QueryGameBalance 查询会员在游戏厂商的余额
+func (s *sBalance) QueryGameBalance(ctx context.Context, req *v1.QueryGameBalanceReq) (*v1.QueryGameBalanceRes, error) {
+	middleware.LogWithTrace(ctx, "info", "查询游戏余额请求 - GameId: %d, UserId: %d", req.GameId, req.UserId)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	user, siteGame, provider, err := s.getGameWallet(ctx, siteId, int(req.UserId), int(req.GameId))
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询游戏余额失败: %v", err)
+		return nil, err
+	}
+
+	balance, err := provider.Balance(ctx, user.Username)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "调用厂商查询余额失败 - 厂商: %s, 错误: %v", provider.Name(), err)
+		return nil, fmt.Errorf("查询游戏余额失败: %v", err)
+	}
+
+	return &v1.QueryGameBalanceRes{
+		Data: &v1.GameBalanceInfo{
+			GameId:         req.GameId,
+			GameName:       siteGame.Name,
+			UserId:         int32(user.Id),
+			Username:       user.Username,
+			Balance:        balance,
+			LastUpdateTime: gtime.Now().Format("Y-m-d H:i:s"),
+		},
+	}, nil
+}
+
+// TransferGame 会员转入或转出游戏，按转账方向调用 TransferToGame 或 TransferFromGame
+// 结果未知的订单进入待对账，由对账任务完成，会员端不应重新发起
+func (s *sBalance) TransferGame(ctx context.Context, req *v1.TransferGameReq) (*v1.TransferGameRes, error) {
+	middleware.LogWithTrace(ctx, "info", "游戏转账请求 - UserId: %d, GameId: %d, Direction: %d, Money: %.2f", req.UserId, req.GameId, req.Direction, req.Money)
+
+	var (
+		order *entity.GameTransfer
+		err   error
+	)
+	switch req.Direction {
+	case consts.GameTransferIn:
+		order, err = s.TransferToGame(ctx, int(req.UserId), int(req.GameId), req.Money)
+	case consts.GameTransferOut:
+		order, err = s.TransferFromGame(ctx, int(req.UserId), int(req.GameId), req.Money)
+	default:
+		return &v1.TransferGameRes{Success: false, Message: "转账方向无效"}, nil
+	}
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "游戏转账失败: %v", err)
+		return &v1.TransferGameRes{Success: false, Message: err.Error()}, nil
+	}
+
+	return &v1.TransferGameRes{
+		Success: order.Status != consts.GameTransferFailed,
+		Message: gameTransferStatusNames[order.Status],
+		Data: &v1.GameTransferInfo{
+			OrderNo:       order.OrderNo,
+			VendorOrderNo: order.VendorOrderNo,
+			GameId:        int32(order.GameId),
+			Direction:     int32(order.Direction),
+			Money:         order.Money,
+			Status:        int32(order.Status),
+			Message:       order.Message,
+		},
+	}, nil
+}
+
+// TransferToGame 会员账户转入游戏：先扣减账户余额，再调用厂商转入
+func (s *sBalance) TransferToGame(ctx context.Context, userId, gameId int, money float64) (*entity.GameTransfer, error) {
+	return s.transferGame(ctx, userId, gameId, money, consts.GameTransferIn)
+}
+
+// TransferFromGame 游戏转出到会员账户：厂商转出成功后再增加账户余额
+func (s *sBalance) TransferFromGame(ctx context.Context, userId, gameId int, money float64) (*entity.GameTransfer, error) {
+	return s.transferGame(ctx, userId, gameId, money, consts.GameTransferOut)
+}
+
+// transferGame 创建游戏转账订单并调用厂商
+func (s *sBalance) transferGame(ctx context.Context, userId, gameId int, money float64, direction int) (*entity.GameTransfer, error) {
+	// 默认站点ID为1
+	siteId := 1
+
+	if money <= 0 {
+		return nil, fmt.Errorf("转账金额必须大于0")
+	}
+
+	user, _, provider, err := s.getGameWallet(ctx, siteId, userId, gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	order := &entity.GameTransfer{
+		SiteId:    siteId,
+		UserId:    int(user.Id),
+		Username:  user.Username,
+		GameId:    gameId,
+		OrderNo:   "GT" + gtime.Now().Format("YmdHis") + grand.Digits(6),
+		Direction: direction,
+		Money:     money,
+		Status:    consts.GameTransferProcessing,
+		CreatedAt: gtime.Now(),
+		UpdatedAt: gtime.Now(),
+	}
+
+	// 转入游戏时订单和扣款在同一事务中，余额不足时不会产生订单
+	err = dao.GameTransfer.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		id, err := dao.GameTransfer.Ctx(ctx).Data(order).OmitEmptyData().InsertAndGetId()
+		if err != nil {
+			return err
+		}
+		order.Id = uint64(id)
+
+		if direction != consts.GameTransferIn {
+			return nil
+		}
+		_, _, err = s.PostLedger(ctx, &model.LedgerEntry{
+			SiteId:     siteId,
+			UserId:     order.UserId,
+			ChangeType: consts.ChangeTypeOut,
+			TradeType:  consts.TradeTypeGameIn,
+			TradeNo:    order.OrderNo,
+			Money:      money,
+			Remark:     fmt.Sprintf("转入游戏 %d", gameId),
+		})
+		return err
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "创建游戏转账订单失败: %v", err)
+		return nil, err
+	}
+
+	request := &game.TransferRequest{
+		OrderNo: order.OrderNo,
+		Account: user.Username,
+		Amount:  money,
+	}
+	var result *game.TransferResult
+	if direction == consts.GameTransferIn {
+		result, err = provider.TransferIn(ctx, request)
+	} else {
+		result, err = provider.TransferOut(ctx, request)
+	}
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "调用厂商转账结果不明确 - 订单号: %s, 厂商: %s, 错误: %v", order.OrderNo, provider.Name(), err)
+	}
+
+	if err = s.applyGameTransferResult(ctx, order, result, err, false); err != nil {
+		return nil, err
+	}
+
+	// 失败的转账不预警，待对账的订单按已发起转账处理
+	if order.Status != consts.GameTransferFailed {
+		riskType := consts.RiskAccountToGame
+		if direction == consts.GameTransferOut {
+			riskType = consts.RiskGameToAccount
+		}
+		err = backend.Risk().Evaluate(ctx, &model.RiskEvent{
+			Type:     riskType,
+			SiteId:   siteId,
+			UserId:   order.UserId,
+			Username: order.Username,
+			Money:    money,
+		})
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "游戏转账风险预警失败: %v", err)
+		}
+	}
+	return order, nil
+}
+
+// ReconcileGameTransfers 对账结果未知的游戏转账订单，由定时任务调用
+func (s *sBalance) ReconcileGameTransfers(ctx context.Context) error {
+	var orders []*entity.GameTransfer
+	err := dao.GameTransfer.Ctx(ctx).
+		Where("status = ? OR (status = ? AND created_at < ?)",
+			consts.GameTransferReconcile, consts.GameTransferProcessing, gtime.Now().Add(-gameTransferStaleAfter)).
+		Order("id ASC").Limit(200).Scan(&orders)
+	if err != nil {
+		return fmt.Errorf("查询待对账游戏转账订单失败: %v", err)
+	}
+
+	resolved := 0
+	for _, order := range orders {
+		provider, err := game.Provider(order.GameId)
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "游戏转账对账失败 - 订单号: %s, 错误: %v", order.OrderNo, err)
+			continue
+		}
+
+		result, err := provider.OrderStatus(ctx, order.OrderNo)
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "查询厂商订单状态失败 - 订单号: %s, 错误: %v", order.OrderNo, err)
+		}
+		if err = s.applyGameTransferResult(ctx, order, result, err, true); err != nil {
+			middleware.LogWithTrace(ctx, "error", "处理游戏转账对账结果失败 - 订单号: %s, 错误: %v", order.OrderNo, err)
+			continue
+		}
+		if order.Status != consts.GameTransferReconcile {
+			resolved++
+		}
+	}
+
+	middleware.LogWithTrace(ctx, "info", "游戏转账对账完成 - 待对账: %d, 已确认: %d", len(orders), resolved)
+	return nil
+}
+
+// applyGameTransferResult 根据厂商结果完成订单
+// 成功时转出订单给账户加款；失败时转入订单退回账户；结果不明确时标记为待对账
+func (s *sBalance) applyGameTransferResult(ctx context.Context, order *entity.GameTransfer, result *game.TransferResult, callErr error, reconciling bool) error {
+	status := consts.GameTransferReconcile
+	message := ""
+	vendorOrderNo := order.VendorOrderNo
+	switch {
+	case callErr != nil:
+		message = callErr.Error()
+	case result == nil:
+		message = "厂商未返回结果"
+	default:
+		message = result.Message
+		if result.VendorOrderNo != "" {
+			vendorOrderNo = result.VendorOrderNo
+		}
+		switch result.Status {
+		case game.TransferSuccess:
+			status = consts.GameTransferSuccess
+		case game.TransferFailed:
+			status = consts.GameTransferFailed
+		}
+	}
+
+	err := dao.GameTransfer.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		data := g.Map{
+			"status":          status,
+			"vendor_order_no": vendorOrderNo,
+			"message":         message,
+			"updated_at":      gtime.Now(),
+		}
+		if reconciling {
+			data["reconcile_times"] = gdb.Raw("reconcile_times + 1")
+		}
+
+		// 只处理未完成的订单，防止并发对账重复记账
+		result, err := dao.GameTransfer.Ctx(ctx).Where("id", order.Id).
+			WhereIn("status", g.Slice{consts.GameTransferProcessing, consts.GameTransferReconcile}).
+			Data(data).Update()
+		if err != nil {
+			return err
+		}
+		if rows, _ := result.RowsAffected(); rows == 0 {
+			return fmt.Errorf("订单 %s 已处理", order.OrderNo)
+		}
+
+		entry := &model.LedgerEntry{
+			SiteId:     order.SiteId,
+			UserId:     order.UserId,
+			ChangeType: consts.ChangeTypeIn,
+			Money:      order.Money,
+		}
+		switch {
+		case order.Direction == consts.GameTransferOut && status == consts.GameTransferSuccess:
+			entry.TradeType = consts.TradeTypeGameOut
+			entry.TradeNo = order.OrderNo
+			entry.Remark = fmt.Sprintf("游戏 %d 转出", order.GameId)
+		case order.Direction == consts.GameTransferIn && status == consts.GameTransferFailed:
+			entry.TradeType = consts.TradeTypeGameRefund
+			entry.TradeNo = order.OrderNo + "R"
+			entry.Remark = fmt.Sprintf("转入游戏 %d 失败退回", order.GameId)
+		default:
+			return nil
+		}
+		_, _, err = s.PostLedger(ctx, entry)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("更新游戏转账订单失败: %v", err)
+	}
+
+	order.Status = status
+	order.VendorOrderNo = vendorOrderNo
+	order.Message = message
+	return nil
+}
+
+// getGameWallet 获取会员、站点游戏和对应的厂商钱包
+func (s *sBalance) getGameWallet(ctx context.Context, siteId, userId, gameId int) (*entity.User, *entity.SiteGame, game.GameWalletProvider, error) {
+	var user *entity.User
+	err := dao.User.Ctx(ctx).Where(do.User{
+		Id:     userId,
+		SiteId: siteId,
+	}).Scan(&user)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("查询会员失败: %v", err)
+	}
+	if user == nil {
+		return nil, nil, nil, fmt.Errorf("会员不存在")
+	}
+
+	var siteGame *entity.SiteGame
+	err = dao.SiteGame.Ctx(ctx).Where(do.SiteGame{
+		SiteId: siteId,
+		GameId: gameId,
+	}).Scan(&siteGame)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("查询游戏失败: %v", err)
+	}
+	if siteGame == nil {
+		return nil, nil, nil, fmt.Errorf("游戏不存在")
+	}
+	if siteGame.Status != 1 || siteGame.IsAvailable != 1 {
+		return nil, nil, nil, fmt.Errorf("游戏已关闭")
+	}
+
+	provider, err := game.Provider(gameId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return user, siteGame, provider, nil
+}
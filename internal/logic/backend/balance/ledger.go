@@ -0,0 +1,109 @@
+package balance
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/model"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// PostLedger 记账：在事务中锁定会员余额，更新余额并写入账变记录
+// 同一站点的流水号只会记账一次，重复提交时返回已有记录且 created 为 false
+// 调用方已开启事务时传入事务上下文即可加入同一事务
+func (s *sBalance) PostLedger(ctx context.Context, in *model.LedgerEntry) (change *entity.BalanceChange, created bool, err error) {
+	if in.TradeNo == "" {
+		return nil, false, fmt.Errorf("流水号不能为空")
+	}
+	if in.Money <= 0 {
+		return nil, false, fmt.Errorf("变动金额必须大于0")
+	}
+	if in.ChangeType != consts.ChangeTypeIn && in.ChangeType != consts.ChangeTypeOut {
+		return nil, false, fmt.Errorf("账变类型无效: %d", in.ChangeType)
+	}
+	money := math.Round(in.Money*100) / 100
+
+	err = dao.BalanceChange.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		var user *entity.User
+		err := dao.User.Ctx(ctx).Where(do.User{
+			Id:     in.UserId,
+			SiteId: in.SiteId,
+		}).LockUpdate().Scan(&user)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return fmt.Errorf("会员不存在")
+		}
+
+		// 幂等检查放在锁定会员之后，同一流水号的并发请求在此排队，后到的请求能查到先提交的记录
+		// 使用加锁读取最新数据，避免调用方事务的快照读不到其他事务已提交的记录
+		err = dao.BalanceChange.Ctx(ctx).Where(do.BalanceChange{
+			SiteId:  in.SiteId,
+			TradeNo: in.TradeNo,
+		}).LockUpdate().Scan(&change)
+		if err != nil {
+			return err
+		}
+		if change != nil {
+			return nil
+		}
+
+		balanceNew := user.Balance + money
+		if in.ChangeType == consts.ChangeTypeOut {
+			balanceNew = user.Balance - money
+			if balanceNew < 0 {
+				return fmt.Errorf("会员余额不足")
+			}
+		}
+		balanceNew = math.Round(balanceNew*100) / 100
+
+		_, err = dao.User.Ctx(ctx).Where("id", user.Id).Data(g.Map{
+			"balance":    balanceNew,
+			"updated_at": gtime.Now(),
+		}).Update()
+		if err != nil {
+			return err
+		}
+
+		change = &entity.BalanceChange{
+			SiteId:        in.SiteId,
+			UserId:        in.UserId,
+			Username:      user.Username,
+			ChangeType:    in.ChangeType,
+			TradeType:     in.TradeType,
+			TradeNo:       in.TradeNo,
+			BalanceOld:    user.Balance,
+			Money:         money,
+			BalanceNew:    balanceNew,
+			BalanceFrozen: user.BalanceFrozen,
+			Status:        1,
+			AdminId:       in.AdminId,
+			Remark:        in.Remark,
+			CreatedAt:     gtime.Now(),
+		}
+		result, err := dao.BalanceChange.Ctx(ctx).Data(change).OmitEmptyData().Insert()
+		if err != nil {
+			return err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		change.Id = uint64(id)
+		created = true
+		return nil
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("记账失败: %v", err)
+	}
+	return change, created, nil
+}
@@ -0,0 +1,119 @@
+package balance
+
+import (
+	"context"
+
+	v1 "jh_app_service/api/backend/balance/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/pubsub"
+	"jh_app_service/internal/service/backend"
+	"jh_app_service/internal/util"
+)
+
+// NotifyRechargeCreated 会员端创建入款订单后调用，按订单表中的记录推送新入款订单
+// 只推送待处理的订单，推送内容以数据库为准，不使用调用方传入的金额
+func (s *sBalance) NotifyRechargeCreated(ctx context.Context, req *v1.NotifyRechargeCreatedReq) (*v1.NotifyRechargeCreatedRes, error) {
+	middleware.LogWithTrace(ctx, "info", "入款订单创建通知请求 - TradeType: %d, Id: %d", req.TradeType, req.Id)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	var (
+		title string
+		data  interface{}
+	)
+	switch req.TradeType {
+	case consts.TradeTypeRechargeOnline:
+		var order *entity.RechargePayment
+		err := dao.RechargePayment.Ctx(ctx).Where(do.RechargePayment{Id: req.Id, SiteId: siteId}).Scan(&order)
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "查询在线入款订单失败: %v", err)
+			return nil, err
+		}
+		if order == nil {
+			return &v1.NotifyRechargeCreatedRes{Success: false, Message: "订单不存在"}, nil
+		}
+		if order.Status != consts.RechargePaymentPending {
+			return &v1.NotifyRechargeCreatedRes{Success: false, Message: "订单已处理"}, nil
+		}
+		title = "新在线入款订单"
+		data = &v1.RechargePaymentInfo{
+			Id:               int64(order.Id),
+			UserId:           int32(order.UserId),
+			Username:         order.Username,
+			PaymentAccountId: int32(order.PaymentAccountId),
+			TradeNo:          order.TradeNo,
+			Money:            order.Money,
+			Status:           int32(order.Status),
+			StatusName:       "待支付",
+			CreatedAt:        util.FormatTime(order.CreatedAt),
+		}
+	case consts.TradeTypeRechargeManual:
+		var order *entity.RechargeManual
+		err := dao.RechargeManual.Ctx(ctx).Where(do.RechargeManual{Id: req.Id, SiteId: siteId}).Scan(&order)
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "查询转账入款订单失败: %v", err)
+			return nil, err
+		}
+		if order == nil {
+			return &v1.NotifyRechargeCreatedRes{Success: false, Message: "订单不存在"}, nil
+		}
+		if order.Status != consts.RechargeManualPending {
+			return &v1.NotifyRechargeCreatedRes{Success: false, Message: "订单已处理"}, nil
+		}
+		title = "新转账入款订单"
+		data = &v1.RechargeManualInfo{
+			Id:         int64(order.Id),
+			UserId:     int32(order.UserId),
+			Username:   order.Username,
+			TradeNo:    order.TradeNo,
+			Money:      order.Money,
+			Status:     int32(order.Status),
+			StatusName: "待确认",
+			Remark:     order.Remark,
+			CreatedAt:  util.FormatTime(order.CreatedAt),
+		}
+	default:
+		return &v1.NotifyRechargeCreatedRes{Success: false, Message: "入款类型无效"}, nil
+	}
+
+	backend.Feed().PublishEvent(ctx, siteId, pubsub.TopicDeposit, title, data)
+
+	return &v1.NotifyRechargeCreatedRes{Success: true, Message: "通知成功"}, nil
+}
+
+// NotifyWithdrawCreated 会员端创建出款申请后调用，推送新出款申请
+// 出款申请保存在会员端，这里只校验会员存在并推送申请摘要
+func (s *sBalance) NotifyWithdrawCreated(ctx context.Context, req *v1.NotifyWithdrawCreatedReq) (*v1.NotifyWithdrawCreatedRes, error) {
+	middleware.LogWithTrace(ctx, "info", "出款申请创建通知请求 - UserId: %d, TradeNo: %s, Money: %.2f", req.UserId, req.TradeNo, req.Money)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	if req.TradeNo == "" || req.Money <= 0 {
+		return &v1.NotifyWithdrawCreatedRes{Success: false, Message: "出款申请无效"}, nil
+	}
+
+	var user *entity.User
+	err := dao.User.Ctx(ctx).Where(do.User{Id: req.UserId, SiteId: siteId}).Fields("id, username").Scan(&user)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询会员失败: %v", err)
+		return nil, err
+	}
+	if user == nil {
+		return &v1.NotifyWithdrawCreatedRes{Success: false, Message: "会员不存在"}, nil
+	}
+
+	backend.Feed().PublishEvent(ctx, siteId, pubsub.TopicWithdraw, "新出款申请", &v1.WithdrawInfo{
+		UserId:   req.UserId,
+		Username: user.Username,
+		TradeNo:  req.TradeNo,
+		Money:    req.Money,
+	})
+
+	return &v1.NotifyWithdrawCreatedRes{Success: true, Message: "通知成功"}, nil
+}
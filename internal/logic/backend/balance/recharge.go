@@ -0,0 +1,186 @@
+package balance
+
+import (
+	"context"
+	"fmt"
+
+	v1 "jh_app_service/api/backend/balance/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+	"jh_app_service/internal/util"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// GetRechargeManuals 获取转账入款订单列表
+func (s *sBalance) GetRechargeManuals(ctx context.Context, req *v1.GetRechargeManualsReq) (*v1.GetRechargeManualsRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取转账入款订单列表请求 - Username: %s, Status: %d, Page: %d, Size: %d", req.Username, req.Status, req.Page, req.Size)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	size := req.Size
+	if size <= 0 {
+		size = 50
+	}
+
+	query := dao.RechargeManual.Ctx(ctx).Where(do.RechargeManual{
+		SiteId: siteId,
+	})
+	if req.Username != "" {
+		query = query.Where("username", req.Username)
+	}
+	if req.Status > 0 {
+		query = query.Where("status", req.Status)
+	}
+	if req.StartTime != "" {
+		query = query.WhereGTE("created_at", req.StartTime)
+	}
+	if req.EndTime != "" {
+		query = query.WhereLTE("created_at", req.EndTime)
+	}
+
+	total, err := query.Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取转账入款订单总数失败: %v", err)
+		return nil, err
+	}
+
+	var orders []*entity.RechargeManual
+	err = query.Order("id DESC").Page(int(page), int(size)).Scan(&orders)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取转账入款订单列表失败: %v", err)
+		return nil, err
+	}
+
+	statusMap := map[int]string{
+		consts.RechargeManualPending:   "待确认",
+		consts.RechargeManualConfirmed: "已确认",
+		consts.RechargeManualCanceled:  "已取消",
+	}
+
+	list := make([]*v1.RechargeManualInfo, 0, len(orders))
+	for _, order := range orders {
+		list = append(list, &v1.RechargeManualInfo{
+			Id:         int64(order.Id),
+			UserId:     int32(order.UserId),
+			Username:   order.Username,
+			TradeNo:    order.TradeNo,
+			Money:      order.Money,
+			Status:     int32(order.Status),
+			StatusName: statusMap[order.Status],
+			AdminId:    int32(order.AdminId),
+			AdminName:  order.AdminName,
+			Remark:     order.Remark,
+			CreatedAt:  util.FormatTime(order.CreatedAt),
+		})
+	}
+
+	middleware.LogWithTrace(ctx, "info", "获取转账入款订单列表成功 - 总数: %d", total)
+
+	return &v1.GetRechargeManualsRes{
+		List:  list,
+		Count: int32(total),
+	}, nil
+}
+
+// ConfirmPaymentOrder 人工确认转账入款订单
+func (s *sBalance) ConfirmPaymentOrder(ctx context.Context, req *v1.ConfirmPaymentOrderReq) (*v1.ConfirmPaymentOrderRes, error) {
+	middleware.LogWithTrace(ctx, "info", "确认转账入款订单请求 - Id: %d", req.Id)
+
+	admin := backend.Admin().CurrentAdmin(ctx)
+	if admin == nil {
+		return &v1.ConfirmPaymentOrderRes{Success: false, Message: "未登录或登录已过期"}, nil
+	}
+
+	if err := s.ConfirmRechargeManual(ctx, req.Id, int(admin.Id), admin.Username, 0, req.Remark); err != nil {
+		middleware.LogWithTrace(ctx, "error", "确认转账入款订单失败: %v", err)
+		return &v1.ConfirmPaymentOrderRes{Success: false, Message: err.Error()}, nil
+	}
+
+	middleware.LogWithTrace(ctx, "info", "确认转账入款订单成功 - Id: %d, Admin: %s", req.Id, admin.Username)
+
+	return &v1.ConfirmPaymentOrderRes{Success: true, Message: "确认成功"}, nil
+}
+
+// ConfirmRechargeManual 确认转账入款订单：更新订单状态、记账并发放充值积分
+// batchId 为自动匹配的对账批次ID，人工确认时为0
+func (s *sBalance) ConfirmRechargeManual(ctx context.Context, orderId int64, adminId int, adminName string, batchId int, remark string) error {
+	// 默认站点ID为1
+	siteId := 1
+
+	var order *entity.RechargeManual
+	err := dao.RechargeManual.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		err := dao.RechargeManual.Ctx(ctx).Where(do.RechargeManual{
+			Id:     orderId,
+			SiteId: siteId,
+		}).LockUpdate().Scan(&order)
+		if err != nil {
+			return err
+		}
+		if order == nil {
+			return fmt.Errorf("订单不存在")
+		}
+		if order.Status != consts.RechargeManualPending {
+			return fmt.Errorf("订单已处理")
+		}
+
+		if remark == "" {
+			remark = order.Remark
+		}
+		_, _, err = s.PostLedger(ctx, &model.LedgerEntry{
+			SiteId:     siteId,
+			UserId:     order.UserId,
+			ChangeType: consts.ChangeTypeIn,
+			TradeType:  consts.TradeTypeRechargeManual,
+			TradeNo:    order.TradeNo,
+			Money:      order.Money,
+			AdminId:    adminId,
+			Remark:     remark,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = dao.RechargeManual.Ctx(ctx).Where("id", order.Id).Data(g.Map{
+			"status":       consts.RechargeManualConfirmed,
+			"batch_id":     batchId,
+			"admin_id":     adminId,
+			"admin_name":   adminName,
+			"remark":       remark,
+			"confirmed_at": gtime.Now(),
+			"updated_at":   gtime.Now(),
+		}).Update()
+		if err != nil {
+			return err
+		}
+
+		_, err = dao.User.Ctx(ctx).Where("id", order.UserId).Increment("pay_times", 1)
+		if err != nil {
+			return err
+		}
+
+		// 收款渠道今日入款与入款在同一事务中累计，避免零点清零前确认的入款被计入次日
+		if order.TransferAccountId > 0 {
+			err = backend.Payment().RecordChannelDeposit(ctx, consts.PaymentChannelTransfer, order.TransferAccountId, order.Money)
+			if err != nil {
+				return err
+			}
+		}
+
+		// 充值积分与入款在同一事务中记账，积分记录按订单号幂等，积分记账失败时入款一并回滚
+		return backend.User().AccrueRechargePoints(ctx, siteId, order.UserId, order.Money, order.TradeNo)
+	})
+	return err
+}
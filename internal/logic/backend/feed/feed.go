@@ -0,0 +1,160 @@
+package feed
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	v1 "jh_app_service/api/backend/feed/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/pubsub"
+	"jh_app_service/internal/service/backend"
+
+	"github.com/gogf/gf/v2/frame/g"
+)
+
+type (
+	sFeed struct{}
+)
+
+func init() {
+	backend.RegisterFeed(&sFeed{})
+}
+
+// feedTopics 后台可订阅的主题
+var feedTopics = []string{pubsub.TopicRisk, pubsub.TopicWithdraw, pubsub.TopicDeposit, pubsub.TopicNotice}
+
+// Subscribe 订阅后台实时推送，按管理员所属站点和权限过滤，定时发送心跳
+// 断线重连时传入最后收到的事件ID，补发断线期间的事件；无法补发时推送重新加载事件
+func (s *sFeed) Subscribe(req *v1.SubscribeReq, stream v1.Feed_SubscribeServer) error {
+	ctx := stream.Context()
+	middleware.LogWithTrace(ctx, "info", "订阅后台推送请求 - Topics: %v, LastEventId: %d", req.Topics, req.LastEventId)
+
+	admin := backend.Admin().CurrentAdmin(ctx)
+	if admin == nil {
+		return fmt.Errorf("请先登录")
+	}
+	topics, err := s.allowedTopics(ctx, admin, req.Topics)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取可订阅主题失败: %v", err)
+		return err
+	}
+	if len(topics) == 0 {
+		return fmt.Errorf("没有可订阅的消息")
+	}
+
+	siteId := admin.SiteId
+	sub, err := pubsub.Subscribe(ctx, func(event *pubsub.Event) bool {
+		return event.SiteId == siteId && topics[event.Topic]
+	}, req.LastEventId)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "订阅后台推送失败: %v", err)
+		return err
+	}
+	defer sub.Close()
+
+	if sub.Gap {
+		if err = stream.Send(&v1.FeedEvent{Kind: consts.FeedKindReset}); err != nil {
+			return err
+		}
+	}
+	for _, event := range sub.Replay {
+		if err = stream.Send(feedEvent(event)); err != nil {
+			return err
+		}
+	}
+	middleware.LogWithTrace(ctx, "info", "订阅后台推送成功 - AdminId: %d, 主题数: %d, 补发: %d, 需重新加载: %v", admin.Id, len(topics), len(sub.Replay), sub.Gap)
+
+	heartbeat := g.Cfg().MustGet(ctx, "feed.heartbeat", 15).Int()
+	if heartbeat <= 0 {
+		heartbeat = 15
+	}
+	ticker := time.NewTicker(time.Duration(heartbeat) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err = stream.Send(&v1.FeedEvent{Kind: consts.FeedKindHeartbeat}); err != nil {
+				return err
+			}
+		case event, ok := <-sub.C:
+			if !ok {
+				// 接收过慢被断开，客户端使用最后收到的事件ID重连即可补发
+				middleware.LogWithTrace(ctx, "warning", "后台推送积压过多，断开订阅 - AdminId: %d", admin.Id)
+				return fmt.Errorf("推送积压过多，请重新连接")
+			}
+			if err = stream.Send(feedEvent(event)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// allowedTopics 计算管理员可订阅的主题
+// 主题需要的权限由 feed.permissions 配置为 admin_permission.backend_url，未配置权限的主题不推送
+func (s *sFeed) allowedTopics(ctx context.Context, admin *entity.Admin, requested []string) (map[string]bool, error) {
+	wanted := make(map[string]bool, len(requested))
+	for _, topic := range requested {
+		wanted[topic] = true
+	}
+	required := g.Cfg().MustGet(ctx, "feed.permissions").MapStrStr()
+
+	var permissions map[string]bool
+	topics := make(map[string]bool, len(feedTopics))
+	for _, topic := range feedTopics {
+		if len(wanted) > 0 && !wanted[topic] {
+			continue
+		}
+		backendUrl := required[topic]
+		if backendUrl == "" {
+			continue
+		}
+		if permissions == nil {
+			var err error
+			if permissions, err = backend.Admin().Permissions(ctx, admin); err != nil {
+				return nil, err
+			}
+		}
+		if !permissions[backendUrl] {
+			continue
+		}
+		topics[topic] = true
+	}
+	return topics, nil
+}
+
+// PublishEvent 发布后台推送事件，只供本服务内的业务调用，data 序列化为 JSON，发布失败只记录日志
+func (s *sFeed) PublishEvent(ctx context.Context, siteId int, topic, title string, data interface{}) {
+	content, err := json.Marshal(data)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "序列化推送事件失败 - Topic: %s, 错误: %v", topic, err)
+		return
+	}
+	err = pubsub.Publish(ctx, &pubsub.Event{
+		SiteId: siteId,
+		Topic:  topic,
+		Title:  title,
+		Data:   content,
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "发布推送事件失败 - Topic: %s, 错误: %v", topic, err)
+	}
+}
+
+// feedEvent 事件转换为响应格式
+func feedEvent(event *pubsub.Event) *v1.FeedEvent {
+	return &v1.FeedEvent{
+		Id:        event.Id,
+		Kind:      consts.FeedKindEvent,
+		Topic:     event.Topic,
+		Title:     event.Title,
+		Data:      string(event.Data),
+		CreatedAt: event.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -79,11 +79,21 @@ func (s *sMessage) GetMessageList(ctx context.Context, req *v1.GetMessageListReq
 		{Id: 3, Name: "SVIP会员"},
 	}
 
-	// 获取层级列表（写死数据，后续快照用）
-	levelList := []*v1.LevelItem{
-		{Id: 1, Name: "一级代理"},
-		{Id: 2, Name: "二级代理"},
-		{Id: 3, Name: "三级代理"},
+	// 获取层级列表，只列出可用的层级
+	var levels []*entity.UserLevel
+	err = dao.UserLevel.Ctx(ctx).
+		Where("site_id", siteId).
+		Where("status", 1).
+		Fields("id, name").
+		Order("id ASC").
+		Scan(&levels)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取层级列表失败: %v", err)
+		return nil, err
+	}
+	levelList := make([]*v1.LevelItem, 0, len(levels))
+	for _, level := range levels {
+		levelList = append(levelList, &v1.LevelItem{Id: int32(level.Id), Name: level.Name})
 	}
 
 	// 获取状态列表
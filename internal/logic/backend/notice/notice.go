@@ -8,9 +8,11 @@ import (
 	"jh_app_service/internal/middleware"
 	"jh_app_service/internal/model/do"
 	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/pubsub"
 	"jh_app_service/internal/service/backend"
 	"jh_app_service/internal/util"
 
+	"github.com/gogf/gf/v2/frame/g"
 	"github.com/gogf/gf/v2/os/gtime"
 )
 
@@ -179,6 +181,18 @@ func (s *sNotice) CreateNotice(ctx context.Context, req *v1.CreateNoticeReq) (*v
 
 	middleware.LogWithTrace(ctx, "info", "创建公告成功 - Title: %s", req.Title)
 
+	// 启用的公告推送给在线管理员
+	if req.Status == 1 {
+		backend.Feed().PublishEvent(ctx, int(siteId), pubsub.TopicNotice, req.Title, g.Map{
+			"title":        req.Title,
+			"content":      req.Content,
+			"type":         req.Type,
+			"url":          req.Url,
+			"start_time":   req.StartTime,
+			"expired_time": req.ExpiredTime,
+		})
+	}
+
 	return &v1.CreateNoticeRes{}, nil
 }
 
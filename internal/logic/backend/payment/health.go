@@ -0,0 +1,329 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "jh_app_service/api/backend/payment/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+	"jh_app_service/internal/util"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// breakerConfig 熔断配置，对应 payment.breaker
+type breakerConfig struct {
+	Window       int     // 按最近多少次网关调用统计失败率
+	MinCalls     int     // 窗口内调用次数达到该值才判断是否熔断
+	FailureRatio float64 // 失败率达到该值时自动熔断
+	OpenSeconds  int     // 熔断多久后放行一笔探测入款
+}
+
+// gatewayCall 一次网关调用结果
+type gatewayCall struct {
+	success bool
+	latency time.Duration
+}
+
+// channelHealth 支付接口调用统计，仅保存在当前进程内存中
+type channelHealth struct {
+	calls         []gatewayCall // 最近调用结果，按时间顺序循环写入
+	next          int
+	totalCalls    int64
+	totalFailures int64
+	lastFailureAt *gtime.Time
+	lastError     string
+}
+
+// record 记录一次调用结果
+func (h *channelHealth) record(call gatewayCall, window int, errMsg string) {
+	if len(h.calls) < window {
+		h.calls = append(h.calls, call)
+	} else {
+		// 窗口大小调小后丢弃较早的结果
+		if len(h.calls) > window {
+			h.calls = h.calls[len(h.calls)-window:]
+		}
+		h.calls[h.next%window] = call
+	}
+	h.next = (h.next + 1) % window
+
+	h.totalCalls++
+	if !call.success {
+		h.totalFailures++
+		h.lastFailureAt = gtime.Now()
+		h.lastError = errMsg
+	}
+}
+
+// reset 清空统计窗口，恢复后重新统计
+func (h *channelHealth) reset() {
+	h.calls = nil
+	h.next = 0
+}
+
+// windowStats 统计窗口内的调用次数、失败次数和平均耗时
+func (h *channelHealth) windowStats() (calls, failures int, avgLatency time.Duration) {
+	var latency time.Duration
+	for _, call := range h.calls {
+		if !call.success {
+			failures++
+		}
+		latency += call.latency
+	}
+	calls = len(h.calls)
+	if calls > 0 {
+		avgLatency = latency / time.Duration(calls)
+	}
+	return calls, failures, avgLatency
+}
+
+// getBreakerConfig 读取熔断配置，未配置时使用默认值
+func getBreakerConfig(ctx context.Context) breakerConfig {
+	cfg := breakerConfig{
+		Window:       g.Cfg().MustGet(ctx, "payment.breaker.window", 20).Int(),
+		MinCalls:     g.Cfg().MustGet(ctx, "payment.breaker.minCalls", 10).Int(),
+		FailureRatio: g.Cfg().MustGet(ctx, "payment.breaker.failureRatio", 0.5).Float64(),
+		OpenSeconds:  g.Cfg().MustGet(ctx, "payment.breaker.openSeconds", 300).Int(),
+	}
+	if cfg.Window <= 0 {
+		cfg.Window = 20
+	}
+	if cfg.MinCalls <= 0 || cfg.MinCalls > cfg.Window {
+		cfg.MinCalls = cfg.Window
+	}
+	if cfg.FailureRatio <= 0 || cfg.FailureRatio > 1 {
+		cfg.FailureRatio = 0.5
+	}
+	if cfg.OpenSeconds <= 0 {
+		cfg.OpenSeconds = 300
+	}
+	return cfg
+}
+
+// nextCircuitState 根据当前熔断状态和本次调用结果计算新的熔断状态，calls/failures 为记录本次调用后的窗口统计
+// 正常状态下窗口失败率达到阈值时熔断；半开探测成功则恢复，失败则重新熔断；已熔断状态不变
+func nextCircuitState(state int, success bool, calls, failures int, cfg breakerConfig) int {
+	switch state {
+	case consts.CircuitHalfOpen:
+		if success {
+			return consts.CircuitClosed
+		}
+		return consts.CircuitOpen
+	case consts.CircuitClosed:
+		if success || calls < cfg.MinCalls || float64(failures)/float64(calls) < cfg.FailureRatio {
+			return consts.CircuitClosed
+		}
+		return consts.CircuitOpen
+	}
+	return state
+}
+
+// probeDue 已熔断或半开的支付接口是否已到熔断时长，到期后可以放行一笔探测入款
+func probeDue(account *entity.PaymentAccount, cfg breakerConfig, now *gtime.Time) bool {
+	return account.CircuitOpenedAt == nil || now.Sub(account.CircuitOpenedAt) >= time.Duration(cfg.OpenSeconds)*time.Second
+}
+
+// ReportGatewayResult 上报支付网关调用结果，会员端每次调用支付网关下单后调用
+func (s *sPayment) ReportGatewayResult(ctx context.Context, req *v1.ReportGatewayResultReq) (*v1.ReportGatewayResultRes, error) {
+	if req.AccountId <= 0 {
+		return &v1.ReportGatewayResultRes{Success: false, Message: "支付接口ID不能为空"}, nil
+	}
+	if req.LatencyMs < 0 {
+		return &v1.ReportGatewayResultRes{Success: false, Message: "调用耗时无效"}, nil
+	}
+
+	err := s.RecordGatewayResult(ctx, int(req.AccountId), req.Success, time.Duration(req.LatencyMs)*time.Millisecond, req.Error)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录支付网关调用结果失败 - AccountId: %d, 错误: %v", req.AccountId, err)
+		return &v1.ReportGatewayResultRes{Success: false, Message: err.Error()}, nil
+	}
+	return &v1.ReportGatewayResultRes{Success: true, Message: "上报成功"}, nil
+}
+
+// RecordGatewayResult 记录支付接口的一次网关调用结果，由调用支付网关下单的地方调用
+// 正常状态下窗口失败率达到阈值时自动熔断
+// 已熔断的接口到期后，第一个上报的结果获得探测机会并切换为半开，探测成功则恢复，失败则重新熔断
+func (s *sPayment) RecordGatewayResult(ctx context.Context, accountId int, success bool, latency time.Duration, errMsg string) error {
+	cfg := getBreakerConfig(ctx)
+
+	s.mu.Lock()
+	health := s.health[accountId]
+	if health == nil {
+		health = &channelHealth{}
+		s.health[accountId] = health
+	}
+	health.record(gatewayCall{success: success, latency: latency}, cfg.Window, errMsg)
+	calls, failures, _ := health.windowStats()
+	s.mu.Unlock()
+
+	var account *entity.PaymentAccount
+	err := dao.PaymentAccount.Ctx(ctx).Fields("id, name, circuit_state, circuit_opened_at").Where("id", accountId).Scan(&account)
+	if err != nil {
+		return fmt.Errorf("查询支付接口失败: %v", err)
+	}
+	if account == nil {
+		return fmt.Errorf("支付接口不存在")
+	}
+
+	// 未到期或探测机会已被其他结果获取时，本次结果只计入统计
+	if account.CircuitState != consts.CircuitClosed {
+		if !probeDue(account, cfg, gtime.Now()) || !s.acquireProbe(ctx, account) {
+			return nil
+		}
+		account.CircuitState = consts.CircuitHalfOpen
+	}
+
+	next := nextCircuitState(account.CircuitState, success, calls, failures, cfg)
+	switch {
+	case next == account.CircuitState:
+		return nil
+	case next == consts.CircuitOpen && account.CircuitState == consts.CircuitHalfOpen:
+		return s.openCircuit(ctx, account, fmt.Sprintf("半开探测失败: %s", errMsg))
+	case next == consts.CircuitOpen:
+		return s.openCircuit(ctx, account, fmt.Sprintf("最近%d次调用失败%d次", calls, failures))
+	case next == consts.CircuitClosed:
+		result, err := dao.PaymentAccount.Ctx(ctx).Where(do.PaymentAccount{
+			Id:           accountId,
+			CircuitState: consts.CircuitHalfOpen,
+		}).Data(g.Map{
+			"circuit_state":     consts.CircuitClosed,
+			"circuit_opened_at": nil,
+			"circuit_reason":    "",
+			"updated_at":        gtime.Now(),
+		}).Update()
+		if err != nil {
+			return fmt.Errorf("恢复支付接口失败: %v", err)
+		}
+		if rows, _ := result.RowsAffected(); rows > 0 {
+			s.mu.Lock()
+			health.reset()
+			s.mu.Unlock()
+			middleware.LogWithTrace(ctx, "info", "支付接口探测成功，已恢复 - AccountId: %d, Name: %s", accountId, account.Name)
+		}
+	}
+	return nil
+}
+
+// openCircuit 熔断支付接口并记录管理员日志，并发时只有一个调用会执行状态切换
+func (s *sPayment) openCircuit(ctx context.Context, account *entity.PaymentAccount, reason string) error {
+	result, err := dao.PaymentAccount.Ctx(ctx).Where(do.PaymentAccount{
+		Id:           account.Id,
+		CircuitState: account.CircuitState,
+	}).Data(g.Map{
+		"circuit_state":     consts.CircuitOpen,
+		"circuit_opened_at": gtime.Now(),
+		"circuit_reason":    reason,
+		"updated_at":        gtime.Now(),
+	}).Update()
+	if err != nil {
+		return fmt.Errorf("熔断支付接口失败: %v", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return nil
+	}
+
+	middleware.LogWithTrace(ctx, "error", "支付接口已自动熔断 - AccountId: %d, Name: %s, 原因: %s", account.Id, account.Name, reason)
+	logMessage := fmt.Sprintf("系统自动熔断支付接口 [ID:%d 名称:%s]，原因: %s", account.Id, account.Name, reason)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+	return nil
+}
+
+// acquireProbe 熔断时间已到的支付接口切换为半开状态，成功切换的网关结果作为探测结果
+// 半开状态超过熔断时长仍未处理完探测结果时，允许重新探测
+func (s *sPayment) acquireProbe(ctx context.Context, account *entity.PaymentAccount) bool {
+	cfg := getBreakerConfig(ctx)
+	result, err := dao.PaymentAccount.Ctx(ctx).Where(do.PaymentAccount{
+		Id:           account.Id,
+		CircuitState: account.CircuitState,
+	}).Where("circuit_opened_at IS NULL OR circuit_opened_at <= ?", gtime.Now().Add(-time.Duration(cfg.OpenSeconds)*time.Second)).Data(g.Map{
+		"circuit_state":     consts.CircuitHalfOpen,
+		"circuit_opened_at": gtime.Now(),
+		"updated_at":        gtime.Now(),
+	}).Update()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "支付接口切换半开状态失败 - AccountId: %d, 错误: %v", account.Id, err)
+		return false
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return false
+	}
+
+	middleware.LogWithTrace(ctx, "info", "支付接口进入半开探测 - AccountId: %d, Name: %s", account.Id, account.Name)
+	return true
+}
+
+// GetPaymentChannelHealth 获取支付接口健康状态
+// 调用统计保存在各服务进程内存中，返回的是当前进程的统计
+func (s *sPayment) GetPaymentChannelHealth(ctx context.Context, req *v1.GetPaymentChannelHealthReq) (*v1.GetPaymentChannelHealthRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取支付接口健康状态请求 - Gateway: %d, CircuitState: %d", req.Gateway, req.CircuitState)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	query := dao.PaymentAccount.Ctx(ctx).Where(do.PaymentAccount{
+		SiteId: siteId,
+	})
+	if req.Gateway > 0 {
+		query = query.Where("gateway", req.Gateway)
+	}
+	if req.CircuitState >= 0 {
+		query = query.Where("circuit_state", req.CircuitState)
+	}
+
+	var accounts []*entity.PaymentAccount
+	err := query.Order("sort ASC, id ASC").Scan(&accounts)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取支付接口列表失败: %v", err)
+		return nil, err
+	}
+
+	stateMap := map[int]string{
+		consts.CircuitClosed:   "正常",
+		consts.CircuitOpen:     "已熔断",
+		consts.CircuitHalfOpen: "半开探测",
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list := make([]*v1.PaymentChannelHealthInfo, 0, len(accounts))
+	for _, account := range accounts {
+		info := &v1.PaymentChannelHealthInfo{
+			AccountId:        int32(account.Id),
+			Name:             account.Name,
+			Gateway:          int32(account.Gateway),
+			Status:           int32(account.Status),
+			CircuitState:     int32(account.CircuitState),
+			CircuitStateName: stateMap[account.CircuitState],
+			CircuitOpenedAt:  util.FormatTime(account.CircuitOpenedAt),
+			CircuitReason:    account.CircuitReason,
+		}
+		if health := s.health[int(account.Id)]; health != nil {
+			calls, failures, avgLatency := health.windowStats()
+			info.WindowCalls = int32(calls)
+			info.WindowFailures = int32(failures)
+			if calls > 0 {
+				info.FailureRatio = float64(failures) / float64(calls)
+			}
+			info.AvgLatencyMs = avgLatency.Milliseconds()
+			info.TotalCalls = health.totalCalls
+			info.TotalFailures = health.totalFailures
+			info.LastFailureAt = util.FormatTime(health.lastFailureAt)
+			info.LastError = health.lastError
+		}
+		list = append(list, info)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "获取支付接口健康状态成功 - 接口数: %d", len(list))
+	return &v1.GetPaymentChannelHealthRes{List: list}, nil
+}
@@ -0,0 +1,83 @@
+package payment
+
+import (
+	"testing"
+	"time"
+
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/model/entity"
+
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+func TestChannelHealthWindow(t *testing.T) {
+	h := &channelHealth{}
+	for i := 0; i < 5; i++ {
+		h.record(gatewayCall{success: i%2 == 0, latency: 100 * time.Millisecond}, 3, "timeout")
+	}
+	calls, failures, avg := h.windowStats()
+	// 窗口只保留最近3次：成功、失败、成功
+	if calls != 3 || failures != 1 || avg != 100*time.Millisecond {
+		t.Fatalf("窗口统计错误: calls=%d failures=%d avg=%v", calls, failures, avg)
+	}
+	if h.totalCalls != 5 || h.totalFailures != 2 || h.lastError != "timeout" {
+		t.Fatalf("累计统计错误: %+v", h)
+	}
+
+	// 窗口调小后丢弃较早的结果
+	h.record(gatewayCall{success: false}, 2, "refused")
+	if calls, failures, _ = h.windowStats(); calls != 2 || failures != 1 {
+		t.Fatalf("窗口调小后统计错误: calls=%d failures=%d", calls, failures)
+	}
+
+	h.reset()
+	if calls, _, _ = h.windowStats(); calls != 0 {
+		t.Fatalf("重置后窗口应为空: %d", calls)
+	}
+}
+
+func TestNextCircuitState(t *testing.T) {
+	cfg := breakerConfig{Window: 10, MinCalls: 4, FailureRatio: 0.5, OpenSeconds: 300}
+	cases := []struct {
+		name     string
+		state    int
+		success  bool
+		calls    int
+		failures int
+		want     int
+	}{
+		{"正常-调用成功", consts.CircuitClosed, true, 10, 9, consts.CircuitClosed},
+		{"正常-调用次数不足", consts.CircuitClosed, false, 3, 3, consts.CircuitClosed},
+		{"正常-失败率未达阈值", consts.CircuitClosed, false, 10, 4, consts.CircuitClosed},
+		{"正常-失败率达到阈值", consts.CircuitClosed, false, 4, 2, consts.CircuitOpen},
+		{"半开-探测成功", consts.CircuitHalfOpen, true, 1, 0, consts.CircuitClosed},
+		{"半开-探测失败", consts.CircuitHalfOpen, false, 1, 1, consts.CircuitOpen},
+		{"已熔断-调用成功", consts.CircuitOpen, true, 10, 0, consts.CircuitOpen},
+		{"已熔断-调用失败", consts.CircuitOpen, false, 10, 10, consts.CircuitOpen},
+	}
+	for _, c := range cases {
+		if got := nextCircuitState(c.state, c.success, c.calls, c.failures, cfg); got != c.want {
+			t.Errorf("%s: got %d, want %d", c.name, got, c.want)
+		}
+	}
+}
+
+func TestProbeDue(t *testing.T) {
+	cfg := breakerConfig{OpenSeconds: 300}
+	now := gtime.Now()
+	cases := []struct {
+		name     string
+		openedAt *gtime.Time
+		want     bool
+	}{
+		{"未记录熔断时间", nil, true},
+		{"熔断未到期", now.Add(-299 * time.Second), false},
+		{"熔断已到期", now.Add(-300 * time.Second), true},
+	}
+	for _, c := range cases {
+		account := &entity.PaymentAccount{CircuitState: consts.CircuitOpen, CircuitOpenedAt: c.openedAt}
+		if got := probeDue(account, cfg, now); got != c.want {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,404 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	v1 "jh_app_service/api/backend/payment/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// 渠道轮询方式，通过配置 payment.routeMode 指定
+const (
+	routeModeSort   = "sort"   // 按排序值优先，同排序值的渠道轮流排在最前
+	routeModeWeight = "weight" // 按权重随机排序
+)
+
+// 轮询游标的最大数量，渠道组合变化会产生新的游标，超出时清空重新计数
+const maxRouteCursors = 1024
+
+type (
+	sPayment struct {
+		mu      sync.Mutex
+		cursors map[string]int         // 同排序值渠道组的轮询游标
+		health  map[int]*channelHealth // 支付接口调用统计，按支付接口ID
+	}
+)
+
+func init() {
+	backend.RegisterPayment(&sPayment{
+		cursors: make(map[string]int),
+		health:  make(map[int]*channelHealth),
+	})
+}
+
+// GetPaymentChannels 获取会员可用的入款渠道
+func (s *sPayment) GetPaymentChannels(ctx context.Context, req *v1.GetPaymentChannelsReq) (*v1.GetPaymentChannelsRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取可用入款渠道请求 - UserId: %d, Amount: %.2f", req.UserId, req.Amount)
+
+	if req.UserId <= 0 {
+		return nil, fmt.Errorf("会员ID不能为空")
+	}
+	if req.Amount <= 0 {
+		return nil, fmt.Errorf("入款金额必须大于0")
+	}
+
+	// 默认站点ID为1
+	siteId := 1
+
+	channels, err := s.SelectChannels(ctx, siteId, int(req.UserId), req.Amount)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取可用入款渠道失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]*v1.PaymentChannel, 0, len(channels))
+	for _, channel := range channels {
+		list = append(list, &v1.PaymentChannel{
+			Type:        int32(channel.Type),
+			AccountId:   int32(channel.AccountId),
+			Gateway:     int32(channel.Gateway),
+			Name:        channel.Name,
+			EachMin:     channel.EachMin,
+			EachMax:     channel.EachMax,
+			DailyRemain: channel.DailyRemain,
+			Sort:        int32(channel.Sort),
+			Weight:      int32(channel.Weight),
+		})
+	}
+
+	middleware.LogWithTrace(ctx, "info", "获取可用入款渠道成功 - UserId: %d, 渠道数: %d", req.UserId, len(list))
+	return &v1.GetPaymentChannelsRes{List: list}, nil
+}
+
+// SelectChannels 按会员层级、渠道状态和限额筛选可用渠道，并按轮询方式排序
+func (s *sPayment) SelectChannels(ctx context.Context, siteId, userId int, amount float64) ([]*model.PaymentChannel, error) {
+	var user *entity.User
+	err := dao.User.Ctx(ctx).Where(do.User{
+		Id:     userId,
+		SiteId: siteId,
+	}).Scan(&user)
+	if err != nil {
+		return nil, fmt.Errorf("查询会员失败: %v", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("会员不存在")
+	}
+
+	onlineChannels, err := s.selectOnlineChannels(ctx, siteId, user.LevelId, amount)
+	if err != nil {
+		return nil, err
+	}
+	transferChannels, err := s.selectTransferChannels(ctx, siteId, user.LevelId, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	channels := append(onlineChannels, transferChannels...)
+	if g.Cfg().MustGet(ctx, "payment.routeMode", routeModeSort).String() == routeModeWeight {
+		s.orderByWeight(channels)
+	} else {
+		s.orderBySort(channels)
+	}
+	return channels, nil
+}
+
+// selectOnlineChannels 筛选会员层级可用的在线支付接口
+func (s *sPayment) selectOnlineChannels(ctx context.Context, siteId, levelId int, amount float64) ([]*model.PaymentChannel, error) {
+	accountIds, err := dao.UserLevelPayment.Ctx(ctx).Where(do.UserLevelPayment{
+		SiteId:      siteId,
+		UserLevelId: levelId,
+	}).Fields("payment_account_id").Array()
+	if err != nil {
+		return nil, fmt.Errorf("查询层级支付接口失败: %v", err)
+	}
+	if len(accountIds) == 0 {
+		return nil, nil
+	}
+
+	var accounts []*entity.PaymentAccount
+	err = dao.PaymentAccount.Ctx(ctx).Where(do.PaymentAccount{
+		SiteId: siteId,
+		Status: 1,
+	}).WhereIn("id", accountIds).Scan(&accounts)
+	if err != nil {
+		return nil, fmt.Errorf("查询支付接口失败: %v", err)
+	}
+
+	breaker := getBreakerConfig(ctx)
+	channels := make([]*model.PaymentChannel, 0, len(accounts))
+	for _, account := range accounts {
+		if !withinLimits(amount, account.EachMin, account.EachMax) {
+			continue
+		}
+		remain, ok := dailyRemain(amount, account.DailyMax, account.TodayAmount)
+		if !ok {
+			continue
+		}
+		// 不支持小数的接口只接受整数金额
+		if account.IsDecimal == 0 && amount != math.Trunc(amount) {
+			continue
+		}
+		// 规定金额的接口只接受金额数组中的值
+		if account.IsInt == 1 && !inMoneyList(amount, account.MoneyList) {
+			continue
+		}
+		// 已熔断的接口暂停分配，熔断时间已到时重新展示，由下单后上报的网关结果决定是否恢复
+		// 获取渠道列表只读取熔断状态，探测机会在上报网关结果时获取
+		if account.CircuitState != consts.CircuitClosed && !probeDue(account, breaker, gtime.Now()) {
+			continue
+		}
+		channels = append(channels, &model.PaymentChannel{
+			Type:        consts.PaymentChannelOnline,
+			AccountId:   int(account.Id),
+			Gateway:     account.Gateway,
+			Name:        account.Name,
+			EachMin:     account.EachMin,
+			EachMax:     account.EachMax,
+			DailyRemain: remain,
+			Sort:        account.Sort,
+			Weight:      account.Weight,
+		})
+	}
+	return channels, nil
+}
+
+// selectTransferChannels 筛选会员层级可用的转账汇款接口
+func (s *sPayment) selectTransferChannels(ctx context.Context, siteId, levelId int, amount float64) ([]*model.PaymentChannel, error) {
+	accountIds, err := dao.UserLevelTransfer.Ctx(ctx).Where(do.UserLevelTransfer{
+		SiteId:      siteId,
+		UserLevelId: levelId,
+	}).Fields("transfer_account_id").Array()
+	if err != nil {
+		return nil, fmt.Errorf("查询层级转账接口失败: %v", err)
+	}
+	if len(accountIds) == 0 {
+		return nil, nil
+	}
+
+	var accounts []*entity.TransferAccount
+	err = dao.TransferAccount.Ctx(ctx).Where(do.TransferAccount{
+		SiteId: siteId,
+		Status: 1,
+	}).WhereIn("id", accountIds).Scan(&accounts)
+	if err != nil {
+		return nil, fmt.Errorf("查询转账接口失败: %v", err)
+	}
+
+	channels := make([]*model.PaymentChannel, 0, len(accounts))
+	for _, account := range accounts {
+		if !withinLimits(amount, account.EachMin, account.EachMax) {
+			continue
+		}
+		remain, ok := dailyRemain(amount, account.DailyMax, account.TodayAmount)
+		if !ok {
+			continue
+		}
+		channels = append(channels, &model.PaymentChannel{
+			Type:        consts.PaymentChannelTransfer,
+			AccountId:   int(account.Id),
+			Gateway:     account.BankType,
+			Name:        account.Name,
+			EachMin:     account.EachMin,
+			EachMax:     account.EachMax,
+			DailyRemain: remain,
+			Sort:        account.Sort,
+			Weight:      account.Weight,
+		})
+	}
+	return channels, nil
+}
+
+// orderBySort 按排序值升序排列，同排序值的渠道每次调用轮换一位，使入款均匀分配
+func (s *sPayment) orderBySort(channels []*model.PaymentChannel) {
+	sort.SliceStable(channels, func(i, j int) bool {
+		if channels[i].Sort != channels[j].Sort {
+			return channels[i].Sort < channels[j].Sort
+		}
+		if channels[i].Type != channels[j].Type {
+			return channels[i].Type < channels[j].Type
+		}
+		return channels[i].AccountId < channels[j].AccountId
+	})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for start := 0; start < len(channels); {
+		end := start + 1
+		for end < len(channels) && channels[end].Sort == channels[start].Sort {
+			end++
+		}
+		group := channels[start:end]
+		if len(group) > 1 {
+			key := groupKey(group)
+			if _, ok := s.cursors[key]; !ok && len(s.cursors) >= maxRouteCursors {
+				s.cursors = make(map[string]int)
+			}
+			offset := s.cursors[key] % len(group)
+			s.cursors[key] = offset + 1
+			rotated := append(append([]*model.PaymentChannel{}, group[offset:]...), group[:offset]...)
+			copy(group, rotated)
+		}
+		start = end
+	}
+}
+
+// orderByWeight 按权重随机排序，权重越大越可能排在前面，未设置权重的渠道按1计算
+func (s *sPayment) orderByWeight(channels []*model.PaymentChannel) {
+	keys := make(map[*model.PaymentChannel]float64, len(channels))
+	for _, channel := range channels {
+		weight := channel.Weight
+		if weight <= 0 {
+			weight = 1
+		}
+		keys[channel] = math.Pow(rand.Float64(), 1/float64(weight))
+	}
+	sort.SliceStable(channels, func(i, j int) bool {
+		return keys[channels[i]] > keys[channels[j]]
+	})
+}
+
+// RecordChannelDeposit 累计渠道今日入款次数和金额，应在确认入款的事务中调用，与入款一起提交或回滚
+func (s *sPayment) RecordChannelDeposit(ctx context.Context, channelType, accountId int, amount float64) error {
+	data := g.Map{
+		"today_count":  gdb.Raw("today_count + 1"),
+		"today_amount": gdb.Raw(fmt.Sprintf("today_amount + %.2f", amount)),
+		"updated_at":   gtime.Now(),
+	}
+
+	var err error
+	switch channelType {
+	case consts.PaymentChannelOnline:
+		_, err = dao.PaymentAccount.Ctx(ctx).Where("id", accountId).Data(data).Update()
+	case consts.PaymentChannelTransfer:
+		_, err = dao.TransferAccount.Ctx(ctx).Where("id", accountId).Data(data).Update()
+	default:
+		return fmt.Errorf("不支持的渠道类型: %d", channelType)
+	}
+	if err != nil {
+		return fmt.Errorf("累计渠道入款失败: %v", err)
+	}
+	return nil
+}
+
+// ResetDailyCounters 清零所有渠道的今日入款次数和金额，由定时任务在每日零点调用
+// 清零前将计数保存为前一日的渠道统计，供日对账使用
+func (s *sPayment) ResetDailyCounters(ctx context.Context) error {
+	statDate := gtime.Now().AddDate(0, 0, -1).StartOfDay()
+
+	var paymentRows, transferRows int
+	err := dao.PaymentChannelDaily.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		var paymentAccounts []*entity.PaymentAccount
+		err := dao.PaymentAccount.Ctx(ctx).Where("today_count > 0 OR today_amount > 0").LockUpdate().Scan(&paymentAccounts)
+		if err != nil {
+			return err
+		}
+		var transferAccounts []*entity.TransferAccount
+		err = dao.TransferAccount.Ctx(ctx).Where("today_count > 0 OR today_amount > 0").LockUpdate().Scan(&transferAccounts)
+		if err != nil {
+			return err
+		}
+
+		snapshots := make([]do.PaymentChannelDaily, 0, len(paymentAccounts)+len(transferAccounts))
+		for _, account := range paymentAccounts {
+			snapshots = append(snapshots, do.PaymentChannelDaily{
+				SiteId:        account.SiteId,
+				ChannelType:   consts.PaymentChannelOnline,
+				AccountId:     account.Id,
+				StatDate:      statDate,
+				DepositCount:  account.TodayCount,
+				DepositAmount: account.TodayAmount,
+				CreatedAt:     gtime.Now(),
+			})
+		}
+		for _, account := range transferAccounts {
+			snapshots = append(snapshots, do.PaymentChannelDaily{
+				SiteId:        account.SiteId,
+				ChannelType:   consts.PaymentChannelTransfer,
+				AccountId:     account.Id,
+				StatDate:      statDate,
+				DepositCount:  account.TodayCount,
+				DepositAmount: account.TodayAmount,
+				CreatedAt:     gtime.Now(),
+			})
+		}
+		if len(snapshots) > 0 {
+			if _, err = dao.PaymentChannelDaily.Ctx(ctx).Data(snapshots).Save(); err != nil {
+				return err
+			}
+		}
+
+		data := g.Map{
+			"today_count":  0,
+			"today_amount": 0,
+		}
+		if _, err = dao.PaymentAccount.Ctx(ctx).Where("today_count > 0 OR today_amount > 0").Data(data).Update(); err != nil {
+			return err
+		}
+		if _, err = dao.TransferAccount.Ctx(ctx).Where("today_count > 0 OR today_amount > 0").Data(data).Update(); err != nil {
+			return err
+		}
+		paymentRows, transferRows = len(paymentAccounts), len(transferAccounts)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("重置渠道今日统计失败: %v", err)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "重置渠道今日统计成功 - 统计日期: %s, 支付接口: %d, 转账接口: %d", statDate.Format("Y-m-d"), paymentRows, transferRows)
+	return nil
+}
+
+// withinLimits 判断金额是否在单笔限额内，单笔最高为0表示不限
+func withinLimits(amount, eachMin, eachMax float64) bool {
+	if amount < eachMin {
+		return false
+	}
+	return eachMax <= 0 || amount <= eachMax
+}
+
+// dailyRemain 返回本次入款前的今日剩余额度，以及本次金额是否未超出剩余额度；单日上限为0时剩余额度返回-1表示不限
+func dailyRemain(amount, dailyMax, todayAmount float64) (float64, bool) {
+	if dailyMax <= 0 {
+		return -1, true
+	}
+	remain := dailyMax - todayAmount
+	return remain, amount <= remain
+}
+
+// inMoneyList 判断金额是否在可选金额数组中，兼容 "100,200" 和 "[100,200]" 两种格式
+func inMoneyList(amount float64, moneyList string) bool {
+	for _, item := range strings.Split(strings.Trim(moneyList, "[] "), ",") {
+		value, err := strconv.ParseFloat(strings.Trim(item, "\" "), 64)
+		if err == nil && value == amount {
+			return true
+		}
+	}
+	return false
+}
+
+// groupKey 生成同排序值渠道组的游标键，组内渠道变化时重新开始轮询
+func groupKey(group []*model.PaymentChannel) string {
+	parts := make([]string, 0, len(group))
+	for _, channel := range group {
+		parts = append(parts, fmt.Sprintf("%d-%d", channel.Type, channel.AccountId))
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,461 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	v1 "jh_app_service/api/backend/payment/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+	"jh_app_service/internal/util"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// reconcileKey 对账维度：渠道类型 + 接口ID
+type reconcileKey struct {
+	channelType int
+	accountId   int
+}
+
+// reconcileStat 同一渠道三个数据来源的入款汇总
+type reconcileStat struct {
+	accountName   string
+	counterCount  int
+	counterAmount float64
+	orderCount    int
+	orderAmount   float64
+	ledgerCount   int
+	ledgerAmount  float64
+}
+
+// matched 三个来源的笔数和金额是否一致
+func (r *reconcileStat) matched() bool {
+	return r.counterCount == r.orderCount && r.orderCount == r.ledgerCount &&
+		amountEqual(r.counterAmount, r.orderAmount) && amountEqual(r.orderAmount, r.ledgerAmount)
+}
+
+// reconcileSum 分组汇总结果
+type reconcileSum struct {
+	AccountId   int     `orm:"account_id"`
+	TotalCount  int     `orm:"total_count"`
+	TotalAmount float64 `orm:"total_amount"`
+}
+
+// ReconcileDaily 对账指定日期各入款渠道的统计计数、已确认订单和账变入款，保存差异记录
+// 已处理或已忽略的差异不会被覆盖；重新对账后一致的待处理差异会被删除。返回差异数量
+func (s *sPayment) ReconcileDaily(ctx context.Context, date *gtime.Time) (int, error) {
+	// 默认站点ID为1
+	siteId := 1
+
+	dayStart := date.StartOfDay()
+	dayEnd := dayStart.AddDate(0, 0, 1)
+	statDate := dayStart.Format("Y-m-d")
+
+	stats := make(map[reconcileKey]*reconcileStat)
+	statOf := func(channelType, accountId int) *reconcileStat {
+		key := reconcileKey{channelType: channelType, accountId: accountId}
+		if stats[key] == nil {
+			stats[key] = &reconcileStat{}
+		}
+		return stats[key]
+	}
+
+	if err := s.loadReconcileCounters(ctx, siteId, dayStart, statOf); err != nil {
+		return 0, fmt.Errorf("查询渠道统计失败: %v", err)
+	}
+	if err := s.loadReconcileOrders(ctx, siteId, dayStart, dayEnd, statOf); err != nil {
+		return 0, fmt.Errorf("查询入款订单失败: %v", err)
+	}
+	if err := s.loadReconcileLedger(ctx, siteId, dayStart, dayEnd, statOf); err != nil {
+		return 0, fmt.Errorf("查询账变记录失败: %v", err)
+	}
+	if err := s.loadReconcileAccountNames(ctx, siteId, stats); err != nil {
+		return 0, fmt.Errorf("查询接口名称失败: %v", err)
+	}
+
+	discrepancies := 0
+	err := dao.PaymentReconcile.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		var existing []*entity.PaymentReconcile
+		err := dao.PaymentReconcile.Ctx(ctx).Where(do.PaymentReconcile{
+			SiteId:   siteId,
+			StatDate: dayStart,
+		}).LockUpdate().Scan(&existing)
+		if err != nil {
+			return err
+		}
+		existingMap := make(map[reconcileKey]*entity.PaymentReconcile, len(existing))
+		for _, record := range existing {
+			existingMap[reconcileKey{channelType: record.ChannelType, accountId: record.AccountId}] = record
+		}
+
+		for key, stat := range stats {
+			record := existingMap[key]
+			delete(existingMap, key)
+
+			if stat.matched() {
+				if record != nil && record.Status == consts.ReconcilePending {
+					if _, err = dao.PaymentReconcile.Ctx(ctx).Where("id", record.Id).Delete(); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+
+			discrepancies++
+			if record != nil && record.Status != consts.ReconcilePending {
+				continue
+			}
+			data := do.PaymentReconcile{
+				AccountName:   stat.accountName,
+				CounterCount:  stat.counterCount,
+				CounterAmount: stat.counterAmount,
+				OrderCount:    stat.orderCount,
+				OrderAmount:   stat.orderAmount,
+				LedgerCount:   stat.ledgerCount,
+				LedgerAmount:  stat.ledgerAmount,
+				UpdatedAt:     gtime.Now(),
+			}
+			if record != nil {
+				_, err = dao.PaymentReconcile.Ctx(ctx).Where("id", record.Id).Data(data).Update()
+			} else {
+				data.SiteId = siteId
+				data.StatDate = dayStart
+				data.ChannelType = key.channelType
+				data.AccountId = key.accountId
+				data.Status = consts.ReconcilePending
+				data.CreatedAt = gtime.Now()
+				_, err = dao.PaymentReconcile.Ctx(ctx).Data(data).Insert()
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		// 三个来源都已没有数据的渠道，其待处理差异同样视为已一致
+		for _, record := range existingMap {
+			if record.Status != consts.ReconcilePending {
+				continue
+			}
+			if _, err = dao.PaymentReconcile.Ctx(ctx).Where("id", record.Id).Delete(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("保存对账差异失败: %v", err)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "入款日对账完成 - 日期: %s, 渠道数: %d, 差异数: %d", statDate, len(stats), discrepancies)
+	return discrepancies, nil
+}
+
+// loadReconcileCounters 加载渠道统计计数：当日使用实时的今日计数，历史日期使用零点保存的渠道统计
+func (s *sPayment) loadReconcileCounters(ctx context.Context, siteId int, dayStart *gtime.Time, statOf func(int, int) *reconcileStat) error {
+	if dayStart.Equal(gtime.Now().StartOfDay()) {
+		var paymentAccounts []*entity.PaymentAccount
+		err := dao.PaymentAccount.Ctx(ctx).Where(do.PaymentAccount{SiteId: siteId}).
+			Where("today_count > 0 OR today_amount > 0").Scan(&paymentAccounts)
+		if err != nil {
+			return err
+		}
+		for _, account := range paymentAccounts {
+			stat := statOf(consts.PaymentChannelOnline, int(account.Id))
+			stat.counterCount, stat.counterAmount = account.TodayCount, account.TodayAmount
+		}
+
+		var transferAccounts []*entity.TransferAccount
+		err = dao.TransferAccount.Ctx(ctx).Where(do.TransferAccount{SiteId: siteId}).
+			Where("today_count > 0 OR today_amount > 0").Scan(&transferAccounts)
+		if err != nil {
+			return err
+		}
+		for _, account := range transferAccounts {
+			stat := statOf(consts.PaymentChannelTransfer, int(account.Id))
+			stat.counterCount, stat.counterAmount = account.TodayCount, account.TodayAmount
+		}
+		return nil
+	}
+
+	var snapshots []*entity.PaymentChannelDaily
+	err := dao.PaymentChannelDaily.Ctx(ctx).Where(do.PaymentChannelDaily{
+		SiteId:   siteId,
+		StatDate: dayStart,
+	}).Scan(&snapshots)
+	if err != nil {
+		return err
+	}
+	for _, snapshot := range snapshots {
+		stat := statOf(snapshot.ChannelType, snapshot.AccountId)
+		stat.counterCount, stat.counterAmount = snapshot.DepositCount, snapshot.DepositAmount
+	}
+	return nil
+}
+
+// loadReconcileOrders 按接口汇总当日确认的转账入款订单和支付成功的在线支付订单
+func (s *sPayment) loadReconcileOrders(ctx context.Context, siteId int, dayStart, dayEnd *gtime.Time, statOf func(int, int) *reconcileStat) error {
+	var manualSums []*reconcileSum
+	err := dao.RechargeManual.Ctx(ctx).
+		Fields("transfer_account_id AS account_id, COUNT(1) AS total_count, SUM(money) AS total_amount").
+		Where(do.RechargeManual{
+			SiteId: siteId,
+			Status: consts.RechargeManualConfirmed,
+		}).
+		WhereGT("transfer_account_id", 0).
+		WhereGTE("confirmed_at", dayStart).
+		WhereLT("confirmed_at", dayEnd).
+		Group("transfer_account_id").Scan(&manualSums)
+	if err != nil {
+		return err
+	}
+	for _, sum := range manualSums {
+		stat := statOf(consts.PaymentChannelTransfer, sum.AccountId)
+		stat.orderCount, stat.orderAmount = sum.TotalCount, sum.TotalAmount
+	}
+
+	var paymentSums []*reconcileSum
+	err = dao.RechargePayment.Ctx(ctx).
+		Fields("payment_account_id AS account_id, COUNT(1) AS total_count, SUM(money) AS total_amount").
+		Where(do.RechargePayment{
+			SiteId: siteId,
+			Status: consts.RechargePaymentPaid,
+		}).
+		WhereGT("payment_account_id", 0).
+		WhereGTE("paid_at", dayStart).
+		WhereLT("paid_at", dayEnd).
+		Group("payment_account_id").Scan(&paymentSums)
+	if err != nil {
+		return err
+	}
+	for _, sum := range paymentSums {
+		stat := statOf(consts.PaymentChannelOnline, sum.AccountId)
+		stat.orderCount, stat.orderAmount = sum.TotalCount, sum.TotalAmount
+	}
+	return nil
+}
+
+// loadReconcileLedger 按接口汇总当日的入款账变，账变通过流水号关联到入款订单所属接口
+func (s *sPayment) loadReconcileLedger(ctx context.Context, siteId int, dayStart, dayEnd *gtime.Time, statOf func(int, int) *reconcileStat) error {
+	sources := []struct {
+		channelType int
+		tradeType   int
+		table       string
+		column      string
+	}{
+		{consts.PaymentChannelTransfer, consts.TradeTypeRechargeManual, dao.RechargeManual.Table(), "transfer_account_id"},
+		{consts.PaymentChannelOnline, consts.TradeTypeRechargeOnline, dao.RechargePayment.Table(), "payment_account_id"},
+	}
+
+	for _, source := range sources {
+		var sums []*reconcileSum
+		err := dao.BalanceChange.Ctx(ctx).As("b").
+			InnerJoin(source.table+" o", "o.site_id = b.site_id AND o.trade_no = b.trade_no").
+			Fields(fmt.Sprintf("o.%s AS account_id, COUNT(1) AS total_count, SUM(b.money) AS total_amount", source.column)).
+			Where("b.site_id", siteId).
+			Where("b.change_type", consts.ChangeTypeIn).
+			Where("b.trade_type", source.tradeType).
+			Where(fmt.Sprintf("o.%s > 0", source.column)).
+			WhereGTE("b.created_at", dayStart).
+			WhereLT("b.created_at", dayEnd).
+			Group("o." + source.column).Scan(&sums)
+		if err != nil {
+			return err
+		}
+		for _, sum := range sums {
+			stat := statOf(source.channelType, sum.AccountId)
+			stat.ledgerCount, stat.ledgerAmount = sum.TotalCount, sum.TotalAmount
+		}
+	}
+	return nil
+}
+
+// loadReconcileAccountNames 补充差异记录中的接口名称
+func (s *sPayment) loadReconcileAccountNames(ctx context.Context, siteId int, stats map[reconcileKey]*reconcileStat) error {
+	paymentIds := make([]int, 0)
+	transferIds := make([]int, 0)
+	for key := range stats {
+		if key.channelType == consts.PaymentChannelOnline {
+			paymentIds = append(paymentIds, key.accountId)
+		} else {
+			transferIds = append(transferIds, key.accountId)
+		}
+	}
+
+	if len(paymentIds) > 0 {
+		var accounts []*entity.PaymentAccount
+		err := dao.PaymentAccount.Ctx(ctx).Fields("id, name").Where("site_id", siteId).WhereIn("id", paymentIds).Scan(&accounts)
+		if err != nil {
+			return err
+		}
+		for _, account := range accounts {
+			stats[reconcileKey{channelType: consts.PaymentChannelOnline, accountId: int(account.Id)}].accountName = account.Name
+		}
+	}
+	if len(transferIds) > 0 {
+		var accounts []*entity.TransferAccount
+		err := dao.TransferAccount.Ctx(ctx).Fields("id, name").Where("site_id", siteId).WhereIn("id", transferIds).Scan(&accounts)
+		if err != nil {
+			return err
+		}
+		for _, account := range accounts {
+			stats[reconcileKey{channelType: consts.PaymentChannelTransfer, accountId: int(account.Id)}].accountName = account.Name
+		}
+	}
+	return nil
+}
+
+// GetReconcileDiscrepancies 获取入款对账差异列表
+func (s *sPayment) GetReconcileDiscrepancies(ctx context.Context, req *v1.GetReconcileDiscrepanciesReq) (*v1.GetReconcileDiscrepanciesRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取入款对账差异列表请求 - StartDate: %s, EndDate: %s, ChannelType: %d, Status: %d", req.StartDate, req.EndDate, req.ChannelType, req.Status)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	size := req.Size
+	if size <= 0 {
+		size = 50
+	}
+
+	query := dao.PaymentReconcile.Ctx(ctx).Where(do.PaymentReconcile{
+		SiteId: siteId,
+	})
+	if req.StartDate != "" {
+		query = query.WhereGTE("stat_date", req.StartDate)
+	}
+	if req.EndDate != "" {
+		query = query.WhereLTE("stat_date", req.EndDate)
+	}
+	if req.ChannelType > 0 {
+		query = query.Where("channel_type", req.ChannelType)
+	}
+	if req.Status > 0 {
+		query = query.Where("status", req.Status)
+	}
+
+	total, err := query.Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取入款对账差异总数失败: %v", err)
+		return nil, err
+	}
+
+	var records []*entity.PaymentReconcile
+	err = query.Order("stat_date DESC, id DESC").Page(int(page), int(size)).Scan(&records)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取入款对账差异列表失败: %v", err)
+		return nil, err
+	}
+
+	statusMap := map[int]string{
+		consts.ReconcilePending:  "待处理",
+		consts.ReconcileResolved: "已处理",
+		consts.ReconcileIgnored:  "已忽略",
+	}
+
+	list := make([]*v1.ReconcileDiscrepancyInfo, 0, len(records))
+	for _, record := range records {
+		list = append(list, &v1.ReconcileDiscrepancyInfo{
+			Id:            int32(record.Id),
+			StatDate:      record.StatDate.Format("Y-m-d"),
+			ChannelType:   int32(record.ChannelType),
+			AccountId:     int32(record.AccountId),
+			AccountName:   record.AccountName,
+			CounterCount:  int32(record.CounterCount),
+			CounterAmount: record.CounterAmount,
+			OrderCount:    int32(record.OrderCount),
+			OrderAmount:   record.OrderAmount,
+			LedgerCount:   int32(record.LedgerCount),
+			LedgerAmount:  record.LedgerAmount,
+			Status:        int32(record.Status),
+			StatusName:    statusMap[record.Status],
+			ResolvedBy:    record.ResolvedBy,
+			ResolvedAt:    util.FormatTime(record.ResolvedAt),
+			ResolveRemark: record.ResolveRemark,
+		})
+	}
+
+	middleware.LogWithTrace(ctx, "info", "获取入款对账差异列表成功 - 总数: %d", total)
+
+	return &v1.GetReconcileDiscrepanciesRes{
+		List:  list,
+		Count: int32(total),
+	}, nil
+}
+
+// ResolveReconcileDiscrepancy 处理入款对账差异
+func (s *sPayment) ResolveReconcileDiscrepancy(ctx context.Context, req *v1.ResolveReconcileDiscrepancyReq) (*v1.ResolveReconcileDiscrepancyRes, error) {
+	middleware.LogWithTrace(ctx, "info", "处理入款对账差异请求 - Id: %d, Status: %d", req.Id, req.Status)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	if req.Status != consts.ReconcileResolved && req.Status != consts.ReconcileIgnored {
+		return &v1.ResolveReconcileDiscrepancyRes{Success: false, Message: "处理结果无效"}, nil
+	}
+
+	admin := backend.Admin().CurrentAdmin(ctx)
+	if admin == nil {
+		return &v1.ResolveReconcileDiscrepancyRes{Success: false, Message: "未登录或登录已过期"}, nil
+	}
+
+	var record *entity.PaymentReconcile
+	err := dao.PaymentReconcile.Ctx(ctx).Where(do.PaymentReconcile{
+		Id:     req.Id,
+		SiteId: siteId,
+	}).Scan(&record)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询入款对账差异失败: %v", err)
+		return nil, err
+	}
+	if record == nil {
+		return &v1.ResolveReconcileDiscrepancyRes{Success: false, Message: "对账差异不存在"}, nil
+	}
+	if record.Status != consts.ReconcilePending {
+		return &v1.ResolveReconcileDiscrepancyRes{Success: false, Message: "该差异已处理"}, nil
+	}
+
+	_, err = dao.PaymentReconcile.Ctx(ctx).Where(do.PaymentReconcile{
+		Id:     record.Id,
+		Status: consts.ReconcilePending,
+	}).Data(g.Map{
+		"status":         req.Status,
+		"resolved_by":    admin.Username,
+		"resolved_at":    gtime.Now(),
+		"resolve_remark": req.Remark,
+		"updated_at":     gtime.Now(),
+	}).Update()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "处理入款对账差异失败: %v", err)
+		return nil, err
+	}
+
+	action := "已处理"
+	if req.Status == consts.ReconcileIgnored {
+		action = "已忽略"
+	}
+	logMessage := fmt.Sprintf("处理入款对账差异 [ID:%d 日期:%s 接口:%s] 结果: %s，说明: %s",
+		record.Id, record.StatDate.Format("Y-m-d"), record.AccountName, action, req.Remark)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "处理入款对账差异成功 - Id: %d, Admin: %s", req.Id, admin.Username)
+
+	return &v1.ResolveReconcileDiscrepancyRes{Success: true, Message: "处理成功"}, nil
+}
+
+// amountEqual 金额按分比较
+func amountEqual(a, b float64) bool {
+	return math.Abs(a-b) < 0.005
+}
@@ -0,0 +1,682 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "jh_app_service/api/backend/payment/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+	"jh_app_service/internal/util"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/text/gstr"
+)
+
+// errStatementItemHandled 流水明细已被确认或忽略
+var errStatementItemHandled = errors.New("该流水已处理，请刷新后重试")
+
+// 银行流水文件最大 5MB
+const statementMaxFileSize = 5 * 1024 * 1024
+
+// 原始行内容最多保存的字符数，与 bank_statement_item.raw 一致
+const statementRawMaxLength = 1024
+
+// 银行流水表头别名，不同银行导出的列名不同
+var statementHeaders = map[string][]string{
+	"trade_time": {"交易时间", "入账时间", "时间", "日期", "trade_time", "time", "date"},
+	"amount":     {"金额", "收入金额", "存入金额", "交易金额", "贷方金额", "amount", "credit"},
+	"payer_name": {"付款人", "对方户名", "付款人姓名", "户名", "payer_name", "payer", "name"},
+	"bank_ref":   {"流水号", "交易流水号", "银行流水号", "bank_ref", "reference", "ref"},
+}
+
+// statementRow 银行流水文件中的一行
+type statementRow struct {
+	rowNo     int
+	tradeTime *gtime.Time
+	amount    float64
+	payerName string
+	bankRef   string
+	raw       string
+	err       string // 解析失败原因
+}
+
+// ImportBankStatement 导入银行流水并自动匹配待确认的转账入款订单
+// 金额一致、交易时间在窗口内且付款人与存款人姓名唯一匹配时自动确认入款，其他有候选订单的流水进入待复核
+func (s *sPayment) ImportBankStatement(ctx context.Context, req *v1.ImportBankStatementReq) (*v1.ImportBankStatementRes, error) {
+	middleware.LogWithTrace(ctx, "info", "导入银行流水请求 - 文件名: %s, 大小: %d, TransferAccountId: %d", req.FileName, len(req.FileData), req.TransferAccountId)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	admin := backend.Admin().CurrentAdmin(ctx)
+	if admin == nil {
+		return &v1.ImportBankStatementRes{Success: false, Message: "未登录或登录已过期"}, nil
+	}
+	if len(req.FileData) == 0 {
+		return &v1.ImportBankStatementRes{Success: false, Message: "文件内容不能为空"}, nil
+	}
+	if len(req.FileData) > statementMaxFileSize {
+		return &v1.ImportBankStatementRes{Success: false, Message: "文件大小不能超过5MB"}, nil
+	}
+
+	// 同一文件只允许导入一次
+	sum := sha256.Sum256(req.FileData)
+	fileHash := hex.EncodeToString(sum[:])
+	count, err := dao.BankStatementBatch.Ctx(ctx).Where(do.BankStatementBatch{
+		SiteId:   siteId,
+		FileHash: fileHash,
+	}).Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询对账批次失败: %v", err)
+		return nil, err
+	}
+	if count > 0 {
+		return &v1.ImportBankStatementRes{Success: false, Message: "该文件已导入过"}, nil
+	}
+
+	rows, err := parseStatement(req.FileData)
+	if err != nil {
+		return &v1.ImportBankStatementRes{Success: false, Message: err.Error()}, nil
+	}
+
+	matchWindow := int(req.MatchWindow)
+	if matchWindow <= 0 {
+		matchWindow = g.Cfg().MustGet(ctx, "payment.matchWindow", 30).Int()
+	}
+
+	batch := &entity.BankStatementBatch{
+		SiteId:            siteId,
+		TransferAccountId: int(req.TransferAccountId),
+		FileName:          req.FileName,
+		FileHash:          fileHash,
+		MatchWindow:       matchWindow,
+		TotalRows:         len(rows),
+		AdminId:           int(admin.Id),
+		AdminName:         admin.Username,
+		CreatedAt:         gtime.Now(),
+	}
+
+	// 批次和明细在同一事务中写入，失败时整批回滚，同一文件可以重新导入
+	// 有效行先保存为未匹配，提交后再逐行自动匹配，匹配中断的流水仍可人工复核
+	items := make([]*entity.BankStatementItem, 0, len(rows))
+	err = dao.BankStatementBatch.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		batchId, err := dao.BankStatementBatch.Ctx(ctx).Data(batch).OmitEmptyData().InsertAndGetId()
+		if err != nil {
+			return err
+		}
+		batch.Id = uint(batchId)
+
+		for _, row := range rows {
+			item := &entity.BankStatementItem{
+				SiteId:    batch.SiteId,
+				BatchId:   int(batch.Id),
+				RowNo:     row.rowNo,
+				TradeTime: row.tradeTime,
+				Amount:    row.amount,
+				PayerName: row.payerName,
+				BankRef:   row.bankRef,
+				Raw:       row.raw,
+				Status:    consts.StatementItemNoMatch,
+				Reason:    "等待自动匹配",
+				CreatedAt: gtime.Now(),
+				UpdatedAt: gtime.Now(),
+			}
+			if row.err != "" {
+				item.Status = consts.StatementItemInvalid
+				item.Reason = row.err
+			}
+			itemId, err := dao.BankStatementItem.Ctx(ctx).Data(item).OmitEmptyData().InsertAndGetId()
+			if err != nil {
+				return fmt.Errorf("保存对账明细失败 - 行号: %d, 错误: %v", row.rowNo, err)
+			}
+			item.Id = uint(itemId)
+			items = append(items, item)
+		}
+		return nil
+	})
+	if err != nil {
+		// 并发导入同一文件时由唯一键 uniq_site_file_hash 拦截
+		if strings.Contains(err.Error(), "Duplicate entry") {
+			return &v1.ImportBankStatementRes{Success: false, Message: "该文件已导入过"}, nil
+		}
+		middleware.LogWithTrace(ctx, "error", "保存对账批次失败: %v", err)
+		return nil, err
+	}
+
+	// 同一批次中已匹配的订单不能再匹配其他流水
+	claimed := make(map[int64]bool)
+	for _, item := range items {
+		if item.Status != consts.StatementItemInvalid {
+			s.matchStatementItem(ctx, batch, item, claimed, admin)
+		}
+		switch item.Status {
+		case consts.StatementItemMatched:
+			batch.MatchedRows++
+		case consts.StatementItemReview:
+			batch.ReviewRows++
+		case consts.StatementItemNoMatch:
+			batch.UnmatchedRows++
+		default:
+			batch.InvalidRows++
+		}
+	}
+
+	_, err = dao.BankStatementBatch.Ctx(ctx).Where("id", batch.Id).Data(g.Map{
+		"matched_rows":   batch.MatchedRows,
+		"review_rows":    batch.ReviewRows,
+		"unmatched_rows": batch.UnmatchedRows,
+		"invalid_rows":   batch.InvalidRows,
+	}).Update()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "更新对账批次失败: %v", err)
+		return nil, err
+	}
+
+	logMessage := fmt.Sprintf("导入银行流水 %s，批次ID: %d，自动匹配 %d 笔，待复核 %d 笔，未匹配 %d 笔，无效 %d 行",
+		req.FileName, batch.Id, batch.MatchedRows, batch.ReviewRows, batch.UnmatchedRows, batch.InvalidRows)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "导入银行流水成功 - 批次ID: %d, 总行数: %d, 自动匹配: %d, 待复核: %d", batch.Id, batch.TotalRows, batch.MatchedRows, batch.ReviewRows)
+
+	return &v1.ImportBankStatementRes{
+		Success: true,
+		Message: "导入成功",
+		Batch:   s.toStatementBatchInfo(batch),
+	}, nil
+}
+
+// matchStatementItem 自动匹配已保存的流水明细，唯一匹配时确认入款，否则标记为待复核或未匹配
+// 明细的状态和原因会同步更新到 item
+func (s *sPayment) matchStatementItem(ctx context.Context, batch *entity.BankStatementBatch, item *entity.BankStatementItem, claimed map[int64]bool, admin *entity.Admin) {
+	window := time.Duration(batch.MatchWindow) * time.Minute
+	query := dao.RechargeManual.Ctx(ctx).Where(do.RechargeManual{
+		SiteId: batch.SiteId,
+		Status: consts.RechargeManualPending,
+	}).Where("money", item.Amount).
+		Where("COALESCE(deposit_time, created_at) BETWEEN ? AND ?", item.TradeTime.Add(-window), item.TradeTime.Add(window))
+	if batch.TransferAccountId > 0 {
+		query = query.Where("transfer_account_id", batch.TransferAccountId)
+	}
+
+	var orders []*entity.RechargeManual
+	if err := query.Order("id ASC").Scan(&orders); err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询候选入款订单失败: %v", err)
+		s.markStatementItem(ctx, item, consts.StatementItemReview, "查询候选订单失败，请人工复核", nil)
+		return
+	}
+
+	var candidates, nameMatched []*entity.RechargeManual
+	for _, order := range orders {
+		if claimed[int64(order.Id)] {
+			continue
+		}
+		candidates = append(candidates, order)
+		if item.PayerName != "" && normalizeName(order.PayerName) == normalizeName(item.PayerName) {
+			nameMatched = append(nameMatched, order)
+		}
+	}
+
+	var reason string
+	switch {
+	case len(candidates) == 0:
+		s.markStatementItem(ctx, item, consts.StatementItemNoMatch, "没有金额和时间一致的待确认订单", nil)
+		return
+	case len(nameMatched) == 1:
+		order := nameMatched[0]
+		claimed[int64(order.Id)] = true
+		err := s.confirmStatementItem(ctx, item.Id, order, int(admin.Id), admin.Username, batch.Id, "银行流水自动匹配", consts.StatementItemMatched)
+		if err == nil {
+			item.Status = consts.StatementItemMatched
+			item.RechargeManualId = int(order.Id)
+			return
+		}
+		middleware.LogWithTrace(ctx, "error", "自动确认入款订单失败 - 订单ID: %d, 错误: %v", order.Id, err)
+		reason = fmt.Sprintf("自动确认失败: %v", err)
+		candidates = nameMatched
+	case len(nameMatched) > 1:
+		reason = "多笔订单金额、时间和付款人一致"
+		candidates = nameMatched
+	case item.PayerName == "":
+		reason = "流水缺少付款人"
+	default:
+		reason = "付款人与存款人姓名不一致"
+	}
+	s.markStatementItem(ctx, item, consts.StatementItemReview, reason, candidates)
+}
+
+// markStatementItem 更新自动匹配结果，只更新仍处于待匹配状态的明细，避免覆盖已人工复核的结果
+func (s *sPayment) markStatementItem(ctx context.Context, item *entity.BankStatementItem, status int, reason string, candidates []*entity.RechargeManual) {
+	ids := make([]string, 0, len(candidates))
+	for _, order := range candidates {
+		ids = append(ids, strconv.FormatUint(order.Id, 10))
+	}
+	result, err := dao.BankStatementItem.Ctx(ctx).
+		Where("id", item.Id).
+		Where("status", consts.StatementItemNoMatch).
+		Data(g.Map{
+			"status":        status,
+			"reason":        reason,
+			"candidate_ids": strings.Join(ids, ","),
+			"updated_at":    gtime.Now(),
+		}).Update()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "更新对账明细失败 - ID: %d, 错误: %v", item.Id, err)
+		return
+	}
+	if affected, _ := result.RowsAffected(); affected > 0 {
+		item.Status = status
+		item.Reason = reason
+		item.CandidateIds = strings.Join(ids, ",")
+	}
+}
+
+// confirmStatementItem 锁定流水明细并确认入款订单，二者在同一事务中完成
+// 同一条流水并发或重复确认时，只有第一次能把明细从待复核/未匹配更新为 status，其余回滚，避免重复入款
+func (s *sPayment) confirmStatementItem(ctx context.Context, itemId uint, order *entity.RechargeManual, adminId int, adminName string, batchId uint, remark string, status int) error {
+	return dao.BankStatementItem.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		var item *entity.BankStatementItem
+		err := dao.BankStatementItem.Ctx(ctx).Where("id", itemId).LockUpdate().Scan(&item)
+		if err != nil {
+			return err
+		}
+		if item == nil {
+			return fmt.Errorf("对账明细不存在")
+		}
+		if item.Status != consts.StatementItemReview && item.Status != consts.StatementItemNoMatch {
+			return errStatementItemHandled
+		}
+
+		if err = backend.Balance().ConfirmRechargeManual(ctx, int64(order.Id), adminId, adminName, int(batchId), remark); err != nil {
+			return err
+		}
+
+		data := g.Map{
+			"status":             status,
+			"recharge_manual_id": order.Id,
+			"updated_at":         gtime.Now(),
+		}
+		if status == consts.StatementItemResolved {
+			data["reviewed_by"] = adminName
+			data["reviewed_at"] = gtime.Now()
+		}
+		result, err := dao.BankStatementItem.Ctx(ctx).
+			Where("id", itemId).
+			WhereIn("status", []int{consts.StatementItemReview, consts.StatementItemNoMatch}).
+			Data(data).
+			Update()
+		if err != nil {
+			return err
+		}
+		if affected, _ := result.RowsAffected(); affected == 0 {
+			return errStatementItemHandled
+		}
+		return nil
+	})
+}
+
+// GetStatementBatches 获取对账批次列表
+func (s *sPayment) GetStatementBatches(ctx context.Context, req *v1.GetStatementBatchesReq) (*v1.GetStatementBatchesRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取对账批次列表请求 - Page: %d, Size: %d", req.Page, req.Size)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	size := req.Size
+	if size <= 0 {
+		size = 20
+	}
+
+	query := dao.BankStatementBatch.Ctx(ctx).Where(do.BankStatementBatch{SiteId: siteId})
+	total, err := query.Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取对账批次总数失败: %v", err)
+		return nil, err
+	}
+
+	var batches []*entity.BankStatementBatch
+	if err = query.Order("id DESC").Page(int(page), int(size)).Scan(&batches); err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取对账批次列表失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]*v1.StatementBatchInfo, 0, len(batches))
+	for _, batch := range batches {
+		list = append(list, s.toStatementBatchInfo(batch))
+	}
+
+	return &v1.GetStatementBatchesRes{
+		List:  list,
+		Count: int32(total),
+	}, nil
+}
+
+// GetStatementItems 获取对账明细，status=2 即为待复核队列
+func (s *sPayment) GetStatementItems(ctx context.Context, req *v1.GetStatementItemsReq) (*v1.GetStatementItemsRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取对账明细请求 - BatchId: %d, Status: %d, Page: %d, Size: %d", req.BatchId, req.Status, req.Page, req.Size)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	size := req.Size
+	if size <= 0 {
+		size = 50
+	}
+
+	query := dao.BankStatementItem.Ctx(ctx).Where(do.BankStatementItem{SiteId: siteId})
+	if req.BatchId > 0 {
+		query = query.Where("batch_id", req.BatchId)
+	}
+	if req.Status > 0 {
+		query = query.Where("status", req.Status)
+	}
+
+	total, err := query.Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取对账明细总数失败: %v", err)
+		return nil, err
+	}
+
+	var items []*entity.BankStatementItem
+	if err = query.Order("batch_id DESC, row_no ASC").Page(int(page), int(size)).Scan(&items); err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取对账明细失败: %v", err)
+		return nil, err
+	}
+
+	statusMap := map[int]string{
+		consts.StatementItemMatched:  "已匹配",
+		consts.StatementItemReview:   "待复核",
+		consts.StatementItemNoMatch:  "未匹配",
+		consts.StatementItemInvalid:  "无效",
+		consts.StatementItemResolved: "复核已确认",
+		consts.StatementItemIgnored:  "复核已忽略",
+	}
+
+	list := make([]*v1.StatementItemInfo, 0, len(items))
+	for _, item := range items {
+		var candidateIds []int64
+		for _, id := range strings.Split(item.CandidateIds, ",") {
+			if v, err := strconv.ParseInt(id, 10, 64); err == nil {
+				candidateIds = append(candidateIds, v)
+			}
+		}
+		list = append(list, &v1.StatementItemInfo{
+			Id:               int32(item.Id),
+			BatchId:          int32(item.BatchId),
+			RowNo:            int32(item.RowNo),
+			TradeTime:        util.FormatTime(item.TradeTime),
+			Amount:           item.Amount,
+			PayerName:        item.PayerName,
+			BankRef:          item.BankRef,
+			Status:           int32(item.Status),
+			StatusName:       statusMap[item.Status],
+			RechargeManualId: int64(item.RechargeManualId),
+			CandidateIds:     candidateIds,
+			Reason:           item.Reason,
+			ReviewedBy:       item.ReviewedBy,
+			ReviewedAt:       util.FormatTime(item.ReviewedAt),
+		})
+	}
+
+	return &v1.GetStatementItemsRes{
+		List:  list,
+		Count: int32(total),
+	}, nil
+}
+
+// ResolveStatementItem 复核对账明细：指定订单确认入款或忽略该流水
+func (s *sPayment) ResolveStatementItem(ctx context.Context, req *v1.ResolveStatementItemReq) (*v1.ResolveStatementItemRes, error) {
+	middleware.LogWithTrace(ctx, "info", "复核对账明细请求 - Id: %d, Action: %d, RechargeManualId: %d", req.Id, req.Action, req.RechargeManualId)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	admin := backend.Admin().CurrentAdmin(ctx)
+	if admin == nil {
+		return &v1.ResolveStatementItemRes{Success: false, Message: "未登录或登录已过期"}, nil
+	}
+
+	var item *entity.BankStatementItem
+	err := dao.BankStatementItem.Ctx(ctx).Where(do.BankStatementItem{
+		Id:     req.Id,
+		SiteId: siteId,
+	}).Scan(&item)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询对账明细失败: %v", err)
+		return nil, err
+	}
+	if item == nil {
+		return &v1.ResolveStatementItemRes{Success: false, Message: "对账明细不存在"}, nil
+	}
+	if item.Status != consts.StatementItemReview && item.Status != consts.StatementItemNoMatch {
+		return &v1.ResolveStatementItemRes{Success: false, Message: "该流水无需复核"}, nil
+	}
+
+	var logMessage string
+	switch req.Action {
+	case 1:
+		var order *entity.RechargeManual
+		err = dao.RechargeManual.Ctx(ctx).Where(do.RechargeManual{
+			Id:     req.RechargeManualId,
+			SiteId: siteId,
+		}).Scan(&order)
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "查询入款订单失败: %v", err)
+			return nil, err
+		}
+		if order == nil {
+			return &v1.ResolveStatementItemRes{Success: false, Message: "入款订单不存在"}, nil
+		}
+		if math.Abs(order.Money-item.Amount) > 0.001 {
+			return &v1.ResolveStatementItemRes{Success: false, Message: "订单金额与流水金额不一致"}, nil
+		}
+		// 有候选订单时只能从候选订单中选择；未匹配的流水没有候选，订单须属于导入批次的收款账户
+		if message, err := s.checkStatementOrder(ctx, item, order); err != nil {
+			middleware.LogWithTrace(ctx, "error", "查询对账批次失败: %v", err)
+			return nil, err
+		} else if message != "" {
+			return &v1.ResolveStatementItemRes{Success: false, Message: message}, nil
+		}
+
+		remark := req.Remark
+		if remark == "" {
+			remark = "银行流水人工复核"
+		}
+		err = s.confirmStatementItem(ctx, item.Id, order, int(admin.Id), admin.Username, uint(item.BatchId), remark, consts.StatementItemResolved)
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "确认入款订单失败: %v", err)
+			return &v1.ResolveStatementItemRes{Success: false, Message: err.Error()}, nil
+		}
+		logMessage = fmt.Sprintf("复核银行流水 #%d，确认入款订单 %s，金额 %.2f", item.Id, order.TradeNo, order.Money)
+	case 2:
+		data := g.Map{
+			"status":      consts.StatementItemIgnored,
+			"reviewed_by": admin.Username,
+			"reviewed_at": gtime.Now(),
+			"updated_at":  gtime.Now(),
+		}
+		if req.Remark != "" {
+			data["reason"] = req.Remark
+		}
+		result, err := dao.BankStatementItem.Ctx(ctx).
+			Where("id", item.Id).
+			WhereIn("status", []int{consts.StatementItemReview, consts.StatementItemNoMatch}).
+			Data(data).
+			Update()
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "更新对账明细失败: %v", err)
+			return nil, err
+		}
+		if affected, _ := result.RowsAffected(); affected == 0 {
+			return &v1.ResolveStatementItemRes{Success: false, Message: errStatementItemHandled.Error()}, nil
+		}
+		logMessage = fmt.Sprintf("复核银行流水 #%d，忽略，金额 %.2f", item.Id, item.Amount)
+	default:
+		return &v1.ResolveStatementItemRes{Success: false, Message: "操作类型无效"}, nil
+	}
+
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	return &v1.ResolveStatementItemRes{Success: true, Message: "操作成功"}, nil
+}
+
+// checkStatementOrder 检查复核时指定的订单是否可以匹配该流水，不可以时返回提示信息
+func (s *sPayment) checkStatementOrder(ctx context.Context, item *entity.BankStatementItem, order *entity.RechargeManual) (string, error) {
+	if candidates := util.SplitIds(item.CandidateIds); len(candidates) > 0 {
+		for _, id := range candidates {
+			if uint64(id) == order.Id {
+				return "", nil
+			}
+		}
+		return "只能选择该流水的候选订单", nil
+	}
+
+	var batch *entity.BankStatementBatch
+	err := dao.BankStatementBatch.Ctx(ctx).Where("id", item.BatchId).Scan(&batch)
+	if err != nil {
+		return "", err
+	}
+	if batch == nil {
+		return "对账批次不存在", nil
+	}
+	if batch.TransferAccountId > 0 && order.TransferAccountId != batch.TransferAccountId {
+		return "订单的收款账户与流水不一致", nil
+	}
+	return "", nil
+}
+
+// toStatementBatchInfo 转换对账批次信息
+func (s *sPayment) toStatementBatchInfo(batch *entity.BankStatementBatch) *v1.StatementBatchInfo {
+	return &v1.StatementBatchInfo{
+		Id:                int32(batch.Id),
+		TransferAccountId: int32(batch.TransferAccountId),
+		FileName:          batch.FileName,
+		MatchWindow:       int32(batch.MatchWindow),
+		TotalRows:         int32(batch.TotalRows),
+		MatchedRows:       int32(batch.MatchedRows),
+		ReviewRows:        int32(batch.ReviewRows),
+		UnmatchedRows:     int32(batch.UnmatchedRows),
+		InvalidRows:       int32(batch.InvalidRows),
+		AdminName:         batch.AdminName,
+		CreatedAt:         util.FormatTime(batch.CreatedAt),
+	}
+}
+
+// parseStatement 解析CSV格式的银行流水，首行为表头
+func parseStatement(data []byte) ([]*statementRow, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("读取表头失败: %v", err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		for field, aliases := range statementHeaders {
+			if _, ok := columns[field]; ok {
+				continue
+			}
+			for _, alias := range aliases {
+				if name == alias {
+					columns[field] = i
+					break
+				}
+			}
+		}
+	}
+	for _, field := range []string{"trade_time", "amount", "payer_name"} {
+		if _, ok := columns[field]; !ok {
+			return nil, fmt.Errorf("表头缺少列: %s", statementHeaders[field][0])
+		}
+	}
+
+	cell := func(record []string, field string) string {
+		i, ok := columns[field]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var rows []*statementRow
+	for rowNo := 2; ; rowNo++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("第%d行格式错误: %v", rowNo, err)
+		}
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+
+		row := &statementRow{
+			rowNo:     rowNo,
+			payerName: cell(record, "payer_name"),
+			bankRef:   cell(record, "bank_ref"),
+			raw:       gstr.SubStrRune(strings.Join(record, ","), 0, statementRawMaxLength),
+		}
+		rows = append(rows, row)
+
+		tradeTime, err := gtime.StrToTime(cell(record, "trade_time"))
+		if err != nil {
+			row.err = "交易时间格式错误"
+			continue
+		}
+		row.tradeTime = tradeTime
+
+		amountText := strings.NewReplacer(",", "", "¥", "", "￥", "", " ", "").Replace(cell(record, "amount"))
+		amount, err := strconv.ParseFloat(amountText, 64)
+		if err != nil {
+			row.err = "金额格式错误"
+			continue
+		}
+		if amount <= 0 {
+			row.err = "非入账记录"
+			continue
+		}
+		row.amount = math.Round(amount*100) / 100
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("文件中没有流水记录")
+	}
+	return rows, nil
+}
+
+// normalizeName 去除空白并统一大小写后比较姓名
+func normalizeName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), ""))
+}
@@ -0,0 +1,557 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	v1 "jh_app_service/api/backend/payment/v1"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/secret"
+	"jh_app_service/internal/service/backend"
+	"jh_app_service/internal/util"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// 转账类型
+const (
+	transferBankTypeBank   = 1 // 网银转账
+	transferBankTypeWechat = 2 // 微信
+	transferBankTypeAlipay = 3 // 支付宝
+)
+
+// transferQrcodeUploadCode 收款二维码的上传标识，对应配置 upload.transfer_qrcode
+const transferQrcodeUploadCode = "transfer_qrcode"
+
+// GetTransferAccounts 获取转账接口列表
+func (s *sPayment) GetTransferAccounts(ctx context.Context, req *v1.GetTransferAccountsReq) (*v1.GetTransferAccountsRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取转账接口列表请求 - BankType: %d, Status: %d, Page: %d, Size: %d", req.BankType, req.Status, req.Page, req.Size)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	size := req.Size
+	if size <= 0 {
+		size = 50
+	}
+
+	query := dao.TransferAccount.Ctx(ctx).Where(do.TransferAccount{
+		SiteId: siteId,
+	})
+	if req.BankType > 0 {
+		query = query.Where("bank_type", req.BankType)
+	}
+	switch req.Status {
+	case 1:
+		query = query.Where("status", 1)
+	case 2:
+		query = query.Where("status", 0)
+	}
+
+	total, err := query.Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取转账接口总数失败: %v", err)
+		return nil, err
+	}
+
+	var accounts []*entity.TransferAccount
+	err = query.Order("sort ASC, id DESC").Page(int(page), int(size)).Scan(&accounts)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取转账接口列表失败: %v", err)
+		return nil, err
+	}
+
+	accountIds := make([]uint, 0, len(accounts))
+	for _, account := range accounts {
+		accountIds = append(accountIds, account.Id)
+	}
+	levelMap, err := s.getTransferLevelMap(ctx, siteId, accountIds)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取转账接口层级失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]*v1.TransferAccountInfo, 0, len(accounts))
+	for _, account := range accounts {
+		info := s.toTransferAccountInfo(account, levelMap[int(account.Id)])
+		// 列表中卡号只返回脱敏值
+		info.CardNo = secret.Mask(ctx, account.CardNo)
+		list = append(list, info)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "获取转账接口列表成功 - 总数: %d, 当前页数量: %d", total, len(list))
+
+	return &v1.GetTransferAccountsRes{
+		List:  list,
+		Count: int32(total),
+	}, nil
+}
+
+// CreateTransferAccount 创建转账接口
+func (s *sPayment) CreateTransferAccount(ctx context.Context, req *v1.CreateTransferAccountReq) (*v1.CreateTransferAccountRes, error) {
+	middleware.LogWithTrace(ctx, "info", "创建转账接口请求 - Name: %s, BankType: %d", req.Name, req.BankType)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	if secret.IsMasked(req.CardNo) {
+		return &v1.CreateTransferAccountRes{Success: false, Message: "请输入完整的卡号"}, nil
+	}
+	if err := s.validateTransferAccount(req.BankType, req.Name, req.BankName, req.CardAccount, req.CardNo, req.Qrcode, req.EachMin, req.EachMax, req.DailyMax); err != nil {
+		return &v1.CreateTransferAccountRes{Success: false, Message: err.Error()}, nil
+	}
+	if req.Qrcode != "" {
+		if err := backend.Upload().ValidateImageUrl(ctx, req.Qrcode, transferQrcodeUploadCode); err != nil {
+			middleware.LogWithTrace(ctx, "error", "收款二维码校验失败: %v", err)
+			return &v1.CreateTransferAccountRes{Success: false, Message: err.Error()}, nil
+		}
+	}
+	if err := s.checkUserLevels(ctx, siteId, req.LevelIds); err != nil {
+		return &v1.CreateTransferAccountRes{Success: false, Message: err.Error()}, nil
+	}
+
+	var accountId int64
+	err := dao.TransferAccount.DB().Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		result, err := tx.Model(dao.TransferAccount.Table()).Data(do.TransferAccount{
+			SiteId:      siteId,
+			BankType:    req.BankType,
+			BankName:    req.BankName,
+			Name:        req.Name,
+			BankUrl:     req.BankUrl,
+			Qrcode:      req.Qrcode,
+			CardAccount: req.CardAccount,
+			CardNo:      req.CardNo,
+			DepositBank: req.DepositBank,
+			EachMin:     req.EachMin,
+			EachMax:     req.EachMax,
+			DailyMax:    req.DailyMax,
+			TodayCount:  0,
+			TodayAmount: 0,
+			Status:      req.Status,
+			Sort:        req.Sort,
+			Weight:      req.Weight,
+			Remark:      req.Remark,
+			CreatedAt:   gtime.Now(),
+			UpdatedAt:   gtime.Now(),
+		}).Insert()
+		if err != nil {
+			return err
+		}
+		if accountId, err = result.LastInsertId(); err != nil {
+			return err
+		}
+		return s.saveTransferLevels(ctx, tx, siteId, int(accountId), int(req.BankType), req.LevelIds)
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "创建转账接口失败: %v", err)
+		return nil, err
+	}
+
+	middleware.LogWithTrace(ctx, "info", "创建转账接口成功 - Id: %d", accountId)
+
+	return &v1.CreateTransferAccountRes{Success: true, Message: "创建成功", Id: int32(accountId)}, nil
+}
+
+// GetTransferAccountUpdate 获取转账接口编辑信息
+func (s *sPayment) GetTransferAccountUpdate(ctx context.Context, req *v1.GetTransferAccountUpdateReq) (*v1.GetTransferAccountUpdateRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取转账接口编辑信息请求 - Id: %d", req.Id)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	account, err := s.getTransferAccount(ctx, siteId, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	levelMap, err := s.getTransferLevelMap(ctx, siteId, []uint{account.Id})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取转账接口层级失败: %v", err)
+		return nil, err
+	}
+
+	return &v1.GetTransferAccountUpdateRes{
+		Info: s.toTransferAccountInfo(account, levelMap[int(account.Id)]),
+	}, nil
+}
+
+// UpdateTransferAccount 更新转账接口
+func (s *sPayment) UpdateTransferAccount(ctx context.Context, req *v1.UpdateTransferAccountReq) (*v1.UpdateTransferAccountRes, error) {
+	middleware.LogWithTrace(ctx, "info", "更新转账接口请求 - Id: %d, Name: %s", req.Id, req.Name)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	account, err := s.getTransferAccount(ctx, siteId, int(req.Id))
+	if err != nil {
+		return &v1.UpdateTransferAccountRes{Success: false, Message: err.Error()}, nil
+	}
+
+	// 提交脱敏卡号表示不修改
+	cardNo := req.CardNo
+	if secret.IsMasked(cardNo) {
+		cardNo = account.CardNo
+	}
+	if err = s.validateTransferAccount(req.BankType, req.Name, req.BankName, req.CardAccount, cardNo, req.Qrcode, req.EachMin, req.EachMax, req.DailyMax); err != nil {
+		return &v1.UpdateTransferAccountRes{Success: false, Message: err.Error()}, nil
+	}
+	// 二维码有变化时才需要重新校验
+	if req.Qrcode != "" && req.Qrcode != account.Qrcode {
+		if err = backend.Upload().ValidateImageUrl(ctx, req.Qrcode, transferQrcodeUploadCode); err != nil {
+			middleware.LogWithTrace(ctx, "error", "收款二维码校验失败: %v", err)
+			return &v1.UpdateTransferAccountRes{Success: false, Message: err.Error()}, nil
+		}
+	}
+
+	err = dao.TransferAccount.DB().Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		_, err := tx.Model(dao.TransferAccount.Table()).Where("id", req.Id).Where("site_id", siteId).Data(g.Map{
+			"bank_type":    req.BankType,
+			"bank_name":    req.BankName,
+			"name":         req.Name,
+			"bank_url":     req.BankUrl,
+			"qrcode":       req.Qrcode,
+			"card_account": req.CardAccount,
+			"card_no":      cardNo,
+			"deposit_bank": req.DepositBank,
+			"each_min":     req.EachMin,
+			"each_max":     req.EachMax,
+			"daily_max":    req.DailyMax,
+			"status":       req.Status,
+			"sort":         req.Sort,
+			"weight":       req.Weight,
+			"remark":       req.Remark,
+			"updated_at":   gtime.Now(),
+		}).Update()
+		if err != nil {
+			return err
+		}
+
+		// 层级关联中冗余了转账类型，需要同步
+		if int(req.BankType) != account.BankType {
+			_, err = tx.Model(dao.UserLevelTransfer.Table()).Where(do.UserLevelTransfer{
+				SiteId:            siteId,
+				TransferAccountId: req.Id,
+			}).Data(g.Map{
+				"bank_type":  req.BankType,
+				"updated_at": gtime.Now(),
+			}).Update()
+		}
+		return err
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "更新转账接口失败: %v", err)
+		return nil, err
+	}
+
+	middleware.LogWithTrace(ctx, "info", "更新转账接口成功 - Id: %d", req.Id)
+
+	return &v1.UpdateTransferAccountRes{Success: true, Message: "更新成功"}, nil
+}
+
+// DeleteTransferAccount 删除转账接口
+func (s *sPayment) DeleteTransferAccount(ctx context.Context, req *v1.DeleteTransferAccountReq) (*v1.DeleteTransferAccountRes, error) {
+	middleware.LogWithTrace(ctx, "info", "删除转账接口请求 - Id: %d", req.Id)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	if _, err := s.getTransferAccount(ctx, siteId, int(req.Id)); err != nil {
+		return &v1.DeleteTransferAccountRes{Success: false, Message: err.Error()}, nil
+	}
+
+	// 同时删除层级关联
+	err := dao.TransferAccount.DB().Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		_, err := tx.Model(dao.TransferAccount.Table()).Where("id", req.Id).Where("site_id", siteId).Delete()
+		if err != nil {
+			return err
+		}
+		_, err = tx.Model(dao.UserLevelTransfer.Table()).Where(do.UserLevelTransfer{
+			SiteId:            siteId,
+			TransferAccountId: req.Id,
+		}).Delete()
+		return err
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "删除转账接口失败: %v", err)
+		return nil, err
+	}
+
+	middleware.LogWithTrace(ctx, "info", "删除转账接口成功 - Id: %d", req.Id)
+
+	return &v1.DeleteTransferAccountRes{Success: true, Message: "删除成功"}, nil
+}
+
+// SortTransferAccounts 批量更新转账接口排序
+func (s *sPayment) SortTransferAccounts(ctx context.Context, req *v1.SortTransferAccountsReq) (*v1.SortTransferAccountsRes, error) {
+	middleware.LogWithTrace(ctx, "info", "转账接口排序请求 - 数量: %d", len(req.Items))
+
+	// 默认站点ID为1
+	siteId := 1
+
+	if len(req.Items) == 0 {
+		return &v1.SortTransferAccountsRes{Success: false, Message: "排序列表不能为空"}, nil
+	}
+
+	err := dao.TransferAccount.DB().Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		for _, item := range req.Items {
+			_, err := tx.Model(dao.TransferAccount.Table()).Where("id", item.Id).Where("site_id", siteId).Data(g.Map{
+				"sort":       item.Sort,
+				"updated_at": gtime.Now(),
+			}).Update()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "转账接口排序失败: %v", err)
+		return nil, err
+	}
+
+	return &v1.SortTransferAccountsRes{Success: true, Message: "排序成功"}, nil
+}
+
+// SetTransferAccountStatus 启用或禁用转账接口
+func (s *sPayment) SetTransferAccountStatus(ctx context.Context, req *v1.SetTransferAccountStatusReq) (*v1.SetTransferAccountStatusRes, error) {
+	middleware.LogWithTrace(ctx, "info", "设置转账接口状态请求 - Id: %d, Status: %d", req.Id, req.Status)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	if req.Status != 0 && req.Status != 1 {
+		return &v1.SetTransferAccountStatusRes{Success: false, Message: "状态值无效"}, nil
+	}
+	if _, err := s.getTransferAccount(ctx, siteId, int(req.Id)); err != nil {
+		return &v1.SetTransferAccountStatusRes{Success: false, Message: err.Error()}, nil
+	}
+
+	_, err := dao.TransferAccount.Ctx(ctx).Where("id", req.Id).Where("site_id", siteId).Data(g.Map{
+		"status":     req.Status,
+		"updated_at": gtime.Now(),
+	}).Update()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "设置转账接口状态失败: %v", err)
+		return nil, err
+	}
+
+	return &v1.SetTransferAccountStatusRes{Success: true, Message: "设置成功"}, nil
+}
+
+// GetTransferAccountLevels 获取转账接口适用的会员层级
+func (s *sPayment) GetTransferAccountLevels(ctx context.Context, req *v1.GetTransferAccountLevelsReq) (*v1.GetTransferAccountLevelsRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取转账接口层级请求 - Id: %d", req.Id)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	account, err := s.getTransferAccount(ctx, siteId, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	var levels []*entity.UserLevel
+	err = dao.UserLevel.Ctx(ctx).Where(do.UserLevel{SiteId: siteId}).Order("id ASC").Scan(&levels)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取会员层级失败: %v", err)
+		return nil, err
+	}
+
+	levelMap, err := s.getTransferLevelMap(ctx, siteId, []uint{account.Id})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取转账接口层级失败: %v", err)
+		return nil, err
+	}
+	checked := make(map[int32]bool)
+	for _, levelId := range levelMap[int(account.Id)] {
+		checked[levelId] = true
+	}
+
+	list := make([]*v1.TransferLevelItem, 0, len(levels))
+	for _, level := range levels {
+		list = append(list, &v1.TransferLevelItem{
+			LevelId: int32(level.Id),
+			Name:    level.Name,
+			Checked: checked[int32(level.Id)],
+		})
+	}
+
+	return &v1.GetTransferAccountLevelsRes{List: list}, nil
+}
+
+// SaveTransferAccountLevels 保存转账接口适用的会员层级
+func (s *sPayment) SaveTransferAccountLevels(ctx context.Context, req *v1.SaveTransferAccountLevelsReq) (*v1.SaveTransferAccountLevelsRes, error) {
+	middleware.LogWithTrace(ctx, "info", "保存转账接口层级请求 - Id: %d, LevelIds: %v", req.Id, req.LevelIds)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	account, err := s.getTransferAccount(ctx, siteId, int(req.Id))
+	if err != nil {
+		return &v1.SaveTransferAccountLevelsRes{Success: false, Message: err.Error()}, nil
+	}
+	if err = s.checkUserLevels(ctx, siteId, req.LevelIds); err != nil {
+		return &v1.SaveTransferAccountLevelsRes{Success: false, Message: err.Error()}, nil
+	}
+
+	err = dao.UserLevelTransfer.DB().Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		_, err := tx.Model(dao.UserLevelTransfer.Table()).Where(do.UserLevelTransfer{
+			SiteId:            siteId,
+			TransferAccountId: req.Id,
+		}).Delete()
+		if err != nil {
+			return err
+		}
+		return s.saveTransferLevels(ctx, tx, siteId, int(account.Id), account.BankType, req.LevelIds)
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "保存转账接口层级失败: %v", err)
+		return nil, err
+	}
+
+	middleware.LogWithTrace(ctx, "info", "保存转账接口层级成功 - Id: %d", req.Id)
+
+	return &v1.SaveTransferAccountLevelsRes{Success: true, Message: "保存成功"}, nil
+}
+
+// getTransferAccount 查询本站点的转账接口
+func (s *sPayment) getTransferAccount(ctx context.Context, siteId, id int) (*entity.TransferAccount, error) {
+	var account *entity.TransferAccount
+	err := dao.TransferAccount.Ctx(ctx).Where("id", id).Where("site_id", siteId).Scan(&account)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询转账接口失败: %v", err)
+		return nil, fmt.Errorf("查询转账接口失败: %v", err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("转账接口不存在")
+	}
+	return account, nil
+}
+
+// getTransferLevelMap 批量获取转账接口关联的会员层级ID
+func (s *sPayment) getTransferLevelMap(ctx context.Context, siteId int, accountIds []uint) (map[int][]int32, error) {
+	levelMap := make(map[int][]int32)
+	if len(accountIds) == 0 {
+		return levelMap, nil
+	}
+
+	var mappings []*entity.UserLevelTransfer
+	err := dao.UserLevelTransfer.Ctx(ctx).Where(do.UserLevelTransfer{
+		SiteId: siteId,
+	}).WhereIn("transfer_account_id", accountIds).Order("user_level_id ASC").Scan(&mappings)
+	if err != nil {
+		return nil, err
+	}
+	for _, mapping := range mappings {
+		levelMap[mapping.TransferAccountId] = append(levelMap[mapping.TransferAccountId], int32(mapping.UserLevelId))
+	}
+	return levelMap, nil
+}
+
+// saveTransferLevels 写入转账接口与会员层级的关联
+func (s *sPayment) saveTransferLevels(ctx context.Context, tx gdb.TX, siteId, accountId, bankType int, levelIds []int32) error {
+	if len(levelIds) == 0 {
+		return nil
+	}
+
+	seen := make(map[int32]bool, len(levelIds))
+	data := make([]do.UserLevelTransfer, 0, len(levelIds))
+	for _, levelId := range levelIds {
+		if seen[levelId] {
+			continue
+		}
+		seen[levelId] = true
+		data = append(data, do.UserLevelTransfer{
+			SiteId:            siteId,
+			UserLevelId:       levelId,
+			BankType:          bankType,
+			TransferAccountId: accountId,
+			CreatedAt:         gtime.Now(),
+			UpdatedAt:         gtime.Now(),
+		})
+	}
+	_, err := tx.Model(dao.UserLevelTransfer.Table()).Ctx(ctx).Data(data).Insert()
+	return err
+}
+
+// checkUserLevels 检查会员层级是否都属于本站点
+func (s *sPayment) checkUserLevels(ctx context.Context, siteId int, levelIds []int32) error {
+	if len(levelIds) == 0 {
+		return nil
+	}
+
+	seen := make(map[int32]bool, len(levelIds))
+	for _, levelId := range levelIds {
+		seen[levelId] = true
+	}
+	count, err := dao.UserLevel.Ctx(ctx).Where(do.UserLevel{SiteId: siteId}).WhereIn("id", levelIds).Count()
+	if err != nil {
+		return fmt.Errorf("查询会员层级失败: %v", err)
+	}
+	if count != len(seen) {
+		return fmt.Errorf("会员层级不存在")
+	}
+	return nil
+}
+
+// validateTransferAccount 校验转账接口参数
+func (s *sPayment) validateTransferAccount(bankType int32, name, bankName, cardAccount, cardNo, qrcode string, eachMin, eachMax, dailyMax float64) error {
+	if name == "" {
+		return fmt.Errorf("转账接口名称不能为空")
+	}
+	switch bankType {
+	case transferBankTypeBank:
+		if bankName == "" || cardAccount == "" || cardNo == "" {
+			return fmt.Errorf("网银转账必须填写银行名称、户名和卡号")
+		}
+	case transferBankTypeWechat, transferBankTypeAlipay:
+		if qrcode == "" && cardNo == "" {
+			return fmt.Errorf("请上传收款二维码或填写收款账号")
+		}
+	default:
+		return fmt.Errorf("转账类型无效")
+	}
+	if eachMin < 0 || eachMax < 0 || dailyMax < 0 {
+		return fmt.Errorf("限额不能小于0")
+	}
+	if eachMax > 0 && eachMin > eachMax {
+		return fmt.Errorf("单笔最低不能大于单笔最高")
+	}
+	return nil
+}
+
+// toTransferAccountInfo 转换转账接口信息
+func (s *sPayment) toTransferAccountInfo(account *entity.TransferAccount, levelIds []int32) *v1.TransferAccountInfo {
+	return &v1.TransferAccountInfo{
+		Id:          int32(account.Id),
+		BankType:    int32(account.BankType),
+		BankName:    account.BankName,
+		Name:        account.Name,
+		BankUrl:     account.BankUrl,
+		Qrcode:      account.Qrcode,
+		CardAccount: account.CardAccount,
+		CardNo:      account.CardNo,
+		DepositBank: account.DepositBank,
+		EachMin:     account.EachMin,
+		EachMax:     account.EachMax,
+		DailyMax:    account.DailyMax,
+		TodayCount:  int32(account.TodayCount),
+		TodayAmount: account.TodayAmount,
+		Status:      int32(account.Status),
+		Sort:        int32(account.Sort),
+		Weight:      int32(account.Weight),
+		Remark:      account.Remark,
+		LevelIds:    levelIds,
+		CreatedAt:   util.FormatTime(account.CreatedAt),
+		UpdatedAt:   util.FormatTime(account.UpdatedAt),
+	}
+}
@@ -0,0 +1,266 @@
+package rebate
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// 每次计算的会员数
+const rebateBatchSize = 500
+
+// rebateTier 返水档位，单日有效投注在 [最小金额, 最大金额) 内适用，最大金额为0表示不限
+type rebateTier struct {
+	option   *entity.RebateRuleOption
+	percents map[int]float64 // 游戏ID -> 返水比例
+}
+
+// rebateConfig 站点的返水配置
+type rebateConfig struct {
+	levels map[int]*entity.UserLevel // 开启返水且规则启用的层级
+	tiers  map[int][]*rebateTier     // 规则ID -> 档位，按最小金额排序
+	grades map[int]*entity.UserGrade // 可用的会员等级
+}
+
+// loadRebateConfig 加载站点的返水层级、规则档位和等级额外返水比例
+func loadRebateConfig(ctx context.Context, siteId int) (*rebateConfig, error) {
+	config := &rebateConfig{
+		levels: make(map[int]*entity.UserLevel),
+		tiers:  make(map[int][]*rebateTier),
+		grades: make(map[int]*entity.UserGrade),
+	}
+
+	var rules []*entity.RebateRule
+	err := dao.RebateRule.Ctx(ctx).Where(do.RebateRule{SiteId: siteId, Status: 1}).Scan(&rules)
+	if err != nil {
+		return nil, fmt.Errorf("查询返水规则失败: %v", err)
+	}
+	if len(rules) == 0 {
+		return config, nil
+	}
+	ruleIds := make([]int, 0, len(rules))
+	for _, rule := range rules {
+		ruleIds = append(ruleIds, int(rule.Id))
+	}
+
+	var levels []*entity.UserLevel
+	err = dao.UserLevel.Ctx(ctx).
+		Where(do.UserLevel{SiteId: siteId, IsRebate: 1}).
+		WhereIn("rebate_rule_id", ruleIds).
+		Scan(&levels)
+	if err != nil {
+		return nil, fmt.Errorf("查询会员层级失败: %v", err)
+	}
+	for _, level := range levels {
+		config.levels[int(level.Id)] = level
+	}
+
+	var options []*entity.RebateRuleOption
+	err = dao.RebateRuleOption.Ctx(ctx).Where(do.RebateRuleOption{SiteId: siteId}).WhereIn("rule_id", ruleIds).Scan(&options)
+	if err != nil {
+		return nil, fmt.Errorf("查询返水档位失败: %v", err)
+	}
+	var games []*entity.RebateRuleOptionGame
+	err = dao.RebateRuleOptionGame.Ctx(ctx).Where(do.RebateRuleOptionGame{SiteId: siteId}).WhereIn("rule_id", ruleIds).Scan(&games)
+	if err != nil {
+		return nil, fmt.Errorf("查询返水比例失败: %v", err)
+	}
+	percents := make(map[int]map[int]float64, len(options))
+	for _, game := range games {
+		if percents[game.RuleOptionId] == nil {
+			percents[game.RuleOptionId] = make(map[int]float64)
+		}
+		percents[game.RuleOptionId][game.GameId] = game.Percent
+	}
+	for _, option := range options {
+		config.tiers[option.RuleId] = append(config.tiers[option.RuleId], &rebateTier{
+			option:   option,
+			percents: percents[int(option.Id)],
+		})
+	}
+	for _, tiers := range config.tiers {
+		sort.Slice(tiers, func(i, j int) bool {
+			return tiers[i].option.DailyValidBetMin < tiers[j].option.DailyValidBetMin
+		})
+	}
+
+	var grades []*entity.UserGrade
+	err = dao.UserGrade.Ctx(ctx).Where(do.UserGrade{SiteId: siteId, Status: 1}).Scan(&grades)
+	if err != nil {
+		return nil, fmt.Errorf("查询会员等级失败: %v", err)
+	}
+	for _, grade := range grades {
+		config.grades[int(grade.Id)] = grade
+	}
+	return config, nil
+}
+
+// tier 有效投注适用的档位，没有时返回 nil
+func (c *rebateConfig) tier(ruleId int, validBet float64) *rebateTier {
+	return pickTier(c.tiers[ruleId], validBet)
+}
+
+// pickTier 选取有效投注所在区间 [最小金额, 最大金额) 的档位，最大金额为0表示不限，没有时返回 nil
+func pickTier(tiers []*rebateTier, validBet float64) *rebateTier {
+	for _, tier := range tiers {
+		if validBet >= tier.option.DailyValidBetMin &&
+			(tier.option.DailyValidBetMax <= 0 || validBet < tier.option.DailyValidBetMax) {
+			return tier
+		}
+	}
+	return nil
+}
+
+// gradePercent 会员等级对游戏类型的额外返水比例
+func (c *rebateConfig) gradePercent(gradeId, gameType int) float64 {
+	grade := c.grades[gradeId]
+	if grade == nil {
+		return 0
+	}
+	switch gameType {
+	case consts.GameTypeSports:
+		return grade.RebatePercentSports
+	case consts.GameTypeLottery:
+		return grade.RebatePercentLottery
+	case consts.GameTypeLive:
+		return grade.RebatePercentLive
+	case consts.GameTypeEgame:
+		return grade.RebatePercentEgame
+	}
+	return 0
+}
+
+// calculateRebate 按会员每个游戏的单日有效投注计算返水，只计算不保存
+// 档位按会员在该游戏的有效投注选取，返水比例为档位中该游戏的比例加上等级的额外比例
+// 层级未开启返水、规则未启用或状态异常的会员不返水
+func calculateRebate(ctx context.Context, siteId int, date *gtime.Time) ([]*entity.RebateDetail, error) {
+	config, err := loadRebateConfig(ctx, siteId)
+	if err != nil {
+		return nil, err
+	}
+	if len(config.levels) == 0 {
+		return nil, nil
+	}
+
+	betDate := date.Format("Y-m-d")
+	rebateDate := gtime.NewFromStr(betDate)
+	now := gtime.Now()
+
+	var details []*entity.RebateDetail
+	lastUserId := 0
+	for {
+		values, err := dao.BetLogDaily.Ctx(ctx).
+			Fields("DISTINCT user_id").
+			Where(do.BetLogDaily{SiteId: siteId}).
+			Where("bet_date", betDate).
+			WhereGT("user_id", lastUserId).
+			OrderAsc("user_id").
+			Limit(rebateBatchSize).
+			Array()
+		if err != nil {
+			return nil, fmt.Errorf("查询投注会员失败: %v", err)
+		}
+		if len(values) == 0 {
+			return details, nil
+		}
+		userIds := make([]int, 0, len(values))
+		for _, value := range values {
+			userIds = append(userIds, value.Int())
+		}
+		lastUserId = userIds[len(userIds)-1]
+
+		var users []*entity.User
+		err = dao.User.Ctx(ctx).
+			Fields("id, username, status, level_id, grade_id").
+			Where(do.User{SiteId: siteId, Status: 1}).
+			WhereIn("id", userIds).
+			Scan(&users)
+		if err != nil {
+			return nil, fmt.Errorf("查询会员失败: %v", err)
+		}
+		byId := make(map[int]*entity.User, len(users))
+		for _, user := range users {
+			if config.levels[user.LevelId] != nil {
+				byId[int(user.Id)] = user
+			}
+		}
+
+		if len(byId) > 0 {
+			var bets []*entity.BetLogDaily
+			err = dao.BetLogDaily.Ctx(ctx).
+				Fields("user_id, game_id, MAX(game_type) AS game_type, SUM(valid_bet_amount) AS valid_bet_amount").
+				Where(do.BetLogDaily{SiteId: siteId}).
+				Where("bet_date", betDate).
+				WhereIn("user_id", userIds).
+				Group("user_id, game_id").
+				OrderAsc("user_id").
+				OrderAsc("game_id").
+				Scan(&bets)
+			if err != nil {
+				return nil, fmt.Errorf("查询投注汇总失败: %v", err)
+			}
+
+			for _, bet := range bets {
+				user := byId[int(bet.UserId)]
+				if user == nil || bet.ValidBetAmount <= 0 {
+					continue
+				}
+				ruleId := config.levels[user.LevelId].RebateRuleId
+				tier := config.tier(ruleId, bet.ValidBetAmount)
+				if tier == nil {
+					continue
+				}
+				percent := tier.percents[int(bet.GameId)]
+				gradePercent := config.gradePercent(user.GradeId, int(bet.GameType))
+				money := math.Round(bet.ValidBetAmount*(percent+gradePercent)) / 100
+				if money <= 0 {
+					continue
+				}
+				details = append(details, &entity.RebateDetail{
+					SiteId:         siteId,
+					RebateDate:     rebateDate,
+					UserId:         int(user.Id),
+					Username:       user.Username,
+					LevelId:        user.LevelId,
+					GradeId:        user.GradeId,
+					RuleId:         ruleId,
+					RuleOptionId:   int(tier.option.Id),
+					GameId:         int(bet.GameId),
+					GameType:       int(bet.GameType),
+					ValidBetAmount: bet.ValidBetAmount,
+					Percent:        percent,
+					GradePercent:   gradePercent,
+					Money:          money,
+					Status:         consts.RebateStatusPending,
+					CreatedAt:      now,
+					UpdatedAt:      now,
+				})
+			}
+		}
+
+		if len(values) < rebateBatchSize {
+			return details, nil
+		}
+	}
+}
+
+// rebateTotals 统计返水人数、有效投注和返水金额
+func rebateTotals(details []*entity.RebateDetail) (int, float64, float64) {
+	users := make(map[int]bool)
+	validBet := 0.0
+	money := 0.0
+	for _, detail := range details {
+		users[detail.UserId] = true
+		validBet += detail.ValidBetAmount
+		money += detail.Money
+	}
+	return len(users), math.Round(validBet*100) / 100, math.Round(money*100) / 100
+}
@@ -0,0 +1,41 @@
+package rebate
+
+import (
+	"testing"
+
+	"jh_app_service/internal/model/entity"
+)
+
+func TestPickTier(t *testing.T) {
+	tiers := []*rebateTier{
+		{option: &entity.RebateRuleOption{Id: 1, DailyValidBetMin: 100, DailyValidBetMax: 1000}},
+		{option: &entity.RebateRuleOption{Id: 2, DailyValidBetMin: 1000, DailyValidBetMax: 5000}},
+		{option: &entity.RebateRuleOption{Id: 3, DailyValidBetMin: 5000, DailyValidBetMax: 0}},
+	}
+	cases := []struct {
+		validBet float64
+		want     uint
+	}{
+		{0, 0},
+		{99.99, 0},
+		{100, 1},
+		{999.99, 1},
+		{1000, 2},
+		{4999.99, 2},
+		{5000, 3},
+		{1e9, 3},
+	}
+	for _, c := range cases {
+		var got uint
+		if tier := pickTier(tiers, c.validBet); tier != nil {
+			got = tier.option.Id
+		}
+		if got != c.want {
+			t.Errorf("pickTier(%.2f) = %d, want %d", c.validBet, got, c.want)
+		}
+	}
+
+	if tier := pickTier(tiers[:2], 5000); tier != nil {
+		t.Errorf("最后一档有上限时超出上限不应返水: %d", tier.option.Id)
+	}
+}
@@ -0,0 +1,626 @@
+package rebate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	v1 "jh_app_service/api/backend/rebate/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+	"jh_app_service/internal/util"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+type (
+	sRebate struct{}
+)
+
+// 每次写入的返水明细数
+const rebateInsertBatchSize = 500
+
+// errRebatePaid 返水已审核发放，不能重新计算
+var errRebatePaid = errors.New("该日返水已审核发放，不能重新计算")
+
+func init() {
+	backend.RegisterRebate(&sRebate{})
+}
+
+// PreviewRebate 预览指定日期的返水计算结果，不保存
+func (s *sRebate) PreviewRebate(ctx context.Context, req *v1.PreviewRebateReq) (*v1.PreviewRebateRes, error) {
+	middleware.LogWithTrace(ctx, "info", "预览返水请求 - Date: %s, Page: %d, Size: %d, Username: %s", req.Date, req.Page, req.Size, req.Username)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	date, message := parseRebateDate(req.Date)
+	if message != "" {
+		return &v1.PreviewRebateRes{Success: false, Message: message}, nil
+	}
+
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	size := req.Size
+	if size <= 0 {
+		size = 50
+	}
+
+	details, err := calculateRebate(ctx, siteId, date)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "计算返水失败: %v", err)
+		return nil, err
+	}
+	userCount, validBet, money := rebateTotals(details)
+
+	if req.Username != "" {
+		filtered := details[:0]
+		for _, detail := range details {
+			if detail.Username == req.Username {
+				filtered = append(filtered, detail)
+			}
+		}
+		details = filtered
+	}
+	count := len(details)
+	start := int((page - 1) * size)
+	if start > count {
+		start = count
+	}
+	end := start + int(size)
+	if end > count {
+		end = count
+	}
+
+	list, err := s.toRebateDetailInfos(ctx, siteId, details[start:end])
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.PreviewRebateRes{
+		Success:        true,
+		UserCount:      int32(userCount),
+		ValidBetAmount: validBet,
+		Money:          money,
+		List:           list,
+		Count:          int32(count),
+	}, nil
+}
+
+// CalculateRebate 计算指定日期的返水并保存为待审核，待审核或已驳回的日期重新计算时替换原结果
+func (s *sRebate) CalculateRebate(ctx context.Context, req *v1.CalculateRebateReq) (*v1.CalculateRebateRes, error) {
+	middleware.LogWithTrace(ctx, "info", "计算返水请求 - Date: %s", req.Date)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	date, message := parseRebateDate(req.Date)
+	if message != "" {
+		return &v1.CalculateRebateRes{Success: false, Message: message}, nil
+	}
+
+	history, err := s.calculateAndSave(ctx, siteId, date)
+	if errors.Is(err, errRebatePaid) {
+		return &v1.CalculateRebateRes{Success: false, Message: err.Error()}, nil
+	}
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "计算返水失败: %v", err)
+		return nil, err
+	}
+
+	logMessage := fmt.Sprintf("计算 %s 返水，%d 人，共 %.2f", date.Format("Y-m-d"), history.UserCount, history.Money)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	return &v1.CalculateRebateRes{
+		Success:        true,
+		Message:        "计算完成，请审核后发放",
+		UserCount:      int32(history.UserCount),
+		ValidBetAmount: history.ValidBetAmount,
+		Money:          history.Money,
+	}, nil
+}
+
+// CalculateRebates 为所有站点计算昨天的返水，供定时任务调用，已发放的日期跳过
+func (s *sRebate) CalculateRebates(ctx context.Context) error {
+	siteIds, err := dao.SiteConfig.Ctx(ctx).Fields("site_id").Array()
+	if err != nil {
+		return fmt.Errorf("查询站点失败: %v", err)
+	}
+	yesterday := gtime.Now().AddDate(0, 0, -1)
+	for _, value := range siteIds {
+		history, err := s.calculateAndSave(ctx, value.Int(), yesterday)
+		if errors.Is(err, errRebatePaid) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("站点 %d 计算返水失败: %v", value.Int(), err)
+		}
+		middleware.LogWithTrace(ctx, "info", "返水计算完成 - SiteId: %d, 人数: %d, 金额: %.2f", value.Int(), history.UserCount, history.Money)
+	}
+	return nil
+}
+
+// ApproveRebate 审核通过并发放返水，每个会员一笔账变，已发放的会员不会重复发放
+func (s *sRebate) ApproveRebate(ctx context.Context, req *v1.ApproveRebateReq) (*v1.ApproveRebateRes, error) {
+	middleware.LogWithTrace(ctx, "info", "审核返水请求 - Date: %s", req.Date)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	admin := backend.Admin().CurrentAdmin(ctx)
+	if admin == nil {
+		return &v1.ApproveRebateRes{Success: false, Message: "未登录或登录已过期"}, nil
+	}
+	date, err := gtime.StrToTimeFormat(req.Date, "Y-m-d")
+	if err != nil {
+		return &v1.ApproveRebateRes{Success: false, Message: "日期格式错误"}, nil
+	}
+	rebateDate := date.Format("Y-m-d")
+
+	message := ""
+	err = dao.RebateHistory.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		history, err := lockRebateHistory(ctx, siteId, rebateDate)
+		if err != nil {
+			return err
+		}
+		switch {
+		case history == nil:
+			message = "请先计算该日返水"
+		case history.Status == consts.RebateStatusRejected:
+			message = "该日返水已驳回，请重新计算"
+		case history.Status == consts.RebateStatusPending:
+			_, err = dao.RebateHistory.Ctx(ctx).Where("id", history.Id).Data(do.RebateHistory{
+				Status:     consts.RebateStatusPaid,
+				AdminId:    admin.Id,
+				ApprovedAt: gtime.Now(),
+				Remark:     req.Remark,
+				UpdatedAt:  gtime.Now(),
+			}).Update()
+		}
+		// 已审核的日期继续发放之前失败的会员
+		return err
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "审核返水失败: %v", err)
+		return nil, err
+	}
+	if message != "" {
+		return &v1.ApproveRebateRes{Success: false, Message: message}, nil
+	}
+
+	paid, failed, money, err := s.payRebate(ctx, siteId, rebateDate, int(admin.Id))
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "发放返水失败: %v", err)
+		return nil, err
+	}
+
+	logMessage := fmt.Sprintf("审核发放 %s 返水，发放 %d 人，共 %.2f，失败 %d 人", rebateDate, paid, money, failed)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	res := &v1.ApproveRebateRes{
+		Success: true,
+		Message: fmt.Sprintf("发放 %d 人", paid),
+		Paid:    int32(paid),
+		Money:   money,
+		Failed:  int32(failed),
+	}
+	if failed > 0 {
+		res.Message += fmt.Sprintf("，失败 %d 人，请重新提交补发", failed)
+	}
+	return res, nil
+}
+
+// RejectRebate 驳回待审核的返水，驳回后可重新计算
+func (s *sRebate) RejectRebate(ctx context.Context, req *v1.RejectRebateReq) (*v1.RejectRebateRes, error) {
+	middleware.LogWithTrace(ctx, "info", "驳回返水请求 - Date: %s", req.Date)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	admin := backend.Admin().CurrentAdmin(ctx)
+	if admin == nil {
+		return &v1.RejectRebateRes{Success: false, Message: "未登录或登录已过期"}, nil
+	}
+	date, err := gtime.StrToTimeFormat(req.Date, "Y-m-d")
+	if err != nil {
+		return &v1.RejectRebateRes{Success: false, Message: "日期格式错误"}, nil
+	}
+	if req.Remark == "" {
+		return &v1.RejectRebateRes{Success: false, Message: "请填写驳回原因"}, nil
+	}
+	rebateDate := date.Format("Y-m-d")
+
+	message := ""
+	err = dao.RebateHistory.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		history, err := lockRebateHistory(ctx, siteId, rebateDate)
+		if err != nil {
+			return err
+		}
+		if history == nil || history.Status != consts.RebateStatusPending {
+			message = "该日没有待审核的返水"
+			return nil
+		}
+		_, err = dao.RebateHistory.Ctx(ctx).Where("id", history.Id).Data(do.RebateHistory{
+			Status:     consts.RebateStatusRejected,
+			AdminId:    admin.Id,
+			ApprovedAt: gtime.Now(),
+			Remark:     req.Remark,
+			UpdatedAt:  gtime.Now(),
+		}).Update()
+		if err != nil {
+			return err
+		}
+		_, err = dao.RebateDetail.Ctx(ctx).
+			Where(do.RebateDetail{SiteId: siteId, Status: consts.RebateStatusPending}).
+			Where("rebate_date", rebateDate).
+			Data(do.RebateDetail{Status: consts.RebateStatusRejected, UpdatedAt: gtime.Now()}).
+			Update()
+		return err
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "驳回返水失败: %v", err)
+		return nil, err
+	}
+	if message != "" {
+		return &v1.RejectRebateRes{Success: false, Message: message}, nil
+	}
+
+	logMessage := fmt.Sprintf("驳回 %s 返水，原因: %s", rebateDate, req.Remark)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	return &v1.RejectRebateRes{Success: true, Message: "已驳回"}, nil
+}
+
+// GetRebateHistories 获取每日返水记录
+func (s *sRebate) GetRebateHistories(ctx context.Context, req *v1.GetRebateHistoriesReq) (*v1.GetRebateHistoriesRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取返水记录请求 - Page: %d, Size: %d, Status: %d", req.Page, req.Size, req.Status)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	size := req.Size
+	if size <= 0 {
+		size = 50
+	}
+
+	query := dao.RebateHistory.Ctx(ctx).Where(do.RebateHistory{SiteId: siteId})
+	if req.Status >= 0 {
+		query = query.Where("status", req.Status)
+	}
+	if req.StartDate != "" {
+		query = query.WhereGTE("rebate_date", req.StartDate)
+	}
+	if req.EndDate != "" {
+		query = query.WhereLTE("rebate_date", req.EndDate)
+	}
+
+	total, err := query.Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取返水记录总数失败: %v", err)
+		return nil, err
+	}
+
+	var histories []*entity.RebateHistory
+	err = query.Page(int(page), int(size)).OrderDesc("rebate_date").Scan(&histories)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取返水记录失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]*v1.RebateHistoryInfo, 0, len(histories))
+	for _, history := range histories {
+		info := &v1.RebateHistoryInfo{
+			Id:             int32(history.Id),
+			UserCount:      int32(history.UserCount),
+			ValidBetAmount: history.ValidBetAmount,
+			Money:          history.Money,
+			Status:         int32(history.Status),
+			AdminId:        int32(history.AdminId),
+			ApprovedAt:     util.FormatTime(history.ApprovedAt),
+			Remark:         history.Remark,
+			CreatedAt:      util.FormatTime(history.CreatedAt),
+			UpdatedAt:      util.FormatTime(history.UpdatedAt),
+		}
+		if history.RebateDate != nil {
+			info.RebateDate = history.RebateDate.Format("Y-m-d")
+		}
+		list = append(list, info)
+	}
+
+	return &v1.GetRebateHistoriesRes{
+		List:  list,
+		Count: int32(total),
+	}, nil
+}
+
+// GetRebateDetails 获取指定日期的会员返水明细
+func (s *sRebate) GetRebateDetails(ctx context.Context, req *v1.GetRebateDetailsReq) (*v1.GetRebateDetailsRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取返水明细请求 - Date: %s, Page: %d, Size: %d, Username: %s, Status: %d", req.Date, req.Page, req.Size, req.Username, req.Status)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	size := req.Size
+	if size <= 0 {
+		size = 50
+	}
+
+	query := dao.RebateDetail.Ctx(ctx).Where(do.RebateDetail{SiteId: siteId})
+	if req.Date != "" {
+		query = query.Where("rebate_date", req.Date)
+	}
+	if req.Username != "" {
+		query = query.Where("username", req.Username)
+	}
+	if req.Status >= 0 {
+		query = query.Where("status", req.Status)
+	}
+
+	total, err := query.Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取返水明细总数失败: %v", err)
+		return nil, err
+	}
+	money, err := query.Sum("money")
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "统计返水金额失败: %v", err)
+		return nil, err
+	}
+
+	var details []*entity.RebateDetail
+	err = query.Page(int(page), int(size)).OrderAsc("user_id").OrderAsc("game_id").Scan(&details)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取返水明细失败: %v", err)
+		return nil, err
+	}
+
+	list, err := s.toRebateDetailInfos(ctx, siteId, details)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetRebateDetailsRes{
+		List:  list,
+		Count: int32(total),
+		Money: math.Round(money*100) / 100,
+	}, nil
+}
+
+// calculateAndSave 计算返水并在事务中替换该日的待审核结果，已发放的日期返回 errRebatePaid
+func (s *sRebate) calculateAndSave(ctx context.Context, siteId int, date *gtime.Time) (*entity.RebateHistory, error) {
+	details, err := calculateRebate(ctx, siteId, date)
+	if err != nil {
+		return nil, err
+	}
+	userCount, validBet, money := rebateTotals(details)
+	rebateDate := date.Format("Y-m-d")
+
+	history := &entity.RebateHistory{
+		UserCount:      uint(userCount),
+		ValidBetAmount: validBet,
+		Money:          money,
+	}
+	err = dao.RebateHistory.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		existing, err := lockRebateHistory(ctx, siteId, rebateDate)
+		if err != nil {
+			return err
+		}
+		if existing != nil && existing.Status == consts.RebateStatusPaid {
+			return errRebatePaid
+		}
+
+		_, err = dao.RebateDetail.Ctx(ctx).Where(do.RebateDetail{SiteId: siteId}).Where("rebate_date", rebateDate).Delete()
+		if err != nil {
+			return err
+		}
+		for start := 0; start < len(details); start += rebateInsertBatchSize {
+			end := start + rebateInsertBatchSize
+			if end > len(details) {
+				end = len(details)
+			}
+			if _, err = dao.RebateDetail.Ctx(ctx).Data(details[start:end]).OmitEmptyData().Insert(); err != nil {
+				return err
+			}
+		}
+
+		if existing == nil {
+			_, err = dao.RebateHistory.Ctx(ctx).Data(do.RebateHistory{
+				SiteId:         siteId,
+				RebateDate:     gtime.NewFromStr(rebateDate),
+				UserCount:      userCount,
+				ValidBetAmount: validBet,
+				Money:          money,
+				Status:         consts.RebateStatusPending,
+				CreatedAt:      gtime.Now(),
+				UpdatedAt:      gtime.Now(),
+			}).Insert()
+			return err
+		}
+		// 重新计算时清除驳回的审核信息
+		_, err = dao.RebateHistory.Ctx(ctx).Where("id", existing.Id).Data(g.Map{
+			"user_count":       userCount,
+			"valid_bet_amount": validBet,
+			"money":            money,
+			"status":           consts.RebateStatusPending,
+			"admin_id":         0,
+			"approved_at":      nil,
+			"remark":           "",
+			"updated_at":       gtime.Now(),
+		}).Update()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// payRebate 发放已审核日期中未发放的会员返水，单个会员失败不影响其他会员，重新提交时补发
+func (s *sRebate) payRebate(ctx context.Context, siteId int, rebateDate string, adminId int) (paid, failed int, money float64, err error) {
+	var members []*entity.RebateDetail
+	err = dao.RebateDetail.Ctx(ctx).
+		Fields("user_id, SUM(money) AS money").
+		Where(do.RebateDetail{SiteId: siteId, Status: consts.RebateStatusPending}).
+		Where("rebate_date", rebateDate).
+		Group("user_id").
+		OrderAsc("user_id").
+		Scan(&members)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("查询待发放返水失败: %v", err)
+	}
+
+	tradeDate := gtime.NewFromStr(rebateDate).Format("Ymd")
+	for _, member := range members {
+		tradeNo := fmt.Sprintf("RB%d_%s", member.UserId, tradeDate)
+		err := dao.RebateDetail.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+			if member.Money > 0 {
+				_, _, err := backend.Balance().PostLedger(ctx, &model.LedgerEntry{
+					SiteId:     siteId,
+					UserId:     member.UserId,
+					ChangeType: consts.ChangeTypeIn,
+					TradeType:  consts.TradeTypeRebate,
+					TradeNo:    tradeNo,
+					Money:      member.Money,
+					AdminId:    adminId,
+					Remark:     rebateDate + " 返水",
+				})
+				if err != nil {
+					return err
+				}
+			}
+			_, err := dao.RebateDetail.Ctx(ctx).
+				Where(do.RebateDetail{SiteId: siteId, UserId: member.UserId, Status: consts.RebateStatusPending}).
+				Where("rebate_date", rebateDate).
+				Data(do.RebateDetail{Status: consts.RebateStatusPaid, TradeNo: tradeNo, UpdatedAt: gtime.Now()}).
+				Update()
+			return err
+		})
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "发放返水失败 - UserId: %d, 错误: %v", member.UserId, err)
+			failed++
+			continue
+		}
+		paid++
+		money += member.Money
+	}
+	return paid, failed, math.Round(money*100) / 100, nil
+}
+
+// toRebateDetailInfos 转换返水明细并补充层级、等级、规则和游戏名称
+func (s *sRebate) toRebateDetailInfos(ctx context.Context, siteId int, details []*entity.RebateDetail) ([]*v1.RebateDetailInfo, error) {
+	list := make([]*v1.RebateDetailInfo, 0, len(details))
+	if len(details) == 0 {
+		return list, nil
+	}
+
+	levelNames := make(map[int]string)
+	var levels []*entity.UserLevel
+	if err := dao.UserLevel.Ctx(ctx).Fields("id, name").Where(do.UserLevel{SiteId: siteId}).Scan(&levels); err != nil {
+		return nil, fmt.Errorf("查询会员层级失败: %v", err)
+	}
+	for _, level := range levels {
+		levelNames[int(level.Id)] = level.Name
+	}
+	gradeNames := make(map[int]string)
+	var grades []*entity.UserGrade
+	if err := dao.UserGrade.Ctx(ctx).Fields("id, name").Where(do.UserGrade{SiteId: siteId}).Scan(&grades); err != nil {
+		return nil, fmt.Errorf("查询会员等级失败: %v", err)
+	}
+	for _, grade := range grades {
+		gradeNames[int(grade.Id)] = grade.Name
+	}
+	ruleNames := make(map[int]string)
+	var rules []*entity.RebateRule
+	if err := dao.RebateRule.Ctx(ctx).Fields("id, name").Where(do.RebateRule{SiteId: siteId}).Scan(&rules); err != nil {
+		return nil, fmt.Errorf("查询返水规则失败: %v", err)
+	}
+	for _, rule := range rules {
+		ruleNames[int(rule.Id)] = rule.Name
+	}
+	gameNames := make(map[int]string)
+	var games []*entity.SiteGame
+	if err := dao.SiteGame.Ctx(ctx).Fields("game_id, name").Where(do.SiteGame{SiteId: siteId}).Scan(&games); err != nil {
+		return nil, fmt.Errorf("查询游戏失败: %v", err)
+	}
+	for _, game := range games {
+		gameNames[game.GameId] = game.Name
+	}
+
+	for _, detail := range details {
+		info := &v1.RebateDetailInfo{
+			Id:             int64(detail.Id),
+			UserId:         int32(detail.UserId),
+			Username:       detail.Username,
+			LevelId:        int32(detail.LevelId),
+			LevelName:      levelNames[detail.LevelId],
+			GradeId:        int32(detail.GradeId),
+			GradeName:      gradeNames[detail.GradeId],
+			RuleId:         int32(detail.RuleId),
+			RuleName:       ruleNames[detail.RuleId],
+			GameId:         int32(detail.GameId),
+			GameName:       gameNames[detail.GameId],
+			GameType:       int32(detail.GameType),
+			ValidBetAmount: detail.ValidBetAmount,
+			Percent:        detail.Percent,
+			GradePercent:   detail.GradePercent,
+			Money:          detail.Money,
+			Status:         int32(detail.Status),
+			TradeNo:        detail.TradeNo,
+		}
+		if detail.RebateDate != nil {
+			info.RebateDate = detail.RebateDate.Format("Y-m-d")
+		}
+		list = append(list, info)
+	}
+	return list, nil
+}
+
+// lockRebateHistory 锁定站点某日的返水记录，需在事务中调用
+func lockRebateHistory(ctx context.Context, siteId int, rebateDate string) (*entity.RebateHistory, error) {
+	var history *entity.RebateHistory
+	err := dao.RebateHistory.Ctx(ctx).
+		Where(do.RebateHistory{SiteId: siteId}).
+		Where("rebate_date", rebateDate).
+		LockUpdate().
+		Scan(&history)
+	return history, err
+}
+
+// parseRebateDate 解析返水日期，默认昨天，只能计算今天以前的日期
+func parseRebateDate(value string) (*gtime.Time, string) {
+	if value == "" {
+		return gtime.Now().AddDate(0, 0, -1), ""
+	}
+	date, err := gtime.StrToTimeFormat(value, "Y-m-d")
+	if err != nil {
+		return nil, "日期格式错误"
+	}
+	if !date.Before(gtime.Now().StartOfDay()) {
+		return nil, "只能计算今天以前的返水"
+	}
+	return date, ""
+}
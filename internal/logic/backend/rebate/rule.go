@@ -0,0 +1,527 @@
+package rebate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "jh_app_service/api/backend/rebate/v1"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+	"jh_app_service/internal/util"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// 单个规则最多设置的档位数
+const rebateTierLimit = 20
+
+// GetRebateRules 获取返水规则列表及各规则被会员层级使用的数量
+func (s *sRebate) GetRebateRules(ctx context.Context, req *v1.GetRebateRulesReq) (*v1.GetRebateRulesRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取返水规则列表请求 - Status: %d", req.Status)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	query := dao.RebateRule.Ctx(ctx).Where(do.RebateRule{SiteId: siteId})
+	if req.Status >= 0 {
+		query = query.Where("status", req.Status)
+	}
+	var rules []*entity.RebateRule
+	if err := query.OrderAsc("id").Scan(&rules); err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取返水规则列表失败: %v", err)
+		return nil, err
+	}
+
+	levelCounts, err := s.ruleLevelCounts(ctx, siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*v1.RebateRuleInfo, 0, len(rules))
+	for _, rule := range rules {
+		list = append(list, &v1.RebateRuleInfo{
+			Id:         int32(rule.Id),
+			Name:       rule.Name,
+			Status:     int32(rule.Status),
+			LevelCount: int32(levelCounts[int(rule.Id)]),
+			CreatedAt:  util.FormatTime(rule.CreatedAt),
+			UpdatedAt:  util.FormatTime(rule.UpdatedAt),
+		})
+	}
+
+	return &v1.GetRebateRulesRes{List: list}, nil
+}
+
+// GetRebateRule 获取返水规则详情，包含档位和各游戏返水比例
+func (s *sRebate) GetRebateRule(ctx context.Context, req *v1.GetRebateRuleReq) (*v1.GetRebateRuleRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取返水规则详情请求 - ID: %d", req.Id)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	rule, err := s.getRebateRule(ctx, siteId, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	if rule == nil {
+		return &v1.GetRebateRuleRes{}, nil
+	}
+
+	tiers, err := s.ruleTiers(ctx, siteId, int(rule.Id))
+	if err != nil {
+		return nil, err
+	}
+	gameNames, err := s.siteGameNames(ctx, siteId)
+	if err != nil {
+		return nil, err
+	}
+	for _, tier := range tiers {
+		for _, game := range tier.Games {
+			game.GameName = gameNames[int(game.GameId)]
+		}
+	}
+	levelCounts, err := s.ruleLevelCounts(ctx, siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetRebateRuleRes{Rule: &v1.RebateRuleInfo{
+		Id:         int32(rule.Id),
+		Name:       rule.Name,
+		Status:     int32(rule.Status),
+		Tiers:      tiers,
+		LevelCount: int32(levelCounts[int(rule.Id)]),
+		CreatedAt:  util.FormatTime(rule.CreatedAt),
+		UpdatedAt:  util.FormatTime(rule.UpdatedAt),
+	}}, nil
+}
+
+// CreateRebateRule 创建返水规则，规则、档位和返水比例在同一事务中保存
+func (s *sRebate) CreateRebateRule(ctx context.Context, req *v1.CreateRebateRuleReq) (*v1.CreateRebateRuleRes, error) {
+	middleware.LogWithTrace(ctx, "info", "创建返水规则请求 - Name: %s, Status: %d, Tiers: %d", req.Name, req.Status, len(req.Tiers))
+
+	// 默认站点ID为1
+	siteId := 1
+
+	name := strings.TrimSpace(req.Name)
+	message, err := s.validateRebateRule(ctx, siteId, 0, name, int(req.Status), req.Tiers)
+	if err != nil {
+		return nil, err
+	}
+	if message != "" {
+		return &v1.CreateRebateRuleRes{Success: false, Message: message}, nil
+	}
+
+	var id int64
+	err = dao.RebateRule.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		var err error
+		id, err = dao.RebateRule.Ctx(ctx).Data(do.RebateRule{
+			SiteId:    siteId,
+			Name:      name,
+			Status:    req.Status,
+			CreatedAt: gtime.Now(),
+			UpdatedAt: gtime.Now(),
+		}).InsertAndGetId()
+		if err != nil {
+			return err
+		}
+		return s.saveRuleTiers(ctx, siteId, int(id), req.Tiers)
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "创建返水规则失败: %v", err)
+		return nil, fmt.Errorf("创建返水规则失败: %v", err)
+	}
+
+	logMessage := fmt.Sprintf("创建返水规则 %s [ID:%d]，状态:%d，档位: %s", name, id, req.Status, describeRebateTiers(req.Tiers))
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "创建返水规则成功 - ID: %d", id)
+	return &v1.CreateRebateRuleRes{Success: true, Message: "创建成功", Id: int32(id)}, nil
+}
+
+// UpdateRebateRule 修改返水规则，档位和返水比例整体替换
+func (s *sRebate) UpdateRebateRule(ctx context.Context, req *v1.UpdateRebateRuleReq) (*v1.UpdateRebateRuleRes, error) {
+	middleware.LogWithTrace(ctx, "info", "修改返水规则请求 - ID: %d, Name: %s, Status: %d, Tiers: %d", req.Id, req.Name, req.Status, len(req.Tiers))
+
+	// 默认站点ID为1
+	siteId := 1
+
+	existing, err := s.getRebateRule(ctx, siteId, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return &v1.UpdateRebateRuleRes{Success: false, Message: "返水规则不存在"}, nil
+	}
+
+	name := strings.TrimSpace(req.Name)
+	message, err := s.validateRebateRule(ctx, siteId, int(existing.Id), name, int(req.Status), req.Tiers)
+	if err != nil {
+		return nil, err
+	}
+	if message != "" {
+		return &v1.UpdateRebateRuleRes{Success: false, Message: message}, nil
+	}
+
+	oldTiers, err := s.ruleTiers(ctx, siteId, int(existing.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	err = dao.RebateRule.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		// 锁定规则，会员层级选择规则时也会锁定，避免禁用时层级同时开启返水
+		_, err := dao.RebateRule.Ctx(ctx).Fields("id").Where("id", existing.Id).LockUpdate().Value()
+		if err != nil {
+			return err
+		}
+		// 开启返水的层级只能使用启用的规则
+		if req.Status == 0 {
+			count, err := dao.UserLevel.Ctx(ctx).Where(do.UserLevel{SiteId: siteId, RebateRuleId: existing.Id, IsRebate: 1}).Count()
+			if err != nil {
+				return err
+			}
+			if count > 0 {
+				message = fmt.Sprintf("有 %d 个开启返水的会员层级使用该规则，不能禁用", count)
+				return nil
+			}
+		}
+
+		_, err = dao.RebateRule.Ctx(ctx).Where("id", existing.Id).Data(g.Map{
+			"name":       name,
+			"status":     req.Status,
+			"updated_at": gtime.Now(),
+		}).Update()
+		if err != nil {
+			return err
+		}
+		if err = s.deleteRuleTiers(ctx, siteId, int(existing.Id)); err != nil {
+			return err
+		}
+		return s.saveRuleTiers(ctx, siteId, int(existing.Id), req.Tiers)
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "修改返水规则失败: %v", err)
+		return nil, fmt.Errorf("修改返水规则失败: %v", err)
+	}
+	if message != "" {
+		return &v1.UpdateRebateRuleRes{Success: false, Message: message}, nil
+	}
+
+	logMessage := fmt.Sprintf("修改返水规则 [ID:%d] 名称: %s→%s，状态: %d→%d，档位: %s → %s",
+		existing.Id, existing.Name, name, existing.Status, req.Status, describeRebateTiers(oldTiers), describeRebateTiers(req.Tiers))
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "修改返水规则成功 - ID: %d", existing.Id)
+	return &v1.UpdateRebateRuleRes{Success: true, Message: "修改成功"}, nil
+}
+
+// DeleteRebateRule 删除返水规则，仍被会员层级使用的规则不能删除
+func (s *sRebate) DeleteRebateRule(ctx context.Context, req *v1.DeleteRebateRuleReq) (*v1.DeleteRebateRuleRes, error) {
+	middleware.LogWithTrace(ctx, "info", "删除返水规则请求 - ID: %d", req.Id)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	rule, err := s.getRebateRule(ctx, siteId, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	if rule == nil {
+		return &v1.DeleteRebateRuleRes{Success: false, Message: "返水规则不存在"}, nil
+	}
+
+	message := ""
+	err = dao.RebateRule.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		// 锁定规则，会员层级选择规则时也会锁定，避免删除时层级同时选择该规则
+		_, err := dao.RebateRule.Ctx(ctx).Fields("id").Where("id", rule.Id).LockUpdate().Value()
+		if err != nil {
+			return err
+		}
+		var levels []*entity.UserLevel
+		err = dao.UserLevel.Ctx(ctx).Fields("id, name").Where(do.UserLevel{SiteId: siteId, RebateRuleId: rule.Id}).Scan(&levels)
+		if err != nil {
+			return err
+		}
+		if len(levels) > 0 {
+			names := make([]string, 0, len(levels))
+			for _, level := range levels {
+				names = append(names, level.Name)
+			}
+			message = fmt.Sprintf("会员层级 [%s] 正在使用该规则，请先修改层级的返水规则", strings.Join(names, ","))
+			return nil
+		}
+
+		if err = s.deleteRuleTiers(ctx, siteId, int(rule.Id)); err != nil {
+			return err
+		}
+		_, err = dao.RebateRule.Ctx(ctx).Where("id", rule.Id).Delete()
+		return err
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "删除返水规则失败: %v", err)
+		return nil, fmt.Errorf("删除返水规则失败: %v", err)
+	}
+	if message != "" {
+		return &v1.DeleteRebateRuleRes{Success: false, Message: message}, nil
+	}
+
+	logMessage := fmt.Sprintf("删除返水规则 %s [ID:%d]", rule.Name, rule.Id)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	return &v1.DeleteRebateRuleRes{Success: true, Message: "删除成功"}, nil
+}
+
+// validateRebateRule 校验返水规则，校验失败时返回提示信息
+// 档位按最小金额排序后必须首尾相接：下一档的最小金额等于上一档的最大金额，只有最后一档的最大金额可以为0 (不限)
+func (s *sRebate) validateRebateRule(ctx context.Context, siteId, id int, name string, status int, tiers []*v1.RebateRuleTier) (string, error) {
+	if name == "" {
+		return "规则名称不能为空", nil
+	}
+	if status != 0 && status != 1 {
+		return "状态错误", nil
+	}
+	query := dao.RebateRule.Ctx(ctx).Where(do.RebateRule{SiteId: siteId, Name: name})
+	if id > 0 {
+		query = query.WhereNot("id", id)
+	}
+	count, err := query.Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询返水规则失败: %v", err)
+		return "", err
+	}
+	if count > 0 {
+		return "规则名称已存在", nil
+	}
+
+	if len(tiers) == 0 {
+		return "请设置返水档位", nil
+	}
+	if len(tiers) > rebateTierLimit {
+		return fmt.Sprintf("最多设置 %d 个档位", rebateTierLimit), nil
+	}
+	sorted := sortRebateTiers(tiers)
+	if message := checkTierRanges(sorted); message != "" {
+		return message, nil
+	}
+
+	maxPercent := g.Cfg().MustGet(ctx, "rebate.maxPercent", 3).Float64()
+	gameNames, err := s.siteGameNames(ctx, siteId)
+	if err != nil {
+		return "", err
+	}
+	for _, tier := range sorted {
+		if len(tier.Games) == 0 {
+			return fmt.Sprintf("请设置档位 %.2f 的游戏返水比例", tier.DailyValidBetMin), nil
+		}
+		seen := make(map[int32]bool, len(tier.Games))
+		for _, game := range tier.Games {
+			if _, ok := gameNames[int(game.GameId)]; !ok {
+				return fmt.Sprintf("游戏 %d 不存在", game.GameId), nil
+			}
+			if seen[game.GameId] {
+				return fmt.Sprintf("档位 %.2f 的游戏 %s 重复", tier.DailyValidBetMin, gameNames[int(game.GameId)]), nil
+			}
+			seen[game.GameId] = true
+			if game.Percent < 0 || game.Percent > maxPercent {
+				return fmt.Sprintf("游戏 %s 的返水比例必须在 0-%.2f%% 之间", gameNames[int(game.GameId)], maxPercent), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// sortRebateTiers 按最小金额升序排列档位，返回新的切片
+func sortRebateTiers(tiers []*v1.RebateRuleTier) []*v1.RebateRuleTier {
+	sorted := make([]*v1.RebateRuleTier, len(tiers))
+	copy(sorted, tiers)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].DailyValidBetMin < sorted[j].DailyValidBetMin
+	})
+	return sorted
+}
+
+// checkTierRanges 校验已按最小金额排序的档位区间，失败时返回提示信息
+// 档位区间为 [最小金额, 最大金额)，必须首尾相接，只有最后一档的最大金额可以为0 (不限)
+func checkTierRanges(sorted []*v1.RebateRuleTier) string {
+	for i, tier := range sorted {
+		if tier.DailyValidBetMin < 0 || tier.DailyValidBetMax < 0 {
+			return "投注金额不能小于0"
+		}
+		last := i == len(sorted)-1
+		if tier.DailyValidBetMax == 0 && !last {
+			return "只有最后一个档位的最大金额可以不限"
+		}
+		if tier.DailyValidBetMax != 0 && tier.DailyValidBetMax <= tier.DailyValidBetMin {
+			return fmt.Sprintf("档位 %.2f 的最大金额必须大于最小金额", tier.DailyValidBetMin)
+		}
+		if i > 0 {
+			prev := sorted[i-1]
+			if tier.DailyValidBetMin < prev.DailyValidBetMax {
+				return fmt.Sprintf("档位 %.2f-%.2f 与 %.2f-%.2f 重叠", prev.DailyValidBetMin, prev.DailyValidBetMax, tier.DailyValidBetMin, tier.DailyValidBetMax)
+			}
+			if tier.DailyValidBetMin > prev.DailyValidBetMax {
+				return fmt.Sprintf("档位 %.2f 与 %.2f 之间没有设置返水", prev.DailyValidBetMax, tier.DailyValidBetMin)
+			}
+		}
+	}
+	return ""
+}
+
+// saveRuleTiers 保存规则的档位和各游戏返水比例，需在事务中调用
+func (s *sRebate) saveRuleTiers(ctx context.Context, siteId, ruleId int, tiers []*v1.RebateRuleTier) error {
+	for _, tier := range tiers {
+		optionId, err := dao.RebateRuleOption.Ctx(ctx).Data(do.RebateRuleOption{
+			SiteId:           siteId,
+			RuleId:           ruleId,
+			DailyValidBetMin: tier.DailyValidBetMin,
+			DailyValidBetMax: tier.DailyValidBetMax,
+			CreatedAt:        gtime.Now(),
+			UpdatedAt:        gtime.Now(),
+		}).InsertAndGetId()
+		if err != nil {
+			return err
+		}
+		games := make([]do.RebateRuleOptionGame, 0, len(tier.Games))
+		for _, game := range tier.Games {
+			games = append(games, do.RebateRuleOptionGame{
+				SiteId:       siteId,
+				RuleId:       ruleId,
+				RuleOptionId: optionId,
+				GameId:       game.GameId,
+				Percent:      game.Percent,
+				CreatedAt:    gtime.Now(),
+				UpdatedAt:    gtime.Now(),
+			})
+		}
+		if _, err = dao.RebateRuleOptionGame.Ctx(ctx).Data(games).Insert(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteRuleTiers 删除规则的档位和各游戏返水比例，需在事务中调用
+func (s *sRebate) deleteRuleTiers(ctx context.Context, siteId, ruleId int) error {
+	_, err := dao.RebateRuleOptionGame.Ctx(ctx).Where(do.RebateRuleOptionGame{SiteId: siteId, RuleId: ruleId}).Delete()
+	if err != nil {
+		return err
+	}
+	_, err = dao.RebateRuleOption.Ctx(ctx).Where(do.RebateRuleOption{SiteId: siteId, RuleId: ruleId}).Delete()
+	return err
+}
+
+// ruleTiers 规则的档位和各游戏返水比例，按最小金额排序
+func (s *sRebate) ruleTiers(ctx context.Context, siteId, ruleId int) ([]*v1.RebateRuleTier, error) {
+	var options []*entity.RebateRuleOption
+	err := dao.RebateRuleOption.Ctx(ctx).
+		Where(do.RebateRuleOption{SiteId: siteId, RuleId: ruleId}).
+		OrderAsc("daily_valid_bet_min").
+		Scan(&options)
+	if err != nil {
+		return nil, fmt.Errorf("查询返水档位失败: %v", err)
+	}
+	var games []*entity.RebateRuleOptionGame
+	err = dao.RebateRuleOptionGame.Ctx(ctx).
+		Where(do.RebateRuleOptionGame{SiteId: siteId, RuleId: ruleId}).
+		OrderAsc("game_id").
+		Scan(&games)
+	if err != nil {
+		return nil, fmt.Errorf("查询返水比例失败: %v", err)
+	}
+
+	tiers := make([]*v1.RebateRuleTier, 0, len(options))
+	byId := make(map[int]*v1.RebateRuleTier, len(options))
+	for _, option := range options {
+		tier := &v1.RebateRuleTier{
+			Id:               int32(option.Id),
+			DailyValidBetMin: option.DailyValidBetMin,
+			DailyValidBetMax: option.DailyValidBetMax,
+			Games:            []*v1.RebateRuleGame{},
+		}
+		byId[int(option.Id)] = tier
+		tiers = append(tiers, tier)
+	}
+	for _, game := range games {
+		if tier := byId[game.RuleOptionId]; tier != nil {
+			tier.Games = append(tier.Games, &v1.RebateRuleGame{
+				GameId:  int32(game.GameId),
+				Percent: game.Percent,
+			})
+		}
+	}
+	return tiers, nil
+}
+
+// getRebateRule 获取站点的返水规则
+func (s *sRebate) getRebateRule(ctx context.Context, siteId, id int) (*entity.RebateRule, error) {
+	var rule *entity.RebateRule
+	err := dao.RebateRule.Ctx(ctx).Where(do.RebateRule{SiteId: siteId, Id: id}).Scan(&rule)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询返水规则失败: %v", err)
+		return nil, err
+	}
+	return rule, nil
+}
+
+// ruleLevelCounts 各返水规则被会员层级使用的数量
+func (s *sRebate) ruleLevelCounts(ctx context.Context, siteId int) (map[int]int, error) {
+	result, err := dao.UserLevel.Ctx(ctx).
+		Fields("rebate_rule_id, COUNT(*) AS total").
+		Where(do.UserLevel{SiteId: siteId}).
+		WhereGT("rebate_rule_id", 0).
+		Group("rebate_rule_id").
+		All()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "统计会员层级失败: %v", err)
+		return nil, err
+	}
+	counts := make(map[int]int, len(result))
+	for _, record := range result {
+		counts[record["rebate_rule_id"].Int()] = record["total"].Int()
+	}
+	return counts, nil
+}
+
+// siteGameNames 站点游戏名称
+func (s *sRebate) siteGameNames(ctx context.Context, siteId int) (map[int]string, error) {
+	var games []*entity.SiteGame
+	err := dao.SiteGame.Ctx(ctx).Fields("game_id, name").Where(do.SiteGame{SiteId: siteId}).Scan(&games)
+	if err != nil {
+		return nil, fmt.Errorf("查询游戏失败: %v", err)
+	}
+	names := make(map[int]string, len(games))
+	for _, game := range games {
+		names[game.GameId] = game.Name
+	}
+	return names, nil
+}
+
+// describeRebateTiers 档位说明，用于管理员日志
+func describeRebateTiers(tiers []*v1.RebateRuleTier) string {
+	parts := make([]string, 0, len(tiers))
+	for _, tier := range tiers {
+		upper := "不限"
+		if tier.DailyValidBetMax > 0 {
+			upper = fmt.Sprintf("%.2f", tier.DailyValidBetMax)
+		}
+		games := make([]string, 0, len(tier.Games))
+		for _, game := range tier.Games {
+			games = append(games, fmt.Sprintf("%d:%g%%", game.GameId, game.Percent))
+		}
+		parts = append(parts, fmt.Sprintf("%.2f-%s(%s)", tier.DailyValidBetMin, upper, strings.Join(games, ",")))
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,34 @@
+package rebate
+
+import (
+	"testing"
+
+	v1 "jh_app_service/api/backend/rebate/v1"
+)
+
+func TestCheckTierRanges(t *testing.T) {
+	tier := func(min, max float64) *v1.RebateRuleTier {
+		return &v1.RebateRuleTier{DailyValidBetMin: min, DailyValidBetMax: max}
+	}
+	cases := []struct {
+		name  string
+		tiers []*v1.RebateRuleTier
+		ok    bool
+	}{
+		{"单档不限", []*v1.RebateRuleTier{tier(0, 0)}, true},
+		{"首尾相接", []*v1.RebateRuleTier{tier(0, 1000), tier(1000, 5000), tier(5000, 0)}, true},
+		{"乱序提交", []*v1.RebateRuleTier{tier(5000, 0), tier(0, 1000), tier(1000, 5000)}, true},
+		{"最后一档有上限", []*v1.RebateRuleTier{tier(0, 1000), tier(1000, 5000)}, true},
+		{"区间重叠", []*v1.RebateRuleTier{tier(0, 1000), tier(500, 0)}, false},
+		{"区间有空隙", []*v1.RebateRuleTier{tier(0, 1000), tier(2000, 0)}, false},
+		{"中间档位不限", []*v1.RebateRuleTier{tier(0, 0), tier(1000, 0)}, false},
+		{"最大金额不大于最小金额", []*v1.RebateRuleTier{tier(1000, 1000)}, false},
+		{"金额为负", []*v1.RebateRuleTier{tier(-1, 0)}, false},
+	}
+	for _, c := range cases {
+		message := checkTierRanges(sortRebateTiers(c.tiers))
+		if (message == "") != c.ok {
+			t.Errorf("%s: message = %q, want ok = %v", c.name, message, c.ok)
+		}
+	}
+}
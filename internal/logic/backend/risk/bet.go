@@ -0,0 +1,110 @@
+package risk
+
+import (
+	"context"
+	"fmt"
+
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// 每批检查的会员数
+const dailyBetsBatchSize = 500
+
+// EvaluateDailyBets 按昨天的投注汇总检查单日投注金额和赢得金额预警，由定时任务调用
+func (s *sRisk) EvaluateDailyBets(ctx context.Context) error {
+	siteIds, err := dao.SiteConfig.Ctx(ctx).Fields("site_id").Array()
+	if err != nil {
+		return fmt.Errorf("查询站点失败: %v", err)
+	}
+	yesterday := gtime.Now().AddDate(0, 0, -1)
+	for _, value := range siteIds {
+		count, err := s.evaluateDailyBets(ctx, value.Int(), yesterday.Format("Y-m-d"))
+		if err != nil {
+			return fmt.Errorf("站点 %d 检查投注预警失败: %v", value.Int(), err)
+		}
+		middleware.LogWithTrace(ctx, "info", "投注预警检查完成 - SiteId: %d, 检查会员数: %d", value.Int(), count)
+	}
+	return nil
+}
+
+// evaluateDailyBets 检查站点某天每个会员的投注合计和输赢合计，返回检查的会员数
+// 预警内容以统计日期开头，已有该日期预警的会员不重复预警，任务可重新执行
+func (s *sRisk) evaluateDailyBets(ctx context.Context, siteId int, betDate string) (int, error) {
+	config, err := s.forewarnConfig(ctx, siteId)
+	if err != nil {
+		return 0, err
+	}
+	checkWin := config.IsWinMoney == 1 && config.WinMoney > 0
+	checkBet := config.IsBetAmount == 1 && config.BetAmount > 0
+	if !checkWin && !checkBet {
+		return 0, nil
+	}
+
+	warned := make(map[int]map[int]bool)
+	for _, riskType := range []int{consts.RiskWinMoney, consts.RiskBetAmount} {
+		userIds, err := dao.RiskForewarnLog.Ctx(ctx).
+			Fields("user_id").
+			Where(do.RiskForewarnLog{SiteId: siteId, Type: riskType}).
+			WhereLike("content", betDate+" %").
+			Array()
+		if err != nil {
+			return 0, fmt.Errorf("查询已有预警失败: %v", err)
+		}
+		warned[riskType] = make(map[int]bool, len(userIds))
+		for _, userId := range userIds {
+			warned[riskType][userId.Int()] = true
+		}
+	}
+
+	count := 0
+	var lastUserId uint
+	for {
+		var totals []*entity.BetLogDaily
+		err = dao.BetLogDaily.Ctx(ctx).
+			Fields("user_id, MAX(username) AS username, SUM(bet_amount) AS bet_amount, SUM(win_or_lose) AS win_or_lose").
+			Where(do.BetLogDaily{SiteId: siteId}).
+			Where("bet_date", betDate).
+			WhereGT("user_id", lastUserId).
+			Group("user_id").
+			OrderAsc("user_id").
+			Limit(dailyBetsBatchSize).
+			Scan(&totals)
+		if err != nil {
+			return count, fmt.Errorf("查询投注汇总失败: %v", err)
+		}
+
+		for _, total := range totals {
+			events := make([]*model.RiskEvent, 0, 2)
+			if checkBet && !warned[consts.RiskBetAmount][int(total.UserId)] {
+				events = append(events, &model.RiskEvent{Type: consts.RiskBetAmount, Money: total.BetAmount})
+			}
+			if checkWin && !warned[consts.RiskWinMoney][int(total.UserId)] {
+				events = append(events, &model.RiskEvent{Type: consts.RiskWinMoney, Money: total.WinOrLose})
+			}
+			for _, event := range events {
+				event.SiteId = siteId
+				event.UserId = int(total.UserId)
+				event.Username = total.Username
+				event.Date = betDate
+				// 单个会员失败不影响其他会员，重新执行时补充预警
+				if err := s.Evaluate(ctx, event); err != nil {
+					middleware.LogWithTrace(ctx, "error", "投注预警检查失败 - UserId: %d, Type: %d, 错误: %v", total.UserId, event.Type, err)
+				}
+			}
+			if len(events) > 0 {
+				count++
+			}
+		}
+		if len(totals) < dailyBetsBatchSize {
+			return count, nil
+		}
+		lastUserId = totals[len(totals)-1].UserId
+	}
+}
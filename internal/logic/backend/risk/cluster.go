@@ -0,0 +1,487 @@
+package risk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "jh_app_service/api/backend/risk/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+	"jh_app_service/internal/util"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+const (
+	clusterMemberLimit = 100 // 每个关联最多返回的会员数
+	clusterTagLimit    = 500 // 标记任务每种关联类型每次最多处理的关联数
+	clusterUpdateSize  = 500 // 标记或确认关联时每次更新的会员数
+)
+
+// clusterSource 多账号关联的数据来源
+type clusterSource struct {
+	model    func(ctx context.Context) *gdb.Model
+	keyExpr  string // 关联值表达式
+	groupBy  string // 分组字段
+	userCol  string // 会员ID字段
+	timeCol  string // 时间窗口字段
+	notEmpty string // 排除关联值为空的条件
+	autoTag  bool   // 是否由标记任务自动标记
+}
+
+// clusterSources 各关联类型的数据来源
+var clusterSources = map[int]clusterSource{
+	consts.ClusterRegisterIp: {
+		model:    func(ctx context.Context) *gdb.Model { return dao.User.Ctx(ctx) },
+		keyExpr:  "register_ip",
+		groupBy:  "register_ip",
+		userCol:  "id",
+		timeCol:  "register_time",
+		notEmpty: "register_ip <> ''",
+		autoTag:  true,
+	},
+	consts.ClusterLoginIp: {
+		model:    func(ctx context.Context) *gdb.Model { return dao.UserLoginLog.Ctx(ctx) },
+		keyExpr:  "login_ip",
+		groupBy:  "login_ip",
+		userCol:  "user_id",
+		timeCol:  "login_time",
+		notEmpty: "login_ip <> ''",
+		autoTag:  true,
+	},
+	// 设备指纹由登录日志中的操作系统、浏览器和分辨率组成，未上报分辨率的登录不参与统计
+	// 登录日志没有设备唯一标识，同型号设备的会员也会被关联，只供人工排查，不参与自动标记
+	consts.ClusterDevice: {
+		model:    func(ctx context.Context) *gdb.Model { return dao.UserLoginLog.Ctx(ctx) },
+		keyExpr:  "CONCAT_WS('|', os, browser, screen)",
+		groupBy:  "os, browser, screen",
+		userCol:  "user_id",
+		timeCol:  "login_time",
+		notEmpty: "screen <> ''",
+	},
+}
+
+// clusterRow 关联统计结果
+type clusterRow struct {
+	ClusterKey  string `json:"cluster_key"`
+	MemberCount int    `json:"member_count"`
+}
+
+// clusterQuery 构建按关联值分组的查询，只保留关联会员数达到 minMembers 的分组
+func clusterQuery(ctx context.Context, source clusterSource, siteId int, start, end *gtime.Time, minMembers int) *gdb.Model {
+	return source.model(ctx).
+		Where("site_id", siteId).
+		Where(source.notEmpty).
+		WhereBetween(source.timeCol, start, end).
+		Group(source.groupBy).
+		Having(fmt.Sprintf("COUNT(DISTINCT %s) >= ?", source.userCol), minMembers)
+}
+
+// findClusters 分页查询关联，按关联会员数倒序
+func findClusters(ctx context.Context, source clusterSource, siteId int, start, end *gtime.Time, minMembers, page, size int) ([]*clusterRow, int, error) {
+	total, err := clusterQuery(ctx, source, siteId, start, end, minMembers).Count()
+	if err != nil {
+		return nil, 0, fmt.Errorf("统计关联数失败: %v", err)
+	}
+
+	var rows []*clusterRow
+	err = clusterQuery(ctx, source, siteId, start, end, minMembers).
+		Fields(fmt.Sprintf("%s AS cluster_key, COUNT(DISTINCT %s) AS member_count", source.keyExpr, source.userCol)).
+		Order("member_count DESC, cluster_key ASC").
+		Page(page, size).
+		Scan(&rows)
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询关联失败: %v", err)
+	}
+	return rows, total, nil
+}
+
+// clusterMemberIds 查询各关联值下的会员ID，每个关联最多返回 limit 个，limit 为0时返回全部
+func clusterMemberIds(ctx context.Context, source clusterSource, siteId int, start, end *gtime.Time, keys []string, limit int) (map[string][]int, error) {
+	members := make(map[string][]int, len(keys))
+	if len(keys) == 0 {
+		return members, nil
+	}
+
+	var rows []struct {
+		ClusterKey string `json:"cluster_key"`
+		UserId     int    `json:"user_id"`
+	}
+	err := source.model(ctx).
+		Fields(fmt.Sprintf("%s AS cluster_key, %s AS user_id", source.keyExpr, source.userCol)).
+		Distinct().
+		Where("site_id", siteId).
+		Where(fmt.Sprintf("%s IN(?)", source.keyExpr), keys).
+		WhereBetween(source.timeCol, start, end).
+		Order("user_id ASC").
+		Scan(&rows)
+	if err != nil {
+		return nil, fmt.Errorf("查询关联会员失败: %v", err)
+	}
+	for _, row := range rows {
+		if limit <= 0 || len(members[row.ClusterKey]) < limit {
+			members[row.ClusterKey] = append(members[row.ClusterKey], row.UserId)
+		}
+	}
+	return members, nil
+}
+
+// clusterWindow 解析时间窗口，未传开始时间时默认最近 days 天
+func clusterWindow(startTime, endTime string, days int) (*gtime.Time, *gtime.Time, error) {
+	end := gtime.Now()
+	if endTime != "" {
+		parsed, err := gtime.StrToTime(endTime)
+		if err != nil {
+			return nil, nil, fmt.Errorf("结束时间格式错误")
+		}
+		end = parsed
+	}
+	start := end.AddDate(0, 0, -days)
+	if startTime != "" {
+		parsed, err := gtime.StrToTime(startTime)
+		if err != nil {
+			return nil, nil, fmt.Errorf("开始时间格式错误")
+		}
+		start = parsed
+	}
+	if start.After(end) {
+		return nil, nil, fmt.Errorf("开始时间不能晚于结束时间")
+	}
+	return start, end, nil
+}
+
+// GetAccountClusters 获取共用注册IP、登录IP或设备指纹的会员关联，用于排查多账号套利
+func (s *sRisk) GetAccountClusters(ctx context.Context, req *v1.GetAccountClustersReq) (*v1.GetAccountClustersRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取多账号关联请求 - Type: %d, StartTime: %s, EndTime: %s, MinMembers: %d, Page: %d, Size: %d", req.Type, req.StartTime, req.EndTime, req.MinMembers, req.Page, req.Size)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	source, ok := clusterSources[int(req.Type)]
+	if !ok {
+		return nil, fmt.Errorf("关联类型无效")
+	}
+	start, end, err := clusterWindow(req.StartTime, req.EndTime, 7)
+	if err != nil {
+		return nil, err
+	}
+
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	size := req.Size
+	if size <= 0 {
+		size = 50
+	}
+	minMembers := int(req.MinMembers)
+	if minMembers < 2 {
+		minMembers = 2
+	}
+
+	rows, total, err := findClusters(ctx, source, siteId, start, end, minMembers, int(page), int(size))
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取多账号关联失败: %v", err)
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, row.ClusterKey)
+	}
+	memberIds, err := clusterMemberIds(ctx, source, siteId, start, end, keys, clusterMemberLimit)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取关联会员失败: %v", err)
+		return nil, err
+	}
+
+	var userIds []int
+	for _, ids := range memberIds {
+		userIds = append(userIds, ids...)
+	}
+	users := make(map[int]*entity.User, len(userIds))
+	if len(userIds) > 0 {
+		var list []*entity.User
+		err = dao.User.Ctx(ctx).
+			Fields("id, username, status, focus_level, register_ip, register_time, last_login_ip, last_login_time").
+			WhereIn("id", userIds).
+			Scan(&list)
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "获取关联会员信息失败: %v", err)
+			return nil, err
+		}
+		for _, user := range list {
+			users[int(user.Id)] = user
+		}
+	}
+
+	reviews := make(map[string]*entity.RiskAccountCluster, len(keys))
+	if len(keys) > 0 {
+		var list []*entity.RiskAccountCluster
+		err = dao.RiskAccountCluster.Ctx(ctx).Where(do.RiskAccountCluster{
+			SiteId: siteId,
+			Type:   req.Type,
+		}).WhereIn("cluster_key", keys).Scan(&list)
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "获取关联审核记录失败: %v", err)
+			return nil, err
+		}
+		for _, review := range list {
+			reviews[review.ClusterKey] = review
+		}
+	}
+
+	list := make([]*v1.AccountCluster, 0, len(rows))
+	for _, row := range rows {
+		cluster := &v1.AccountCluster{
+			Key:         row.ClusterKey,
+			MemberCount: int32(row.MemberCount),
+			Members:     make([]*v1.AccountClusterMember, 0, len(memberIds[row.ClusterKey])),
+		}
+		for _, userId := range memberIds[row.ClusterKey] {
+			user := users[userId]
+			if user == nil {
+				continue
+			}
+			cluster.Members = append(cluster.Members, &v1.AccountClusterMember{
+				UserId:        int32(user.Id),
+				Username:      user.Username,
+				Status:        int32(user.Status),
+				FocusLevel:    int32(user.FocusLevel),
+				RegisterIp:    user.RegisterIp,
+				RegisterTime:  util.FormatTime(user.RegisterTime),
+				LastLoginIp:   user.LastLoginIp,
+				LastLoginTime: util.FormatTime(user.LastLoginTime),
+			})
+		}
+		if review := reviews[row.ClusterKey]; review != nil {
+			cluster.ReviewId = int64(review.Id)
+			cluster.ReviewStatus = int32(review.Status)
+		}
+		list = append(list, cluster)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "获取多账号关联成功 - 总数: %d, 返回: %d", total, len(list))
+	return &v1.GetAccountClustersRes{
+		List:  list,
+		Count: int32(total),
+	}, nil
+}
+
+// ReviewAccountCluster 审核多账号关联，确认后关联会员标记为危险
+func (s *sRisk) ReviewAccountCluster(ctx context.Context, req *v1.ReviewAccountClusterReq) (*v1.ReviewAccountClusterRes, error) {
+	middleware.LogWithTrace(ctx, "info", "审核多账号关联请求 - ID: %d, Status: %d", req.Id, req.Status)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	if req.Status != consts.ClusterConfirmed && req.Status != consts.ClusterIgnored {
+		return &v1.ReviewAccountClusterRes{Success: false, Message: "审核结果无效"}, nil
+	}
+
+	var cluster *entity.RiskAccountCluster
+	err := dao.RiskAccountCluster.Ctx(ctx).Where(do.RiskAccountCluster{
+		Id:     req.Id,
+		SiteId: siteId,
+	}).Scan(&cluster)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询关联审核记录失败: %v", err)
+		return nil, err
+	}
+	if cluster == nil {
+		return &v1.ReviewAccountClusterRes{Success: false, Message: "关联记录不存在"}, nil
+	}
+	if cluster.Status != consts.ClusterPending {
+		return &v1.ReviewAccountClusterRes{Success: false, Message: "关联记录已审核"}, nil
+	}
+
+	reviewAdmin := ""
+	if admin := backend.Admin().CurrentAdmin(ctx); admin != nil {
+		reviewAdmin = admin.Username
+	}
+
+	err = dao.RiskAccountCluster.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		result, err := dao.RiskAccountCluster.Ctx(ctx).Where(do.RiskAccountCluster{
+			Id:     cluster.Id,
+			Status: consts.ClusterPending,
+		}).Data(do.RiskAccountCluster{
+			Status:      req.Status,
+			ReviewAdmin: reviewAdmin,
+			ReviewedAt:  gtime.Now(),
+			Remark:      req.Remark,
+			UpdatedAt:   gtime.Now(),
+		}).Update()
+		if err != nil {
+			return err
+		}
+		if rows, _ := result.RowsAffected(); rows == 0 {
+			return fmt.Errorf("关联记录已审核")
+		}
+		if req.Status != consts.ClusterConfirmed || cluster.UserIds == "" {
+			return nil
+		}
+		// 关联会员可能很多，分批更新
+		userIds := strings.Split(cluster.UserIds, ",")
+		for i := 0; i < len(userIds); i += clusterUpdateSize {
+			batch := userIds[i:min(i+clusterUpdateSize, len(userIds))]
+			_, err = dao.User.Ctx(ctx).Where("site_id", siteId).
+				WhereIn("id", batch).
+				WhereLT("focus_level", consts.FocusLevelDanger).
+				Data(do.User{
+					FocusLevel: consts.FocusLevelDanger,
+					UpdatedAt:  gtime.Now(),
+				}).Update()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "审核多账号关联失败: %v", err)
+		return nil, fmt.Errorf("审核多账号关联失败: %v", err)
+	}
+
+	statusName := "确认"
+	if req.Status == consts.ClusterIgnored {
+		statusName = "忽略"
+	}
+	logMessage := fmt.Sprintf("审核多账号关联 [ID:%d 关联值:%s 会员数:%d] 结果: %s", cluster.Id, cluster.ClusterKey, cluster.MemberCount, statusName)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "审核多账号关联成功 - ID: %d", cluster.Id)
+	return &v1.ReviewAccountClusterRes{Success: true, Message: "审核成功"}, nil
+}
+
+// TagAccountClusters 标记可疑的多账号关联，由定时任务调用
+// 新出现或会员数增加的关联进入待审核，关联会员的关注级别由正常调整为可疑
+func (s *sRisk) TagAccountClusters(ctx context.Context) error {
+	days := g.Cfg().MustGet(ctx, "risk.cluster.days", 7).Int()
+	if days <= 0 {
+		days = 7
+	}
+	minMembers := g.Cfg().MustGet(ctx, "risk.cluster.minMembers", 3).Int()
+	if minMembers < 2 {
+		minMembers = 2
+	}
+	end := gtime.Now()
+	start := end.AddDate(0, 0, -days)
+
+	siteIds, err := dao.SiteConfig.Ctx(ctx).Fields("site_id").Array()
+	if err != nil {
+		return fmt.Errorf("查询站点失败: %v", err)
+	}
+
+	types := make([]int, 0, len(clusterSources))
+	for clusterType, source := range clusterSources {
+		if source.autoTag {
+			types = append(types, clusterType)
+		}
+	}
+	sort.Ints(types)
+
+	tagged := 0
+	for _, value := range siteIds {
+		siteId := value.Int()
+		for _, clusterType := range types {
+			source := clusterSources[clusterType]
+			rows, _, err := findClusters(ctx, source, siteId, start, end, minMembers, 1, clusterTagLimit)
+			if err != nil {
+				return err
+			}
+			keys := make([]string, 0, len(rows))
+			for _, row := range rows {
+				keys = append(keys, row.ClusterKey)
+			}
+			// 保存全部关联会员，确认时全部标记为危险
+			memberIds, err := clusterMemberIds(ctx, source, siteId, start, end, keys, 0)
+			if err != nil {
+				return err
+			}
+			for _, row := range rows {
+				created, err := s.tagCluster(ctx, siteId, clusterType, row, memberIds[row.ClusterKey])
+				if err != nil {
+					middleware.LogWithTrace(ctx, "error", "标记多账号关联失败 - SiteId: %d, Type: %d, 关联值: %s, 错误: %v", siteId, clusterType, row.ClusterKey, err)
+					continue
+				}
+				if created {
+					tagged++
+				}
+			}
+		}
+	}
+
+	middleware.LogWithTrace(ctx, "info", "多账号关联标记完成 - 新增待审核: %d", tagged)
+	return nil
+}
+
+// tagCluster 保存一个关联，返回是否新进入待审核
+func (s *sRisk) tagCluster(ctx context.Context, siteId, clusterType int, row *clusterRow, userIds []int) (bool, error) {
+	created := false
+	err := dao.RiskAccountCluster.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		var cluster *entity.RiskAccountCluster
+		err := dao.RiskAccountCluster.Ctx(ctx).Where(do.RiskAccountCluster{
+			SiteId:     siteId,
+			Type:       clusterType,
+			ClusterKey: row.ClusterKey,
+		}).LockUpdate().Scan(&cluster)
+		if err != nil {
+			return err
+		}
+
+		data := do.RiskAccountCluster{
+			MemberCount: row.MemberCount,
+			UserIds:     strings.Join(gconv.Strings(userIds), ","),
+			UpdatedAt:   gtime.Now(),
+		}
+		switch {
+		case cluster == nil:
+			data.SiteId = siteId
+			data.Type = clusterType
+			data.ClusterKey = row.ClusterKey
+			data.Status = consts.ClusterPending
+			data.CreatedAt = gtime.Now()
+			if _, err = dao.RiskAccountCluster.Ctx(ctx).Data(data).Insert(); err != nil {
+				return err
+			}
+			created = true
+		case cluster.Status == consts.ClusterPending || row.MemberCount > cluster.MemberCount:
+			// 已审核的关联出现新会员时重新审核
+			if cluster.Status != consts.ClusterPending {
+				data.Status = consts.ClusterPending
+				created = true
+			}
+			if _, err = dao.RiskAccountCluster.Ctx(ctx).Where("id", cluster.Id).Data(data).Update(); err != nil {
+				return err
+			}
+		default:
+			return nil
+		}
+
+		for i := 0; i < len(userIds); i += clusterUpdateSize {
+			_, err = dao.User.Ctx(ctx).Where(do.User{
+				SiteId:     siteId,
+				FocusLevel: consts.FocusLevelNormal,
+			}).WhereIn("id", userIds[i:min(i+clusterUpdateSize, len(userIds))]).Data(do.User{
+				FocusLevel: consts.FocusLevelSuspicious,
+				UpdatedAt:  gtime.Now(),
+			}).Update()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return created, err
+}
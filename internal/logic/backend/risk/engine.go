@@ -0,0 +1,197 @@
+package risk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/geoip"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/pubsub"
+	"jh_app_service/internal/service/backend"
+
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// forewarnRule 预警规则，命中时返回预警内容，未命中时返回空字符串
+type forewarnRule func(ctx context.Context, config *entity.RiskForewarnConfig, event *model.RiskEvent) (string, error)
+
+// forewarnRules 各预警类型对应的规则
+var forewarnRules = map[int]forewarnRule{
+	consts.RiskAccountToGame:  accountToGameRule,
+	consts.RiskGameToAccount:  gameToAccountRule,
+	consts.RiskWinMoney:       winMoneyRule,
+	consts.RiskBetAmount:      betAmountRule,
+	consts.RiskAlterBankCard:  alterBankCardRule,
+	consts.RiskLoginArea:      loginAreaRule,
+	consts.RiskSameIpRegister: sameIpRegisterRule,
+}
+
+// forewarnTypeNames 预警类型名称
+var forewarnTypeNames = map[int]string{
+	consts.RiskAccountToGame:  "转入游戏",
+	consts.RiskGameToAccount:  "游戏转出",
+	consts.RiskWinMoney:       "赢得金额",
+	consts.RiskBetAmount:      "投注金额",
+	consts.RiskAlterBankCard:  "修改银行卡",
+	consts.RiskLoginArea:      "登录地区变化",
+	consts.RiskSameIpRegister: "同IP注册",
+}
+
+// Evaluate 按站点预警配置检查风险事件，命中时写入预警日志并推送给订阅者
+// 由转账、修改银行卡、登录和注册等业务在操作完成后调用，投注类预警由每日投注汇总任务调用，预警失败不应影响业务本身
+func (s *sRisk) Evaluate(ctx context.Context, event *model.RiskEvent) error {
+	rule, ok := forewarnRules[event.Type]
+	if !ok {
+		return fmt.Errorf("未知的预警类型: %d", event.Type)
+	}
+
+	config, err := s.forewarnConfig(ctx, event.SiteId)
+	if err != nil {
+		return err
+	}
+	if event.Ip == "" {
+		event.Ip = middleware.GetClientIPFromContext(ctx)
+	}
+
+	content, err := rule(ctx, config, event)
+	if err != nil {
+		return err
+	}
+	if content == "" {
+		return nil
+	}
+
+	log := &entity.RiskForewarnLog{
+		SiteId:    event.SiteId,
+		UserId:    event.UserId,
+		Type:      event.Type,
+		Username:  event.Username,
+		Content:   content,
+		Ip:        event.Ip,
+		Device:    event.Device,
+		Status:    consts.RiskForewarnPending,
+		CreatedAt: gtime.Now(),
+		UpdatedAt: gtime.Now(),
+	}
+	id, err := dao.RiskForewarnLog.Ctx(ctx).Data(log).OmitEmptyData().InsertAndGetId()
+	if err != nil {
+		return fmt.Errorf("写入预警日志失败: %v", err)
+	}
+	log.Id = uint64(id)
+
+	middleware.LogWithTrace(ctx, "warning", "触发风险预警 - Type: %s, UserId: %d, Username: %s, 内容: %s", forewarnTypeNames[event.Type], event.UserId, event.Username, content)
+	backend.Feed().PublishEvent(ctx, log.SiteId, pubsub.TopicRisk, forewarnTypeNames[log.Type], forewarnLogInfo(log))
+	return nil
+}
+
+// amountRule 单笔金额达到阈值时预警，开关未开启或阈值为0时不预警
+func amountRule(enabled int, threshold, money float64, format string) string {
+	if enabled != 1 || threshold <= 0 || money < threshold {
+		return ""
+	}
+	return fmt.Sprintf(format, money, threshold)
+}
+
+func accountToGameRule(ctx context.Context, config *entity.RiskForewarnConfig, event *model.RiskEvent) (string, error) {
+	return amountRule(config.IsAccountToGame, config.AccountToGame, event.Money, "账户转入游戏 %.2f，达到预警金额 %.2f"), nil
+}
+
+func gameToAccountRule(ctx context.Context, config *entity.RiskForewarnConfig, event *model.RiskEvent) (string, error) {
+	return amountRule(config.IsGameToAccount, config.GameToAccount, event.Money, "游戏转出到账户 %.2f，达到预警金额 %.2f"), nil
+}
+
+// winMoneyRule 会员单日输赢合计达到阈值时预警，预警内容以统计日期开头
+func winMoneyRule(ctx context.Context, config *entity.RiskForewarnConfig, event *model.RiskEvent) (string, error) {
+	content := amountRule(config.IsWinMoney, config.WinMoney, event.Money, "游戏赢得 %.2f，达到单日预警金额 %.2f")
+	if content == "" {
+		return "", nil
+	}
+	return event.Date + " " + content, nil
+}
+
+// betAmountRule 会员单日投注合计达到阈值时预警，预警内容以统计日期开头
+func betAmountRule(ctx context.Context, config *entity.RiskForewarnConfig, event *model.RiskEvent) (string, error) {
+	content := amountRule(config.IsBetAmount, config.BetAmount, event.Money, "游戏投注 %.2f，达到单日预警金额 %.2f")
+	if content == "" {
+		return "", nil
+	}
+	return event.Date + " " + content, nil
+}
+
+// alterBankCardRule 会员银行卡被添加、修改或删除时预警，卡号由调用方脱敏后传入
+func alterBankCardRule(ctx context.Context, config *entity.RiskForewarnConfig, event *model.RiskEvent) (string, error) {
+	if config.IsAlterBankCard != 1 || config.AlterBankCard != 1 {
+		return "", nil
+	}
+	if event.Content != "" {
+		return event.Content, nil
+	}
+	if event.OldValue == event.NewValue {
+		return "", nil
+	}
+	if event.OldValue == "" {
+		return fmt.Sprintf("会员绑定银行卡 %s", event.NewValue), nil
+	}
+	if event.NewValue == "" {
+		return fmt.Sprintf("会员删除银行卡 %s", event.OldValue), nil
+	}
+	return fmt.Sprintf("会员银行卡号由 %s 修改为 %s", event.OldValue, event.NewValue), nil
+}
+
+// loginAreaRule 本次登录IP与上次登录IP所在的国家或省份不同时预警，离线库未收录的IP不比较
+func loginAreaRule(ctx context.Context, config *entity.RiskForewarnConfig, event *model.RiskEvent) (string, error) {
+	if config.IsLoginAreaDifference != 1 || config.LoginAreaDifference != 1 {
+		return "", nil
+	}
+	if event.OldValue == "" || event.OldValue == event.NewValue {
+		return "", nil
+	}
+
+	last, err := geoip.Lookup(event.OldValue)
+	if err != nil || last == nil {
+		return "", nil
+	}
+	current, err := geoip.Lookup(event.NewValue)
+	if err != nil || current == nil {
+		return "", nil
+	}
+	if last.Country == current.Country && last.Province == current.Province {
+		return "", nil
+	}
+	return fmt.Sprintf("本次登录地区 [%s] 与上次登录地区 [%s] 不同", current.String(), last.String()), nil
+}
+
+// sameIpRegisterRule 同一IP在统计时间内注册的会员数达到设定次数时预警，无法获取真实IP时不统计
+func sameIpRegisterRule(ctx context.Context, config *entity.RiskForewarnConfig, event *model.RiskEvent) (string, error) {
+	if config.IsSameipRegisterCount != 1 || config.SameipRegisterCount <= 0 {
+		return "", nil
+	}
+	if event.Ip == "" || event.Ip == "127.0.0.1" {
+		return "", nil
+	}
+
+	query := dao.User.Ctx(ctx).Where(do.User{
+		SiteId:     event.SiteId,
+		RegisterIp: event.Ip,
+	})
+	if config.SameipRegisterTime > 0 {
+		query = query.WhereGTE("register_time", gtime.Now().Add(-time.Duration(config.SameipRegisterTime)*time.Minute))
+	}
+	count, err := query.Count()
+	if err != nil {
+		return "", fmt.Errorf("统计同IP注册数失败: %v", err)
+	}
+	if count < config.SameipRegisterCount {
+		return "", nil
+	}
+	if config.SameipRegisterTime > 0 {
+		return fmt.Sprintf("同一IP %d 分钟内已注册 %d 个会员", config.SameipRegisterTime, count), nil
+	}
+	return fmt.Sprintf("同一IP已注册 %d 个会员", count), nil
+}
@@ -0,0 +1,168 @@
+package risk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	v1 "jh_app_service/api/backend/risk/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+	"jh_app_service/internal/util"
+
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// GetForewarnLogs 获取风险预警列表
+func (s *sRisk) GetForewarnLogs(ctx context.Context, req *v1.GetForewarnLogsReq) (*v1.GetForewarnLogsRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取风险预警列表请求 - Page: %d, Size: %d, Type: %d, Status: %d, Username: %s", req.Page, req.Size, req.Type, req.Status, req.Username)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	size := req.Size
+	if size <= 0 {
+		size = 50
+	}
+
+	query := dao.RiskForewarnLog.Ctx(ctx).Where(do.RiskForewarnLog{
+		SiteId: siteId,
+	})
+	if req.Type > 0 {
+		query = query.Where("type", req.Type)
+	}
+	if req.Status >= 0 {
+		query = query.Where("status", req.Status)
+	}
+	if req.Username != "" {
+		query = query.Where("username", req.Username)
+	}
+	if req.StartTime != "" {
+		query = query.WhereGTE("created_at", req.StartTime)
+	}
+	if req.EndTime != "" {
+		query = query.WhereLTE("created_at", req.EndTime)
+	}
+
+	total, err := query.Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取风险预警总数失败: %v", err)
+		return nil, err
+	}
+
+	var logs []*entity.RiskForewarnLog
+	err = query.Page(int(page), int(size)).Order("id DESC").Scan(&logs)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取风险预警列表失败: %v", err)
+		return nil, err
+	}
+
+	pending, err := dao.RiskForewarnLog.Ctx(ctx).Where(do.RiskForewarnLog{
+		SiteId: siteId,
+		Status: consts.RiskForewarnPending,
+	}).Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取未处理预警数失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]*v1.ForewarnLogInfo, 0, len(logs))
+	for _, log := range logs {
+		list = append(list, forewarnLogInfo(log))
+	}
+
+	middleware.LogWithTrace(ctx, "info", "获取风险预警列表成功 - 总数: %d, 返回: %d, 未处理: %d", total, len(list), pending)
+	return &v1.GetForewarnLogsRes{
+		List:     list,
+		Count:    int32(total),
+		Pending:  int32(pending),
+		TypeList: forewarnTypeList(),
+	}, nil
+}
+
+// AckForewarnLogs 将风险预警标记为已处理
+func (s *sRisk) AckForewarnLogs(ctx context.Context, req *v1.AckForewarnLogsReq) (*v1.AckForewarnLogsRes, error) {
+	middleware.LogWithTrace(ctx, "info", "处理风险预警请求 - IDs: %v", req.Ids)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	if len(req.Ids) == 0 {
+		return &v1.AckForewarnLogsRes{Success: false, Message: "请选择要处理的预警"}, nil
+	}
+
+	handleAdmin := ""
+	if admin := backend.Admin().CurrentAdmin(ctx); admin != nil {
+		handleAdmin = admin.Username
+	}
+
+	result, err := dao.RiskForewarnLog.Ctx(ctx).Where(do.RiskForewarnLog{
+		SiteId: siteId,
+		Status: consts.RiskForewarnPending,
+	}).WhereIn("id", req.Ids).Data(do.RiskForewarnLog{
+		Status:      consts.RiskForewarnHandled,
+		HandleAdmin: handleAdmin,
+		HandledAt:   gtime.Now(),
+		UpdatedAt:   gtime.Now(),
+	}).Update()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "处理风险预警失败: %v", err)
+		return nil, fmt.Errorf("处理风险预警失败: %v", err)
+	}
+	rows, _ := result.RowsAffected()
+
+	if rows > 0 {
+		logMessage := fmt.Sprintf("处理风险预警 [ID:%v]", req.Ids)
+		if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+			middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+		}
+	}
+
+	middleware.LogWithTrace(ctx, "info", "处理风险预警成功 - 处理数量: %d", rows)
+	return &v1.AckForewarnLogsRes{
+		Success: true,
+		Message: "处理成功",
+		Count:   int32(rows),
+	}, nil
+}
+
+// forewarnLogInfo 预警日志转换为响应格式
+func forewarnLogInfo(log *entity.RiskForewarnLog) *v1.ForewarnLogInfo {
+	return &v1.ForewarnLogInfo{
+		Id:          int64(log.Id),
+		UserId:      int32(log.UserId),
+		Username:    log.Username,
+		Type:        int32(log.Type),
+		TypeName:    forewarnTypeNames[log.Type],
+		Content:     log.Content,
+		Ip:          log.Ip,
+		Device:      int32(log.Device),
+		Status:      int32(log.Status),
+		HandleAdmin: log.HandleAdmin,
+		HandledAt:   util.FormatTime(log.HandledAt),
+		CreatedAt:   util.FormatTime(log.CreatedAt),
+	}
+}
+
+// forewarnTypeList 预警类型列表，按类型值排序
+func forewarnTypeList() []*v1.ForewarnTypeItem {
+	types := make([]int, 0, len(forewarnTypeNames))
+	for t := range forewarnTypeNames {
+		types = append(types, t)
+	}
+	sort.Ints(types)
+
+	list := make([]*v1.ForewarnTypeItem, 0, len(types))
+	for _, t := range types {
+		list = append(list, &v1.ForewarnTypeItem{Value: int32(t), Name: forewarnTypeNames[t]})
+	}
+	return list
+}
@@ -0,0 +1,204 @@
+package risk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "jh_app_service/api/backend/risk/v1"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+	"jh_app_service/internal/util"
+
+	"github.com/gogf/gf/v2/os/gcache"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// 预警配置缓存时长，本进程修改配置后立即清除，其他进程最多延迟该时长生效
+const forewarnConfigCacheTTL = time.Minute
+
+type (
+	sRisk struct{}
+)
+
+func init() {
+	backend.RegisterRisk(&sRisk{})
+}
+
+// forewarnConfigCacheKey 预警配置缓存键
+func forewarnConfigCacheKey(siteId int) string {
+	return fmt.Sprintf("risk_forewarn_config:%d", siteId)
+}
+
+// forewarnConfig 获取站点预警配置，未配置时返回全部关闭的配置，结果按站点缓存
+func (s *sRisk) forewarnConfig(ctx context.Context, siteId int) (*entity.RiskForewarnConfig, error) {
+	value, err := gcache.GetOrSetFuncLock(ctx, forewarnConfigCacheKey(siteId), func(ctx context.Context) (interface{}, error) {
+		var config *entity.RiskForewarnConfig
+		err := dao.RiskForewarnConfig.Ctx(ctx).Where(do.RiskForewarnConfig{SiteId: siteId}).Scan(&config)
+		if err != nil {
+			return nil, err
+		}
+		if config == nil {
+			config = &entity.RiskForewarnConfig{SiteId: siteId}
+		}
+		return config, nil
+	}, forewarnConfigCacheTTL)
+	if err != nil {
+		return nil, fmt.Errorf("查询预警配置失败: %v", err)
+	}
+
+	config, _ := value.Val().(*entity.RiskForewarnConfig)
+	if config == nil {
+		config = &entity.RiskForewarnConfig{SiteId: siteId}
+	}
+	return config, nil
+}
+
+// GetForewarnConfig 获取风险预警配置
+func (s *sRisk) GetForewarnConfig(ctx context.Context, req *v1.GetForewarnConfigReq) (*v1.GetForewarnConfigRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取风险预警配置请求")
+
+	// 默认站点ID为1
+	siteId := 1
+
+	var config *entity.RiskForewarnConfig
+	err := dao.RiskForewarnConfig.Ctx(ctx).Where(do.RiskForewarnConfig{SiteId: siteId}).Scan(&config)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取风险预警配置失败: %v", err)
+		return nil, err
+	}
+	if config == nil {
+		return &v1.GetForewarnConfigRes{Config: &v1.ForewarnConfig{}}, nil
+	}
+
+	return &v1.GetForewarnConfigRes{
+		Config: &v1.ForewarnConfig{
+			AccountToGame:         config.AccountToGame,
+			GameToAccount:         config.GameToAccount,
+			WinMoney:              config.WinMoney,
+			BetAmount:             config.BetAmount,
+			AlterBankCard:         config.AlterBankCard == 1,
+			LoginAreaDifference:   config.LoginAreaDifference == 1,
+			SameipRegisterTime:    int32(config.SameipRegisterTime),
+			SameipRegisterCount:   int32(config.SameipRegisterCount),
+			IsAccountToGame:       config.IsAccountToGame == 1,
+			IsGameToAccount:       config.IsGameToAccount == 1,
+			IsWinMoney:            config.IsWinMoney == 1,
+			IsBetAmount:           config.IsBetAmount == 1,
+			IsAlterBankCard:       config.IsAlterBankCard == 1,
+			IsLoginAreaDifference: config.IsLoginAreaDifference == 1,
+			IsSameipRegisterCount: config.IsSameipRegisterCount == 1,
+		},
+		UpdatedAt: util.FormatTime(config.UpdatedAt),
+	}, nil
+}
+
+// UpdateForewarnConfig 更新风险预警配置，站点未配置时新建
+func (s *sRisk) UpdateForewarnConfig(ctx context.Context, req *v1.UpdateForewarnConfigReq) (*v1.UpdateForewarnConfigRes, error) {
+	middleware.LogWithTrace(ctx, "info", "更新风险预警配置请求")
+
+	// 默认站点ID为1
+	siteId := 1
+
+	config := req.Config
+	if config == nil {
+		return &v1.UpdateForewarnConfigRes{Success: false, Message: "请提交预警配置"}, nil
+	}
+	if config.AccountToGame < 0 || config.GameToAccount < 0 || config.WinMoney < 0 || config.BetAmount < 0 {
+		return &v1.UpdateForewarnConfigRes{Success: false, Message: "预警金额不能小于0"}, nil
+	}
+	if config.SameipRegisterTime < 0 || config.SameipRegisterCount < 0 {
+		return &v1.UpdateForewarnConfigRes{Success: false, Message: "同IP注册限制不能小于0"}, nil
+	}
+	if config.IsSameipRegisterCount && config.SameipRegisterCount == 0 {
+		return &v1.UpdateForewarnConfigRes{Success: false, Message: "开启同IP注册预警时请设置注册次数"}, nil
+	}
+
+	data := do.RiskForewarnConfig{
+		SiteId:                siteId,
+		AccountToGame:         config.AccountToGame,
+		GameToAccount:         config.GameToAccount,
+		WinMoney:              config.WinMoney,
+		BetAmount:             config.BetAmount,
+		AlterBankCard:         boolToInt(config.AlterBankCard),
+		LoginAreaDifference:   boolToInt(config.LoginAreaDifference),
+		SameipRegisterTime:    config.SameipRegisterTime,
+		SameipRegisterCount:   config.SameipRegisterCount,
+		IsAccountToGame:       boolToInt(config.IsAccountToGame),
+		IsGameToAccount:       boolToInt(config.IsGameToAccount),
+		IsWinMoney:            boolToInt(config.IsWinMoney),
+		IsBetAmount:           boolToInt(config.IsBetAmount),
+		IsAlterBankCard:       boolToInt(config.IsAlterBankCard),
+		IsLoginAreaDifference: boolToInt(config.IsLoginAreaDifference),
+		IsSameipRegisterCount: boolToInt(config.IsSameipRegisterCount),
+		UpdatedAt:             gtime.Now(),
+	}
+
+	count, err := dao.RiskForewarnConfig.Ctx(ctx).Where(do.RiskForewarnConfig{SiteId: siteId}).Count()
+	if err == nil {
+		if count > 0 {
+			_, err = dao.RiskForewarnConfig.Ctx(ctx).Where(do.RiskForewarnConfig{SiteId: siteId}).Data(data).Update()
+		} else {
+			data.CreatedAt = gtime.Now()
+			_, err = dao.RiskForewarnConfig.Ctx(ctx).Data(data).Insert()
+		}
+	}
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "更新风险预警配置失败: %v", err)
+		return nil, fmt.Errorf("更新风险预警配置失败: %v", err)
+	}
+
+	if _, err = gcache.Remove(ctx, forewarnConfigCacheKey(siteId)); err != nil {
+		middleware.LogWithTrace(ctx, "error", "清除预警配置缓存失败: %v", err)
+	}
+
+	logMessage := fmt.Sprintf("修改风险预警配置 %s", forewarnConfigDesc(config))
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "更新风险预警配置成功 - SiteId: %d", siteId)
+	return &v1.UpdateForewarnConfigRes{Success: true, Message: "设置成功"}, nil
+}
+
+// forewarnConfigDesc 生成预警配置的日志描述，只列出已开启的预警
+func forewarnConfigDesc(config *v1.ForewarnConfig) string {
+	var items []string
+	if config.IsAccountToGame {
+		items = append(items, fmt.Sprintf("转入游戏:%.2f", config.AccountToGame))
+	}
+	if config.IsGameToAccount {
+		items = append(items, fmt.Sprintf("游戏转出:%.2f", config.GameToAccount))
+	}
+	if config.IsWinMoney {
+		items = append(items, fmt.Sprintf("赢得金额:%.2f", config.WinMoney))
+	}
+	if config.IsBetAmount {
+		items = append(items, fmt.Sprintf("投注金额:%.2f", config.BetAmount))
+	}
+	if config.IsAlterBankCard && config.AlterBankCard {
+		items = append(items, "修改银行卡")
+	}
+	if config.IsLoginAreaDifference && config.LoginAreaDifference {
+		items = append(items, "登录地区变化")
+	}
+	if config.IsSameipRegisterCount {
+		items = append(items, fmt.Sprintf("同IP注册:%d分钟%d次", config.SameipRegisterTime, config.SameipRegisterCount))
+	}
+	if len(items) == 0 {
+		return "[全部关闭]"
+	}
+	return "[" + strings.Join(items, "，") + "]"
+}
+
+// boolToInt 布尔值转换为数据库中的 1/0
+func boolToInt(value bool) int {
+	if value {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,391 @@
+package site
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	v1 "jh_app_service/api/backend/site/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/geoip"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+	"jh_app_service/internal/util"
+
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// 屏蔽规则缓存时长，本进程修改规则后立即刷新，其他进程最多延迟该时长生效
+const denyRulesCacheTTL = time.Minute
+
+// denyRules 站点的访问屏蔽规则
+type denyRules struct {
+	ips       map[string]bool // 单个IP
+	networks  []*net.IPNet    // 网段
+	addresses []string        // 地区名称
+	loadedAt  time.Time
+}
+
+// CheckAccess 检查IP是否允许访问站点，被屏蔽时返回错误
+// 供管理员登录、会员注册和会员登录调用，IP为空时使用请求元数据中的客户端IP
+func (s *sSite) CheckAccess(ctx context.Context, siteId int, ip string) error {
+	if ip == "" {
+		ip = middleware.GetClientIPFromContext(ctx)
+	}
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		middleware.LogWithTrace(ctx, "warning", "客户端IP格式错误，跳过访问屏蔽检查 - IP: %s", ip)
+		return nil
+	}
+
+	rules, err := s.getDenyRules(ctx, siteId)
+	if err != nil {
+		// 规则加载失败时不阻断访问，避免数据库异常导致所有人无法登录
+		middleware.LogWithTrace(ctx, "error", "加载访问屏蔽规则失败: %v", err)
+		return nil
+	}
+
+	if rules.ips[parsed.String()] {
+		middleware.LogWithTrace(ctx, "warning", "IP已被屏蔽 - SiteId: %d, IP: %s", siteId, ip)
+		return fmt.Errorf("当前IP禁止访问")
+	}
+	for _, network := range rules.networks {
+		if network.Contains(parsed) {
+			middleware.LogWithTrace(ctx, "warning", "IP所在网段已被屏蔽 - SiteId: %d, IP: %s, 网段: %s", siteId, ip, network.String())
+			return fmt.Errorf("当前IP禁止访问")
+		}
+	}
+
+	if len(rules.addresses) == 0 {
+		return nil
+	}
+	region, err := geoip.Lookup(ip)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询IP地区失败 - IP: %s, 错误: %v", ip, err)
+		return nil
+	}
+	for _, address := range rules.addresses {
+		if region.Matches(address) {
+			middleware.LogWithTrace(ctx, "warning", "IP所在地区已被屏蔽 - SiteId: %d, IP: %s, 地区: %s", siteId, ip, region.String())
+			return fmt.Errorf("当前地区禁止访问")
+		}
+	}
+	return nil
+}
+
+// getDenyRules 获取站点的屏蔽规则，缓存过期或规则修改后重新加载
+func (s *sSite) getDenyRules(ctx context.Context, siteId int) (*denyRules, error) {
+	s.mu.RLock()
+	rules := s.denys[siteId]
+	s.mu.RUnlock()
+	if rules != nil && time.Since(rules.loadedAt) < denyRulesCacheTTL {
+		return rules, nil
+	}
+
+	var records []*entity.SiteDeny
+	err := dao.SiteDeny.Ctx(ctx).Where(do.SiteDeny{SiteId: siteId}).Scan(&records)
+	if err != nil {
+		return nil, err
+	}
+
+	rules = &denyRules{
+		ips:      make(map[string]bool),
+		loadedAt: time.Now(),
+	}
+	for _, record := range records {
+		switch record.Type {
+		case consts.SiteDenyTypeIp:
+			if _, network, err := net.ParseCIDR(record.Ip); err == nil {
+				rules.networks = append(rules.networks, network)
+			} else if ip := net.ParseIP(record.Ip); ip != nil {
+				rules.ips[ip.String()] = true
+			}
+		case consts.SiteDenyTypeAddress:
+			if address := strings.TrimSpace(record.Address); address != "" {
+				rules.addresses = append(rules.addresses, address)
+			}
+		}
+	}
+	if len(rules.addresses) > 0 && !geoip.Enabled() {
+		middleware.LogWithTrace(ctx, "warning", "站点已配置地区屏蔽，但GeoIP离线库未加载 - SiteId: %d", siteId)
+	}
+
+	s.mu.Lock()
+	s.denys[siteId] = rules
+	s.mu.Unlock()
+	return rules, nil
+}
+
+// refreshDenyRules 规则修改后清除缓存，下次检查时重新加载
+func (s *sSite) refreshDenyRules(siteId int) {
+	s.mu.Lock()
+	delete(s.denys, siteId)
+	s.mu.Unlock()
+}
+
+// CheckSiteAccess 检查IP是否允许访问站点，供网关在会员登录等入口调用
+func (s *sSite) CheckSiteAccess(ctx context.Context, req *v1.CheckSiteAccessReq) (*v1.CheckSiteAccessRes, error) {
+	// 默认站点ID为1
+	siteId := 1
+
+	if err := s.CheckAccess(ctx, siteId, req.Ip); err != nil {
+		return &v1.CheckSiteAccessRes{Allowed: false, Message: err.Error()}, nil
+	}
+	return &v1.CheckSiteAccessRes{Allowed: true}, nil
+}
+
+// GetSiteDenies 获取访问屏蔽规则列表
+func (s *sSite) GetSiteDenies(ctx context.Context, req *v1.GetSiteDeniesReq) (*v1.GetSiteDeniesRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取访问屏蔽规则列表请求 - Type: %d, Keyword: %s, Page: %d, Size: %d", req.Type, req.Keyword, req.Page, req.Size)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	size := req.Size
+	if size <= 0 {
+		size = 50
+	}
+
+	query := dao.SiteDeny.Ctx(ctx).Where(do.SiteDeny{
+		SiteId: siteId,
+	})
+	if req.Type > 0 {
+		query = query.Where("type", req.Type)
+	}
+	if req.Keyword != "" {
+		query = query.Where("(ip LIKE ? OR address LIKE ?)", "%"+req.Keyword+"%", "%"+req.Keyword+"%")
+	}
+
+	total, err := query.Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取访问屏蔽规则总数失败: %v", err)
+		return nil, err
+	}
+
+	var records []*entity.SiteDeny
+	err = query.Order("id DESC").Page(int(page), int(size)).Scan(&records)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取访问屏蔽规则列表失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]*v1.SiteDenyInfo, 0, len(records))
+	for _, record := range records {
+		list = append(list, &v1.SiteDenyInfo{
+			Id:        int32(record.Id),
+			Type:      int32(record.Type),
+			Ip:        record.Ip,
+			Address:   record.Address,
+			CreatedAt: util.FormatTime(record.CreatedAt),
+			UpdatedAt: util.FormatTime(record.UpdatedAt),
+		})
+	}
+
+	middleware.LogWithTrace(ctx, "info", "获取访问屏蔽规则列表成功 - 总数: %d", total)
+
+	return &v1.GetSiteDeniesRes{
+		List:  list,
+		Count: int32(total),
+	}, nil
+}
+
+// CreateSiteDeny 添加访问屏蔽规则
+func (s *sSite) CreateSiteDeny(ctx context.Context, req *v1.CreateSiteDenyReq) (*v1.CreateSiteDenyRes, error) {
+	middleware.LogWithTrace(ctx, "info", "添加访问屏蔽规则请求 - Type: %d, Ip: %s, Address: %s", req.Type, req.Ip, req.Address)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	ip, address, message := normalizeSiteDeny(int(req.Type), req.Ip, req.Address)
+	if message != "" {
+		return &v1.CreateSiteDenyRes{Success: false, Message: message}, nil
+	}
+	exists, err := s.siteDenyExists(ctx, siteId, 0, int(req.Type), ip, address)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询访问屏蔽规则失败: %v", err)
+		return nil, err
+	}
+	if exists {
+		return &v1.CreateSiteDenyRes{Success: false, Message: "屏蔽规则已存在"}, nil
+	}
+
+	id, err := dao.SiteDeny.Ctx(ctx).Data(do.SiteDeny{
+		SiteId:    siteId,
+		Type:      req.Type,
+		Ip:        ip,
+		Address:   address,
+		CreatedAt: gtime.Now(),
+		UpdatedAt: gtime.Now(),
+	}).InsertAndGetId()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "添加访问屏蔽规则失败: %v", err)
+		return nil, err
+	}
+	s.refreshDenyRules(siteId)
+
+	if err = backend.Admin().WriteLog(ctx, fmt.Sprintf("添加访问屏蔽规则 [ID:%d] %s", id, siteDenyDesc(int(req.Type), ip, address))); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "添加访问屏蔽规则成功 - Id: %d", id)
+	return &v1.CreateSiteDenyRes{Success: true, Message: "添加成功", Id: int32(id)}, nil
+}
+
+// UpdateSiteDeny 修改访问屏蔽规则
+func (s *sSite) UpdateSiteDeny(ctx context.Context, req *v1.UpdateSiteDenyReq) (*v1.UpdateSiteDenyRes, error) {
+	middleware.LogWithTrace(ctx, "info", "修改访问屏蔽规则请求 - Id: %d, Type: %d, Ip: %s, Address: %s", req.Id, req.Type, req.Ip, req.Address)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	var record *entity.SiteDeny
+	err := dao.SiteDeny.Ctx(ctx).Where(do.SiteDeny{
+		Id:     req.Id,
+		SiteId: siteId,
+	}).Scan(&record)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询访问屏蔽规则失败: %v", err)
+		return nil, err
+	}
+	if record == nil {
+		return &v1.UpdateSiteDenyRes{Success: false, Message: "屏蔽规则不存在"}, nil
+	}
+
+	ip, address, message := normalizeSiteDeny(int(req.Type), req.Ip, req.Address)
+	if message != "" {
+		return &v1.UpdateSiteDenyRes{Success: false, Message: message}, nil
+	}
+	exists, err := s.siteDenyExists(ctx, siteId, int(record.Id), int(req.Type), ip, address)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询访问屏蔽规则失败: %v", err)
+		return nil, err
+	}
+	if exists {
+		return &v1.UpdateSiteDenyRes{Success: false, Message: "屏蔽规则已存在"}, nil
+	}
+
+	_, err = dao.SiteDeny.Ctx(ctx).Where("id", record.Id).Data(do.SiteDeny{
+		Type:      req.Type,
+		Ip:        ip,
+		Address:   address,
+		UpdatedAt: gtime.Now(),
+	}).Update()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "修改访问屏蔽规则失败: %v", err)
+		return nil, err
+	}
+	s.refreshDenyRules(siteId)
+
+	logMessage := fmt.Sprintf("修改访问屏蔽规则 [ID:%d] %s 改为 %s", record.Id,
+		siteDenyDesc(record.Type, record.Ip, record.Address), siteDenyDesc(int(req.Type), ip, address))
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "修改访问屏蔽规则成功 - Id: %d", req.Id)
+	return &v1.UpdateSiteDenyRes{Success: true, Message: "修改成功"}, nil
+}
+
+// DeleteSiteDeny 删除访问屏蔽规则
+func (s *sSite) DeleteSiteDeny(ctx context.Context, req *v1.DeleteSiteDenyReq) (*v1.DeleteSiteDenyRes, error) {
+	middleware.LogWithTrace(ctx, "info", "删除访问屏蔽规则请求 - Ids: %v", req.Ids)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	if len(req.Ids) == 0 {
+		return &v1.DeleteSiteDenyRes{Success: false, Message: "请选择要删除的屏蔽规则"}, nil
+	}
+
+	var records []*entity.SiteDeny
+	err := dao.SiteDeny.Ctx(ctx).Where(do.SiteDeny{SiteId: siteId}).WhereIn("id", req.Ids).Scan(&records)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询访问屏蔽规则失败: %v", err)
+		return nil, err
+	}
+	if len(records) == 0 {
+		return &v1.DeleteSiteDenyRes{Success: false, Message: "屏蔽规则不存在"}, nil
+	}
+
+	_, err = dao.SiteDeny.Ctx(ctx).Where(do.SiteDeny{SiteId: siteId}).WhereIn("id", req.Ids).Delete()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "删除访问屏蔽规则失败: %v", err)
+		return nil, err
+	}
+	s.refreshDenyRules(siteId)
+
+	descs := make([]string, 0, len(records))
+	for _, record := range records {
+		descs = append(descs, siteDenyDesc(record.Type, record.Ip, record.Address))
+	}
+	if err = backend.Admin().WriteLog(ctx, fmt.Sprintf("删除访问屏蔽规则 %s", strings.Join(descs, "，"))); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "删除访问屏蔽规则成功 - 数量: %d", len(records))
+	return &v1.DeleteSiteDenyRes{Success: true, Message: "删除成功"}, nil
+}
+
+// siteDenyExists 检查相同的屏蔽规则是否已存在，excludeId 为修改时排除的自身ID
+func (s *sSite) siteDenyExists(ctx context.Context, siteId, excludeId, denyType int, ip, address string) (bool, error) {
+	query := dao.SiteDeny.Ctx(ctx).Where(do.SiteDeny{
+		SiteId: siteId,
+		Type:   denyType,
+	})
+	if denyType == consts.SiteDenyTypeIp {
+		query = query.Where("ip", ip)
+	} else {
+		query = query.Where("address", address)
+	}
+	if excludeId > 0 {
+		query = query.WhereNot("id", excludeId)
+	}
+	count, err := query.Count()
+	return count > 0, err
+}
+
+// normalizeSiteDeny 校验并规范化屏蔽规则，网段统一保存为网络地址形式，如 1.2.3.4/24 保存为 1.2.3.0/24
+func normalizeSiteDeny(denyType int, ip, address string) (string, string, string) {
+	ip, address = strings.TrimSpace(ip), strings.TrimSpace(address)
+	switch denyType {
+	case consts.SiteDenyTypeIp:
+		if ip == "" {
+			return "", "", "请输入IP地址或网段"
+		}
+		if strings.Contains(ip, "/") {
+			_, network, err := net.ParseCIDR(ip)
+			if err != nil {
+				return "", "", "网段格式错误"
+			}
+			return network.String(), "", ""
+		}
+		parsed := net.ParseIP(ip)
+		if parsed == nil {
+			return "", "", "IP地址格式错误"
+		}
+		return parsed.String(), "", ""
+	case consts.SiteDenyTypeAddress:
+		if address == "" {
+			return "", "", "请输入地区名称"
+		}
+		return "", address, ""
+	}
+	return "", "", "屏蔽类型无效"
+}
+
+// siteDenyDesc 屏蔽规则描述，用于管理员日志
+func siteDenyDesc(denyType int, ip, address string) string {
+	if denyType == consts.SiteDenyTypeIp {
+		return "IP:" + ip
+	}
+	return "地区:" + address
+}
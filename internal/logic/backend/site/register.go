@@ -0,0 +1,195 @@
+package site
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "jh_app_service/api/backend/site/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/os/gcache"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// 注册项缓存时长，本进程修改后立即失效，其他进程最多延迟该时长生效
+const registerFieldsCacheTTL = time.Minute
+
+// registerCoreFields 核心注册项，必须显示且必填
+var registerCoreFields = map[string]bool{
+	"username": true,
+	"password": true,
+}
+
+// registerFieldsCacheKey 注册项缓存键
+func registerFieldsCacheKey(siteId, fieldType int) string {
+	return fmt.Sprintf("site_register:%d:%d", siteId, fieldType)
+}
+
+// GetRegisterFields 获取注册项设置
+func (s *sSite) GetRegisterFields(ctx context.Context, req *v1.GetRegisterFieldsReq) (*v1.GetRegisterFieldsRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取注册项设置请求 - SiteId: %d, Type: %d", req.SiteId, req.Type)
+
+	// 默认站点ID为1，如果请求中有指定则使用指定的
+	siteId := int32(1)
+	if req.SiteId > 0 {
+		siteId = req.SiteId
+	}
+	fieldType := int(req.Type)
+	if fieldType == 0 {
+		fieldType = consts.SiteRegisterTypeUser
+	}
+
+	var fields []*entity.SiteRegister
+	err := dao.SiteRegister.Ctx(ctx).Where(do.SiteRegister{
+		SiteId: siteId,
+		Type:   fieldType,
+	}).Order("id ASC").Scan(&fields)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询注册项失败: %v", err)
+		return nil, fmt.Errorf("查询注册项失败: %v", err)
+	}
+
+	list := make([]*v1.RegisterFieldInfo, 0, len(fields))
+	for _, field := range fields {
+		list = append(list, &v1.RegisterFieldInfo{
+			Id:        int32(field.Id),
+			Type:      int32(field.Type),
+			Name:      field.Name,
+			FieldName: field.FieldName,
+			Display:   field.Display == 1,
+			Required:  field.Required == 1,
+			IsCore:    registerCoreFields[field.FieldName],
+		})
+	}
+
+	middleware.LogWithTrace(ctx, "info", "获取注册项设置成功 - SiteId: %d, 数量: %d", siteId, len(list))
+	return &v1.GetRegisterFieldsRes{List: list}, nil
+}
+
+// UpdateRegisterFields 批量更新注册项的显示和必填设置
+func (s *sSite) UpdateRegisterFields(ctx context.Context, req *v1.UpdateRegisterFieldsReq) (*v1.UpdateRegisterFieldsRes, error) {
+	middleware.LogWithTrace(ctx, "info", "更新注册项设置请求 - SiteId: %d, Type: %d, 数量: %d", req.SiteId, req.Type, len(req.Fields))
+
+	// 默认站点ID为1，如果请求中有指定则使用指定的
+	siteId := int32(1)
+	if req.SiteId > 0 {
+		siteId = req.SiteId
+	}
+	fieldType := int(req.Type)
+	if fieldType == 0 {
+		fieldType = consts.SiteRegisterTypeUser
+	}
+	if fieldType != consts.SiteRegisterTypeUser && fieldType != consts.SiteRegisterTypeAgent {
+		return &v1.UpdateRegisterFieldsRes{Success: false, Message: "注册表单类型无效"}, nil
+	}
+	if len(req.Fields) == 0 {
+		return &v1.UpdateRegisterFieldsRes{Success: false, Message: "请选择要更新的注册项"}, nil
+	}
+
+	var fields []*entity.SiteRegister
+	err := dao.SiteRegister.Ctx(ctx).Where(do.SiteRegister{
+		SiteId: siteId,
+		Type:   fieldType,
+	}).Scan(&fields)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询注册项失败: %v", err)
+		return nil, fmt.Errorf("查询注册项失败: %v", err)
+	}
+	fieldMap := make(map[int32]*entity.SiteRegister, len(fields))
+	for _, field := range fields {
+		fieldMap[int32(field.Id)] = field
+	}
+
+	changed := make([]string, 0, len(req.Fields))
+	for _, setting := range req.Fields {
+		field := fieldMap[setting.Id]
+		if field == nil {
+			return &v1.UpdateRegisterFieldsRes{Success: false, Message: fmt.Sprintf("注册项不存在: %d", setting.Id)}, nil
+		}
+		if registerCoreFields[field.FieldName] && (!setting.Display || !setting.Required) {
+			return &v1.UpdateRegisterFieldsRes{Success: false, Message: fmt.Sprintf("%s为核心注册项，必须显示且必填", field.Name)}, nil
+		}
+		if setting.Required && !setting.Display {
+			return &v1.UpdateRegisterFieldsRes{Success: false, Message: fmt.Sprintf("%s未显示时不能设为必填", field.Name)}, nil
+		}
+	}
+
+	err = dao.SiteRegister.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		for _, setting := range req.Fields {
+			field := fieldMap[setting.Id]
+			display, required := boolToInt(setting.Display), boolToInt(setting.Required)
+			if field.Display == display && field.Required == required {
+				continue
+			}
+			_, err := dao.SiteRegister.Ctx(ctx).Where("id", field.Id).Data(do.SiteRegister{
+				Display:   display,
+				Required:  required,
+				UpdatedAt: gtime.Now(),
+			}).Update()
+			if err != nil {
+				return err
+			}
+			changed = append(changed, fmt.Sprintf("%s(显示:%d 必填:%d)", field.Name, display, required))
+		}
+		return nil
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "更新注册项失败: %v", err)
+		return nil, fmt.Errorf("更新注册项失败: %v", err)
+	}
+
+	if _, err = gcache.Remove(ctx, registerFieldsCacheKey(int(siteId), fieldType)); err != nil {
+		middleware.LogWithTrace(ctx, "error", "清除注册项缓存失败: %v", err)
+	}
+
+	if len(changed) > 0 {
+		logMessage := fmt.Sprintf("修改注册项设置 [类型:%d] %s", fieldType, strings.Join(changed, "，"))
+		if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+			middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+		}
+	}
+
+	middleware.LogWithTrace(ctx, "info", "更新注册项设置成功 - SiteId: %d, 修改数量: %d", siteId, len(changed))
+	return &v1.UpdateRegisterFieldsRes{Success: true, Message: "设置成功"}, nil
+}
+
+// RegisterFields 获取站点注册项设置，供注册流程使用，结果按站点缓存
+func (s *sSite) RegisterFields(ctx context.Context, siteId, fieldType int) ([]*entity.SiteRegister, error) {
+	value, err := gcache.GetOrSetFuncLock(ctx, registerFieldsCacheKey(siteId, fieldType), func(ctx context.Context) (interface{}, error) {
+		var fields []*entity.SiteRegister
+		err := dao.SiteRegister.Ctx(ctx).Where(do.SiteRegister{
+			SiteId: siteId,
+			Type:   fieldType,
+		}).Order("id ASC").Scan(&fields)
+		if err != nil {
+			return nil, err
+		}
+		// 空结果同样缓存，避免未配置注册项的站点每次注册都查询数据库
+		if fields == nil {
+			fields = []*entity.SiteRegister{}
+		}
+		return fields, nil
+	}, registerFieldsCacheTTL)
+	if err != nil {
+		return nil, fmt.Errorf("查询注册项失败: %v", err)
+	}
+
+	fields, _ := value.Val().([]*entity.SiteRegister)
+	return fields, nil
+}
+
+// boolToInt 布尔值转换为数据库中的 1/0
+func boolToInt(value bool) int {
+	if value {
+		return 1
+	}
+	return 0
+}
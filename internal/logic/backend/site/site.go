@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"jh_app_service/api/backend/site/v1"
 	"jh_app_service/internal/service/backend"
+	"sync"
 
 	"github.com/gogf/gf/v2/frame/g"
 	"jh_app_service/internal/dao"
@@ -14,11 +15,16 @@ import (
 )
 
 type (
-	sSite struct{}
+	sSite struct {
+		mu    sync.RWMutex
+		denys map[int]*denyRules // 访问屏蔽规则缓存，按站点ID
+	}
 )
 
 func init() {
-	backend.RegisterSite(&sSite{})
+	backend.RegisterSite(&sSite{
+		denys: make(map[int]*denyRules),
+	})
 }
 
 // GetBasicSetting 获取站点基本设置
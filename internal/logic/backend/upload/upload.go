@@ -225,20 +225,13 @@ func (s *sUpload) generateStoragePath(fileName string) string {
 
 // uploadToMinio 上传文件到MinIO
 func (s *sUpload) uploadToMinio(ctx context.Context, fileData []byte, objectPath, contentType string) (string, error) {
-	// 获取MinIO配置
 	endpoint := g.Cfg().MustGet(ctx, "minio.endpoint", "localhost:19000").String()
-	accessKey := g.Cfg().MustGet(ctx, "minio.accessKey", "minioadmin").String()
-	secretKey := g.Cfg().MustGet(ctx, "minio.secretKey", "minioadmin123").String()
 	bucketName := g.Cfg().MustGet(ctx, "minio.bucket", "uploads").String()
-	useSSL := g.Cfg().MustGet(ctx, "minio.useSSL", false).Bool()
 
 	// 创建MinIO客户端
-	minioClient, err := minio.New(endpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(accessKey, secretKey, ""),
-		Secure: useSSL,
-	})
+	minioClient, err := s.newMinioClient(ctx)
 	if err != nil {
-		return "", fmt.Errorf("创建MinIO客户端失败: %v", err)
+		return "", err
 	}
 
 	// 检查bucket是否存在，不存在则创建
@@ -270,3 +263,61 @@ func (s *sUpload) uploadToMinio(ctx context.Context, fileData []byte, objectPath
 
 	return imageURL, nil
 }
+
+// newMinioClient 根据配置创建MinIO客户端
+func (s *sUpload) newMinioClient(ctx context.Context) (*minio.Client, error) {
+	// 获取MinIO配置
+	endpoint := g.Cfg().MustGet(ctx, "minio.endpoint", "localhost:19000").String()
+	accessKey := g.Cfg().MustGet(ctx, "minio.accessKey", "minioadmin").String()
+	secretKey := g.Cfg().MustGet(ctx, "minio.secretKey", "minioadmin123").String()
+	useSSL := g.Cfg().MustGet(ctx, "minio.useSSL", false).Bool()
+
+	minioClient, err := minio.New(endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(accessKey, secretKey, ""),
+		Secure: useSSL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("创建MinIO客户端失败: %v", err)
+	}
+	return minioClient, nil
+}
+
+// ValidateImageUrl 校验图片地址是否为上传接口返回的本站图片，并按上传标识检查文件类型和大小
+func (s *sUpload) ValidateImageUrl(ctx context.Context, imageUrl, uploadCode string) error {
+	if imageUrl == "" {
+		return fmt.Errorf("图片地址不能为空")
+	}
+	if uploadCode == "" {
+		uploadCode = "default"
+	}
+
+	endpoint := g.Cfg().MustGet(ctx, "minio.endpoint", "localhost:19000").String()
+	bucketName := g.Cfg().MustGet(ctx, "minio.bucket", "uploads").String()
+	baseURL := g.Cfg().MustGet(ctx, "minio.publicURL", fmt.Sprintf("http://%s", endpoint)).String()
+	siteCode := g.Cfg().MustGet(ctx, "site.code", "site_1").String()
+
+	// 地址格式与 uploadToMinio 生成的一致: baseURL/bucket/site_code/YYYY/MM/filename
+	prefix := fmt.Sprintf("%s/%s/", baseURL, bucketName)
+	if !strings.HasPrefix(imageUrl, prefix) {
+		return fmt.Errorf("图片地址无效，请通过上传接口上传图片")
+	}
+	objectPath := strings.TrimPrefix(imageUrl, prefix)
+	if !strings.HasPrefix(objectPath, siteCode+"/") || strings.Contains(objectPath, "..") {
+		return fmt.Errorf("图片地址无效，请通过上传接口上传图片")
+	}
+
+	minioClient, err := s.newMinioClient(ctx)
+	if err != nil {
+		return err
+	}
+	info, err := minioClient.StatObject(ctx, bucketName, objectPath, minio.StatObjectOptions{})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询图片信息失败 - 路径: %s, 错误: %v", objectPath, err)
+		return fmt.Errorf("图片不存在，请重新上传")
+	}
+
+	if err = s.validateFileType(info.ContentType, uploadCode); err != nil {
+		return err
+	}
+	return s.validateFileSize(info.Size, uploadCode)
+}
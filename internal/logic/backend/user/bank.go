@@ -0,0 +1,532 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	v1 "jh_app_service/api/backend/user/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/secret"
+	"jh_app_service/internal/service/backend"
+	"jh_app_service/internal/util"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+var cardNoPattern = regexp.MustCompile(`^[0-9]{12,19}$`)
+
+// userBankActionNames 银行卡变更操作名称
+var userBankActionNames = map[int]string{
+	consts.UserBankCreate:     "添加",
+	consts.UserBankUpdate:     "修改",
+	consts.UserBankSetDefault: "设为默认",
+	consts.UserBankDelete:     "删除",
+}
+
+// GetUserBanks 获取会员银行卡，卡号脱敏返回
+func (s *sUser) GetUserBanks(ctx context.Context, req *v1.GetUserBanksReq) (*v1.GetUserBanksRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取会员银行卡请求 - UserId: %d", req.UserId)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	var banks []*entity.UserBank
+	err := dao.UserBank.Ctx(ctx).Where(do.UserBank{
+		SiteId: siteId,
+		UserId: req.UserId,
+	}).Order("is_default DESC, id ASC").Scan(&banks)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取会员银行卡失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]*v1.UserBankInfo, 0, len(banks))
+	for _, bank := range banks {
+		list = append(list, &v1.UserBankInfo{
+			Id:          int32(bank.Id),
+			BankName:    bank.BankName,
+			CardAccount: bank.CardAccount,
+			CardNo:      secret.Mask(ctx, bank.CardNo),
+			DepositBank: bank.DepositBank,
+			IsDefault:   bank.IsDefault == 1,
+			CreatedAt:   util.FormatTime(bank.CreatedAt),
+			UpdatedAt:   util.FormatTime(bank.UpdatedAt),
+		})
+	}
+
+	return &v1.GetUserBanksRes{List: list}, nil
+}
+
+// CreateUserBank 为会员添加银行卡，卡号加密存储
+func (s *sUser) CreateUserBank(ctx context.Context, req *v1.CreateUserBankReq) (*v1.CreateUserBankRes, error) {
+	middleware.LogWithTrace(ctx, "info", "添加会员银行卡请求 - UserId: %d, BankName: %s", req.UserId, req.BankName)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	user, err := s.getBankUser(ctx, siteId, int(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return &v1.CreateUserBankRes{Success: false, Message: "会员不存在"}, nil
+	}
+
+	cardNo := normalizeCardNo(req.CardNo)
+	if secret.IsMasked(cardNo) {
+		return &v1.CreateUserBankRes{Success: false, Message: "请输入完整的卡号"}, nil
+	}
+	if message := validateUserBank(user, req.BankName, req.CardAccount, cardNo); message != "" {
+		return &v1.CreateUserBankRes{Success: false, Message: message}, nil
+	}
+
+	var banks []*entity.UserBank
+	err = dao.UserBank.Ctx(ctx).Where(do.UserBank{
+		SiteId: siteId,
+		UserId: int(user.Id),
+	}).Scan(&banks)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询会员银行卡失败: %v", err)
+		return nil, err
+	}
+	if exists, err := cardExists(ctx, banks, cardNo, 0); err != nil {
+		return nil, err
+	} else if exists {
+		return &v1.CreateUserBankRes{Success: false, Message: "该银行卡已添加"}, nil
+	}
+
+	encrypted, err := secret.Encrypt(ctx, cardNo)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "加密银行卡号失败: %v", err)
+		return nil, fmt.Errorf("加密银行卡号失败: %v", err)
+	}
+
+	// 会员的第一张卡自动设为默认
+	isDefault := req.IsDefault || len(banks) == 0
+	bank := &entity.UserBank{
+		SiteId:      siteId,
+		UserId:      int(user.Id),
+		BankName:    strings.TrimSpace(req.BankName),
+		CardAccount: strings.TrimSpace(req.CardAccount),
+		CardNo:      encrypted,
+		DepositBank: strings.TrimSpace(req.DepositBank),
+		IsDefault:   boolToInt(isDefault),
+		CreatedAt:   gtime.Now(),
+		UpdatedAt:   gtime.Now(),
+	}
+	masked := secret.Mask(ctx, encrypted)
+
+	err = dao.UserBank.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		if isDefault {
+			if err := clearDefaultBank(ctx, siteId, int(user.Id)); err != nil {
+				return err
+			}
+		}
+		id, err := dao.UserBank.Ctx(ctx).Data(bank).OmitEmptyData().InsertAndGetId()
+		if err != nil {
+			return err
+		}
+		bank.Id = uint(id)
+		return addUserBankLog(ctx, bank, consts.UserBankCreate, "", encrypted, fmt.Sprintf("添加银行卡 %s %s", bank.BankName, masked))
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "添加会员银行卡失败: %v", err)
+		return nil, fmt.Errorf("添加会员银行卡失败: %v", err)
+	}
+
+	s.afterUserBankChanged(ctx, user, fmt.Sprintf("添加银行卡 %s %s", bank.BankName, masked))
+
+	middleware.LogWithTrace(ctx, "info", "添加会员银行卡成功 - UserId: %d, BankId: %d", user.Id, bank.Id)
+	return &v1.CreateUserBankRes{Success: true, Message: "添加成功", Id: int32(bank.Id)}, nil
+}
+
+// UpdateUserBank 修改会员银行卡，卡号为空或为脱敏值时保持不变
+func (s *sUser) UpdateUserBank(ctx context.Context, req *v1.UpdateUserBankReq) (*v1.UpdateUserBankRes, error) {
+	middleware.LogWithTrace(ctx, "info", "修改会员银行卡请求 - BankId: %d", req.Id)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	bank, user, err := s.getUserBank(ctx, siteId, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	if bank == nil || user == nil {
+		return &v1.UpdateUserBankRes{Success: false, Message: "银行卡不存在"}, nil
+	}
+
+	oldCardNo, err := secret.Decrypt(ctx, bank.CardNo)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "解密银行卡号失败: %v", err)
+		return nil, fmt.Errorf("解密银行卡号失败: %v", err)
+	}
+	cardNo := normalizeCardNo(req.CardNo)
+	if cardNo == "" || secret.IsMasked(cardNo) {
+		cardNo = oldCardNo
+	}
+	if message := validateUserBank(user, req.BankName, req.CardAccount, cardNo); message != "" {
+		return &v1.UpdateUserBankRes{Success: false, Message: message}, nil
+	}
+
+	updated := *bank
+	updated.BankName = strings.TrimSpace(req.BankName)
+	updated.CardAccount = strings.TrimSpace(req.CardAccount)
+	updated.DepositBank = strings.TrimSpace(req.DepositBank)
+
+	var changes []string
+	if updated.BankName != bank.BankName {
+		changes = append(changes, fmt.Sprintf("银行名称: %s → %s", bank.BankName, updated.BankName))
+	}
+	if updated.CardAccount != bank.CardAccount {
+		changes = append(changes, fmt.Sprintf("开户人: %s → %s", bank.CardAccount, updated.CardAccount))
+	}
+	if updated.DepositBank != bank.DepositBank {
+		changes = append(changes, fmt.Sprintf("开户行: %s → %s", bank.DepositBank, updated.DepositBank))
+	}
+	oldMasked := secret.Mask(ctx, bank.CardNo)
+	newMasked := oldMasked
+	if cardNo != oldCardNo {
+		var banks []*entity.UserBank
+		err = dao.UserBank.Ctx(ctx).Where(do.UserBank{
+			SiteId: siteId,
+			UserId: bank.UserId,
+		}).Scan(&banks)
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "查询会员银行卡失败: %v", err)
+			return nil, err
+		}
+		if exists, err := cardExists(ctx, banks, cardNo, bank.Id); err != nil {
+			return nil, err
+		} else if exists {
+			return &v1.UpdateUserBankRes{Success: false, Message: "该银行卡已添加"}, nil
+		}
+		if updated.CardNo, err = secret.Encrypt(ctx, cardNo); err != nil {
+			middleware.LogWithTrace(ctx, "error", "加密银行卡号失败: %v", err)
+			return nil, fmt.Errorf("加密银行卡号失败: %v", err)
+		}
+		newMasked = secret.Mask(ctx, updated.CardNo)
+		changes = append(changes, fmt.Sprintf("卡号: %s → %s", oldMasked, newMasked))
+	}
+	if len(changes) == 0 {
+		return &v1.UpdateUserBankRes{Success: true, Message: "未修改任何内容"}, nil
+	}
+	content := fmt.Sprintf("修改银行卡 %s，%s", oldMasked, strings.Join(changes, "，"))
+
+	err = dao.UserBank.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		_, err := dao.UserBank.Ctx(ctx).Where("id", bank.Id).Data(do.UserBank{
+			BankName:    updated.BankName,
+			CardAccount: updated.CardAccount,
+			CardNo:      updated.CardNo,
+			DepositBank: updated.DepositBank,
+			UpdatedAt:   gtime.Now(),
+		}).Update()
+		if err != nil {
+			return err
+		}
+		return addUserBankLog(ctx, &updated, consts.UserBankUpdate, bank.CardNo, updated.CardNo, content)
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "修改会员银行卡失败: %v", err)
+		return nil, fmt.Errorf("修改会员银行卡失败: %v", err)
+	}
+
+	s.afterUserBankChanged(ctx, user, content)
+
+	middleware.LogWithTrace(ctx, "info", "修改会员银行卡成功 - BankId: %d", bank.Id)
+	return &v1.UpdateUserBankRes{Success: true, Message: "修改成功"}, nil
+}
+
+// SetDefaultUserBank 设置会员默认银行卡
+func (s *sUser) SetDefaultUserBank(ctx context.Context, req *v1.SetDefaultUserBankReq) (*v1.SetDefaultUserBankRes, error) {
+	middleware.LogWithTrace(ctx, "info", "设置默认银行卡请求 - BankId: %d", req.Id)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	bank, user, err := s.getUserBank(ctx, siteId, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	if bank == nil || user == nil {
+		return &v1.SetDefaultUserBankRes{Success: false, Message: "银行卡不存在"}, nil
+	}
+	if bank.IsDefault == 1 {
+		return &v1.SetDefaultUserBankRes{Success: true, Message: "已是默认银行卡"}, nil
+	}
+
+	content := fmt.Sprintf("默认银行卡改为 %s %s", bank.BankName, secret.Mask(ctx, bank.CardNo))
+	err = dao.UserBank.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		if err := clearDefaultBank(ctx, siteId, bank.UserId); err != nil {
+			return err
+		}
+		_, err := dao.UserBank.Ctx(ctx).Where("id", bank.Id).Data(do.UserBank{
+			IsDefault: 1,
+			UpdatedAt: gtime.Now(),
+		}).Update()
+		if err != nil {
+			return err
+		}
+		return addUserBankLog(ctx, bank, consts.UserBankSetDefault, bank.CardNo, bank.CardNo, content)
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "设置默认银行卡失败: %v", err)
+		return nil, fmt.Errorf("设置默认银行卡失败: %v", err)
+	}
+
+	s.afterUserBankChanged(ctx, user, content)
+
+	middleware.LogWithTrace(ctx, "info", "设置默认银行卡成功 - BankId: %d", bank.Id)
+	return &v1.SetDefaultUserBankRes{Success: true, Message: "设置成功"}, nil
+}
+
+// DeleteUserBank 删除会员银行卡，删除默认卡时将最近添加的一张设为默认
+func (s *sUser) DeleteUserBank(ctx context.Context, req *v1.DeleteUserBankReq) (*v1.DeleteUserBankRes, error) {
+	middleware.LogWithTrace(ctx, "info", "删除会员银行卡请求 - BankId: %d", req.Id)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	bank, user, err := s.getUserBank(ctx, siteId, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	if bank == nil || user == nil {
+		return &v1.DeleteUserBankRes{Success: false, Message: "银行卡不存在"}, nil
+	}
+
+	content := fmt.Sprintf("删除银行卡 %s %s", bank.BankName, secret.Mask(ctx, bank.CardNo))
+	err = dao.UserBank.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		if _, err := dao.UserBank.Ctx(ctx).Where("id", bank.Id).Delete(); err != nil {
+			return err
+		}
+		if bank.IsDefault == 1 {
+			var next *entity.UserBank
+			err := dao.UserBank.Ctx(ctx).Where(do.UserBank{
+				SiteId: siteId,
+				UserId: bank.UserId,
+			}).OrderDesc("id").Limit(1).Scan(&next)
+			if err != nil {
+				return err
+			}
+			if next != nil {
+				_, err = dao.UserBank.Ctx(ctx).Where("id", next.Id).Data(do.UserBank{
+					IsDefault: 1,
+					UpdatedAt: gtime.Now(),
+				}).Update()
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return addUserBankLog(ctx, bank, consts.UserBankDelete, bank.CardNo, "", content)
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "删除会员银行卡失败: %v", err)
+		return nil, fmt.Errorf("删除会员银行卡失败: %v", err)
+	}
+
+	s.afterUserBankChanged(ctx, user, content)
+
+	middleware.LogWithTrace(ctx, "info", "删除会员银行卡成功 - BankId: %d", bank.Id)
+	return &v1.DeleteUserBankRes{Success: true, Message: "删除成功"}, nil
+}
+
+// GetUserBankLogs 获取会员银行卡变更记录
+func (s *sUser) GetUserBankLogs(ctx context.Context, req *v1.GetUserBankLogsReq) (*v1.GetUserBankLogsRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取银行卡变更记录请求 - UserId: %d, Page: %d, Size: %d", req.UserId, req.Page, req.Size)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	size := req.Size
+	if size <= 0 {
+		size = 50
+	}
+
+	query := dao.UserBankLog.Ctx(ctx).Where(do.UserBankLog{
+		SiteId: siteId,
+		UserId: req.UserId,
+	})
+	total, err := query.Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取银行卡变更记录总数失败: %v", err)
+		return nil, err
+	}
+
+	var logs []*entity.UserBankLog
+	err = query.Page(int(page), int(size)).OrderDesc("id").Scan(&logs)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取银行卡变更记录失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]*v1.UserBankLogInfo, 0, len(logs))
+	for _, log := range logs {
+		list = append(list, &v1.UserBankLogInfo{
+			Id:         int32(log.Id),
+			BankId:     int32(log.BankId),
+			Action:     int32(log.Action),
+			ActionName: userBankActionNames[log.Action],
+			OldCardNo:  secret.Mask(ctx, log.OldCardNo),
+			NewCardNo:  secret.Mask(ctx, log.NewCardNo),
+			Content:    log.Content,
+			AdminName:  log.AdminName,
+			Ip:         log.Ip,
+			CreatedAt:  util.FormatTime(log.CreatedAt),
+		})
+	}
+
+	return &v1.GetUserBankLogsRes{
+		List:  list,
+		Count: int32(total),
+	}, nil
+}
+
+// getBankUser 查询银行卡所属会员
+func (s *sUser) getBankUser(ctx context.Context, siteId, userId int) (*entity.User, error) {
+	var user *entity.User
+	err := dao.User.Ctx(ctx).Fields("id, site_id, username, realname").Where(do.User{
+		SiteId: siteId,
+		Id:     userId,
+	}).Scan(&user)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询会员失败: %v", err)
+		return nil, fmt.Errorf("查询会员失败: %v", err)
+	}
+	return user, nil
+}
+
+// getUserBank 查询银行卡及所属会员
+func (s *sUser) getUserBank(ctx context.Context, siteId, bankId int) (*entity.UserBank, *entity.User, error) {
+	var bank *entity.UserBank
+	err := dao.UserBank.Ctx(ctx).Where(do.UserBank{
+		SiteId: siteId,
+		Id:     bankId,
+	}).Scan(&bank)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询银行卡失败: %v", err)
+		return nil, nil, fmt.Errorf("查询银行卡失败: %v", err)
+	}
+	if bank == nil {
+		return nil, nil, nil
+	}
+	user, err := s.getBankUser(ctx, siteId, bank.UserId)
+	if err != nil {
+		return nil, nil, err
+	}
+	return bank, user, nil
+}
+
+// afterUserBankChanged 银行卡变更后记录管理员日志并触发风险预警
+func (s *sUser) afterUserBankChanged(ctx context.Context, user *entity.User, content string) {
+	logMessage := fmt.Sprintf("会员 %s %s", user.Username, content)
+	if err := backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	err := backend.Risk().Evaluate(ctx, &model.RiskEvent{
+		Type:     consts.RiskAlterBankCard,
+		SiteId:   user.SiteId,
+		UserId:   int(user.Id),
+		Username: user.Username,
+		Content:  content,
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "修改银行卡风险预警失败: %v", err)
+	}
+}
+
+// normalizeCardNo 去掉卡号中的空格
+func normalizeCardNo(cardNo string) string {
+	return strings.ReplaceAll(strings.TrimSpace(cardNo), " ", "")
+}
+
+// validateUserBank 校验银行卡信息，失败时返回提示信息
+func validateUserBank(user *entity.User, bankName, cardAccount, cardNo string) string {
+	if strings.TrimSpace(bankName) == "" {
+		return "请填写银行名称"
+	}
+	cardAccount = strings.TrimSpace(cardAccount)
+	if cardAccount == "" {
+		return "请填写开户人"
+	}
+	if user.Realname != "" && cardAccount != user.Realname {
+		return "开户人须与会员真实姓名一致"
+	}
+	if !cardNoPattern.MatchString(cardNo) {
+		return "银行卡号须为12-19位数字"
+	}
+	return ""
+}
+
+// cardExists 检查会员是否已添加该卡号，卡号加密存储，需逐张解密比较
+func cardExists(ctx context.Context, banks []*entity.UserBank, cardNo string, excludeId uint) (bool, error) {
+	for _, bank := range banks {
+		if bank.Id == excludeId {
+			continue
+		}
+		plaintext, err := secret.Decrypt(ctx, bank.CardNo)
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "解密银行卡号失败 - BankId: %d, 错误: %v", bank.Id, err)
+			return false, fmt.Errorf("解密银行卡号失败: %v", err)
+		}
+		if plaintext == cardNo {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// clearDefaultBank 取消会员所有银行卡的默认状态
+func clearDefaultBank(ctx context.Context, siteId, userId int) error {
+	_, err := dao.UserBank.Ctx(ctx).Where(do.UserBank{
+		SiteId:    siteId,
+		UserId:    userId,
+		IsDefault: 1,
+	}).Data(do.UserBank{
+		IsDefault: 0,
+		UpdatedAt: gtime.Now(),
+	}).Update()
+	return err
+}
+
+// addUserBankLog 记录银行卡变更，卡号保存密文
+func addUserBankLog(ctx context.Context, bank *entity.UserBank, action int, oldCardNo, newCardNo, content string) error {
+	log := do.UserBankLog{
+		SiteId:    bank.SiteId,
+		UserId:    bank.UserId,
+		BankId:    bank.Id,
+		Action:    action,
+		OldCardNo: oldCardNo,
+		NewCardNo: newCardNo,
+		Content:   content,
+		Ip:        middleware.GetClientIPFromContext(ctx),
+		CreatedAt: gtime.Now(),
+	}
+	if admin := backend.Admin().CurrentAdmin(ctx); admin != nil {
+		log.AdminId = admin.Id
+		log.AdminName = admin.Username
+	}
+	_, err := dao.UserBankLog.Ctx(ctx).Data(log).Insert()
+	return err
+}
+
+// boolToInt 布尔值转换为数据库中的 1/0
+func boolToInt(value bool) int {
+	if value {
+		return 1
+	}
+	return 0
+}
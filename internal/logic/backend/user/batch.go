@@ -0,0 +1,314 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "jh_app_service/api/backend/user/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+const (
+	// 单次批量修改的会员数上限
+	batchUpdateLimit = 5000
+	// 每个事务修改的会员数
+	batchUpdateChunkSize = 200
+	// 已是目标值的会员的结果说明
+	batchResultUnchanged = "无需修改"
+)
+
+// batchChange 批量修改的内容，-1 表示不修改
+type batchChange struct {
+	status        int
+	gradeId       int
+	levelId       int
+	agentId       int
+	focusLevel    int
+	balanceStatus int
+	desc          []string // 修改内容说明，用于管理员日志
+}
+
+// BatchUpdateUsers 批量修改会员状态、等级、层级、代理等，按批次在事务中执行并返回每个会员的结果
+func (s *sUser) BatchUpdateUsers(ctx context.Context, req *v1.BatchUpdateUsersReq) (*v1.BatchUpdateUsersRes, error) {
+	middleware.LogWithTrace(ctx, "info", "批量修改会员请求 - IDs: %d, Status: %d, GradeId: %d, LevelId: %d, Agent: %s, FocusLevel: %d, BalanceStatus: %d",
+		len(req.Ids), req.Status, req.GradeId, req.LevelId, req.AgentUsername, req.FocusLevel, req.BalanceStatus)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	change, message, err := s.batchUserChange(ctx, siteId, req)
+	if err != nil {
+		return nil, err
+	}
+	if message != "" {
+		return &v1.BatchUpdateUsersRes{Success: false, Message: message}, nil
+	}
+
+	ids, message, err := s.batchUserIds(ctx, siteId, req)
+	if err != nil {
+		return nil, err
+	}
+	if message != "" {
+		return &v1.BatchUpdateUsersRes{Success: false, Message: message}, nil
+	}
+	if len(ids) == 0 {
+		return &v1.BatchUpdateUsersRes{Success: true, Message: "没有符合条件的会员", Results: []*v1.BatchUpdateUserResult{}}, nil
+	}
+
+	res := &v1.BatchUpdateUsersRes{
+		Success: true,
+		Total:   int32(len(ids)),
+		Results: make([]*v1.BatchUpdateUserResult, 0, len(ids)),
+	}
+	for start := 0; start < len(ids); start += batchUpdateChunkSize {
+		end := start + batchUpdateChunkSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		chunk := ids[start:end]
+
+		results, err := s.batchUpdateChunk(ctx, siteId, chunk, change)
+		if err != nil {
+			// 本批次回滚，继续处理后续批次
+			middleware.LogWithTrace(ctx, "error", "批量修改会员失败 - 批次: %d-%d, 错误: %v", start, end, err)
+			results = make([]*v1.BatchUpdateUserResult, 0, len(chunk))
+			for _, id := range chunk {
+				results = append(results, &v1.BatchUpdateUserResult{Id: int32(id), Success: false, Message: "修改失败，本批次已回滚"})
+			}
+		}
+		res.Results = append(res.Results, results...)
+	}
+
+	for _, result := range res.Results {
+		switch {
+		case !result.Success:
+			res.Failed++
+		case result.Message == batchResultUnchanged:
+			res.Unchanged++
+		default:
+			res.Updated++
+		}
+	}
+	res.Message = fmt.Sprintf("修改成功 %d 个，无需修改 %d 个，失败 %d 个", res.Updated, res.Unchanged, res.Failed)
+
+	logMessage := fmt.Sprintf("批量修改会员 [%s] 共 %d 个，%s", strings.Join(change.desc, "，"), res.Total, res.Message)
+	if req.Reason != "" {
+		logMessage += "，原因: " + req.Reason
+	}
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "批量修改会员完成 - 总数: %d, 成功: %d, 无需修改: %d, 失败: %d", res.Total, res.Updated, res.Unchanged, res.Failed)
+	return res, nil
+}
+
+// batchUserChange 校验并整理批量修改的内容，校验失败时返回提示信息
+func (s *sUser) batchUserChange(ctx context.Context, siteId int, req *v1.BatchUpdateUsersReq) (*batchChange, string, error) {
+	change := &batchChange{status: -1, gradeId: -1, levelId: -1, agentId: -1, focusLevel: -1, balanceStatus: -1}
+
+	if req.Status < 0 || req.GradeId < 0 || req.LevelId < 0 || req.FocusLevel < 0 || req.BalanceStatus < 0 {
+		return nil, "参数错误", nil
+	}
+	// 请求中的状态从1开始，0表示不修改
+	if req.Status > 0 {
+		if req.Status > 2 {
+			return nil, "状态错误", nil
+		}
+		change.status = int(req.Status) - 1
+		change.desc = append(change.desc, fmt.Sprintf("状态:%d", change.status))
+	}
+	if req.GradeId > 0 {
+		var grade *entity.UserGrade
+		err := dao.UserGrade.Ctx(ctx).Where(do.UserGrade{SiteId: siteId, Id: req.GradeId}).Scan(&grade)
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "查询会员等级失败: %v", err)
+			return nil, "", fmt.Errorf("查询会员等级失败: %v", err)
+		}
+		if grade == nil {
+			return nil, "会员等级不存在", nil
+		}
+		change.gradeId = int(grade.Id)
+		change.desc = append(change.desc, "等级:"+grade.Name)
+	}
+	if req.LevelId > 0 {
+		var level *entity.UserLevel
+		err := dao.UserLevel.Ctx(ctx).Where(do.UserLevel{SiteId: siteId, Id: req.LevelId}).Scan(&level)
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "查询会员层级失败: %v", err)
+			return nil, "", fmt.Errorf("查询会员层级失败: %v", err)
+		}
+		if level == nil {
+			return nil, "会员层级不存在", nil
+		}
+		change.levelId = int(level.Id)
+		change.desc = append(change.desc, "层级:"+level.Name)
+	}
+	if req.AgentUsername != "" {
+		var agent *entity.Agent
+		err := dao.Agent.Ctx(ctx).Where(do.Agent{SiteId: siteId, Username: req.AgentUsername}).Scan(&agent)
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "查询代理失败: %v", err)
+			return nil, "", fmt.Errorf("查询代理失败: %v", err)
+		}
+		if agent == nil {
+			return nil, "代理不存在", nil
+		}
+		change.agentId = int(agent.Id)
+		change.desc = append(change.desc, "代理:"+agent.Username)
+	}
+	if req.FocusLevel > 0 {
+		if req.FocusLevel > consts.FocusLevelDanger {
+			return nil, "关注级别错误", nil
+		}
+		change.focusLevel = int(req.FocusLevel)
+		change.desc = append(change.desc, fmt.Sprintf("关注级别:%d", req.FocusLevel))
+	}
+	// 请求中的资金状态从1开始，0表示不修改
+	if req.BalanceStatus > 0 {
+		if req.BalanceStatus > 2 {
+			return nil, "资金状态错误", nil
+		}
+		change.balanceStatus = int(req.BalanceStatus) - 1
+		change.desc = append(change.desc, fmt.Sprintf("资金状态:%d", change.balanceStatus))
+	}
+
+	if len(change.desc) == 0 {
+		return nil, "请选择要修改的内容", nil
+	}
+	return change, "", nil
+}
+
+// batchUserIds 获取要修改的会员ID，未指定ID时按筛选条件查询
+func (s *sUser) batchUserIds(ctx context.Context, siteId int, req *v1.BatchUpdateUsersReq) ([]int, string, error) {
+	if len(req.Ids) > 0 {
+		seen := make(map[int32]bool, len(req.Ids))
+		ids := make([]int, 0, len(req.Ids))
+		for _, id := range req.Ids {
+			if id > 0 && !seen[id] {
+				seen[id] = true
+				ids = append(ids, int(id))
+			}
+		}
+		if len(ids) > batchUpdateLimit {
+			return nil, fmt.Sprintf("单次最多修改 %d 个会员", batchUpdateLimit), nil
+		}
+		return ids, "", nil
+	}
+
+	if req.Filter == nil {
+		return nil, "请选择会员或设置筛选条件", nil
+	}
+	query, found := s.userListQuery(ctx, siteId, req.Filter)
+	if !found {
+		return nil, "", nil
+	}
+	values, err := query.Fields("id").OrderAsc("id").Limit(batchUpdateLimit + 1).Array()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询会员失败: %v", err)
+		return nil, "", fmt.Errorf("查询会员失败: %v", err)
+	}
+	if len(values) > batchUpdateLimit {
+		return nil, fmt.Sprintf("符合条件的会员超过 %d 个，请缩小筛选范围", batchUpdateLimit), nil
+	}
+	ids := make([]int, 0, len(values))
+	for _, value := range values {
+		ids = append(ids, value.Int())
+	}
+	return ids, "", nil
+}
+
+// batchUpdateChunk 在一个事务中修改一批会员，已是目标值的会员不重复更新
+func (s *sUser) batchUpdateChunk(ctx context.Context, siteId int, ids []int, change *batchChange) ([]*v1.BatchUpdateUserResult, error) {
+	results := make([]*v1.BatchUpdateUserResult, 0, len(ids))
+	err := dao.User.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		results = results[:0]
+
+		var users []*entity.User
+		err := dao.User.Ctx(ctx).
+			Fields("id, username, status, grade_id, level_id, agent_id, focus_level, balance_status").
+			Where(do.User{SiteId: siteId}).
+			WhereIn("id", ids).
+			LockUpdate().
+			Scan(&users)
+		if err != nil {
+			return err
+		}
+		byId := make(map[int]*entity.User, len(users))
+		for _, user := range users {
+			byId[int(user.Id)] = user
+		}
+
+		var changedIds []int
+		for _, id := range ids {
+			user := byId[id]
+			if user == nil {
+				results = append(results, &v1.BatchUpdateUserResult{Id: int32(id), Success: false, Message: "会员不存在"})
+				continue
+			}
+			result := &v1.BatchUpdateUserResult{Id: int32(id), Username: user.Username, Success: true, Message: batchResultUnchanged}
+			if change.applies(user) {
+				changedIds = append(changedIds, id)
+				result.Message = "修改成功"
+			}
+			results = append(results, result)
+		}
+		if len(changedIds) == 0 {
+			return nil
+		}
+
+		_, err = dao.User.Ctx(ctx).
+			Where(do.User{SiteId: siteId}).
+			WhereIn("id", changedIds).
+			Data(change.data()).
+			Update()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// applies 会员是否有需要修改的字段
+func (c *batchChange) applies(user *entity.User) bool {
+	return (c.status >= 0 && user.Status != c.status) ||
+		(c.gradeId >= 0 && user.GradeId != c.gradeId) ||
+		(c.levelId >= 0 && user.LevelId != c.levelId) ||
+		(c.agentId >= 0 && user.AgentId != c.agentId) ||
+		(c.focusLevel >= 0 && user.FocusLevel != c.focusLevel) ||
+		(c.balanceStatus >= 0 && int(user.BalanceStatus) != c.balanceStatus)
+}
+
+// data 生成更新数据，只包含需要修改的字段
+func (c *batchChange) data() do.User {
+	data := do.User{UpdatedAt: gtime.Now()}
+	if c.status >= 0 {
+		data.Status = c.status
+	}
+	if c.gradeId >= 0 {
+		data.GradeId = c.gradeId
+	}
+	if c.levelId >= 0 {
+		data.LevelId = c.levelId
+	}
+	if c.agentId >= 0 {
+		data.AgentId = c.agentId
+	}
+	if c.focusLevel >= 0 {
+		data.FocusLevel = c.focusLevel
+	}
+	if c.balanceStatus >= 0 {
+		data.BalanceStatus = c.balanceStatus
+	}
+	return data
+}
@@ -0,0 +1,264 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	v1 "jh_app_service/api/backend/user/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+	"jh_app_service/internal/util"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// auto_providing 中表示自动发放生日彩金的字段
+const autoProvidingBonusBirthday = "bonus_birthday"
+
+// errBirthdayBonusPaid 会员今年的生日彩金已发放
+var errBirthdayBonusPaid = errors.New("今年的生日彩金已发放")
+
+// RunBirthdayBonuses 发放指定日期生日会员的彩金，已发放的会员不会重复发放
+func (s *sUser) RunBirthdayBonuses(ctx context.Context, req *v1.RunBirthdayBonusesReq) (*v1.RunBirthdayBonusesRes, error) {
+	middleware.LogWithTrace(ctx, "info", "发放生日彩金请求 - Date: %s", req.Date)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	date := gtime.Now()
+	if req.Date != "" {
+		var err error
+		if date, err = gtime.StrToTimeFormat(req.Date, "Y-m-d"); err != nil {
+			return &v1.RunBirthdayBonusesRes{Success: false, Message: "日期格式错误"}, nil
+		}
+		if date.After(gtime.Now()) {
+			return &v1.RunBirthdayBonusesRes{Success: false, Message: "不能发放未来日期的生日彩金"}, nil
+		}
+	}
+
+	paid, money, err := s.payBirthdayBonuses(ctx, siteId, date.Time)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "发放生日彩金失败: %v", err)
+		return nil, err
+	}
+
+	logMessage := fmt.Sprintf("发放 %s 生日彩金，%d 人，共 %.2f", date.Format("Y-m-d"), paid, money)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	return &v1.RunBirthdayBonusesRes{
+		Success:    true,
+		Message:    fmt.Sprintf("发放 %d 人", paid),
+		Paid:       int32(paid),
+		MoneyTotal: money,
+	}, nil
+}
+
+// PayBirthdayBonuses 为所有站点发放今天生日会员的彩金，供定时任务调用
+func (s *sUser) PayBirthdayBonuses(ctx context.Context) error {
+	siteIds, err := dao.SiteConfig.Ctx(ctx).Fields("site_id").Array()
+	if err != nil {
+		return fmt.Errorf("查询站点失败: %v", err)
+	}
+	today := time.Now()
+	for _, value := range siteIds {
+		paid, money, err := s.payBirthdayBonuses(ctx, value.Int(), today)
+		if err != nil {
+			return fmt.Errorf("站点 %d 发放生日彩金失败: %v", value.Int(), err)
+		}
+		middleware.LogWithTrace(ctx, "info", "生日彩金发放完成 - SiteId: %d, 人数: %d, 金额: %.2f", value.Int(), paid, money)
+	}
+	return nil
+}
+
+// GetBirthdayBonuses 获取生日彩金发放记录
+func (s *sUser) GetBirthdayBonuses(ctx context.Context, req *v1.GetBirthdayBonusesReq) (*v1.GetBirthdayBonusesRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取生日彩金发放记录请求 - Page: %d, Size: %d, Year: %d, Username: %s", req.Page, req.Size, req.Year, req.Username)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	size := req.Size
+	if size <= 0 {
+		size = 50
+	}
+
+	query := dao.UserBirthdayBonus.Ctx(ctx).Where(do.UserBirthdayBonus{SiteId: siteId})
+	if req.Year > 0 {
+		query = query.Where("year", req.Year)
+	}
+	if req.Username != "" {
+		query = query.Where("username", req.Username)
+	}
+	if req.StartTime != "" {
+		query = query.WhereGTE("created_at", req.StartTime)
+	}
+	if req.EndTime != "" {
+		query = query.WhereLTE("created_at", req.EndTime)
+	}
+
+	total, err := query.Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取生日彩金发放记录总数失败: %v", err)
+		return nil, err
+	}
+	moneyTotal, err := query.Sum("money")
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "统计生日彩金发放金额失败: %v", err)
+		return nil, err
+	}
+
+	var bonuses []*entity.UserBirthdayBonus
+	err = query.Page(int(page), int(size)).OrderDesc("id").Scan(&bonuses)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取生日彩金发放记录失败: %v", err)
+		return nil, err
+	}
+
+	gradeNames, err := s.gradeNames(ctx, siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*v1.BirthdayBonusInfo, 0, len(bonuses))
+	for _, bonus := range bonuses {
+		list = append(list, &v1.BirthdayBonusInfo{
+			Id:        int64(bonus.Id),
+			UserId:    int32(bonus.UserId),
+			Username:  bonus.Username,
+			GradeId:   int32(bonus.GradeId),
+			GradeName: gradeNames[bonus.GradeId],
+			Year:      int32(bonus.Year),
+			Money:     bonus.Money,
+			TradeNo:   bonus.TradeNo,
+			CreatedAt: util.FormatTime(bonus.CreatedAt),
+		})
+	}
+
+	return &v1.GetBirthdayBonusesRes{
+		List:       list,
+		Count:      int32(total),
+		MoneyTotal: math.Round(moneyTotal*100) / 100,
+	}, nil
+}
+
+// payBirthdayBonuses 发放指定日期生日会员的彩金，只发放给状态和资金状态正常的会员
+// 非闰年2月29日生日的会员在2月28日发放
+func (s *sUser) payBirthdayBonuses(ctx context.Context, siteId int, date time.Time) (int, float64, error) {
+	var grades []*entity.UserGrade
+	if err := dao.UserGrade.Ctx(ctx).Where(do.UserGrade{SiteId: siteId}).Scan(&grades); err != nil {
+		return 0, 0, fmt.Errorf("查询会员等级失败: %v", err)
+	}
+	if !autoProviding(grades, autoProvidingBonusBirthday) {
+		return 0, 0, nil
+	}
+	bonusByGrade := make(map[int]float64, len(grades))
+	var gradeIds []int
+	for _, grade := range grades {
+		if grade.Status == 1 && grade.BonusBirthday > 0 {
+			bonusByGrade[int(grade.Id)] = grade.BonusBirthday
+			gradeIds = append(gradeIds, int(grade.Id))
+		}
+	}
+	if len(gradeIds) == 0 {
+		return 0, 0, nil
+	}
+
+	days := []int{date.Day()}
+	if date.Month() == time.February && date.Day() == 28 && !isLeapYear(date.Year()) {
+		days = append(days, 29)
+	}
+
+	paid := 0
+	moneyTotal := 0.0
+	var lastId uint
+	for {
+		var users []*entity.User
+		err := dao.User.Ctx(ctx).
+			Fields("id, site_id, username, grade_id").
+			Where(do.User{SiteId: siteId, Status: 1, BalanceStatus: 1}).
+			WhereIn("grade_id", gradeIds).
+			Where("MONTH(birthday) = ?", int(date.Month())).
+			Where("DAY(birthday) IN(?)", days).
+			WhereGT("id", lastId).
+			OrderAsc("id").
+			Limit(gradeUpgradeBatchSize).
+			Scan(&users)
+		if err != nil {
+			return paid, moneyTotal, fmt.Errorf("查询生日会员失败: %v", err)
+		}
+
+		for _, user := range users {
+			money := bonusByGrade[user.GradeId]
+			err = s.payBirthdayBonus(ctx, user, date.Year(), money)
+			if errors.Is(err, errBirthdayBonusPaid) {
+				continue
+			}
+			if err != nil {
+				// 单个会员失败不影响其他会员，重新执行时补发
+				middleware.LogWithTrace(ctx, "error", "发放生日彩金失败 - UserId: %d, 错误: %v", user.Id, err)
+				continue
+			}
+			paid++
+			moneyTotal += money
+		}
+		if len(users) < gradeUpgradeBatchSize {
+			return paid, math.Round(moneyTotal*100) / 100, nil
+		}
+		lastId = users[len(users)-1].Id
+	}
+}
+
+// payBirthdayBonus 发放会员一年一次的生日彩金，发放记录和账变在同一事务中写入
+func (s *sUser) payBirthdayBonus(ctx context.Context, user *entity.User, year int, money float64) error {
+	tradeNo := fmt.Sprintf("BD%d_%d", user.Id, year)
+	return dao.UserBirthdayBonus.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		// 站点+会员+年份唯一，已有记录时不会重复插入
+		result, err := dao.UserBirthdayBonus.Ctx(ctx).Data(do.UserBirthdayBonus{
+			SiteId:    user.SiteId,
+			UserId:    user.Id,
+			Username:  user.Username,
+			GradeId:   user.GradeId,
+			Year:      year,
+			Money:     money,
+			TradeNo:   tradeNo,
+			CreatedAt: gtime.Now(),
+		}).InsertIgnore()
+		if err != nil {
+			return err
+		}
+		if rows, _ := result.RowsAffected(); rows == 0 {
+			return errBirthdayBonusPaid
+		}
+
+		_, _, err = backend.Balance().PostLedger(ctx, &model.LedgerEntry{
+			SiteId:     user.SiteId,
+			UserId:     int(user.Id),
+			ChangeType: consts.ChangeTypeIn,
+			TradeType:  consts.TradeTypeBirthdayBonus,
+			TradeNo:    tradeNo,
+			Money:      money,
+			Remark:     fmt.Sprintf("%d年生日彩金", year),
+		})
+		return err
+	})
+}
+
+// isLeapYear 是否闰年
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
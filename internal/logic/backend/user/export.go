@@ -0,0 +1,328 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	v1 "jh_app_service/api/backend/user/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/export"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+	"jh_app_service/internal/util"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// 导出文件每块的大小
+const exportChunkSize = 64 * 1024
+
+// exportColumn 导出列，key 与会员列表字段名 (admin_custom_field.fields) 一致
+type exportColumn struct {
+	key   string
+	title string
+	value func(row *exportRow) string
+}
+
+// exportRow 导出的一行会员数据
+type exportRow struct {
+	user          *entity.User
+	gradeName     string
+	levelName     string
+	agentUsername string
+	mobile        string
+}
+
+// userExportColumns 可导出的列，未设置自定义字段时按此顺序全部导出
+var userExportColumns = []exportColumn{
+	{"id", "会员ID", func(r *exportRow) string { return strconv.Itoa(int(r.user.Id)) }},
+	{"username", "会员账号", func(r *exportRow) string { return r.user.Username }},
+	{"realname", "真实姓名", func(r *exportRow) string { return r.user.Realname }},
+	{"grade_name", "等级", func(r *exportRow) string { return r.gradeName }},
+	{"level_name", "层级", func(r *exportRow) string { return r.levelName }},
+	{"agent_username", "代理", func(r *exportRow) string { return r.agentUsername }},
+	{"status", "状态", func(r *exportRow) string { return strconv.Itoa(r.user.Status) }},
+	{"mobile", "手机号", func(r *exportRow) string { return r.mobile }},
+	{"email", "邮箱", func(r *exportRow) string { return r.user.Email }},
+	{"balance", "余额", func(r *exportRow) string { return fmt.Sprintf("%.2f", r.user.Balance) }},
+	{"balance_frozen", "冻结余额", func(r *exportRow) string { return fmt.Sprintf("%.2f", r.user.BalanceFrozen) }},
+	{"balance_status", "资金状态", func(r *exportRow) string { return strconv.Itoa(int(r.user.BalanceStatus)) }},
+	{"focus_level", "关注级别", func(r *exportRow) string { return strconv.Itoa(r.user.FocusLevel) }},
+	{"pay_times", "存款次数", func(r *exportRow) string { return strconv.Itoa(r.user.PayTimes) }},
+	{"is_online", "是否在线", func(r *exportRow) string { return strconv.Itoa(r.user.IsOnline) }},
+	{"register_ip", "注册IP", func(r *exportRow) string { return r.user.RegisterIp }},
+	{"register_time", "注册时间", func(r *exportRow) string { return util.FormatTime(r.user.RegisterTime) }},
+	{"register_url", "注册来源", func(r *exportRow) string { return r.user.RegisterUrl }},
+	{"last_login_ip", "最后登录IP", func(r *exportRow) string { return r.user.LastLoginIp }},
+	{"last_login_time", "最后登录时间", func(r *exportRow) string { return util.FormatTime(r.user.LastLoginTime) }},
+	{"last_login_address", "最后登录地址", func(r *exportRow) string { return r.user.LastLoginAddress }},
+}
+
+// ExportUserList 按会员列表的筛选条件流式导出会员，导出列使用管理员的自定义字段
+func (s *sUser) ExportUserList(req *v1.ExportUserListReq, stream v1.User_ExportUserListServer) error {
+	ctx := stream.Context()
+	filter := req.Filter
+	if filter == nil {
+		filter = &v1.GetUserListReq{}
+	}
+	middleware.LogWithTrace(ctx, "info", "导出会员列表请求 - Format: %d, Status: %d, GradeId: %d, LevelId: %d, Agent: %s", req.Format, filter.Status, filter.GradeId, filter.LevelId, filter.AgentUsername)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	admin := backend.Admin().CurrentAdmin(ctx)
+	if admin == nil {
+		return fmt.Errorf("请先登录")
+	}
+
+	format := req.Format
+	if format == 0 {
+		format = consts.ExportFormatCsv
+	}
+	if format != consts.ExportFormatCsv && format != consts.ExportFormatXlsx {
+		return fmt.Errorf("不支持的导出格式: %d", req.Format)
+	}
+
+	columns, err := s.exportColumns(ctx, siteId, int(admin.Id))
+	if err != nil {
+		return err
+	}
+
+	// 拥有导出敏感信息权限的管理员导出完整手机号
+	showMobile := false
+	if permission := g.Cfg().MustGet(ctx, "user.export.sensitivePermission").String(); permission != "" {
+		showMobile = backend.Admin().HasPermission(ctx, permission)
+	}
+
+	filename := "users_" + gtime.Now().Format("YmdHis")
+	contentType := "text/csv; charset=utf-8"
+	if format == consts.ExportFormatXlsx {
+		filename += ".xlsx"
+		contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	} else {
+		filename += ".csv"
+	}
+
+	first := true
+	chunks := export.NewChunkWriter(exportChunkSize, func(chunk []byte) error {
+		res := &v1.ExportUserListChunk{Data: chunk}
+		if first {
+			res.Filename = filename
+			res.ContentType = contentType
+			first = false
+		}
+		return stream.Send(res)
+	})
+
+	var writer export.Writer
+	if format == consts.ExportFormatXlsx {
+		writer, err = export.NewXLSX(chunks, "会员列表")
+	} else {
+		writer, err = export.NewCSV(chunks)
+	}
+	if err != nil {
+		return err
+	}
+
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = column.title
+	}
+	if err = writer.WriteRow(header); err != nil {
+		return err
+	}
+
+	count := 0
+	query, found := s.userListQuery(ctx, siteId, filter)
+	if found {
+		count, err = s.exportUsers(ctx, siteId, query, columns, showMobile, writer)
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "导出会员列表失败: %v", err)
+			return err
+		}
+	}
+	if err = writer.Close(); err != nil {
+		return err
+	}
+	if err = chunks.Flush(); err != nil {
+		return err
+	}
+
+	res := &v1.ExportUserListChunk{Done: true, Count: int32(count)}
+	if first {
+		res.Filename = filename
+		res.ContentType = contentType
+	}
+	if err = stream.Send(res); err != nil {
+		return err
+	}
+
+	logMessage := fmt.Sprintf("导出会员列表 %d 条 [%s]", count, filename)
+	if showMobile {
+		logMessage += "，包含完整手机号"
+	}
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "导出会员列表成功 - 数量: %d", count)
+	return nil
+}
+
+// exportUsers 按ID分批查询会员并写入，返回导出的会员数
+func (s *sUser) exportUsers(ctx context.Context, siteId int, query *gdb.Model, columns []exportColumn, showMobile bool, writer export.Writer) (int, error) {
+	batchSize := g.Cfg().MustGet(ctx, "user.export.batchSize", 1000).Int()
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	gradeNames, err := s.gradeNames(ctx, siteId)
+	if err != nil {
+		return 0, err
+	}
+	levelNames, err := s.levelNames(ctx, siteId)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	var lastId uint
+	for {
+		if err = ctx.Err(); err != nil {
+			return count, err
+		}
+
+		var users []*entity.User
+		err = query.WhereGT("id", lastId).OrderAsc("id").Limit(batchSize).Scan(&users)
+		if err != nil {
+			return count, fmt.Errorf("查询会员失败: %v", err)
+		}
+		if len(users) == 0 {
+			return count, nil
+		}
+
+		agentNames, err := s.agentNames(ctx, siteId, users)
+		if err != nil {
+			return count, err
+		}
+
+		cells := make([]string, len(columns))
+		for _, user := range users {
+			row := &exportRow{
+				user:          user,
+				gradeName:     gradeNames[user.GradeId],
+				levelName:     levelNames[user.LevelId],
+				agentUsername: agentNames[user.AgentId],
+				mobile:        user.Mobile,
+			}
+			if !showMobile {
+				row.mobile = s.maskMobile(user.Mobile)
+			}
+			for i, column := range columns {
+				cells[i] = column.value(row)
+			}
+			if err = writer.WriteRow(cells); err != nil {
+				return count, err
+			}
+			count++
+		}
+
+		lastId = users[len(users)-1].Id
+		if len(users) < batchSize {
+			return count, nil
+		}
+	}
+}
+
+// exportColumns 获取管理员在会员列表设置的自定义字段，未设置时导出全部列
+func (s *sUser) exportColumns(ctx context.Context, siteId, adminId int) ([]exportColumn, error) {
+	var customField *entity.AdminCustomField
+	err := dao.AdminCustomField.Ctx(ctx).Where(do.AdminCustomField{
+		SiteId:  siteId,
+		AdminId: adminId,
+		Page:    consts.CustomFieldPageUser,
+	}).Scan(&customField)
+	if err != nil {
+		return nil, fmt.Errorf("查询自定义字段失败: %v", err)
+	}
+	if customField == nil || customField.Fields == "" {
+		return userExportColumns, nil
+	}
+
+	byKey := make(map[string]exportColumn, len(userExportColumns))
+	for _, column := range userExportColumns {
+		byKey[column.key] = column
+	}
+	var columns []exportColumn
+	for _, key := range strings.Split(customField.Fields, ",") {
+		if column, ok := byKey[strings.TrimSpace(key)]; ok {
+			columns = append(columns, column)
+			delete(byKey, column.key)
+		}
+	}
+	if len(columns) == 0 {
+		return userExportColumns, nil
+	}
+	return columns, nil
+}
+
+// gradeNames 站点会员等级名称
+func (s *sUser) gradeNames(ctx context.Context, siteId int) (map[int]string, error) {
+	var grades []*entity.UserGrade
+	err := dao.UserGrade.Ctx(ctx).Fields("id, name").Where(do.UserGrade{SiteId: siteId}).Scan(&grades)
+	if err != nil {
+		return nil, fmt.Errorf("查询会员等级失败: %v", err)
+	}
+	names := make(map[int]string, len(grades))
+	for _, grade := range grades {
+		names[int(grade.Id)] = grade.Name
+	}
+	return names, nil
+}
+
+// levelNames 站点会员层级名称
+func (s *sUser) levelNames(ctx context.Context, siteId int) (map[int]string, error) {
+	var levels []*entity.UserLevel
+	err := dao.UserLevel.Ctx(ctx).Fields("id, name").Where(do.UserLevel{SiteId: siteId}).Scan(&levels)
+	if err != nil {
+		return nil, fmt.Errorf("查询会员层级失败: %v", err)
+	}
+	names := make(map[int]string, len(levels))
+	for _, level := range levels {
+		names[int(level.Id)] = level.Name
+	}
+	return names, nil
+}
+
+// agentNames 本批会员所属代理的账号
+func (s *sUser) agentNames(ctx context.Context, siteId int, users []*entity.User) (map[int]string, error) {
+	ids := make([]int, 0, len(users))
+	seen := make(map[int]bool, len(users))
+	for _, user := range users {
+		if user.AgentId > 0 && !seen[user.AgentId] {
+			seen[user.AgentId] = true
+			ids = append(ids, user.AgentId)
+		}
+	}
+	names := make(map[int]string, len(ids))
+	if len(ids) == 0 {
+		return names, nil
+	}
+
+	var agents []*entity.Agent
+	err := dao.Agent.Ctx(ctx).Fields("id, username").Where(do.Agent{SiteId: siteId}).WhereIn("id", ids).Scan(&agents)
+	if err != nil {
+		return nil, fmt.Errorf("查询代理失败: %v", err)
+	}
+	for _, agent := range agents {
+		names[int(agent.Id)] = agent.Username
+	}
+	return names, nil
+}
@@ -0,0 +1,418 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	v1 "jh_app_service/api/backend/user/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+	"jh_app_service/internal/util"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+const (
+	// 每次查询的会员数
+	gradeUpgradeBatchSize = 500
+	// 预览最多返回的会员数
+	gradeUpgradePreviewLimit = 1000
+	// auto_providing 中表示自动发放升级彩金的字段
+	autoProvidingBonusUpgrade = "bonus_upgrade"
+)
+
+// errGradeChanged 评估后会员等级已被修改，本次跳过
+var errGradeChanged = errors.New("会员等级已变更")
+
+// gradeTable 站点的等级配置
+type gradeTable struct {
+	all       map[int]*entity.UserGrade // 全部等级，用于查询会员当前等级
+	enabled   []*entity.UserGrade       // 可用等级，按升级积分从低到高排序
+	autoBonus bool                      // 是否自动发放升级彩金
+}
+
+// gradeUpgrade 会员的升级计划
+type gradeUpgrade struct {
+	user  *entity.User
+	from  *entity.UserGrade   // 当前等级，可能为 nil
+	steps []*entity.UserGrade // 依次跨过的等级，每级单独记录和发放彩金
+}
+
+// bonus 升级计划的彩金总额
+func (u *gradeUpgrade) bonus() float64 {
+	total := 0.0
+	for _, grade := range u.steps {
+		total += grade.BonusUpgrade
+	}
+	return math.Round(total*100) / 100
+}
+
+// PreviewGradeUpgrades 预览积分已达到升级条件的会员，不做任何修改
+func (s *sUser) PreviewGradeUpgrades(ctx context.Context, req *v1.PreviewGradeUpgradesReq) (*v1.PreviewGradeUpgradesRes, error) {
+	middleware.LogWithTrace(ctx, "info", "预览等级升级请求 - UserIds: %d", len(req.UserIds))
+
+	// 默认站点ID为1
+	siteId := 1
+
+	res := &v1.PreviewGradeUpgradesRes{List: []*v1.GradeUpgradeItem{}}
+	bonusTotal := 0.0
+	err := s.eachGradeUpgrade(ctx, siteId, int32sToInts(req.UserIds), func(table *gradeTable, upgrade *gradeUpgrade) error {
+		res.Count++
+		if table.autoBonus {
+			bonusTotal += upgrade.bonus()
+		}
+		if len(res.List) >= gradeUpgradePreviewLimit {
+			return nil
+		}
+		item := &v1.GradeUpgradeItem{
+			UserId:      int32(upgrade.user.Id),
+			Username:    upgrade.user.Username,
+			Points:      int32(upgrade.user.Points),
+			FromGradeId: int32(upgrade.user.GradeId),
+			ToGradeId:   int32(upgrade.steps[len(upgrade.steps)-1].Id),
+			ToGradeName: upgrade.steps[len(upgrade.steps)-1].Name,
+		}
+		if upgrade.from != nil {
+			item.FromGradeName = upgrade.from.Name
+		}
+		if table.autoBonus {
+			item.Bonus = upgrade.bonus()
+		}
+		res.List = append(res.List, item)
+		return nil
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "预览等级升级失败: %v", err)
+		return nil, err
+	}
+	res.BonusTotal = math.Round(bonusTotal*100) / 100
+
+	middleware.LogWithTrace(ctx, "info", "预览等级升级成功 - 待升级: %d, 彩金: %.2f", res.Count, res.BonusTotal)
+	return res, nil
+}
+
+// RunGradeUpgrades 立即执行等级升级
+func (s *sUser) RunGradeUpgrades(ctx context.Context, req *v1.RunGradeUpgradesReq) (*v1.RunGradeUpgradesRes, error) {
+	middleware.LogWithTrace(ctx, "info", "执行等级升级请求 - UserIds: %d", len(req.UserIds))
+
+	// 默认站点ID为1
+	siteId := 1
+
+	upgraded, bonus, err := s.upgradeGrades(ctx, siteId, int32sToInts(req.UserIds))
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "执行等级升级失败: %v", err)
+		return nil, err
+	}
+
+	logMessage := fmt.Sprintf("执行会员等级升级，升级 %d 个会员，发放彩金 %.2f", upgraded, bonus)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	return &v1.RunGradeUpgradesRes{
+		Success:    true,
+		Message:    fmt.Sprintf("升级 %d 个会员", upgraded),
+		Upgraded:   int32(upgraded),
+		BonusTotal: bonus,
+	}, nil
+}
+
+// UpgradeGrades 为所有站点执行等级升级，供定时任务调用
+func (s *sUser) UpgradeGrades(ctx context.Context) error {
+	siteIds, err := dao.SiteConfig.Ctx(ctx).Fields("site_id").Array()
+	if err != nil {
+		return fmt.Errorf("查询站点失败: %v", err)
+	}
+	for _, value := range siteIds {
+		upgraded, bonus, err := s.upgradeGrades(ctx, value.Int(), nil)
+		if err != nil {
+			return fmt.Errorf("站点 %d 等级升级失败: %v", value.Int(), err)
+		}
+		if upgraded > 0 {
+			middleware.LogWithTrace(ctx, "info", "等级升级完成 - SiteId: %d, 升级: %d, 彩金: %.2f", value.Int(), upgraded, bonus)
+		}
+	}
+	return nil
+}
+
+// UpgradeUserGrade 评估单个会员的等级，积分变化后调用
+func (s *sUser) UpgradeUserGrade(ctx context.Context, siteId, userId int) error {
+	_, _, err := s.upgradeGrades(ctx, siteId, []int{userId})
+	return err
+}
+
+// GetUserGradeLogs 获取会员等级变更记录
+func (s *sUser) GetUserGradeLogs(ctx context.Context, req *v1.GetUserGradeLogsReq) (*v1.GetUserGradeLogsRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取等级变更记录请求 - Page: %d, Size: %d, Username: %s", req.Page, req.Size, req.Username)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	size := req.Size
+	if size <= 0 {
+		size = 50
+	}
+
+	query := dao.UserGradeLog.Ctx(ctx).Where(do.UserGradeLog{SiteId: siteId})
+	if req.Username != "" {
+		query = query.Where("username", req.Username)
+	}
+	if req.StartTime != "" {
+		query = query.WhereGTE("created_at", req.StartTime)
+	}
+	if req.EndTime != "" {
+		query = query.WhereLTE("created_at", req.EndTime)
+	}
+
+	total, err := query.Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取等级变更记录总数失败: %v", err)
+		return nil, err
+	}
+
+	var logs []*entity.UserGradeLog
+	err = query.Page(int(page), int(size)).OrderDesc("id").Scan(&logs)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取等级变更记录失败: %v", err)
+		return nil, err
+	}
+
+	gradeNames, err := s.gradeNames(ctx, siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*v1.UserGradeLogInfo, 0, len(logs))
+	for _, log := range logs {
+		list = append(list, &v1.UserGradeLogInfo{
+			Id:            int64(log.Id),
+			UserId:        int32(log.UserId),
+			Username:      log.Username,
+			FromGradeId:   int32(log.FromGradeId),
+			FromGradeName: gradeNames[log.FromGradeId],
+			ToGradeId:     int32(log.ToGradeId),
+			ToGradeName:   gradeNames[log.ToGradeId],
+			Points:        int32(log.Points),
+			Bonus:         log.Bonus,
+			BonusStatus:   int32(log.BonusStatus),
+			TradeNo:       log.TradeNo,
+			Remark:        log.Remark,
+			CreatedAt:     util.FormatTime(log.CreatedAt),
+		})
+	}
+
+	return &v1.GetUserGradeLogsRes{List: list, Count: int32(total)}, nil
+}
+
+// upgradeGrades 升级积分已达到条件的会员，返回升级的会员数和发放的彩金总额
+func (s *sUser) upgradeGrades(ctx context.Context, siteId int, userIds []int) (int, float64, error) {
+	upgraded := 0
+	bonusTotal := 0.0
+	err := s.eachGradeUpgrade(ctx, siteId, userIds, func(table *gradeTable, upgrade *gradeUpgrade) error {
+		bonus, err := s.applyGradeUpgrade(ctx, table, upgrade)
+		if errors.Is(err, errGradeChanged) {
+			return nil
+		}
+		if err != nil {
+			// 单个会员失败不影响其他会员，下次执行时重试
+			middleware.LogWithTrace(ctx, "error", "会员等级升级失败 - UserId: %d, 错误: %v", upgrade.user.Id, err)
+			return nil
+		}
+		upgraded++
+		bonusTotal += bonus
+		return nil
+	})
+	return upgraded, math.Round(bonusTotal*100) / 100, err
+}
+
+// eachGradeUpgrade 按ID分批查询会员，对需要升级的会员调用 fn
+func (s *sUser) eachGradeUpgrade(ctx context.Context, siteId int, userIds []int, fn func(table *gradeTable, upgrade *gradeUpgrade) error) error {
+	table, err := s.loadGradeTable(ctx, siteId)
+	if err != nil {
+		return err
+	}
+	if len(table.enabled) == 0 {
+		return nil
+	}
+
+	var lastId uint
+	for {
+		query := dao.User.Ctx(ctx).
+			Fields("id, site_id, username, grade_id, points").
+			Where(do.User{SiteId: siteId}).
+			WhereGTE("points", table.enabled[0].PointsUpgrade).
+			WhereGT("id", lastId)
+		if len(userIds) > 0 {
+			query = query.WhereIn("id", userIds)
+		}
+		var users []*entity.User
+		if err = query.OrderAsc("id").Limit(gradeUpgradeBatchSize).Scan(&users); err != nil {
+			return fmt.Errorf("查询会员失败: %v", err)
+		}
+
+		for _, user := range users {
+			if steps := table.plan(user); len(steps) > 0 {
+				upgrade := &gradeUpgrade{user: user, from: table.all[user.GradeId], steps: steps}
+				if err = fn(table, upgrade); err != nil {
+					return err
+				}
+			}
+		}
+		if len(users) < gradeUpgradeBatchSize {
+			return nil
+		}
+		lastId = users[len(users)-1].Id
+	}
+}
+
+// loadGradeTable 加载站点等级配置
+func (s *sUser) loadGradeTable(ctx context.Context, siteId int) (*gradeTable, error) {
+	var grades []*entity.UserGrade
+	if err := dao.UserGrade.Ctx(ctx).Where(do.UserGrade{SiteId: siteId}).Scan(&grades); err != nil {
+		return nil, fmt.Errorf("查询会员等级失败: %v", err)
+	}
+
+	table := &gradeTable{all: make(map[int]*entity.UserGrade, len(grades))}
+	for _, grade := range grades {
+		table.all[int(grade.Id)] = grade
+		if grade.Status == 1 {
+			table.enabled = append(table.enabled, grade)
+		}
+	}
+	table.autoBonus = autoProviding(grades, autoProvidingBonusUpgrade)
+	sort.Slice(table.enabled, func(i, j int) bool {
+		if table.enabled[i].PointsUpgrade != table.enabled[j].PointsUpgrade {
+			return table.enabled[i].PointsUpgrade < table.enabled[j].PointsUpgrade
+		}
+		return table.enabled[i].Id < table.enabled[j].Id
+	})
+	return table, nil
+}
+
+// plan 计算会员需要依次升入的等级，只升不降
+func (t *gradeTable) plan(user *entity.User) []*entity.UserGrade {
+	current := -1
+	if grade := t.all[user.GradeId]; grade != nil {
+		current = grade.PointsUpgrade
+	}
+	var steps []*entity.UserGrade
+	for _, grade := range t.enabled {
+		if grade.PointsUpgrade > current && grade.PointsUpgrade <= user.Points {
+			steps = append(steps, grade)
+		}
+	}
+	return steps
+}
+
+// applyGradeUpgrade 在一个事务中修改会员等级、记录变更并发放升级彩金
+// 彩金流水号由会员和等级生成，同一等级的彩金只会发放一次
+func (s *sUser) applyGradeUpgrade(ctx context.Context, table *gradeTable, upgrade *gradeUpgrade) (float64, error) {
+	user := upgrade.user
+	target := upgrade.steps[len(upgrade.steps)-1]
+	paid := 0.0
+
+	err := dao.UserGradeLog.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		paid = 0
+		result, err := dao.User.Ctx(ctx).Where(do.User{
+			SiteId:  user.SiteId,
+			Id:      user.Id,
+			GradeId: user.GradeId,
+		}).Data(do.User{
+			GradeId:   target.Id,
+			UpdatedAt: gtime.Now(),
+		}).Update()
+		if err != nil {
+			return err
+		}
+		if rows, _ := result.RowsAffected(); rows == 0 {
+			return errGradeChanged
+		}
+
+		from := user.GradeId
+		for _, grade := range upgrade.steps {
+			log := do.UserGradeLog{
+				SiteId:      user.SiteId,
+				UserId:      user.Id,
+				Username:    user.Username,
+				FromGradeId: from,
+				ToGradeId:   grade.Id,
+				Points:      user.Points,
+				Bonus:       grade.BonusUpgrade,
+				BonusStatus: consts.GradeBonusNone,
+				Remark:      "积分达到升级条件",
+				CreatedAt:   gtime.Now(),
+			}
+			if grade.BonusUpgrade > 0 {
+				log.BonusStatus = consts.GradeBonusManual
+				if table.autoBonus {
+					tradeNo := fmt.Sprintf("GU%d_%d", user.Id, grade.Id)
+					_, created, err := backend.Balance().PostLedger(ctx, &model.LedgerEntry{
+						SiteId:     user.SiteId,
+						UserId:     int(user.Id),
+						ChangeType: consts.ChangeTypeIn,
+						TradeType:  consts.TradeTypeGradeBonus,
+						TradeNo:    tradeNo,
+						Money:      grade.BonusUpgrade,
+						Remark:     "升级彩金: " + grade.Name,
+					})
+					if err != nil {
+						return fmt.Errorf("发放升级彩金失败: %v", err)
+					}
+					log.TradeNo = tradeNo
+					log.BonusStatus = consts.GradeBonusPaidOnce
+					if created {
+						log.BonusStatus = consts.GradeBonusPaid
+						paid += grade.BonusUpgrade
+					}
+				}
+			}
+			if _, err = dao.UserGradeLog.Ctx(ctx).Data(log).Insert(); err != nil {
+				return err
+			}
+			from = int(grade.Id)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	middleware.LogWithTrace(ctx, "info", "会员等级升级 - UserId: %d, 等级: %d → %d, 彩金: %.2f", user.Id, user.GradeId, target.Id, paid)
+	return paid, nil
+}
+
+// autoProviding 等级配置中是否自动发放指定字段的彩金
+// auto_providing 由 SaveUserGrades 统一保存，各等级相同
+func autoProviding(grades []*entity.UserGrade, field string) bool {
+	for _, grade := range grades {
+		for _, item := range strings.Split(grade.AutoProviding, ",") {
+			if strings.TrimSpace(item) == field {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// int32sToInts 转换请求中的ID列表
+func int32sToInts(values []int32) []int {
+	ints := make([]int, 0, len(values))
+	for _, value := range values {
+		ints = append(ints, int(value))
+	}
+	return ints
+}
@@ -0,0 +1,370 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "jh_app_service/api/backend/user/v1"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+	"jh_app_service/internal/util"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// GetUserLevels 获取会员层级列表及各层级会员数量
+func (s *sUser) GetUserLevels(ctx context.Context, req *v1.GetUserLevelsReq) (*v1.GetUserLevelsRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取会员层级列表请求 - Status: %d", req.Status)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	query := dao.UserLevel.Ctx(ctx).Where(do.UserLevel{SiteId: siteId})
+	if req.Status >= 0 {
+		query = query.Where("status", req.Status)
+	}
+	var levels []*entity.UserLevel
+	if err := query.OrderAsc("id").Scan(&levels); err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取会员层级列表失败: %v", err)
+		return nil, err
+	}
+
+	userCounts, err := s.levelUserCounts(ctx, siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []*entity.RebateRule
+	err = dao.RebateRule.Ctx(ctx).Fields("id, name").Where(do.RebateRule{SiteId: siteId}).Scan(&rules)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取返水规则失败: %v", err)
+		return nil, err
+	}
+	ruleNames := make(map[int]string, len(rules))
+	for _, rule := range rules {
+		ruleNames[int(rule.Id)] = rule.Name
+	}
+
+	list := make([]*v1.UserLevelInfo, 0, len(levels))
+	for _, level := range levels {
+		list = append(list, &v1.UserLevelInfo{
+			Id:                 int32(level.Id),
+			Name:               level.Name,
+			IsRebate:           level.IsRebate == 1,
+			RebateRuleId:       int32(level.RebateRuleId),
+			RebateRuleName:     ruleNames[level.RebateRuleId],
+			DailyWithdrawTimes: int32(level.DailyWithdrawTimes),
+			LoginUrl:           level.LoginUrl,
+			Status:             int32(level.Status),
+			UserCount:          int32(userCounts[int(level.Id)]),
+			CreatedAt:          util.FormatTime(level.CreatedAt),
+			UpdatedAt:          util.FormatTime(level.UpdatedAt),
+		})
+	}
+
+	return &v1.GetUserLevelsRes{List: list}, nil
+}
+
+// CreateUserLevel 创建会员层级
+func (s *sUser) CreateUserLevel(ctx context.Context, req *v1.CreateUserLevelReq) (*v1.CreateUserLevelRes, error) {
+	middleware.LogWithTrace(ctx, "info", "创建会员层级请求 - Name: %s, RebateRuleId: %d", req.Name, req.RebateRuleId)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	level := &entity.UserLevel{
+		SiteId:             siteId,
+		Name:               strings.TrimSpace(req.Name),
+		IsRebate:           boolToInt(req.IsRebate),
+		RebateRuleId:       int(req.RebateRuleId),
+		DailyWithdrawTimes: int(req.DailyWithdrawTimes),
+		LoginUrl:           strings.TrimSpace(req.LoginUrl),
+		Status:             int(req.Status),
+	}
+	message, err := s.validateUserLevel(ctx, level)
+	if err != nil {
+		return nil, err
+	}
+	if message != "" {
+		return &v1.CreateUserLevelRes{Success: false, Message: message}, nil
+	}
+
+	var id int64
+	err = dao.UserLevel.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		var err error
+		if message, err = s.lockLevelRebateRule(ctx, level); err != nil || message != "" {
+			return err
+		}
+		id, err = dao.UserLevel.Ctx(ctx).Data(do.UserLevel{
+			SiteId:             level.SiteId,
+			Name:               level.Name,
+			IsRebate:           level.IsRebate,
+			RebateRuleId:       level.RebateRuleId,
+			DailyWithdrawTimes: level.DailyWithdrawTimes,
+			LoginUrl:           level.LoginUrl,
+			Status:             level.Status,
+			CreatedAt:          gtime.Now(),
+			UpdatedAt:          gtime.Now(),
+		}).InsertAndGetId()
+		return err
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "创建会员层级失败: %v", err)
+		return nil, fmt.Errorf("创建会员层级失败: %v", err)
+	}
+	if message != "" {
+		return &v1.CreateUserLevelRes{Success: false, Message: message}, nil
+	}
+
+	logMessage := fmt.Sprintf("创建会员层级 %s [ID:%d]", level.Name, id)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "创建会员层级成功 - ID: %d", id)
+	return &v1.CreateUserLevelRes{Success: true, Message: "创建成功", Id: int32(id)}, nil
+}
+
+// UpdateUserLevel 修改会员层级
+func (s *sUser) UpdateUserLevel(ctx context.Context, req *v1.UpdateUserLevelReq) (*v1.UpdateUserLevelRes, error) {
+	middleware.LogWithTrace(ctx, "info", "修改会员层级请求 - ID: %d, Name: %s, RebateRuleId: %d", req.Id, req.Name, req.RebateRuleId)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	var existing *entity.UserLevel
+	err := dao.UserLevel.Ctx(ctx).Where(do.UserLevel{SiteId: siteId, Id: req.Id}).Scan(&existing)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询会员层级失败: %v", err)
+		return nil, err
+	}
+	if existing == nil {
+		return &v1.UpdateUserLevelRes{Success: false, Message: "会员层级不存在"}, nil
+	}
+
+	level := &entity.UserLevel{
+		Id:                 existing.Id,
+		SiteId:             siteId,
+		Name:               strings.TrimSpace(req.Name),
+		IsRebate:           boolToInt(req.IsRebate),
+		RebateRuleId:       int(req.RebateRuleId),
+		DailyWithdrawTimes: int(req.DailyWithdrawTimes),
+		LoginUrl:           strings.TrimSpace(req.LoginUrl),
+		Status:             int(req.Status),
+	}
+	message, err := s.validateUserLevel(ctx, level)
+	if err != nil {
+		return nil, err
+	}
+	if message != "" {
+		return &v1.UpdateUserLevelRes{Success: false, Message: message}, nil
+	}
+
+	err = dao.UserLevel.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		var err error
+		if message, err = s.lockLevelRebateRule(ctx, level); err != nil || message != "" {
+			return err
+		}
+		_, err = dao.UserLevel.Ctx(ctx).Where(do.UserLevel{SiteId: siteId, Id: existing.Id}).Data(do.UserLevel{
+			Name:               level.Name,
+			IsRebate:           level.IsRebate,
+			RebateRuleId:       level.RebateRuleId,
+			DailyWithdrawTimes: level.DailyWithdrawTimes,
+			LoginUrl:           level.LoginUrl,
+			Status:             level.Status,
+			UpdatedAt:          gtime.Now(),
+		}).Update()
+		return err
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "修改会员层级失败: %v", err)
+		return nil, fmt.Errorf("修改会员层级失败: %v", err)
+	}
+	if message != "" {
+		return &v1.UpdateUserLevelRes{Success: false, Message: message}, nil
+	}
+
+	logMessage := fmt.Sprintf("修改会员层级 %s [ID:%d]", level.Name, level.Id)
+	if existing.Name != level.Name {
+		logMessage = fmt.Sprintf("修改会员层级 %s → %s [ID:%d]", existing.Name, level.Name, level.Id)
+	}
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "修改会员层级成功 - ID: %d", level.Id)
+	return &v1.UpdateUserLevelRes{Success: true, Message: "修改成功"}, nil
+}
+
+// DeleteUserLevel 删除会员层级，层级下还有会员时需指定迁移到的层级
+func (s *sUser) DeleteUserLevel(ctx context.Context, req *v1.DeleteUserLevelReq) (*v1.DeleteUserLevelRes, error) {
+	middleware.LogWithTrace(ctx, "info", "删除会员层级请求 - ID: %d, MigrateToId: %d", req.Id, req.MigrateToId)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	var level *entity.UserLevel
+	err := dao.UserLevel.Ctx(ctx).Where(do.UserLevel{SiteId: siteId, Id: req.Id}).Scan(&level)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询会员层级失败: %v", err)
+		return nil, err
+	}
+	if level == nil {
+		return &v1.DeleteUserLevelRes{Success: false, Message: "会员层级不存在"}, nil
+	}
+
+	var target *entity.UserLevel
+	if req.MigrateToId > 0 {
+		if req.MigrateToId == req.Id {
+			return &v1.DeleteUserLevelRes{Success: false, Message: "不能迁移到要删除的层级"}, nil
+		}
+		err = dao.UserLevel.Ctx(ctx).Where(do.UserLevel{SiteId: siteId, Id: req.MigrateToId}).Scan(&target)
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "查询会员层级失败: %v", err)
+			return nil, err
+		}
+		if target == nil {
+			return &v1.DeleteUserLevelRes{Success: false, Message: "迁移到的层级不存在"}, nil
+		}
+		if target.Status != 1 {
+			return &v1.DeleteUserLevelRes{Success: false, Message: "迁移到的层级已禁用"}, nil
+		}
+	}
+
+	var migrated int64
+	message := ""
+	err = dao.UserLevel.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		// 在事务中统计并迁移，避免统计后又有会员被分配到该层级
+		count, err := dao.User.Ctx(ctx).Where(do.User{SiteId: siteId, LevelId: level.Id}).LockUpdate().Count()
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			if target == nil {
+				message = fmt.Sprintf("该层级下还有 %d 个会员，请选择迁移到的层级", count)
+				return nil
+			}
+			// 锁定迁移到的层级，避免迁移过程中被禁用或删除
+			var locked *entity.UserLevel
+			err = dao.UserLevel.Ctx(ctx).Where(do.UserLevel{SiteId: siteId, Id: target.Id}).LockUpdate().Scan(&locked)
+			if err != nil {
+				return err
+			}
+			if locked == nil || locked.Status != 1 {
+				message = "迁移到的层级不存在或已禁用"
+				return nil
+			}
+			result, err := dao.User.Ctx(ctx).Where(do.User{SiteId: siteId, LevelId: level.Id}).Data(do.User{
+				LevelId:   target.Id,
+				UpdatedAt: gtime.Now(),
+			}).Update()
+			if err != nil {
+				return err
+			}
+			migrated, _ = result.RowsAffected()
+		}
+		_, err = dao.UserLevel.Ctx(ctx).Where(do.UserLevel{SiteId: siteId, Id: level.Id}).Delete()
+		return err
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "删除会员层级失败: %v", err)
+		return nil, fmt.Errorf("删除会员层级失败: %v", err)
+	}
+	if message != "" {
+		return &v1.DeleteUserLevelRes{Success: false, Message: message}, nil
+	}
+
+	logMessage := fmt.Sprintf("删除会员层级 %s [ID:%d]", level.Name, level.Id)
+	if migrated > 0 {
+		logMessage += fmt.Sprintf("，%d 个会员迁移到 %s", migrated, target.Name)
+	}
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "删除会员层级成功 - ID: %d, 迁移会员: %d", level.Id, migrated)
+	return &v1.DeleteUserLevelRes{Success: true, Message: "删除成功", Migrated: int32(migrated)}, nil
+}
+
+// validateUserLevel 校验层级信息，失败时返回提示信息
+func (s *sUser) validateUserLevel(ctx context.Context, level *entity.UserLevel) (string, error) {
+	if level.Name == "" {
+		return "请填写层级名称", nil
+	}
+	if len([]rune(level.Name)) > 50 {
+		return "层级名称不能超过50个字符", nil
+	}
+	if level.Status != 0 && level.Status != 1 {
+		return "状态错误", nil
+	}
+	if level.DailyWithdrawTimes < 0 {
+		return "单日提款次数不能小于0", nil
+	}
+	if level.LoginUrl != "" && !strings.HasPrefix(level.LoginUrl, "http://") && !strings.HasPrefix(level.LoginUrl, "https://") {
+		return "专用登录网址须以 http:// 或 https:// 开头", nil
+	}
+
+	query := dao.UserLevel.Ctx(ctx).Where(do.UserLevel{SiteId: level.SiteId, Name: level.Name})
+	if level.Id > 0 {
+		query = query.WhereNot("id", level.Id)
+	}
+	count, err := query.Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询会员层级失败: %v", err)
+		return "", err
+	}
+	if count > 0 {
+		return "层级名称已存在", nil
+	}
+
+	if level.RebateRuleId < 0 {
+		return "返水规则错误", nil
+	}
+	if level.IsRebate == 1 && level.RebateRuleId == 0 {
+		return "开启返水时请选择返水规则", nil
+	}
+	return "", nil
+}
+
+// lockLevelRebateRule 锁定层级选择的返水规则并校验，需在保存层级的事务中调用，失败时返回提示信息
+// 删除或禁用返水规则时也会锁定规则，避免层级选择的规则同时被删除或禁用
+func (s *sUser) lockLevelRebateRule(ctx context.Context, level *entity.UserLevel) (string, error) {
+	if level.RebateRuleId == 0 {
+		return "", nil
+	}
+	var rule *entity.RebateRule
+	err := dao.RebateRule.Ctx(ctx).Where(do.RebateRule{SiteId: level.SiteId, Id: level.RebateRuleId}).LockUpdate().Scan(&rule)
+	if err != nil {
+		return "", fmt.Errorf("查询返水规则失败: %v", err)
+	}
+	if rule == nil {
+		return "返水规则不存在", nil
+	}
+	if level.IsRebate == 1 && rule.Status != 1 {
+		return "返水规则已停用", nil
+	}
+	return "", nil
+}
+
+// levelUserCounts 各层级的会员数量
+func (s *sUser) levelUserCounts(ctx context.Context, siteId int) (map[int]int, error) {
+	records, err := dao.User.Ctx(ctx).
+		Fields("level_id, COUNT(*) AS total").
+		Where(do.User{SiteId: siteId}).
+		Group("level_id").
+		All()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "统计层级会员数失败: %v", err)
+		return nil, err
+	}
+	counts := make(map[int]int, len(records))
+	for _, record := range records {
+		counts[record["level_id"].Int()] = record["total"].Int()
+	}
+	return counts, nil
+}
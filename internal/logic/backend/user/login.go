@@ -0,0 +1,111 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "jh_app_service/api/backend/user/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/geoip"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+
+	"github.com/gogf/gf/v2/os/gtime"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Login 会员登录：校验访问屏蔽、账号密码和账号状态，记录登录信息
+// 登录令牌由网关签发，这里只负责校验并返回会员信息
+func (s *sUser) Login(ctx context.Context, req *v1.LoginReq) (*v1.LoginRes, error) {
+	loginIp := middleware.GetClientIPFromContext(ctx)
+	middleware.LogWithTrace(ctx, "info", "会员登录请求 - Username: %s, IP: %s, Device: %d", req.Username, loginIp, req.Device)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	if err := backend.Site().CheckAccess(ctx, siteId, loginIp); err != nil {
+		return &v1.LoginRes{Success: false, Message: err.Error()}, nil
+	}
+
+	username := strings.TrimSpace(req.Username)
+	if username == "" || req.Password == "" {
+		return &v1.LoginRes{Success: false, Message: "请输入账号和密码"}, nil
+	}
+
+	var user *entity.User
+	err := dao.User.Ctx(ctx).Where(do.User{
+		SiteId:   siteId,
+		Username: username,
+	}).Scan(&user)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询会员失败: %v", err)
+		return nil, fmt.Errorf("查询会员失败: %v", err)
+	}
+	if user == nil || bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)) != nil {
+		middleware.LogWithTrace(ctx, "warning", "会员登录失败，账号或密码错误 - Username: %s", username)
+		return &v1.LoginRes{Success: false, Message: "账号或密码错误"}, nil
+	}
+	if user.Status != 1 {
+		return &v1.LoginRes{Success: false, Message: "账号已被停用"}, nil
+	}
+
+	loginAddress := geoip.Address(loginIp)
+	_, err = dao.User.Ctx(ctx).Where("id", user.Id).Data(do.User{
+		LastLoginIp:      loginIp,
+		LastLoginAddress: loginAddress,
+		LastLoginTime:    gtime.Now(),
+		IsOnline:         1,
+		UpdatedAt:        gtime.Now(),
+	}).Update()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "更新会员登录信息失败: %v", err)
+	}
+
+	_, err = dao.UserLoginLog.Ctx(ctx).Data(do.UserLoginLog{
+		SiteId:       siteId,
+		UserId:       user.Id,
+		Username:     user.Username,
+		RefererUrl:   req.RefererUrl,
+		LoginUrl:     req.LoginUrl,
+		LoginTime:    gtime.Now(),
+		LoginIp:      loginIp,
+		LoginAddress: loginAddress,
+		Os:           req.Os,
+		Network:      req.Network,
+		Screen:       req.Screen,
+		Browser:      req.Browser,
+		Device:       req.Device,
+		CreatedAt:    gtime.Now(),
+	}).Insert()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录会员登录日志失败: %v", err)
+	}
+
+	err = backend.Risk().Evaluate(ctx, &model.RiskEvent{
+		Type:     consts.RiskLoginArea,
+		SiteId:   siteId,
+		UserId:   int(user.Id),
+		Username: user.Username,
+		Ip:       loginIp,
+		Device:   int(req.Device),
+		OldValue: user.LastLoginIp,
+		NewValue: loginIp,
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "登录地区风险预警失败: %v", err)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "会员登录成功 - UserId: %d, Username: %s", user.Id, user.Username)
+
+	return &v1.LoginRes{
+		Success:  true,
+		Message:  "登录成功",
+		UserId:   int32(user.Id),
+		Username: user.Username,
+	}, nil
+}
@@ -0,0 +1,535 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	v1 "jh_app_service/api/backend/user/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+	"jh_app_service/internal/util"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// 每次查询投注汇总的会员数
+const bettingPointsBatchSize = 500
+
+// pointsEntry 一笔积分变动
+type pointsEntry struct {
+	siteId     int
+	userId     int
+	source     int
+	changeType int
+	points     int
+	bizNo      string      // 业务单号，同一站点只记录一次
+	date       *gtime.Time // 积分归属日期
+	adminId    int
+	remark     string
+	capped     bool // 是否计入每日积分上限
+}
+
+// GetPointsSetting 获取积分设置
+func (s *sUser) GetPointsSetting(ctx context.Context, req *v1.GetPointsSettingReq) (*v1.GetPointsSettingRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取积分设置请求")
+
+	// 默认站点ID为1
+	siteId := 1
+
+	setting, err := s.loadPointsSetting(ctx, siteId)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取积分设置失败: %v", err)
+		return nil, err
+	}
+
+	res := &v1.GetPointsSettingRes{Setting: &v1.PointsSetting{}}
+	if setting != nil {
+		res.Setting = &v1.PointsSetting{
+			SwitchSitePoints:     int32(setting.SwitchSitePoints),
+			SwitchRechargePoints: int32(setting.SwitchRechargePoints),
+			EachRechargeAmount:   setting.EachRechargeAmount,
+			EachRechargePoints:   int32(setting.EachRechargePoints),
+			SwitchBettingPoints:  int32(setting.SwitchBettingPoints),
+			EachBettingAmount:    setting.EachBettingAmount,
+			EachBettingPoints:    int32(setting.EachBettingPoints),
+			MaxDailyPoints:       int32(setting.MaxDailyPoints),
+		}
+	}
+	return res, nil
+}
+
+// UpdatePointsSetting 修改积分设置，站点未设置过时新建
+func (s *sUser) UpdatePointsSetting(ctx context.Context, req *v1.UpdatePointsSettingReq) (*v1.UpdatePointsSettingRes, error) {
+	middleware.LogWithTrace(ctx, "info", "修改积分设置请求 - Setting: %v", req.Setting)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	setting := req.Setting
+	if setting == nil {
+		return &v1.UpdatePointsSettingRes{Success: false, Message: "参数错误"}, nil
+	}
+	if message := validatePointsSetting(setting); message != "" {
+		return &v1.UpdatePointsSettingRes{Success: false, Message: message}, nil
+	}
+
+	data := do.SitePoints{
+		SiteId:               siteId,
+		SwitchSitePoints:     setting.SwitchSitePoints,
+		SwitchRechargePoints: setting.SwitchRechargePoints,
+		EachRechargeAmount:   setting.EachRechargeAmount,
+		EachRechargePoints:   setting.EachRechargePoints,
+		SwitchBettingPoints:  setting.SwitchBettingPoints,
+		EachBettingAmount:    setting.EachBettingAmount,
+		EachBettingPoints:    setting.EachBettingPoints,
+		MaxDailyPoints:       setting.MaxDailyPoints,
+		UpdatedAt:            gtime.Now(),
+	}
+
+	existing, err := s.loadPointsSetting(ctx, siteId)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询积分设置失败: %v", err)
+		return nil, err
+	}
+	if existing == nil {
+		data.CreatedAt = gtime.Now()
+		_, err = dao.SitePoints.Ctx(ctx).Data(data).Insert()
+	} else {
+		_, err = dao.SitePoints.Ctx(ctx).Where("id", existing.Id).Data(data).Update()
+	}
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "保存积分设置失败: %v", err)
+		return nil, err
+	}
+
+	logMessage := fmt.Sprintf("修改积分设置 [开关:%d, 充值:%d 每%.2f得%d, 投注:%d 每%.2f得%d, 每日上限:%d]",
+		setting.SwitchSitePoints, setting.SwitchRechargePoints, setting.EachRechargeAmount, setting.EachRechargePoints,
+		setting.SwitchBettingPoints, setting.EachBettingAmount, setting.EachBettingPoints, setting.MaxDailyPoints)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	return &v1.UpdatePointsSettingRes{Success: true, Message: "保存成功"}, nil
+}
+
+// GetUserPointsLogs 获取会员积分记录
+func (s *sUser) GetUserPointsLogs(ctx context.Context, req *v1.GetUserPointsLogsReq) (*v1.GetUserPointsLogsRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取会员积分记录请求 - Page: %d, Size: %d, UserId: %d, Username: %s, Source: %d", req.Page, req.Size, req.UserId, req.Username, req.Source)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	size := req.Size
+	if size <= 0 {
+		size = 50
+	}
+
+	query := dao.UserPointsLog.Ctx(ctx).Where(do.UserPointsLog{SiteId: siteId})
+	if req.UserId > 0 {
+		query = query.Where("user_id", req.UserId)
+	}
+	if req.Username != "" {
+		query = query.Where("username", req.Username)
+	}
+	if req.Source > 0 {
+		query = query.Where("source", req.Source)
+	}
+	if req.StartTime != "" {
+		query = query.WhereGTE("created_at", req.StartTime)
+	}
+	if req.EndTime != "" {
+		query = query.WhereLTE("created_at", req.EndTime)
+	}
+
+	total, err := query.Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取会员积分记录总数失败: %v", err)
+		return nil, err
+	}
+
+	var logs []*entity.UserPointsLog
+	err = query.Page(int(page), int(size)).OrderDesc("id").Scan(&logs)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取会员积分记录失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]*v1.UserPointsLogInfo, 0, len(logs))
+	for _, log := range logs {
+		info := &v1.UserPointsLogInfo{
+			Id:         int64(log.Id),
+			UserId:     int32(log.UserId),
+			Username:   log.Username,
+			Source:     int32(log.Source),
+			ChangeType: int32(log.ChangeType),
+			Points:     int32(log.Points),
+			PointsOld:  int32(log.PointsOld),
+			PointsNew:  int32(log.PointsNew),
+			BizNo:      log.BizNo,
+			AdminId:    int32(log.AdminId),
+			Remark:     log.Remark,
+			CreatedAt:  util.FormatTime(log.CreatedAt),
+		}
+		if log.PointsDate != nil {
+			info.PointsDate = log.PointsDate.Format("Y-m-d")
+		}
+		list = append(list, info)
+	}
+
+	return &v1.GetUserPointsLogsRes{
+		List:  list,
+		Count: int32(total),
+	}, nil
+}
+
+// AdjustUserPoints 后台调整会员积分，不计入每日积分上限
+func (s *sUser) AdjustUserPoints(ctx context.Context, req *v1.AdjustUserPointsReq) (*v1.AdjustUserPointsRes, error) {
+	middleware.LogWithTrace(ctx, "info", "调整会员积分请求 - UserId: %d, ChangeType: %d, Points: %d", req.UserId, req.ChangeType, req.Points)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	admin := backend.Admin().CurrentAdmin(ctx)
+	if admin == nil {
+		return &v1.AdjustUserPointsRes{Success: false, Message: "未登录或登录已过期"}, nil
+	}
+	if req.UserId <= 0 {
+		return &v1.AdjustUserPointsRes{Success: false, Message: "会员ID不能为空"}, nil
+	}
+	if req.ChangeType != consts.ChangeTypeIn && req.ChangeType != consts.ChangeTypeOut {
+		return &v1.AdjustUserPointsRes{Success: false, Message: "变动类型错误"}, nil
+	}
+	if req.Points <= 0 {
+		return &v1.AdjustUserPointsRes{Success: false, Message: "变动积分必须大于0"}, nil
+	}
+	if req.Remark == "" {
+		return &v1.AdjustUserPointsRes{Success: false, Message: "请填写调整原因"}, nil
+	}
+
+	log, _, err := s.postPoints(ctx, &pointsEntry{
+		siteId:     siteId,
+		userId:     int(req.UserId),
+		source:     consts.PointsSourceManual,
+		changeType: int(req.ChangeType),
+		points:     int(req.Points),
+		bizNo:      fmt.Sprintf("PA%d_%d", req.UserId, time.Now().UnixNano()),
+		date:       gtime.Now(),
+		adminId:    int(admin.Id),
+		remark:     req.Remark,
+	}, 0)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "调整会员积分失败: %v", err)
+		return &v1.AdjustUserPointsRes{Success: false, Message: err.Error()}, nil
+	}
+
+	action := "增加"
+	if req.ChangeType == consts.ChangeTypeOut {
+		action = "扣除"
+	}
+	logMessage := fmt.Sprintf("调整会员积分 [%s] %s %d，调整后 %d，原因: %s", log.Username, action, req.Points, log.PointsNew, req.Remark)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	return &v1.AdjustUserPointsRes{Success: true, Message: "调整成功", Points: int32(log.PointsNew)}, nil
+}
+
+// RunBettingPoints 按指定日期的有效投注发放投注积分，已发放的会员不会重复发放
+func (s *sUser) RunBettingPoints(ctx context.Context, req *v1.RunBettingPointsReq) (*v1.RunBettingPointsRes, error) {
+	middleware.LogWithTrace(ctx, "info", "发放投注积分请求 - Date: %s", req.Date)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	date := gtime.Now().AddDate(0, 0, -1)
+	if req.Date != "" {
+		var err error
+		if date, err = gtime.StrToTimeFormat(req.Date, "Y-m-d"); err != nil {
+			return &v1.RunBettingPointsRes{Success: false, Message: "日期格式错误"}, nil
+		}
+		if !date.Before(gtime.Now().StartOfDay()) {
+			return &v1.RunBettingPointsRes{Success: false, Message: "只能发放今天以前的投注积分"}, nil
+		}
+	}
+
+	users, points, err := s.accrueBettingPoints(ctx, siteId, date)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "发放投注积分失败: %v", err)
+		return nil, err
+	}
+
+	logMessage := fmt.Sprintf("发放 %s 投注积分，%d 人，共 %d 积分", date.Format("Y-m-d"), users, points)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	return &v1.RunBettingPointsRes{
+		Success:     true,
+		Message:     fmt.Sprintf("发放 %d 人", users),
+		Users:       int32(users),
+		PointsTotal: int32(points),
+	}, nil
+}
+
+// AccrueBettingPoints 为所有站点发放昨天的投注积分，供定时任务调用
+func (s *sUser) AccrueBettingPoints(ctx context.Context) error {
+	siteIds, err := dao.SiteConfig.Ctx(ctx).Fields("site_id").Array()
+	if err != nil {
+		return fmt.Errorf("查询站点失败: %v", err)
+	}
+	yesterday := gtime.Now().AddDate(0, 0, -1)
+	for _, value := range siteIds {
+		users, points, err := s.accrueBettingPoints(ctx, value.Int(), yesterday)
+		if err != nil {
+			return fmt.Errorf("站点 %d 发放投注积分失败: %v", value.Int(), err)
+		}
+		middleware.LogWithTrace(ctx, "info", "投注积分发放完成 - SiteId: %d, 人数: %d, 积分: %d", value.Int(), users, points)
+	}
+	return nil
+}
+
+// AccrueRechargePoints 按充值金额发放积分，同一流水号只发放一次，由入款确认在同一事务中调用
+func (s *sUser) AccrueRechargePoints(ctx context.Context, siteId, userId int, money float64, tradeNo string) error {
+	setting, err := s.loadPointsSetting(ctx, siteId)
+	if err != nil {
+		return err
+	}
+	if setting == nil || setting.SwitchSitePoints != 1 || setting.SwitchRechargePoints != 1 {
+		return nil
+	}
+	points := pointsFor(money, setting.EachRechargeAmount, setting.EachRechargePoints)
+	if points <= 0 {
+		return nil
+	}
+
+	_, _, err = s.postPoints(ctx, &pointsEntry{
+		siteId:     siteId,
+		userId:     userId,
+		source:     consts.PointsSourceRecharge,
+		changeType: consts.ChangeTypeIn,
+		points:     points,
+		bizNo:      "RC" + tradeNo,
+		date:       gtime.Now(),
+		remark:     fmt.Sprintf("充值 %.2f", money),
+		capped:     true,
+	}, setting.MaxDailyPoints)
+	return err
+}
+
+// accrueBettingPoints 按会员当天的有效投注总额发放积分，返回获得积分的会员数和积分总数
+// 投注汇总在发放后才更新的部分不会补发
+func (s *sUser) accrueBettingPoints(ctx context.Context, siteId int, date *gtime.Time) (int, int, error) {
+	setting, err := s.loadPointsSetting(ctx, siteId)
+	if err != nil {
+		return 0, 0, err
+	}
+	if setting == nil || setting.SwitchSitePoints != 1 || setting.SwitchBettingPoints != 1 ||
+		setting.EachBettingAmount <= 0 || setting.EachBettingPoints <= 0 {
+		return 0, 0, nil
+	}
+
+	betDate := date.Format("Y-m-d")
+	users := 0
+	pointsTotal := 0
+	var lastUserId uint
+	for {
+		var totals []*entity.BetLogDaily
+		err = dao.BetLogDaily.Ctx(ctx).
+			Fields("user_id, MAX(username) AS username, SUM(valid_bet_amount) AS valid_bet_amount").
+			Where(do.BetLogDaily{SiteId: siteId}).
+			Where("bet_date", betDate).
+			WhereGT("user_id", lastUserId).
+			Group("user_id").
+			OrderAsc("user_id").
+			Limit(bettingPointsBatchSize).
+			Scan(&totals)
+		if err != nil {
+			return users, pointsTotal, fmt.Errorf("查询投注汇总失败: %v", err)
+		}
+
+		for _, total := range totals {
+			points := pointsFor(total.ValidBetAmount, setting.EachBettingAmount, setting.EachBettingPoints)
+			if points <= 0 {
+				continue
+			}
+			log, created, err := s.postPoints(ctx, &pointsEntry{
+				siteId:     siteId,
+				userId:     int(total.UserId),
+				source:     consts.PointsSourceBetting,
+				changeType: consts.ChangeTypeIn,
+				points:     points,
+				bizNo:      fmt.Sprintf("BT%d_%s", total.UserId, date.Format("Ymd")),
+				date:       date,
+				remark:     fmt.Sprintf("%s 有效投注 %.2f", betDate, total.ValidBetAmount),
+				capped:     true,
+			}, setting.MaxDailyPoints)
+			if err != nil {
+				// 单个会员失败不影响其他会员，重新执行时补发
+				middleware.LogWithTrace(ctx, "error", "发放投注积分失败 - UserId: %d, 错误: %v", total.UserId, err)
+				continue
+			}
+			if created {
+				users++
+				pointsTotal += log.Points
+			}
+		}
+		if len(totals) < bettingPointsBatchSize {
+			return users, pointsTotal, nil
+		}
+		lastUserId = totals[len(totals)-1].UserId
+	}
+}
+
+// postPoints 在事务中锁定会员，更新积分并写入积分记录，同一业务单号只记录一次
+// 计入每日上限的积分超出 maxDaily 时只发放剩余额度，额度用完时不记录，created 为 false
+// 会员积分增加后立即评估等级
+func (s *sUser) postPoints(ctx context.Context, entry *pointsEntry, maxDaily int) (log *entity.UserPointsLog, created bool, err error) {
+	err = dao.UserPointsLog.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		// 幂等检查
+		err := dao.UserPointsLog.Ctx(ctx).Where(do.UserPointsLog{
+			SiteId: entry.siteId,
+			BizNo:  entry.bizNo,
+		}).Scan(&log)
+		if err != nil || log != nil {
+			return err
+		}
+
+		var user *entity.User
+		err = dao.User.Ctx(ctx).
+			Fields("id, username, points").
+			Where(do.User{SiteId: entry.siteId, Id: entry.userId}).
+			LockUpdate().
+			Scan(&user)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return fmt.Errorf("会员不存在")
+		}
+
+		points := entry.points
+		if entry.capped && maxDaily > 0 {
+			used, err := dao.UserPointsLog.Ctx(ctx).
+				Where(do.UserPointsLog{
+					SiteId:     entry.siteId,
+					UserId:     entry.userId,
+					ChangeType: consts.ChangeTypeIn,
+				}).
+				Where("points_date", entry.date.Format("Y-m-d")).
+				WhereIn("source", []int{consts.PointsSourceRecharge, consts.PointsSourceBetting}).
+				Sum("points")
+			if err != nil {
+				return err
+			}
+			if remain := maxDaily - int(used); points > remain {
+				points = remain
+			}
+			if points <= 0 {
+				return nil
+			}
+		}
+
+		pointsNew := user.Points + points
+		if entry.changeType == consts.ChangeTypeOut {
+			pointsNew = user.Points - points
+			if pointsNew < 0 {
+				return fmt.Errorf("会员积分不足")
+			}
+		}
+
+		_, err = dao.User.Ctx(ctx).Where("id", user.Id).Data(do.User{
+			Points:    pointsNew,
+			UpdatedAt: gtime.Now(),
+		}).Update()
+		if err != nil {
+			return err
+		}
+
+		log = &entity.UserPointsLog{
+			SiteId:     entry.siteId,
+			UserId:     entry.userId,
+			Username:   user.Username,
+			Source:     entry.source,
+			ChangeType: entry.changeType,
+			Points:     points,
+			PointsOld:  user.Points,
+			PointsNew:  pointsNew,
+			BizNo:      entry.bizNo,
+			PointsDate: gtime.NewFromStr(entry.date.Format("Y-m-d")),
+			AdminId:    entry.adminId,
+			Remark:     entry.remark,
+			CreatedAt:  gtime.Now(),
+		}
+		result, err := dao.UserPointsLog.Ctx(ctx).Data(log).OmitEmptyData().Insert()
+		if err != nil {
+			return err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		log.Id = uint64(id)
+		created = true
+		return nil
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("积分记账失败: %v", err)
+	}
+
+	if created && entry.changeType == consts.ChangeTypeIn {
+		if err = s.UpgradeUserGrade(ctx, entry.siteId, entry.userId); err != nil {
+			middleware.LogWithTrace(ctx, "error", "评估会员等级失败 - UserId: %d, 错误: %v", entry.userId, err)
+		}
+	}
+	return log, created, nil
+}
+
+// loadPointsSetting 获取站点积分设置，未设置时返回 nil
+func (s *sUser) loadPointsSetting(ctx context.Context, siteId int) (*entity.SitePoints, error) {
+	var setting *entity.SitePoints
+	err := dao.SitePoints.Ctx(ctx).Where(do.SitePoints{SiteId: siteId}).Scan(&setting)
+	if err != nil {
+		return nil, fmt.Errorf("查询积分设置失败: %v", err)
+	}
+	return setting, nil
+}
+
+// validatePointsSetting 校验积分设置，返回错误提示
+func validatePointsSetting(setting *v1.PointsSetting) string {
+	for _, value := range []int32{setting.SwitchSitePoints, setting.SwitchRechargePoints, setting.SwitchBettingPoints} {
+		if value != 0 && value != 1 {
+			return "开关参数错误"
+		}
+	}
+	if setting.EachRechargeAmount < 0 || setting.EachRechargePoints < 0 ||
+		setting.EachBettingAmount < 0 || setting.EachBettingPoints < 0 || setting.MaxDailyPoints < 0 {
+		return "金额和积分不能小于0"
+	}
+	if setting.SwitchRechargePoints == 1 && (setting.EachRechargeAmount <= 0 || setting.EachRechargePoints <= 0) {
+		return "开启充值积分时请设置充值金额和获得积分"
+	}
+	if setting.SwitchBettingPoints == 1 && (setting.EachBettingAmount <= 0 || setting.EachBettingPoints <= 0) {
+		return "开启投注积分时请设置投注金额和获得积分"
+	}
+	return ""
+}
+
+// pointsFor 按每 eachAmount 获得 eachPoints 计算积分，不足 eachAmount 的部分不计
+func pointsFor(amount, eachAmount float64, eachPoints int) int {
+	if eachAmount <= 0 || eachPoints <= 0 || amount < eachAmount {
+		return 0
+	}
+	// 避免浮点误差导致整倍数金额少算一份
+	return int(math.Floor(amount/eachAmount+1e-9)) * eachPoints
+}
@@ -0,0 +1,294 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	v1 "jh_app_service/api/backend/user/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/text/gstr"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	usernamePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]{3,15}$`)
+	mobilePattern   = regexp.MustCompile(`^\+?[0-9]{6,20}$`)
+	emailPattern    = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+	qqPattern       = regexp.MustCompile(`^[1-9][0-9]{4,11}$`)
+)
+
+// registerFieldValue 注册项标识对应的请求值，未在此列出的注册项忽略
+func registerFieldValue(req *v1.RegisterReq, fieldName string) (string, bool) {
+	switch fieldName {
+	case "pay_password":
+		return req.PayPassword, true
+	case "realname":
+		return req.Realname, true
+	case "mobile":
+		return req.Mobile, true
+	case "email":
+		return req.Email, true
+	case "qq":
+		return req.Qq, true
+	case "birthday":
+		return req.Birthday, true
+	case "sex":
+		if req.Sex > 0 {
+			return fmt.Sprint(req.Sex), true
+		}
+		return "", true
+	case "safe_question":
+		return req.SafeQuestion, true
+	case "safe_answer":
+		return req.SafeAnswer, true
+	case "agent":
+		return req.AgentUsername, true
+	}
+	return "", false
+}
+
+// Register 会员注册：按站点注册项校验字段，限制同一IP注册间隔，并应用站点默认等级、层级和代理
+func (s *sUser) Register(ctx context.Context, req *v1.RegisterReq) (*v1.RegisterRes, error) {
+	registerIp := middleware.GetClientIPFromContext(ctx)
+	middleware.LogWithTrace(ctx, "info", "会员注册请求 - Username: %s, IP: %s, Device: %d", req.Username, registerIp, req.RegisterDevice)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	var siteConfig *entity.SiteConfig
+	err := dao.SiteConfig.Ctx(ctx).Where(do.SiteConfig{SiteId: siteId}).Scan(&siteConfig)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取站点配置失败: %v", err)
+		return nil, err
+	}
+	if siteConfig == nil || siteConfig.SwitchRegister != 1 {
+		return &v1.RegisterRes{Success: false, Message: "暂未开放注册"}, nil
+	}
+	if err = backend.Site().CheckAccess(ctx, siteId, registerIp); err != nil {
+		return &v1.RegisterRes{Success: false, Message: err.Error()}, nil
+	}
+
+	req.Username = strings.TrimSpace(req.Username)
+	if !usernamePattern.MatchString(req.Username) {
+		return &v1.RegisterRes{Success: false, Message: "会员账号须为字母开头的4-16位字母或数字"}, nil
+	}
+	if len(req.Password) < 6 || len(req.Password) > 20 {
+		return &v1.RegisterRes{Success: false, Message: "登录密码长度须为6-20位"}, nil
+	}
+	if req.RegisterDevice < 0 || req.RegisterDevice > 3 {
+		return &v1.RegisterRes{Success: false, Message: "注册设备无效"}, nil
+	}
+
+	fields, err := backend.Site().RegisterFields(ctx, siteId, consts.SiteRegisterTypeUser)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取注册项失败: %v", err)
+		return nil, err
+	}
+	displayed := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		value, known := registerFieldValue(req, field.FieldName)
+		if !known || field.Display != 1 {
+			continue
+		}
+		displayed[field.FieldName] = true
+		if field.Required == 1 && strings.TrimSpace(value) == "" {
+			return &v1.RegisterRes{Success: false, Message: fmt.Sprintf("请填写%s", field.Name)}, nil
+		}
+	}
+
+	user := do.User{
+		SiteId:            siteId,
+		GradeId:           siteConfig.DefaultGradeId,
+		LevelId:           siteConfig.DefaultLevelId,
+		AgentId:           siteConfig.DefaultAgentId,
+		Username:          req.Username,
+		Status:            1,
+		RegisterIp:        registerIp,
+		RegisterTime:      gtime.Now(),
+		RegisterUrl:       req.RegisterUrl,
+		RegisterDevice:    int(req.RegisterDevice),
+		FocusLevel:        1,
+		BalanceStatus:     1,
+		ShowBeginnerGuide: 1,
+		CreatedAt:         gtime.Now(),
+		UpdatedAt:         gtime.Now(),
+	}
+
+	// 只保存站点开启显示的注册项，未显示的注册项即使提交也忽略
+	if message := s.applyRegisterFields(ctx, siteId, req, displayed, &user); message != "" {
+		return &v1.RegisterRes{Success: false, Message: message}, nil
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return &v1.RegisterRes{Success: false, Message: "密码加密失败"}, nil
+	}
+	user.Password = string(hashedPassword)
+	if displayed["pay_password"] && req.PayPassword != "" {
+		hashedPayPassword, err := bcrypt.GenerateFromPassword([]byte(req.PayPassword), bcrypt.DefaultCost)
+		if err != nil {
+			return &v1.RegisterRes{Success: false, Message: "资金密码加密失败"}, nil
+		}
+		user.PayPassword = string(hashedPayPassword)
+	}
+	// 密保答案与密码一样只保存哈希值
+	if displayed["safe_answer"] && req.SafeAnswer != "" {
+		hashedSafeAnswer, err := bcrypt.GenerateFromPassword([]byte(strings.TrimSpace(req.SafeAnswer)), bcrypt.DefaultCost)
+		if err != nil {
+			return &v1.RegisterRes{Success: false, Message: "密保答案加密失败"}, nil
+		}
+		user.SafeAnswer = string(hashedSafeAnswer)
+	}
+
+	var (
+		userId  int64
+		message string
+	)
+	// 同一IP在设定时间内只能注册一次，单位为小时；配置 user.register.intervalExemptIps 中的IP不限制
+	checkInterval := siteConfig.RegisterTimeInterval > 0 &&
+		!gstr.InArray(g.Cfg().MustGet(ctx, "user.register.intervalExemptIps").Strings(), registerIp)
+	err = dao.User.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		if checkInterval {
+			// 锁定该IP的注册锁记录，同一IP的并发注册在此排队，事务提交后才能检查下一个
+			_, err := dao.UserRegisterLock.Ctx(ctx).Data(do.UserRegisterLock{
+				SiteId:     siteId,
+				RegisterIp: registerIp,
+				CreatedAt:  gtime.Now(),
+			}).InsertIgnore()
+			if err != nil {
+				return err
+			}
+			_, err = dao.UserRegisterLock.Ctx(ctx).Where(do.UserRegisterLock{
+				SiteId:     siteId,
+				RegisterIp: registerIp,
+			}).LockUpdate().One()
+			if err != nil {
+				return err
+			}
+
+			count, err := dao.User.Ctx(ctx).Where(do.User{
+				SiteId:     siteId,
+				RegisterIp: registerIp,
+			}).WhereGTE("register_time", gtime.Now().Add(-time.Duration(siteConfig.RegisterTimeInterval)*time.Hour)).Count()
+			if err != nil {
+				return err
+			}
+			if count > 0 {
+				message = fmt.Sprintf("同一IP%d小时内只能注册一次", siteConfig.RegisterTimeInterval)
+				return nil
+			}
+		}
+
+		// 账号唯一由 uk_site_username 保证，并发注册同一账号时只有一个能插入成功
+		var err error
+		userId, err = dao.User.Ctx(ctx).Data(user).InsertAndGetId()
+		if err != nil && strings.Contains(err.Error(), "Duplicate entry") {
+			message = "会员账号已存在"
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "会员注册失败: %v", err)
+		return nil, fmt.Errorf("会员注册失败: %v", err)
+	}
+	if message != "" {
+		return &v1.RegisterRes{Success: false, Message: message}, nil
+	}
+
+	err = backend.Risk().Evaluate(ctx, &model.RiskEvent{
+		Type:     consts.RiskSameIpRegister,
+		SiteId:   siteId,
+		UserId:   int(userId),
+		Username: req.Username,
+		Ip:       registerIp,
+		Device:   int(req.RegisterDevice),
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "同IP注册风险预警失败: %v", err)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "会员注册成功 - UserId: %d, Username: %s", userId, req.Username)
+
+	return &v1.RegisterRes{
+		Success: true,
+		Message: "注册成功",
+		UserId:  int32(userId),
+	}, nil
+}
+
+// applyRegisterFields 校验已显示注册项的格式并写入会员数据，校验失败时返回提示信息
+func (s *sUser) applyRegisterFields(ctx context.Context, siteId int, req *v1.RegisterReq, displayed map[string]bool, user *do.User) string {
+	if displayed["pay_password"] && req.PayPassword != "" && (len(req.PayPassword) < 6 || len(req.PayPassword) > 20) {
+		return "资金密码长度须为6-20位"
+	}
+	if displayed["realname"] && req.Realname != "" {
+		user.Realname = strings.TrimSpace(req.Realname)
+	}
+	if displayed["mobile"] && req.Mobile != "" {
+		if !mobilePattern.MatchString(req.Mobile) {
+			return "手机号格式错误"
+		}
+		user.Mobile = req.Mobile
+	}
+	if displayed["email"] && req.Email != "" {
+		if !emailPattern.MatchString(req.Email) {
+			return "邮箱格式错误"
+		}
+		user.Email = req.Email
+	}
+	if displayed["qq"] && req.Qq != "" {
+		if !qqPattern.MatchString(req.Qq) {
+			return "QQ号格式错误"
+		}
+		user.Qq = req.Qq
+	}
+	if displayed["birthday"] && req.Birthday != "" {
+		birthday, err := gtime.StrToTimeFormat(req.Birthday, "Y-m-d")
+		if err != nil || birthday.After(gtime.Now()) {
+			return "生日格式错误"
+		}
+		user.Birthday = birthday
+	}
+	if displayed["sex"] && req.Sex != 0 {
+		if req.Sex != 1 && req.Sex != 2 {
+			return "性别无效"
+		}
+		user.Sex = int(req.Sex)
+	}
+	if displayed["safe_question"] && req.SafeQuestion != "" {
+		user.SafeQuestion = req.SafeQuestion
+	}
+	if displayed["safe_answer"] && len(strings.TrimSpace(req.SafeAnswer)) > 72 {
+		return "密保答案过长"
+	}
+	if displayed["agent"] && req.AgentUsername != "" {
+		var agent *entity.Agent
+		err := dao.Agent.Ctx(ctx).Where(do.Agent{
+			SiteId:   siteId,
+			Username: req.AgentUsername,
+		}).Scan(&agent)
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "查询推荐代理失败: %v", err)
+			return "查询推荐代理失败"
+		}
+		if agent == nil || agent.Status != 1 {
+			return "推荐代理不存在"
+		}
+		user.AgentId = int(agent.Id)
+	}
+	return ""
+}
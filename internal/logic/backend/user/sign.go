@@ -0,0 +1,763 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	v1 "jh_app_service/api/backend/user/v1"
+	consts "jh_app_service/internal/consts/backend"
+	"jh_app_service/internal/dao"
+	"jh_app_service/internal/middleware"
+	"jh_app_service/internal/model"
+	"jh_app_service/internal/model/do"
+	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/service/backend"
+	"jh_app_service/internal/util"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+const (
+	// 签到终端
+	signPlatformAll    = 0
+	signPlatformWeb    = 1
+	signPlatformMobile = 2
+	// 单个活动最多设置的奖励档数
+	signRewardLimit = 31
+)
+
+// errSignedToday 会员今天已签到
+var errSignedToday = errors.New("今天已签到")
+
+// signCampaign 签到活动的修改内容
+type signCampaign struct {
+	sign    *entity.SiteSign
+	rewards []*v1.SignReward
+}
+
+// GetSignCampaigns 获取签到活动列表
+func (s *sUser) GetSignCampaigns(ctx context.Context, req *v1.GetSignCampaignsReq) (*v1.GetSignCampaignsRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取签到活动列表请求 - Status: %d", req.Status)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	query := dao.SiteSign.Ctx(ctx).Where(do.SiteSign{SiteId: siteId})
+	if req.Status >= 0 {
+		query = query.Where("status", req.Status)
+	}
+	var signs []*entity.SiteSign
+	if err := query.OrderDesc("id").Scan(&signs); err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取签到活动列表失败: %v", err)
+		return nil, err
+	}
+
+	signIds := make([]int, 0, len(signs))
+	for _, sign := range signs {
+		signIds = append(signIds, int(sign.Id))
+	}
+	rewards, err := s.signRewards(ctx, siteId, signIds)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*v1.SignCampaignInfo, 0, len(signs))
+	for _, sign := range signs {
+		list = append(list, &v1.SignCampaignInfo{
+			Id:        int32(sign.Id),
+			Name:      sign.Name,
+			StartTime: util.FormatTime(sign.StartTime),
+			EndTime:   util.FormatTime(sign.EndTime),
+			Status:    int32(sign.Status),
+			GradeIds:  util.SplitIds(sign.UserGrade),
+			LevelIds:  util.SplitIds(sign.UserLevel),
+			Platform:  int32(sign.Platform),
+			Remark:    sign.Remark,
+			Rewards:   toSignRewards(rewards[int(sign.Id)]),
+			CreatedAt: util.FormatTime(sign.CreatedAt),
+			UpdatedAt: util.FormatTime(sign.UpdatedAt),
+		})
+	}
+
+	return &v1.GetSignCampaignsRes{List: list}, nil
+}
+
+// CreateSignCampaign 创建签到活动
+func (s *sUser) CreateSignCampaign(ctx context.Context, req *v1.CreateSignCampaignReq) (*v1.CreateSignCampaignRes, error) {
+	middleware.LogWithTrace(ctx, "info", "创建签到活动请求 - Name: %s, StartTime: %s, EndTime: %s", req.Name, req.StartTime, req.EndTime)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	campaign, message, err := s.buildSignCampaign(ctx, siteId, req.Name, req.StartTime, req.EndTime, req.Status, req.GradeIds, req.LevelIds, req.Platform, req.Remark, req.Rewards)
+	if err != nil {
+		return nil, err
+	}
+	if message != "" {
+		return &v1.CreateSignCampaignRes{Success: false, Message: message}, nil
+	}
+
+	var id int64
+	err = dao.SiteSign.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		var err error
+		id, err = dao.SiteSign.Ctx(ctx).Data(do.SiteSign{
+			SiteId:    siteId,
+			Name:      campaign.sign.Name,
+			StartTime: campaign.sign.StartTime,
+			EndTime:   campaign.sign.EndTime,
+			Status:    campaign.sign.Status,
+			UserGrade: campaign.sign.UserGrade,
+			UserLevel: campaign.sign.UserLevel,
+			Platform:  campaign.sign.Platform,
+			Remark:    campaign.sign.Remark,
+			CreatedAt: gtime.Now(),
+			UpdatedAt: gtime.Now(),
+		}).InsertAndGetId()
+		if err != nil {
+			return err
+		}
+		return s.saveSignRewards(ctx, siteId, int(id), campaign.rewards)
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "创建签到活动失败: %v", err)
+		return nil, fmt.Errorf("创建签到活动失败: %v", err)
+	}
+
+	logMessage := fmt.Sprintf("创建签到活动 %s [ID:%d]", campaign.sign.Name, id)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "创建签到活动成功 - ID: %d", id)
+	return &v1.CreateSignCampaignRes{Success: true, Message: "创建成功", Id: int32(id)}, nil
+}
+
+// UpdateSignCampaign 修改签到活动，连续签到奖励整体替换
+func (s *sUser) UpdateSignCampaign(ctx context.Context, req *v1.UpdateSignCampaignReq) (*v1.UpdateSignCampaignRes, error) {
+	middleware.LogWithTrace(ctx, "info", "修改签到活动请求 - ID: %d, Name: %s, StartTime: %s, EndTime: %s", req.Id, req.Name, req.StartTime, req.EndTime)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	var existing *entity.SiteSign
+	err := dao.SiteSign.Ctx(ctx).Where(do.SiteSign{SiteId: siteId, Id: req.Id}).Scan(&existing)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询签到活动失败: %v", err)
+		return nil, err
+	}
+	if existing == nil {
+		return &v1.UpdateSignCampaignRes{Success: false, Message: "签到活动不存在"}, nil
+	}
+
+	campaign, message, err := s.buildSignCampaign(ctx, siteId, req.Name, req.StartTime, req.EndTime, req.Status, req.GradeIds, req.LevelIds, req.Platform, req.Remark, req.Rewards)
+	if err != nil {
+		return nil, err
+	}
+	if message != "" {
+		return &v1.UpdateSignCampaignRes{Success: false, Message: message}, nil
+	}
+
+	err = dao.SiteSign.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		_, err := dao.SiteSign.Ctx(ctx).Where("id", existing.Id).Data(do.SiteSign{
+			Name:      campaign.sign.Name,
+			StartTime: campaign.sign.StartTime,
+			EndTime:   campaign.sign.EndTime,
+			Status:    campaign.sign.Status,
+			UserGrade: campaign.sign.UserGrade,
+			UserLevel: campaign.sign.UserLevel,
+			Platform:  campaign.sign.Platform,
+			Remark:    campaign.sign.Remark,
+			UpdatedAt: gtime.Now(),
+		}).Update()
+		if err != nil {
+			return err
+		}
+		return s.saveSignRewards(ctx, siteId, int(existing.Id), campaign.rewards)
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "修改签到活动失败: %v", err)
+		return nil, fmt.Errorf("修改签到活动失败: %v", err)
+	}
+
+	logMessage := fmt.Sprintf("修改签到活动 %s [ID:%d]", campaign.sign.Name, existing.Id)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	middleware.LogWithTrace(ctx, "info", "修改签到活动成功 - ID: %d", existing.Id)
+	return &v1.UpdateSignCampaignRes{Success: true, Message: "修改成功"}, nil
+}
+
+// DeleteSignCampaign 删除签到活动，进行中的活动需先关闭，签到记录保留
+func (s *sUser) DeleteSignCampaign(ctx context.Context, req *v1.DeleteSignCampaignReq) (*v1.DeleteSignCampaignRes, error) {
+	middleware.LogWithTrace(ctx, "info", "删除签到活动请求 - ID: %d", req.Id)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	var sign *entity.SiteSign
+	err := dao.SiteSign.Ctx(ctx).Where(do.SiteSign{SiteId: siteId, Id: req.Id}).Scan(&sign)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询签到活动失败: %v", err)
+		return nil, err
+	}
+	if sign == nil {
+		return &v1.DeleteSignCampaignRes{Success: false, Message: "签到活动不存在"}, nil
+	}
+	if signRunning(sign, gtime.Now()) {
+		return &v1.DeleteSignCampaignRes{Success: false, Message: "活动进行中，请先关闭活动"}, nil
+	}
+
+	err = dao.SiteSign.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		_, err := dao.SiteSignReward.Ctx(ctx).Where(do.SiteSignReward{SiteId: siteId, SignId: sign.Id}).Delete()
+		if err != nil {
+			return err
+		}
+		_, err = dao.SiteSign.Ctx(ctx).Where("id", sign.Id).Delete()
+		return err
+	})
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "删除签到活动失败: %v", err)
+		return nil, fmt.Errorf("删除签到活动失败: %v", err)
+	}
+
+	logMessage := fmt.Sprintf("删除签到活动 %s [ID:%d]", sign.Name, sign.Id)
+	if err = backend.Admin().WriteLog(ctx, logMessage); err != nil {
+		middleware.LogWithTrace(ctx, "error", "记录管理员日志失败: %v", err)
+	}
+
+	return &v1.DeleteSignCampaignRes{Success: true, Message: "删除成功"}, nil
+}
+
+// GetSignLogs 获取签到记录
+func (s *sUser) GetSignLogs(ctx context.Context, req *v1.GetSignLogsReq) (*v1.GetSignLogsRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取签到记录请求 - Page: %d, Size: %d, SignId: %d, Username: %s", req.Page, req.Size, req.SignId, req.Username)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	size := req.Size
+	if size <= 0 {
+		size = 50
+	}
+
+	query := dao.SignLog.Ctx(ctx).Where(do.SignLog{SiteId: siteId})
+	if req.SignId > 0 {
+		query = query.Where("sign_id", req.SignId)
+	}
+	if req.Username != "" {
+		query = query.Where("username", req.Username)
+	}
+	if req.StartDate != "" {
+		query = query.WhereGTE("sign_date", req.StartDate)
+	}
+	if req.EndDate != "" {
+		query = query.WhereLTE("sign_date", req.EndDate)
+	}
+
+	total, err := query.Count()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取签到记录总数失败: %v", err)
+		return nil, err
+	}
+	moneyTotal, err := query.Sum("reward_money")
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "统计签到奖励彩金失败: %v", err)
+		return nil, err
+	}
+	pointsTotal, err := query.Sum("reward_points")
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "统计签到奖励积分失败: %v", err)
+		return nil, err
+	}
+
+	var logs []*entity.SignLog
+	err = query.Page(int(page), int(size)).OrderDesc("id").Scan(&logs)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "获取签到记录失败: %v", err)
+		return nil, err
+	}
+
+	var signs []*entity.SiteSign
+	err = dao.SiteSign.Ctx(ctx).Fields("id, name").Where(do.SiteSign{SiteId: siteId}).Scan(&signs)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询签到活动失败: %v", err)
+		return nil, err
+	}
+	signNames := make(map[int]string, len(signs))
+	for _, sign := range signs {
+		signNames[int(sign.Id)] = sign.Name
+	}
+
+	list := make([]*v1.SignLogInfo, 0, len(logs))
+	for _, log := range logs {
+		info := &v1.SignLogInfo{
+			Id:           int64(log.Id),
+			SignId:       int32(log.SignId),
+			SignName:     signNames[log.SignId],
+			UserId:       int32(log.UserId),
+			Username:     log.Username,
+			Platform:     int32(log.Platform),
+			Streak:       int32(log.Streak),
+			RewardMoney:  log.RewardMoney,
+			RewardPoints: int32(log.RewardPoints),
+			TradeNo:      log.TradeNo,
+			CreatedAt:    util.FormatTime(log.CreatedAt),
+		}
+		if log.SignDate != nil {
+			info.SignDate = log.SignDate.Format("Y-m-d")
+		}
+		list = append(list, info)
+	}
+
+	return &v1.GetSignLogsRes{
+		List:        list,
+		Count:       int32(total),
+		MoneyTotal:  math.Round(moneyTotal*100) / 100,
+		PointsTotal: int32(pointsTotal),
+	}, nil
+}
+
+// GetUserSignStatus 获取会员当前可参与的签到活动和连续签到天数
+func (s *sUser) GetUserSignStatus(ctx context.Context, req *v1.GetUserSignStatusReq) (*v1.GetUserSignStatusRes, error) {
+	middleware.LogWithTrace(ctx, "info", "获取会员签到状态请求 - UserId: %d, Platform: %d", req.UserId, req.Platform)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	if req.Platform != signPlatformWeb && req.Platform != signPlatformMobile {
+		return &v1.GetUserSignStatusRes{Available: false, Message: "签到终端错误"}, nil
+	}
+	user, err := s.getSignUser(ctx, siteId, int(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return &v1.GetUserSignStatusRes{Available: false, Message: "会员不存在"}, nil
+	}
+
+	now := gtime.Now()
+	sign, message, err := s.signCampaignFor(ctx, siteId, user, int(req.Platform), now)
+	if err != nil {
+		return nil, err
+	}
+	if sign == nil {
+		return &v1.GetUserSignStatusRes{Available: false, Message: message}, nil
+	}
+
+	last, err := s.lastSignLog(ctx, siteId, int(user.Id))
+	if err != nil {
+		return nil, err
+	}
+	rewards, err := s.signRewards(ctx, siteId, []int{int(sign.Id)})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &v1.GetUserSignStatusRes{
+		Available: true,
+		SignId:    int32(sign.Id),
+		SignName:  sign.Name,
+		Rewards:   toSignRewards(rewards[int(sign.Id)]),
+	}
+	if last != nil && last.SignId == int(sign.Id) && last.SignDate != nil {
+		switch last.SignDate.Format("Y-m-d") {
+		case now.Format("Y-m-d"):
+			res.SignedToday = true
+			res.Streak = int32(last.Streak)
+		case now.AddDate(0, 0, -1).Format("Y-m-d"):
+			res.Streak = int32(last.Streak)
+		}
+	}
+	return res, nil
+}
+
+// UserSign 会员签到，每个站点每天只能签到一次，连续签到天数达到奖励档位时发放彩金和积分
+// 签到日期按服务时区 (配置 timezone) 计算
+func (s *sUser) UserSign(ctx context.Context, req *v1.UserSignReq) (*v1.UserSignRes, error) {
+	middleware.LogWithTrace(ctx, "info", "会员签到请求 - UserId: %d, Platform: %d", req.UserId, req.Platform)
+
+	// 默认站点ID为1
+	siteId := 1
+
+	if req.Platform != signPlatformWeb && req.Platform != signPlatformMobile {
+		return &v1.UserSignRes{Success: false, Message: "签到终端错误"}, nil
+	}
+	user, err := s.getSignUser(ctx, siteId, int(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return &v1.UserSignRes{Success: false, Message: "会员不存在"}, nil
+	}
+
+	now := gtime.Now()
+	sign, message, err := s.signCampaignFor(ctx, siteId, user, int(req.Platform), now)
+	if err != nil {
+		return nil, err
+	}
+	if sign == nil {
+		return &v1.UserSignRes{Success: false, Message: message}, nil
+	}
+
+	log, err := s.sign(ctx, sign, user, int(req.Platform), now)
+	if errors.Is(err, errSignedToday) {
+		return &v1.UserSignRes{Success: false, Message: err.Error()}, nil
+	}
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "会员签到失败 - UserId: %d, 错误: %v", user.Id, err)
+		return nil, err
+	}
+
+	middleware.LogWithTrace(ctx, "info", "会员签到成功 - UserId: %d, SignId: %d, 连续: %d, 彩金: %.2f, 积分: %d", user.Id, sign.Id, log.Streak, log.RewardMoney, log.RewardPoints)
+	return &v1.UserSignRes{
+		Success:      true,
+		Message:      fmt.Sprintf("签到成功，已连续签到 %d 天", log.Streak),
+		Streak:       int32(log.Streak),
+		RewardMoney:  log.RewardMoney,
+		RewardPoints: int32(log.RewardPoints),
+	}, nil
+}
+
+// sign 在事务中写入签到记录并发放奖励，锁定会员避免并发签到重复计算连续天数
+func (s *sUser) sign(ctx context.Context, sign *entity.SiteSign, user *entity.User, platform int, now *gtime.Time) (*entity.SignLog, error) {
+	today := now.Format("Y-m-d")
+	yesterday := now.AddDate(0, 0, -1).Format("Y-m-d")
+
+	var log *entity.SignLog
+	err := dao.SignLog.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		_, err := dao.User.Ctx(ctx).Fields("id").Where("id", user.Id).LockUpdate().Value()
+		if err != nil {
+			return err
+		}
+
+		last, err := s.lastSignLog(ctx, sign.SiteId, int(user.Id))
+		if err != nil {
+			return err
+		}
+		streak := 1
+		if last != nil && last.SignDate != nil {
+			switch last.SignDate.Format("Y-m-d") {
+			case today:
+				return errSignedToday
+			case yesterday:
+				// 换活动后重新计算连续天数
+				if last.SignId == int(sign.Id) {
+					streak = last.Streak + 1
+				}
+			}
+		}
+
+		var reward *entity.SiteSignReward
+		err = dao.SiteSignReward.Ctx(ctx).Where(do.SiteSignReward{
+			SiteId: sign.SiteId,
+			SignId: sign.Id,
+			Days:   streak,
+		}).Scan(&reward)
+		if err != nil {
+			return err
+		}
+
+		log = &entity.SignLog{
+			SiteId:    sign.SiteId,
+			UserId:    int(user.Id),
+			SignId:    int(sign.Id),
+			Username:  user.Username,
+			Platform:  platform,
+			Streak:    streak,
+			SignDate:  gtime.NewFromStr(today),
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		tradeNo := fmt.Sprintf("SG%d_%s", user.Id, now.Format("Ymd"))
+		if reward != nil {
+			log.RewardMoney = reward.Money
+			log.RewardPoints = reward.Points
+			if reward.Money > 0 {
+				log.TradeNo = tradeNo
+			}
+		}
+
+		// 站点+会员+日期唯一，并发签到时只有一次成功
+		result, err := dao.SignLog.Ctx(ctx).Data(log).OmitEmptyData().InsertIgnore()
+		if err != nil {
+			return err
+		}
+		if rows, _ := result.RowsAffected(); rows == 0 {
+			return errSignedToday
+		}
+
+		if log.RewardMoney > 0 {
+			_, _, err = backend.Balance().PostLedger(ctx, &model.LedgerEntry{
+				SiteId:     sign.SiteId,
+				UserId:     int(user.Id),
+				ChangeType: consts.ChangeTypeIn,
+				TradeType:  consts.TradeTypeSignBonus,
+				TradeNo:    tradeNo,
+				Money:      log.RewardMoney,
+				Remark:     fmt.Sprintf("%s 连续签到 %d 天", sign.Name, streak),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if log.RewardPoints > 0 {
+			_, _, err = s.postPoints(ctx, &pointsEntry{
+				siteId:     sign.SiteId,
+				userId:     int(user.Id),
+				source:     consts.PointsSourceSign,
+				changeType: consts.ChangeTypeIn,
+				points:     log.RewardPoints,
+				bizNo:      tradeNo,
+				date:       now,
+				remark:     fmt.Sprintf("%s 连续签到 %d 天", sign.Name, streak),
+			}, 0)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return log, nil
+}
+
+// signCampaignFor 获取会员当前可参与的签到活动，多个活动符合时取最早创建的，没有时返回原因
+func (s *sUser) signCampaignFor(ctx context.Context, siteId int, user *entity.User, platform int, now *gtime.Time) (*entity.SiteSign, string, error) {
+	switchSign, err := dao.SiteConfig.Ctx(ctx).Fields("switch_sign").Where(do.SiteConfig{SiteId: siteId}).Value()
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询站点配置失败: %v", err)
+		return nil, "", err
+	}
+	if switchSign.String() != "1" {
+		return nil, "签到功能未开启", nil
+	}
+	if user.Status != 1 {
+		return nil, "会员状态异常", nil
+	}
+
+	var signs []*entity.SiteSign
+	err = dao.SiteSign.Ctx(ctx).
+		Where(do.SiteSign{SiteId: siteId, Status: 1}).
+		WhereLTE("start_time", now).
+		WhereGTE("end_time", now).
+		OrderAsc("id").
+		Scan(&signs)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询签到活动失败: %v", err)
+		return nil, "", err
+	}
+	if len(signs) == 0 {
+		return nil, "当前没有进行中的签到活动", nil
+	}
+
+	for _, sign := range signs {
+		if sign.Platform != signPlatformAll && sign.Platform != platform {
+			continue
+		}
+		if !idListContains(sign.UserGrade, user.GradeId) || !idListContains(sign.UserLevel, user.LevelId) {
+			continue
+		}
+		return sign, "", nil
+	}
+	return nil, "您暂不符合签到活动的参与条件", nil
+}
+
+// buildSignCampaign 校验并整理签到活动，校验失败时返回提示信息
+func (s *sUser) buildSignCampaign(ctx context.Context, siteId int, name, startTime, endTime string, status int32, gradeIds, levelIds []int32, platform int32, remark string, rewards []*v1.SignReward) (*signCampaign, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "活动名称不能为空", nil
+	}
+	start, err := gtime.StrToTime(startTime)
+	if err != nil || start == nil {
+		return nil, "开始时间格式错误", nil
+	}
+	end, err := gtime.StrToTime(endTime)
+	if err != nil || end == nil {
+		return nil, "结束时间格式错误", nil
+	}
+	if !end.After(start) {
+		return nil, "结束时间必须晚于开始时间", nil
+	}
+	if status != 0 && status != 1 {
+		return nil, "状态错误", nil
+	}
+	if platform < signPlatformAll || platform > signPlatformMobile {
+		return nil, "签到终端错误", nil
+	}
+
+	if len(gradeIds) > 0 {
+		count, err := dao.UserGrade.Ctx(ctx).Where(do.UserGrade{SiteId: siteId}).WhereIn("id", gradeIds).Count()
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "查询会员等级失败: %v", err)
+			return nil, "", err
+		}
+		if count != len(util.UniqueIds(gradeIds)) {
+			return nil, "会员等级不存在", nil
+		}
+	}
+	if len(levelIds) > 0 {
+		count, err := dao.UserLevel.Ctx(ctx).Where(do.UserLevel{SiteId: siteId}).WhereIn("id", levelIds).Count()
+		if err != nil {
+			middleware.LogWithTrace(ctx, "error", "查询会员层级失败: %v", err)
+			return nil, "", err
+		}
+		if count != len(util.UniqueIds(levelIds)) {
+			return nil, "会员层级不存在", nil
+		}
+	}
+
+	if len(rewards) > signRewardLimit {
+		return nil, fmt.Sprintf("最多设置 %d 档奖励", signRewardLimit), nil
+	}
+	days := make(map[int32]bool, len(rewards))
+	for _, reward := range rewards {
+		if reward.Days <= 0 {
+			return nil, "连续签到天数必须大于0", nil
+		}
+		if days[reward.Days] {
+			return nil, fmt.Sprintf("连续签到 %d 天的奖励重复", reward.Days), nil
+		}
+		days[reward.Days] = true
+		if reward.Money < 0 || reward.Points < 0 {
+			return nil, "奖励不能小于0", nil
+		}
+		if reward.Money == 0 && reward.Points == 0 {
+			return nil, fmt.Sprintf("请设置连续签到 %d 天的奖励", reward.Days), nil
+		}
+	}
+
+	return &signCampaign{
+		sign: &entity.SiteSign{
+			Name:      name,
+			StartTime: start,
+			EndTime:   end,
+			Status:    int(status),
+			UserGrade: util.JoinIds(util.UniqueIds(gradeIds)),
+			UserLevel: util.JoinIds(util.UniqueIds(levelIds)),
+			Platform:  int(platform),
+			Remark:    strings.TrimSpace(remark),
+		},
+		rewards: rewards,
+	}, "", nil
+}
+
+// saveSignRewards 替换签到活动的奖励档位，需在事务中调用
+func (s *sUser) saveSignRewards(ctx context.Context, siteId, signId int, rewards []*v1.SignReward) error {
+	_, err := dao.SiteSignReward.Ctx(ctx).Where(do.SiteSignReward{SiteId: siteId, SignId: signId}).Delete()
+	if err != nil {
+		return err
+	}
+	if len(rewards) == 0 {
+		return nil
+	}
+	data := make([]do.SiteSignReward, 0, len(rewards))
+	for _, reward := range rewards {
+		data = append(data, do.SiteSignReward{
+			SiteId:    siteId,
+			SignId:    signId,
+			Days:      reward.Days,
+			Money:     math.Round(reward.Money*100) / 100,
+			Points:    reward.Points,
+			CreatedAt: gtime.Now(),
+		})
+	}
+	_, err = dao.SiteSignReward.Ctx(ctx).Data(data).Insert()
+	return err
+}
+
+// signRewards 签到活动的奖励档位，按连续天数排序
+func (s *sUser) signRewards(ctx context.Context, siteId int, signIds []int) (map[int][]*entity.SiteSignReward, error) {
+	rewards := make(map[int][]*entity.SiteSignReward, len(signIds))
+	if len(signIds) == 0 {
+		return rewards, nil
+	}
+	var list []*entity.SiteSignReward
+	err := dao.SiteSignReward.Ctx(ctx).
+		Where(do.SiteSignReward{SiteId: siteId}).
+		WhereIn("sign_id", signIds).
+		OrderAsc("days").
+		Scan(&list)
+	if err != nil {
+		return nil, fmt.Errorf("查询签到奖励失败: %v", err)
+	}
+	for _, reward := range list {
+		rewards[reward.SignId] = append(rewards[reward.SignId], reward)
+	}
+	return rewards, nil
+}
+
+// lastSignLog 会员最近一次签到记录
+func (s *sUser) lastSignLog(ctx context.Context, siteId, userId int) (*entity.SignLog, error) {
+	var log *entity.SignLog
+	err := dao.SignLog.Ctx(ctx).
+		Where(do.SignLog{SiteId: siteId, UserId: userId}).
+		OrderDesc("sign_date").
+		Limit(1).
+		Scan(&log)
+	if err != nil {
+		return nil, fmt.Errorf("查询签到记录失败: %v", err)
+	}
+	return log, nil
+}
+
+// getSignUser 获取签到会员
+func (s *sUser) getSignUser(ctx context.Context, siteId, userId int) (*entity.User, error) {
+	if userId <= 0 {
+		return nil, nil
+	}
+	var user *entity.User
+	err := dao.User.Ctx(ctx).
+		Fields("id, site_id, username, status, grade_id, level_id").
+		Where(do.User{SiteId: siteId, Id: userId}).
+		Scan(&user)
+	if err != nil {
+		middleware.LogWithTrace(ctx, "error", "查询会员失败: %v", err)
+		return nil, err
+	}
+	return user, nil
+}
+
+// signRunning 活动是否开启且在活动时间内
+func signRunning(sign *entity.SiteSign, now *gtime.Time) bool {
+	return sign.Status == 1 && sign.StartTime != nil && sign.EndTime != nil &&
+		!now.Before(sign.StartTime) && !now.After(sign.EndTime)
+}
+
+// toSignRewards 转换奖励档位
+func toSignRewards(rewards []*entity.SiteSignReward) []*v1.SignReward {
+	list := make([]*v1.SignReward, 0, len(rewards))
+	for _, reward := range rewards {
+		list = append(list, &v1.SignReward{
+			Days:   int32(reward.Days),
+			Money:  reward.Money,
+			Points: int32(reward.Points),
+		})
+	}
+	return list
+}
+
+// idListContains 以,隔开的ID列表是否包含 id，列表为空时表示不限
+func idListContains(list string, id int) bool {
+	if strings.TrimSpace(list) == "" {
+		return true
+	}
+	for _, value := range strings.Split(list, ",") {
+		if strings.TrimSpace(value) == strconv.Itoa(id) {
+			return true
+		}
+	}
+	return false
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	v1 "jh_app_service/api/backend/user/v1"
 	"jh_app_service/internal/service/backend"
+	"strconv"
 
 	"github.com/gogf/gf/v2/database/gdb"
 	"github.com/gogf/gf/v2/frame/g"
@@ -17,6 +18,7 @@ import (
 	"jh_app_service/internal/middleware"
 	"jh_app_service/internal/model/do"
 	"jh_app_service/internal/model/entity"
+	"jh_app_service/internal/secret"
 	"jh_app_service/internal/tracing"
 )
 
@@ -66,52 +68,9 @@ func (s *sUser) GetUserList(ctx context.Context, req *v1.GetUserListReq) (*v1.Ge
 	}
 
 	// 构建查询条件
-	query := dao.User.Ctx(ctx).Where("site_id = ?", siteId)
-
-	middleware.LogWithTrace(ctx, "info", fmt.Sprintf("基础查询条件 - 站点ID: %d", siteId))
-
-	// 添加筛选条件
-	if req.Status > 0 {
-		query = query.Where("status = ?", req.Status)
-	}
-	if req.GradeId > 0 {
-		query = query.Where("grade_id = ?", req.GradeId)
-	}
-	if req.LevelId > 0 {
-		query = query.Where("level_id = ?", req.LevelId)
-	}
-	if req.Username != "" {
-		query = query.Where("username = ?", req.Username)
-	}
-	if req.Realname != "" {
-		query = query.Where("realname = ?", req.Realname)
-	}
-	if req.Mobile != "" {
-		query = query.Where("mobile = ?", req.Mobile)
-	}
-
-	// 代理用户名筛选
-	if req.AgentUsername != "" {
-		// 先查找代理ID
-		var agent entity.Agent
-		err := dao.Agent.Ctx(ctx).Where("site_id = ? AND username = ?", siteId, req.AgentUsername).Scan(&agent)
-		if err != nil {
-			return &v1.GetUserListRes{List: []*v1.UserInfo{}, Count: 0}, nil
-		}
-		query = query.Where("agent_id = ?", agent.Id)
-	}
-
-	// 域名筛选
-	if req.Domain != "" {
-		query = query.WhereLike("register_url", "%"+req.Domain+"%")
-	}
-
-	// 时间范围筛选
-	if req.StartDate != "" {
-		query = query.Where("created_at >= ?", req.StartDate)
-	}
-	if req.EndDate != "" {
-		query = query.Where("created_at <= ?", req.EndDate)
+	query, found := s.userListQuery(ctx, siteId, req)
+	if !found {
+		return &v1.GetUserListRes{List: []*v1.UserInfo{}, Count: 0}, nil
 	}
 
 	// 数据库查询span - 获取总数
@@ -186,6 +145,7 @@ func (s *sUser) GetUserList(ctx context.Context, req *v1.GetUserListReq) (*v1.Ge
 			BalanceStatus: int32(user.BalanceStatus),
 			IsOnline:      int32(user.IsOnline),
 			PayTimes:      int32(user.PayTimes),
+			Points:        strconv.Itoa(user.Points),
 		}
 
 		// 时间格式化
@@ -435,7 +395,7 @@ func (s *sUser) GetUserBasicInfo(ctx context.Context, req *v1.GetUserBasicInfoRe
 	for _, bank := range banks {
 		bankList = append(bankList, &v1.BankInfo{
 			BankName: bank.BankName,
-			CardNo:   bank.CardNo, // 注意：实际应用中可能需要解密
+			CardNo:   secret.Mask(ctx, bank.CardNo),
 		})
 	}
 	basicInfo.Banks = bankList
@@ -463,6 +423,62 @@ func (s *sUser) getOrderByField(sortField string) string {
 	}
 }
 
+// userListQuery 按会员列表的筛选条件构建查询，代理不存在时返回 false
+func (s *sUser) userListQuery(ctx context.Context, siteId int, req *v1.GetUserListReq) (*gdb.Model, bool) {
+	query := dao.User.Ctx(ctx).Where("site_id = ?", siteId)
+
+	middleware.LogWithTrace(ctx, "info", fmt.Sprintf("基础查询条件 - 站点ID: %d", siteId))
+
+	// 添加筛选条件
+	if req.Status > 0 {
+		query = query.Where("status = ?", req.Status)
+	}
+	if req.GradeId > 0 {
+		query = query.Where("grade_id = ?", req.GradeId)
+	}
+	if req.LevelId > 0 {
+		query = query.Where("level_id = ?", req.LevelId)
+	}
+	if req.Username != "" {
+		query = query.Where("username = ?", req.Username)
+	}
+	if req.Realname != "" {
+		query = query.Where("realname = ?", req.Realname)
+	}
+	if req.Mobile != "" {
+		query = query.Where("mobile = ?", req.Mobile)
+	}
+	// 首存筛选：会员列表、导出和批量操作共用此条件，列表此前未生效
+	if req.Charge == 1 {
+		query = query.Where("pay_times >= ?", 1)
+	}
+
+	// 代理用户名筛选
+	if req.AgentUsername != "" {
+		// 先查找代理ID
+		var agent entity.Agent
+		err := dao.Agent.Ctx(ctx).Where("site_id = ? AND username = ?", siteId, req.AgentUsername).Scan(&agent)
+		if err != nil {
+			return nil, false
+		}
+		query = query.Where("agent_id = ?", agent.Id)
+	}
+
+	// 域名筛选
+	if req.Domain != "" {
+		query = query.WhereLike("register_url", "%"+req.Domain+"%")
+	}
+
+	// 时间范围筛选
+	if req.StartDate != "" {
+		query = query.Where("created_at >= ?", req.StartDate)
+	}
+	if req.EndDate != "" {
+		query = query.Where("created_at <= ?", req.EndDate)
+	}
+	return query, true
+}
+
 // maskMobile 手机号脱敏
 func (s *sUser) maskMobile(mobile string) string {
 	if len(mobile) < 8 {
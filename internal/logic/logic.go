@@ -5,11 +5,17 @@
 package logic
 
 import (
+	_ "jh_app_service/internal/logic/backend/activity"
 	_ "jh_app_service/internal/logic/backend/ad"
 	_ "jh_app_service/internal/logic/backend/admin"
+	_ "jh_app_service/internal/logic/backend/balance"
+	_ "jh_app_service/internal/logic/backend/feed"
 	_ "jh_app_service/internal/logic/backend/message"
 	_ "jh_app_service/internal/logic/backend/notice"
 	_ "jh_app_service/internal/logic/backend/option"
+	_ "jh_app_service/internal/logic/backend/payment"
+	_ "jh_app_service/internal/logic/backend/rebate"
+	_ "jh_app_service/internal/logic/backend/risk"
 	_ "jh_app_service/internal/logic/backend/role"
 	_ "jh_app_service/internal/logic/backend/site"
 	_ "jh_app_service/internal/logic/backend/upload"
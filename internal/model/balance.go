@@ -0,0 +1,13 @@
+package model
+
+// LedgerEntry 账变记录入参
+type LedgerEntry struct {
+	SiteId     int
+	UserId     int
+	ChangeType int     // 账变类型。1=入款；2=出款
+	TradeType  int     // 交易类型
+	TradeNo    string  // 流水号。同一站点唯一，重复提交时不会重复记账
+	Money      float64 // 变动金额，始终为正数
+	AdminId    int     // 操作管理员ID。0=系统
+	Remark     string
+}
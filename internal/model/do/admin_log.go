@@ -17,6 +17,7 @@ type AdminLog struct {
 	AdminId       any         //
 	AdminUsername any         //
 	Ip            any         //
+	Address       any         // IP所在地区
 	CreatedAt     *gtime.Time //
 	Remark        any         //
 }
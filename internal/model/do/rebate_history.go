@@ -18,6 +18,10 @@ type RebateHistory struct {
 	UserCount      any         // 返水人数
 	ValidBetAmount any         // 有效投注金额
 	Money          any         // 返水金额
+	Status         any         // 状态。0=待审核；1=已发放；2=已驳回
+	AdminId        any         // 审核管理员ID
+	ApprovedAt     *gtime.Time // 审核时间
+	Remark         any         // 审核备注
 	CreatedAt      *gtime.Time //
 	UpdatedAt      *gtime.Time //
 }
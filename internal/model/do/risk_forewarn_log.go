@@ -11,15 +11,18 @@ import (
 
 // RiskForewarnLog is the golang structure of table risk_forewarn_log for DAO operations like Where/Data.
 type RiskForewarnLog struct {
-	g.Meta    `orm:"table:risk_forewarn_log, do:true"`
-	Id        any         //
-	SiteId    any         // 站点ID
-	UserId    any         // 会员ID
-	Username  any         // 用户名
-	Content   any         // 预警提示内容
-	Ip        any         // 用户IP
-	Device    any         // 终端。1=电脑；2=手机；3=平板
-	Status    any         //
-	CreatedAt *gtime.Time //
-	UpdatedAt *gtime.Time //
+	g.Meta      `orm:"table:risk_forewarn_log, do:true"`
+	Id          any         //
+	SiteId      any         // 站点ID
+	UserId      any         // 会员ID
+	Type        any         // 预警类型。1=转入游戏；2=游戏转出；3=赢得金额；4=投注金额；5=修改银行卡；6=登录地区变化；7=同IP注册
+	Username    any         // 用户名
+	Content     any         // 预警提示内容
+	Ip          any         // 用户IP
+	Device      any         // 终端。1=电脑；2=手机；3=平板
+	Status      any         //
+	HandleAdmin any         // 处理管理员
+	HandledAt   *gtime.Time // 处理时间
+	CreatedAt   *gtime.Time //
+	UpdatedAt   *gtime.Time //
 }
@@ -11,11 +11,18 @@ import (
 
 // SignLog is the golang structure of table sign_log for DAO operations like Where/Data.
 type SignLog struct {
-	g.Meta    `orm:"table:sign_log, do:true"`
-	Id        any         //
-	SiteId    any         // 站点ID
-	UserId    any         // 会员ID
-	SignDate  *gtime.Time // 签到日期
-	CreatedAt *gtime.Time //
-	UpdatedAt *gtime.Time //
+	g.Meta       `orm:"table:sign_log, do:true"`
+	Id           any         //
+	SiteId       any         // 站点ID
+	UserId       any         // 会员ID
+	SignId       any         // 签到活动ID
+	Username     any         // 会员账号
+	Platform     any         // 签到终端。1=网站；2=手机
+	Streak       any         // 连续签到天数
+	RewardMoney  any         // 奖励彩金
+	RewardPoints any         // 奖励积分
+	TradeNo      any         // 彩金流水号
+	SignDate     *gtime.Time // 签到日期
+	CreatedAt    *gtime.Time //
+	UpdatedAt    *gtime.Time //
 }
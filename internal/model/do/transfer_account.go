@@ -29,6 +29,7 @@ type TransferAccount struct {
 	TodayAmount any         // 今日转账总额
 	Status      any         // 状态。1=可用；0=禁用
 	Sort        any         // 排序。值越小排名越靠前
+	Weight      any         // 权重。按权重轮询时使用，值越大被选中概率越高
 	CreatedAt   *gtime.Time //
 	UpdatedAt   *gtime.Time //
 	Remark      any         //
@@ -37,6 +37,8 @@ type User struct {
 	IsOnline          any         //
 	FocusLevel        any         // 会员关注级别。1=正常；2=可疑；3=危险
 	BalanceStatus     any         // 1=0=
+	Balance           any         // 可用余额
+	BalanceFrozen     any         // 冻结余额
 	SafeQuestion      any         // 密保问题
 	SafeAnswer        any         // 密保答案
 	ShowBeginnerGuide any         // 是否显示新手引导。1=显示；0=不显示
@@ -44,4 +46,5 @@ type User struct {
 	CreatedAt         *gtime.Time //
 	UpdatedAt         *gtime.Time //
 	PayTimes          any         // 充值次数
+	Points            any         // 积分
 }
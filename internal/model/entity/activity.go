@@ -28,7 +28,7 @@ type Activity struct {
 	Sort             int         `json:"sort"             orm:"sort"               description:"排序"`
 	CreatedAt        *gtime.Time `json:"createdAt"        orm:"created_at"         description:""`
 	UpdatedAt        *gtime.Time `json:"updatedAt"        orm:"updated_at"         description:""`
-	ActivityType     int         `json:"activityType"     orm:"activity_type"      description:"活动类型"`
+	ActivityType     int         `json:"activityType"     orm:"activity_type"      description:"活动类型。1=自定义活动；2=充值活动"`
 	Name             string      `json:"name"             orm:"name"               description:"名称"`
 	MobileCover      string      `json:"mobileCover"      orm:"mobile_cover"       description:"手机端封面"`
 	Intro            string      `json:"intro"            orm:"intro"              description:""`
@@ -15,6 +15,7 @@ type AdminLog struct {
 	AdminId       int         `json:"adminId"       orm:"admin_id"       description:""`
 	AdminUsername string      `json:"adminUsername" orm:"admin_username" description:""`
 	Ip            string      `json:"ip"            orm:"ip"             description:""`
+	Address       string      `json:"address"       orm:"address"        description:"IP所在地区"`
 	CreatedAt     *gtime.Time `json:"createdAt"     orm:"created_at"     description:""`
 	Remark        string      `json:"remark"        orm:"remark"         description:""`
 }
@@ -16,6 +16,10 @@ type RebateHistory struct {
 	UserCount      uint        `json:"userCount"      orm:"user_count"       description:"返水人数"`
 	ValidBetAmount float64     `json:"validBetAmount" orm:"valid_bet_amount" description:"有效投注金额"`
 	Money          float64     `json:"money"          orm:"money"            description:"返水金额"`
+	Status         int         `json:"status"         orm:"status"           description:"状态。0=待审核；1=已发放；2=已驳回"`
+	AdminId        int         `json:"adminId"        orm:"admin_id"         description:"审核管理员ID"`
+	ApprovedAt     *gtime.Time `json:"approvedAt"     orm:"approved_at"      description:"审核时间"`
+	Remark         string      `json:"remark"         orm:"remark"           description:"审核备注"`
 	CreatedAt      *gtime.Time `json:"createdAt"      orm:"created_at"       description:""`
 	UpdatedAt      *gtime.Time `json:"updatedAt"      orm:"updated_at"       description:""`
 }
@@ -10,14 +10,17 @@ import (
 
 // RiskForewarnLog is the golang structure for table risk_forewarn_log.
 type RiskForewarnLog struct {
-	Id        uint64      `json:"id"        orm:"id"         description:""`
-	SiteId    int         `json:"siteId"    orm:"site_id"    description:"站点ID"`
-	UserId    int         `json:"userId"    orm:"user_id"    description:"会员ID"`
-	Username  string      `json:"username"  orm:"username"   description:"用户名"`
-	Content   string      `json:"content"   orm:"content"    description:"预警提示内容"`
-	Ip        string      `json:"ip"        orm:"ip"         description:"用户IP"`
-	Device    int         `json:"device"    orm:"device"     description:"终端。1=电脑；2=手机；3=平板"`
-	Status    int         `json:"status"    orm:"status"     description:""`
-	CreatedAt *gtime.Time `json:"createdAt" orm:"created_at" description:""`
-	UpdatedAt *gtime.Time `json:"updatedAt" orm:"updated_at" description:""`
+	Id          uint64      `json:"id"          orm:"id"           description:""`
+	SiteId      int         `json:"siteId"      orm:"site_id"      description:"站点ID"`
+	UserId      int         `json:"userId"      orm:"user_id"      description:"会员ID"`
+	Type        int         `json:"type"        orm:"type"         description:"预警类型。1=转入游戏；2=游戏转出；3=赢得金额；4=投注金额；5=修改银行卡；6=登录地区变化；7=同IP注册"`
+	Username    string      `json:"username"    orm:"username"     description:"用户名"`
+	Content     string      `json:"content"     orm:"content"      description:"预警提示内容"`
+	Ip          string      `json:"ip"          orm:"ip"           description:"用户IP"`
+	Device      int         `json:"device"      orm:"device"       description:"终端。1=电脑；2=手机；3=平板"`
+	Status      int         `json:"status"      orm:"status"       description:""`
+	HandleAdmin string      `json:"handleAdmin" orm:"handle_admin" description:"处理管理员"`
+	HandledAt   *gtime.Time `json:"handledAt"   orm:"handled_at"   description:"处理时间"`
+	CreatedAt   *gtime.Time `json:"createdAt"   orm:"created_at"   description:""`
+	UpdatedAt   *gtime.Time `json:"updatedAt"   orm:"updated_at"   description:""`
 }
@@ -10,10 +10,17 @@ import (
 
 // SignLog is the golang structure for table sign_log.
 type SignLog struct {
-	Id        uint        `json:"id"        orm:"id"         description:""`
-	SiteId    int         `json:"siteId"    orm:"site_id"    description:"站点ID"`
-	UserId    int         `json:"userId"    orm:"user_id"    description:"会员ID"`
-	SignDate  *gtime.Time `json:"signDate"  orm:"sign_date"  description:"签到日期"`
-	CreatedAt *gtime.Time `json:"createdAt" orm:"created_at" description:""`
-	UpdatedAt *gtime.Time `json:"updatedAt" orm:"updated_at" description:""`
+	Id           uint        `json:"id"           orm:"id"            description:""`
+	SiteId       int         `json:"siteId"       orm:"site_id"       description:"站点ID"`
+	UserId       int         `json:"userId"       orm:"user_id"       description:"会员ID"`
+	SignId       int         `json:"signId"       orm:"sign_id"       description:"签到活动ID"`
+	Username     string      `json:"username"     orm:"username"      description:"会员账号"`
+	Platform     int         `json:"platform"     orm:"platform"      description:"签到终端。1=网站；2=手机"`
+	Streak       int         `json:"streak"       orm:"streak"        description:"连续签到天数"`
+	RewardMoney  float64     `json:"rewardMoney"  orm:"reward_money"  description:"奖励彩金"`
+	RewardPoints int         `json:"rewardPoints" orm:"reward_points" description:"奖励积分"`
+	TradeNo      string      `json:"tradeNo"      orm:"trade_no"      description:"彩金流水号"`
+	SignDate     *gtime.Time `json:"signDate"     orm:"sign_date"     description:"签到日期"`
+	CreatedAt    *gtime.Time `json:"createdAt"    orm:"created_at"    description:""`
+	UpdatedAt    *gtime.Time `json:"updatedAt"    orm:"updated_at"    description:""`
 }
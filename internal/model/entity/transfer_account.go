@@ -27,6 +27,7 @@ type TransferAccount struct {
 	TodayAmount float64     `json:"todayAmount" orm:"today_amount" description:"今日转账总额"`
 	Status      int         `json:"status"      orm:"status"       description:"状态。1=可用；0=禁用"`
 	Sort        int         `json:"sort"        orm:"sort"         description:"排序。值越小排名越靠前"`
+	Weight      int         `json:"weight"      orm:"weight"       description:"权重。按权重轮询时使用，值越大被选中概率越高"`
 	CreatedAt   *gtime.Time `json:"createdAt"   orm:"created_at"   description:""`
 	UpdatedAt   *gtime.Time `json:"updatedAt"   orm:"updated_at"   description:""`
 	Remark      string      `json:"remark"      orm:"remark"       description:""`
@@ -35,6 +35,8 @@ type User struct {
 	IsOnline          int         `json:"isOnline"          orm:"is_online"           description:""`
 	FocusLevel        int         `json:"focusLevel"        orm:"focus_level"         description:"会员关注级别。1=正常；2=可疑；3=危险"`
 	BalanceStatus     uint        `json:"balanceStatus"     orm:"balance_status"      description:"1=0="`
+	Balance           float64     `json:"balance"           orm:"balance"             description:"可用余额"`
+	BalanceFrozen     float64     `json:"balanceFrozen"     orm:"balance_frozen"      description:"冻结余额"`
 	SafeQuestion      string      `json:"safeQuestion"      orm:"safe_question"       description:"密保问题"`
 	SafeAnswer        string      `json:"safeAnswer"        orm:"safe_answer"         description:"密保答案"`
 	ShowBeginnerGuide int         `json:"showBeginnerGuide" orm:"show_beginner_guide" description:"是否显示新手引导。1=显示；0=不显示"`
@@ -42,4 +44,5 @@ type User struct {
 	CreatedAt         *gtime.Time `json:"createdAt"         orm:"created_at"          description:""`
 	UpdatedAt         *gtime.Time `json:"updatedAt"         orm:"updated_at"          description:""`
 	PayTimes          int         `json:"payTimes"          orm:"pay_times"           description:"充值次数"`
+	Points            int         `json:"points"            orm:"points"              description:"积分"`
 }
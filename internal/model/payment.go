@@ -0,0 +1,14 @@
+package model
+
+// PaymentChannel 可用的入款渠道
+type PaymentChannel struct {
+	Type        int     // 渠道类型。1=在线支付；2=转账汇款
+	AccountId   int     // 支付接口ID或转账接口ID
+	Gateway     int     // 支付网关 (在线支付) 或转账类型 (转账汇款)
+	Name        string  // 接口名称
+	EachMin     float64 // 单笔最低
+	EachMax     float64 // 单笔最高。0=不限
+	DailyRemain float64 // 今日剩余额度。-1=不限
+	Sort        int     // 排序。值越小排名越靠前
+	Weight      int     // 权重
+}
@@ -0,0 +1,16 @@
+package model
+
+// RiskEvent 风险预警事件，由转账、投注、修改银行卡、登录和注册等业务触发
+type RiskEvent struct {
+	Type     int // 预警类型
+	SiteId   int
+	UserId   int
+	Username string
+	Ip       string  // 会员IP，为空时使用请求元数据中的客户端IP
+	Device   int     // 终端。1=电脑；2=手机；3=平板
+	Money    float64 // 转账金额，或单日投注、赢得金额
+	Date     string  // 统计日期 (Y-m-d)，单日投注、赢得金额预警使用
+	OldValue string  // 变更前的值，如上次登录IP、原银行卡号 (已脱敏)
+	NewValue string  // 变更后的值
+	Content  string  // 事件说明，不为空时作为预警内容
+}
@@ -0,0 +1,116 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryBroker 进程内消息代理，保留最近的事件用于断线续传
+// 只能推送当前进程发布的事件，多实例部署时需替换为消息队列实现
+type MemoryBroker struct {
+	mu          sync.Mutex
+	lastId      uint64
+	history     []*Event // 最近发布的事件，按事件ID升序
+	replaySize  int
+	bufferSize  int
+	subscribers map[*memorySubscriber]struct{}
+}
+
+// memorySubscriber 进程内订阅者
+type memorySubscriber struct {
+	filter Filter
+	ch     chan *Event
+}
+
+// NewMemoryBroker 创建进程内消息代理
+// replaySize 为保留用于续传的事件数，bufferSize 为每个订阅者最多积压的事件数
+func NewMemoryBroker(replaySize, bufferSize int) *MemoryBroker {
+	if replaySize < 0 {
+		replaySize = 0
+	}
+	if bufferSize <= 0 {
+		bufferSize = 64
+	}
+	return &MemoryBroker{
+		// 事件ID从当前微秒时间戳开始，重启后的事件ID大于重启前，客户端续传时能识别出中断
+		lastId:      uint64(time.Now().UnixMicro()),
+		replaySize:  replaySize,
+		bufferSize:  bufferSize,
+		subscribers: make(map[*memorySubscriber]struct{}),
+	}
+}
+
+// Publish 发布事件。订阅者积压已满时断开该订阅，由订阅者续传，不阻塞发布方
+func (b *MemoryBroker) Publish(ctx context.Context, event *Event) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lastId++
+	event.Id = b.lastId
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+
+	if b.replaySize > 0 {
+		if len(b.history) >= b.replaySize {
+			b.history = append(b.history[:0], b.history[len(b.history)-b.replaySize+1:]...)
+		}
+		b.history = append(b.history, event)
+	}
+
+	for sub := range b.subscribers {
+		if sub.filter != nil && !sub.filter(event) {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			delete(b.subscribers, sub)
+			close(sub.ch)
+		}
+	}
+	return nil
+}
+
+// Subscribe 订阅事件，ctx 结束时自动取消订阅
+func (b *MemoryBroker) Subscribe(ctx context.Context, filter Filter, lastEventId uint64) (*Subscription, error) {
+	sub := &memorySubscriber{
+		filter: filter,
+		ch:     make(chan *Event, b.bufferSize),
+	}
+
+	b.mu.Lock()
+	subscription := &Subscription{C: sub.ch}
+	if lastEventId > 0 && lastEventId != b.lastId {
+		// 续传的事件ID不在缓存范围内：事件已被淘汰、服务已重启或来自其他实例
+		if lastEventId > b.lastId || len(b.history) == 0 || lastEventId < b.history[0].Id-1 {
+			subscription.Gap = true
+		} else {
+			for _, event := range b.history {
+				if event.Id > lastEventId && (filter == nil || filter(event)) {
+					subscription.Replay = append(subscription.Replay, event)
+				}
+			}
+		}
+	}
+	b.subscribers[sub] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	subscription.close = func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			if _, ok := b.subscribers[sub]; ok {
+				delete(b.subscribers, sub)
+				close(sub.ch)
+			}
+		})
+	}
+	go func() {
+		<-ctx.Done()
+		subscription.close()
+	}()
+	return subscription, nil
+}
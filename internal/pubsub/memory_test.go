@@ -0,0 +1,90 @@
+package pubsub
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMemoryBrokerReplay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	b := NewMemoryBroker(3, 8)
+
+	var ids []uint64
+	for i := 0; i < 5; i++ {
+		event := &Event{SiteId: 1, Topic: TopicRisk}
+		if err := b.Publish(ctx, event); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, event.Id)
+	}
+	for i := 1; i < len(ids); i++ {
+		if ids[i] != ids[i-1]+1 {
+			t.Fatalf("事件ID应连续递增: %v", ids)
+		}
+	}
+
+	// 缓存中保留最后3条，从第2条之后续传可以补齐
+	sub, err := b.Subscribe(ctx, nil, ids[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sub.Gap || len(sub.Replay) != 3 || sub.Replay[0].Id != ids[2] {
+		t.Fatalf("续传结果错误: gap=%v replay=%d", sub.Gap, len(sub.Replay))
+	}
+	sub.Close()
+
+	// 第1条之后的事件已被淘汰
+	sub, _ = b.Subscribe(ctx, nil, ids[0])
+	if !sub.Gap {
+		t.Fatal("事件已淘汰时应返回 Gap")
+	}
+	sub.Close()
+
+	// 已是最新事件，无需补发
+	sub, _ = b.Subscribe(ctx, nil, ids[4])
+	if sub.Gap || len(sub.Replay) != 0 {
+		t.Fatalf("已是最新事件: gap=%v replay=%d", sub.Gap, len(sub.Replay))
+	}
+	sub.Close()
+
+	// 来自重启前或其他实例的事件ID
+	sub, _ = b.Subscribe(ctx, nil, ids[4]+100)
+	if !sub.Gap {
+		t.Fatal("未知的事件ID应返回 Gap")
+	}
+	sub.Close()
+}
+
+func TestMemoryBrokerFilterAndSlowSubscriber(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	b := NewMemoryBroker(10, 2)
+
+	sub, _ := b.Subscribe(ctx, func(event *Event) bool { return event.SiteId == 1 }, 0)
+	_ = b.Publish(ctx, &Event{SiteId: 2, Topic: TopicNotice})
+	_ = b.Publish(ctx, &Event{SiteId: 1, Topic: TopicNotice})
+	if event := <-sub.C; event.SiteId != 1 {
+		t.Fatalf("不应收到其他站点的事件: %d", event.SiteId)
+	}
+
+	// 积压超过缓冲后订阅被断开
+	for i := 0; i < 3; i++ {
+		_ = b.Publish(ctx, &Event{SiteId: 1, Topic: TopicNotice})
+	}
+	count := 0
+	for range sub.C {
+		count++
+	}
+	if count != 2 {
+		t.Fatalf("断开前应收到缓冲中的2条事件，实际 %d", count)
+	}
+	sub.Close()
+
+	// ctx 结束后自动取消订阅
+	subCtx, subCancel := context.WithCancel(ctx)
+	sub, _ = b.Subscribe(subCtx, nil, 0)
+	subCancel()
+	for range sub.C {
+	}
+}
@@ -0,0 +1,84 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// 后台实时推送的事件主题
+const (
+	TopicRisk     = "risk"     // 风险预警
+	TopicWithdraw = "withdraw" // 新出款申请
+	TopicDeposit  = "deposit"  // 新入款订单
+	TopicNotice   = "notice"   // 系统公告
+)
+
+// Event 推送事件
+type Event struct {
+	Id        uint64    // 事件ID，递增，用于断线续传
+	SiteId    int       // 站点ID
+	Topic     string    // 事件主题
+	Title     string    // 事件标题，供控制台直接展示
+	Data      []byte    // 事件内容 (JSON)
+	CreatedAt time.Time // 事件时间
+}
+
+// Filter 订阅过滤条件，返回 true 时推送
+type Filter func(event *Event) bool
+
+// Subscription 一个订阅连接
+type Subscription struct {
+	// C 新事件通道。订阅者来不及接收时通道会被关闭，订阅者应使用最后收到的事件ID重新订阅
+	C <-chan *Event
+	// Replay 重新订阅时需要补发的事件，按事件ID升序
+	Replay []*Event
+	// Gap 为 true 表示请求续传的事件已不在缓存中，订阅者需要重新加载完整数据
+	Gap bool
+
+	close func()
+}
+
+// Close 取消订阅
+func (s *Subscription) Close() {
+	if s.close != nil {
+		s.close()
+	}
+}
+
+// Broker 消息代理，默认为进程内实现，多实例部署时可替换为消息队列
+type Broker interface {
+	// Publish 发布事件，事件ID和时间由代理生成
+	Publish(ctx context.Context, event *Event) error
+	// Subscribe 订阅事件。lastEventId 大于0时补发该ID之后的事件
+	Subscribe(ctx context.Context, filter Filter, lastEventId uint64) (*Subscription, error)
+}
+
+var (
+	mu     sync.RWMutex
+	broker Broker = NewMemoryBroker(1000, 64)
+)
+
+// SetBroker 替换默认的消息代理，应在服务启动时调用
+func SetBroker(b Broker) {
+	mu.Lock()
+	defer mu.Unlock()
+	broker = b
+}
+
+// Default 获取当前的消息代理
+func Default() Broker {
+	mu.RLock()
+	defer mu.RUnlock()
+	return broker
+}
+
+// Publish 使用默认消息代理发布事件
+func Publish(ctx context.Context, event *Event) error {
+	return Default().Publish(ctx, event)
+}
+
+// Subscribe 使用默认消息代理订阅事件
+func Subscribe(ctx context.Context, filter Filter, lastEventId uint64) (*Subscription, error) {
+	return Default().Subscribe(ctx, filter, lastEventId)
+}
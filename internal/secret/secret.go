@@ -0,0 +1,307 @@
+package secret
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/gogf/gf/v2/frame/g"
+)
+
+// 密文格式: enc:<主密钥版本>:<被主密钥加密的数据密钥>:<被数据密钥加密的明文>
+// 每个值使用独立的随机数据密钥(DEK)加密，DEK 再由环境变量提供的主密钥(KEK)加密后与密文一起存储。
+// 轮换主密钥时只需用新主密钥重新加密 DEK，数据本身无需重新加密。
+const (
+	cipherPrefix = "enc"
+	dekSize      = 32
+
+	// MaskPrefix 脱敏值前缀，提交回来的值带有该前缀时视为未修改
+	MaskPrefix = "******"
+)
+
+type keyring struct {
+	active string
+	keys   map[string][]byte
+}
+
+var (
+	ring     *keyring
+	ringErr  error
+	ringOnce sync.Once
+)
+
+// 主密钥不写入配置文件，通过环境变量提供:
+//
+//	SECRET_ACTIVE_KEY=v2                        当前用于加密的主密钥版本，未设置时使用配置 secret.activeKey
+//	SECRET_MASTER_KEY_V1=base64编码的32字节密钥  版本号取变量名后缀的小写形式
+//	SECRET_MASTER_KEY_V2=base64编码的32字节密钥
+const (
+	envActiveKey     = "SECRET_ACTIVE_KEY"
+	envMasterKeyPref = "SECRET_MASTER_KEY_"
+)
+
+// loadKeyring 从环境变量加载主密钥，只加载一次
+func loadKeyring(ctx context.Context) (*keyring, error) {
+	ringOnce.Do(func() {
+		active := os.Getenv(envActiveKey)
+		if active == "" {
+			active = g.Cfg().MustGet(ctx, "secret.activeKey", "").String()
+		}
+		ring, ringErr = newKeyring(active, os.Environ())
+	})
+	return ring, ringErr
+}
+
+// newKeyring 从环境变量列表 (KEY=VALUE) 中解析主密钥
+func newKeyring(active string, environ []string) (*keyring, error) {
+	if active == "" {
+		return nil, fmt.Errorf("未配置当前主密钥版本 (%s 或 secret.activeKey)", envActiveKey)
+	}
+
+	kr := &keyring{active: active, keys: make(map[string][]byte)}
+	for _, item := range environ {
+		name, encoded, ok := strings.Cut(item, "=")
+		if !ok || !strings.HasPrefix(name, envMasterKeyPref) {
+			continue
+		}
+		version := strings.ToLower(strings.TrimPrefix(name, envMasterKeyPref))
+		if version == "" {
+			continue
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("主密钥 %s 不是有效的base64: %v", version, err)
+		}
+		if len(key) != 32 {
+			return nil, fmt.Errorf("主密钥 %s 长度必须为32字节", version)
+		}
+		kr.keys[version] = key
+	}
+
+	if len(kr.keys) == 0 {
+		return nil, fmt.Errorf("未配置主密钥，请通过环境变量 %s<版本> 提供", envMasterKeyPref)
+	}
+	if _, ok := kr.keys[active]; !ok {
+		return nil, fmt.Errorf("当前主密钥版本 %s 不存在", active)
+	}
+	return kr, nil
+}
+
+// ActiveKeyVersion 返回当前用于加密的主密钥版本
+func ActiveKeyVersion(ctx context.Context) (string, error) {
+	kr, err := loadKeyring(ctx)
+	if err != nil {
+		return "", err
+	}
+	return kr.active, nil
+}
+
+// IsEncrypted 判断值是否已经是密文
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, cipherPrefix+":")
+}
+
+// IsMasked 判断值是否为脱敏后的展示值
+func IsMasked(value string) bool {
+	return strings.HasPrefix(value, MaskPrefix)
+}
+
+// Encrypt 使用当前主密钥加密明文，空字符串原样返回
+func Encrypt(ctx context.Context, plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+
+	kr, err := loadKeyring(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	dek := make([]byte, dekSize)
+	if _, err = io.ReadFull(rand.Reader, dek); err != nil {
+		return "", fmt.Errorf("生成数据密钥失败: %v", err)
+	}
+
+	data, err := seal(dek, []byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+	wrapped, err := seal(kr.keys[kr.active], dek)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join([]string{
+		cipherPrefix,
+		kr.active,
+		base64.StdEncoding.EncodeToString(wrapped),
+		base64.StdEncoding.EncodeToString(data),
+	}, ":"), nil
+}
+
+// Decrypt 解密密文，未加密的历史明文原样返回
+func Decrypt(ctx context.Context, value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	version, wrapped, data, err := parse(value)
+	if err != nil {
+		return "", err
+	}
+
+	dek, err := unwrap(ctx, version, wrapped)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := open(dek, data)
+	if err != nil {
+		return "", fmt.Errorf("解密数据失败: %v", err)
+	}
+	return string(plaintext), nil
+}
+
+// NeedsRewrap 判断值是否需要迁移：历史明文或使用旧版本主密钥加密
+func NeedsRewrap(ctx context.Context, value string) bool {
+	if value == "" {
+		return false
+	}
+	if !IsEncrypted(value) {
+		return true
+	}
+
+	kr, err := loadKeyring(ctx)
+	if err != nil {
+		return false
+	}
+	version, _, _, err := parse(value)
+	return err == nil && version != kr.active
+}
+
+// Rewrap 将值迁移到当前主密钥
+// 明文会被加密；旧主密钥加密的值只重新加密数据密钥，数据密文保持不变
+func Rewrap(ctx context.Context, value string) (string, error) {
+	if !IsEncrypted(value) {
+		return Encrypt(ctx, value)
+	}
+
+	kr, err := loadKeyring(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	version, wrapped, data, err := parse(value)
+	if err != nil {
+		return "", err
+	}
+	if version == kr.active {
+		return value, nil
+	}
+
+	dek, err := unwrap(ctx, version, wrapped)
+	if err != nil {
+		return "", err
+	}
+	rewrapped, err := seal(kr.keys[kr.active], dek)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join([]string{
+		cipherPrefix,
+		kr.active,
+		base64.StdEncoding.EncodeToString(rewrapped),
+		base64.StdEncoding.EncodeToString(data),
+	}, ":"), nil
+}
+
+// Mask 返回用于展示的脱敏值，只保留明文末4位
+func Mask(ctx context.Context, value string) string {
+	if value == "" {
+		return ""
+	}
+
+	plaintext, err := Decrypt(ctx, value)
+	if err != nil || len(plaintext) <= 4 {
+		return MaskPrefix
+	}
+	return MaskPrefix + plaintext[len(plaintext)-4:]
+}
+
+// parse 拆分密文各部分
+func parse(value string) (version string, wrapped, data []byte, err error) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 4 || parts[0] != cipherPrefix {
+		return "", nil, nil, fmt.Errorf("密文格式错误")
+	}
+	if wrapped, err = base64.StdEncoding.DecodeString(parts[2]); err != nil {
+		return "", nil, nil, fmt.Errorf("密文格式错误: %v", err)
+	}
+	if data, err = base64.StdEncoding.DecodeString(parts[3]); err != nil {
+		return "", nil, nil, fmt.Errorf("密文格式错误: %v", err)
+	}
+	return parts[1], wrapped, data, nil
+}
+
+// unwrap 使用指定版本的主密钥解出数据密钥
+func unwrap(ctx context.Context, version string, wrapped []byte) ([]byte, error) {
+	kr, err := loadKeyring(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	kek, ok := kr.keys[version]
+	if !ok {
+		return nil, fmt.Errorf("主密钥版本 %s 不存在", version)
+	}
+
+	dek, err := open(kek, wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("解密数据密钥失败: %v", err)
+	}
+	return dek, nil
+}
+
+// seal AES-GCM 加密，输出 nonce+密文
+func seal(key, plaintext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// open AES-GCM 解密 nonce+密文
+func open(key, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < gcm.NonceSize() {
+		return nil, fmt.Errorf("密文长度错误")
+	}
+	nonce, ciphertext := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
@@ -0,0 +1,151 @@
+package secret
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+// useKeyring 在测试中直接安装主密钥，跳过环境变量加载
+func useKeyring(t *testing.T, active string, versions ...string) {
+	t.Helper()
+	environ := make([]string, 0, len(versions))
+	for i, version := range versions {
+		key := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{byte(i + 1)}, 32))
+		environ = append(environ, envMasterKeyPref+strings.ToUpper(version)+"="+key)
+	}
+	kr, err := newKeyring(active, environ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ringOnce.Do(func() {})
+	ring, ringErr = kr, nil
+}
+
+func TestNewKeyring(t *testing.T) {
+	valid := base64.StdEncoding.EncodeToString(make([]byte, 32))
+	cases := []struct {
+		name    string
+		active  string
+		environ []string
+		wantErr bool
+	}{
+		{"未配置主密钥", "v1", []string{"PATH=/bin"}, true},
+		{"未配置当前版本", "", []string{envMasterKeyPref + "V1=" + valid}, true},
+		{"当前版本不存在", "v2", []string{envMasterKeyPref + "V1=" + valid}, true},
+		{"密钥长度错误", "v1", []string{envMasterKeyPref + "V1=" + base64.StdEncoding.EncodeToString(make([]byte, 16))}, true},
+		{"密钥不是base64", "v1", []string{envMasterKeyPref + "V1=not-base64!"}, true},
+		{"正常", "v1", []string{envMasterKeyPref + "V1=" + valid}, false},
+	}
+	for _, c := range cases {
+		_, err := newKeyring(c.active, c.environ)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: err = %v, wantErr = %v", c.name, err, c.wantErr)
+		}
+	}
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	ctx := context.Background()
+	useKeyring(t, "v1", "v1")
+
+	ciphertext, err := Encrypt(ctx, "private-key-123456")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsEncrypted(ciphertext) || strings.Contains(ciphertext, "private-key") {
+		t.Fatalf("密文格式错误: %s", ciphertext)
+	}
+	plaintext, err := Decrypt(ctx, ciphertext)
+	if err != nil || plaintext != "private-key-123456" {
+		t.Fatalf("解密结果错误: %q, %v", plaintext, err)
+	}
+
+	// 空值和历史明文原样返回
+	if value, _ := Encrypt(ctx, ""); value != "" {
+		t.Fatalf("空值不应加密: %q", value)
+	}
+	if value, _ := Decrypt(ctx, "legacy"); value != "legacy" {
+		t.Fatalf("明文应原样返回: %q", value)
+	}
+
+	// 篡改密文后解密失败
+	parts := strings.Split(ciphertext, ":")
+	data, _ := base64.StdEncoding.DecodeString(parts[3])
+	data[len(data)-1] ^= 0xff
+	parts[3] = base64.StdEncoding.EncodeToString(data)
+	if _, err = Decrypt(ctx, strings.Join(parts, ":")); err == nil {
+		t.Fatal("篡改后的密文应解密失败")
+	}
+}
+
+func TestRewrap(t *testing.T) {
+	ctx := context.Background()
+	useKeyring(t, "v1", "v1", "v2")
+
+	old, err := Encrypt(ctx, "md5-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if NeedsRewrap(ctx, old) {
+		t.Fatal("当前版本加密的值不需要迁移")
+	}
+
+	// 轮换到 v2
+	useKeyring(t, "v2", "v1", "v2")
+	if !NeedsRewrap(ctx, old) || !NeedsRewrap(ctx, "plain") || NeedsRewrap(ctx, "") {
+		t.Fatal("旧版本密文和明文需要迁移，空值不需要")
+	}
+	rewrapped, err := Rewrap(ctx, old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(rewrapped, cipherPrefix+":v2:") {
+		t.Fatalf("应使用 v2 重新加密: %s", rewrapped)
+	}
+	// 只重新加密数据密钥，数据密文保持不变
+	if strings.Split(rewrapped, ":")[3] != strings.Split(old, ":")[3] {
+		t.Fatal("数据密文不应改变")
+	}
+	if plaintext, err := Decrypt(ctx, rewrapped); err != nil || plaintext != "md5-secret" {
+		t.Fatalf("迁移后解密错误: %q, %v", plaintext, err)
+	}
+
+	encrypted, err := Rewrap(ctx, "plain")
+	if err != nil || !IsEncrypted(encrypted) {
+		t.Fatalf("明文应被加密: %q, %v", encrypted, err)
+	}
+
+	// 旧版本主密钥移除后无法解密未迁移的值
+	useKeyring(t, "v2", "v2")
+	if _, err = Decrypt(ctx, old); err == nil {
+		t.Fatal("缺少旧版本主密钥时应解密失败")
+	}
+}
+
+func TestMask(t *testing.T) {
+	ctx := context.Background()
+	useKeyring(t, "v1", "v1")
+
+	ciphertext, _ := Encrypt(ctx, "6222020200112233")
+	cases := []struct {
+		value string
+		want  string
+	}{
+		{"", ""},
+		{ciphertext, MaskPrefix + "2233"},
+		{"abcd", MaskPrefix},
+		{"plain-text-9876", MaskPrefix + "9876"},
+		{"enc:v9:AAAA:AAAA", MaskPrefix},
+	}
+	for _, c := range cases {
+		if got := Mask(ctx, c.value); got != c.want {
+			t.Errorf("Mask(%q) = %q, want %q", c.value, got, c.want)
+		}
+	}
+	if !IsMasked(Mask(ctx, ciphertext)) {
+		t.Fatal("脱敏值应带有前缀")
+	}
+}
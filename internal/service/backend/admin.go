@@ -24,6 +24,10 @@ type (
 		Logout(ctx context.Context, req *v1.LogoutReq) (*v1.LogoutRes, error)
 		ChangePassword(ctx context.Context, req *v1.ChangePasswordReq) (*v1.ChangePasswordRes, error)
 		GetAdminLogs(ctx context.Context, req *v1.GetAdminLogsReq) (*v1.GetAdminLogsRes, error)
+		CurrentAdmin(ctx context.Context) *entity.Admin
+		WriteLog(ctx context.Context, message string) error
+		Permissions(ctx context.Context, admin *entity.Admin) (map[string]bool, error)
+		HasPermission(ctx context.Context, backendUrl string) bool
 	}
 )
 
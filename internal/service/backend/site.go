@@ -8,12 +8,22 @@ package backend
 import (
 	"context"
 	"jh_app_service/api/backend/site/v1"
+	"jh_app_service/internal/model/entity"
 )
 
 type (
 	ISite interface {
 		GetBasicSetting(ctx context.Context, req *v1.GetBasicSettingReq) (*v1.GetBasicSettingRes, error)
 		UpdateBasicSetting(ctx context.Context, req *v1.UpdateBasicSettingReq) (*v1.UpdateBasicSettingRes, error)
+		GetRegisterFields(ctx context.Context, req *v1.GetRegisterFieldsReq) (*v1.GetRegisterFieldsRes, error)
+		UpdateRegisterFields(ctx context.Context, req *v1.UpdateRegisterFieldsReq) (*v1.UpdateRegisterFieldsRes, error)
+		RegisterFields(ctx context.Context, siteId, fieldType int) ([]*entity.SiteRegister, error)
+		CheckAccess(ctx context.Context, siteId int, ip string) error
+		CheckSiteAccess(ctx context.Context, req *v1.CheckSiteAccessReq) (*v1.CheckSiteAccessRes, error)
+		GetSiteDenies(ctx context.Context, req *v1.GetSiteDeniesReq) (*v1.GetSiteDeniesRes, error)
+		CreateSiteDeny(ctx context.Context, req *v1.CreateSiteDenyReq) (*v1.CreateSiteDenyRes, error)
+		UpdateSiteDeny(ctx context.Context, req *v1.UpdateSiteDenyReq) (*v1.UpdateSiteDenyRes, error)
+		DeleteSiteDeny(ctx context.Context, req *v1.DeleteSiteDenyReq) (*v1.DeleteSiteDenyRes, error)
 	}
 )
 
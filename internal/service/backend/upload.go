@@ -8,6 +8,7 @@ import (
 type (
 	IUpload interface {
 		UploadImage(ctx context.Context, req *v1.UploadImageReq) (*v1.UploadImageRes, error)
+		ValidateImageUrl(ctx context.Context, imageUrl, uploadCode string) error
 	}
 )
 
@@ -9,15 +9,61 @@ type (
 	IUser interface {
 		GetUserList(ctx context.Context, req *v1.GetUserListReq) (*v1.GetUserListRes, error)
 		UpdateUser(ctx context.Context, req *v1.UpdateUserReq) (*v1.UpdateUserRes, error)
+		BatchUpdateUsers(ctx context.Context, req *v1.BatchUpdateUsersReq) (*v1.BatchUpdateUsersRes, error)
 		GetUserBasicInfo(ctx context.Context, req *v1.GetUserBasicInfoReq) (*v1.GetUserBasicInfoRes, error)
+		Register(ctx context.Context, req *v1.RegisterReq) (*v1.RegisterRes, error)
+		Login(ctx context.Context, req *v1.LoginReq) (*v1.LoginRes, error)
 
 		// UserGrade相关方法
 		GetUserGrades(ctx context.Context, req *v1.GetUserGradesReq) (*v1.GetUserGradesRes, error)
 		SaveUserGrades(ctx context.Context, req *v1.SaveUserGradesReq) (*v1.SaveUserGradesRes, error)
 		DeleteUserGrades(ctx context.Context, req *v1.DeleteUserGradesReq) (*v1.DeleteUserGradesRes, error)
+		PreviewGradeUpgrades(ctx context.Context, req *v1.PreviewGradeUpgradesReq) (*v1.PreviewGradeUpgradesRes, error)
+		RunGradeUpgrades(ctx context.Context, req *v1.RunGradeUpgradesReq) (*v1.RunGradeUpgradesRes, error)
+		GetUserGradeLogs(ctx context.Context, req *v1.GetUserGradeLogsReq) (*v1.GetUserGradeLogsRes, error)
+		UpgradeGrades(ctx context.Context) error
+		UpgradeUserGrade(ctx context.Context, siteId, userId int) error
+		RunBirthdayBonuses(ctx context.Context, req *v1.RunBirthdayBonusesReq) (*v1.RunBirthdayBonusesRes, error)
+		GetBirthdayBonuses(ctx context.Context, req *v1.GetBirthdayBonusesReq) (*v1.GetBirthdayBonusesRes, error)
+		PayBirthdayBonuses(ctx context.Context) error
+
+		// 会员积分相关方法
+		GetPointsSetting(ctx context.Context, req *v1.GetPointsSettingReq) (*v1.GetPointsSettingRes, error)
+		UpdatePointsSetting(ctx context.Context, req *v1.UpdatePointsSettingReq) (*v1.UpdatePointsSettingRes, error)
+		GetUserPointsLogs(ctx context.Context, req *v1.GetUserPointsLogsReq) (*v1.GetUserPointsLogsRes, error)
+		AdjustUserPoints(ctx context.Context, req *v1.AdjustUserPointsReq) (*v1.AdjustUserPointsRes, error)
+		RunBettingPoints(ctx context.Context, req *v1.RunBettingPointsReq) (*v1.RunBettingPointsRes, error)
+		AccrueBettingPoints(ctx context.Context) error
+		AccrueRechargePoints(ctx context.Context, siteId, userId int, money float64, tradeNo string) error
+
+		// 签到活动相关方法
+		GetSignCampaigns(ctx context.Context, req *v1.GetSignCampaignsReq) (*v1.GetSignCampaignsRes, error)
+		CreateSignCampaign(ctx context.Context, req *v1.CreateSignCampaignReq) (*v1.CreateSignCampaignRes, error)
+		UpdateSignCampaign(ctx context.Context, req *v1.UpdateSignCampaignReq) (*v1.UpdateSignCampaignRes, error)
+		DeleteSignCampaign(ctx context.Context, req *v1.DeleteSignCampaignReq) (*v1.DeleteSignCampaignRes, error)
+		GetSignLogs(ctx context.Context, req *v1.GetSignLogsReq) (*v1.GetSignLogsRes, error)
+		GetUserSignStatus(ctx context.Context, req *v1.GetUserSignStatusReq) (*v1.GetUserSignStatusRes, error)
+		UserSign(ctx context.Context, req *v1.UserSignReq) (*v1.UserSignRes, error)
+
+		// UserLevel相关方法
+		GetUserLevels(ctx context.Context, req *v1.GetUserLevelsReq) (*v1.GetUserLevelsRes, error)
+		CreateUserLevel(ctx context.Context, req *v1.CreateUserLevelReq) (*v1.CreateUserLevelRes, error)
+		UpdateUserLevel(ctx context.Context, req *v1.UpdateUserLevelReq) (*v1.UpdateUserLevelRes, error)
+		DeleteUserLevel(ctx context.Context, req *v1.DeleteUserLevelReq) (*v1.DeleteUserLevelRes, error)
 
 		// UserLoginLog相关方法
 		GetUserLoginLogs(ctx context.Context, req *v1.GetUserLoginLogsReq) (*v1.GetUserLoginLogsRes, error)
+
+		// UserBank相关方法
+		GetUserBanks(ctx context.Context, req *v1.GetUserBanksReq) (*v1.GetUserBanksRes, error)
+		CreateUserBank(ctx context.Context, req *v1.CreateUserBankReq) (*v1.CreateUserBankRes, error)
+		UpdateUserBank(ctx context.Context, req *v1.UpdateUserBankReq) (*v1.UpdateUserBankRes, error)
+		SetDefaultUserBank(ctx context.Context, req *v1.SetDefaultUserBankReq) (*v1.SetDefaultUserBankRes, error)
+		DeleteUserBank(ctx context.Context, req *v1.DeleteUserBankReq) (*v1.DeleteUserBankRes, error)
+		GetUserBankLogs(ctx context.Context, req *v1.GetUserBankLogsReq) (*v1.GetUserBankLogsRes, error)
+
+		// 会员导出
+		ExportUserList(req *v1.ExportUserListReq, stream v1.User_ExportUserListServer) error
 	}
 )
 
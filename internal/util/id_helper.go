@@ -0,0 +1,41 @@
+package util
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SplitIds 解析以,隔开的ID列表，忽略无效和非正数的ID
+func SplitIds(list string) []int32 {
+	ids := make([]int32, 0)
+	for _, value := range strings.Split(list, ",") {
+		if id, err := strconv.Atoi(strings.TrimSpace(value)); err == nil && id > 0 {
+			ids = append(ids, int32(id))
+		}
+	}
+	return ids
+}
+
+// JoinIds 将ID列表拼接为以,隔开的字符串
+func JoinIds(ids []int32) string {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, strconv.Itoa(int(id)))
+	}
+	return strings.Join(values, ",")
+}
+
+// UniqueIds 去重并升序排列
+func UniqueIds(ids []int32) []int32 {
+	seen := make(map[int32]bool, len(ids))
+	result := make([]int32, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
@@ -9,3 +9,411 @@ CREATE TABLE `user` (
     PRIMARY KEY (`id`),
     UNIQUE KEY `uniq_passport` (`passport`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 支付接口密钥加密存储 (信封加密后密文长度大于明文)
+ALTER TABLE `payment_account`
+    MODIFY `md5_key` text COMMENT 'MD5密钥',
+    MODIFY `public_key` text COMMENT '公钥',
+    MODIFY `private_key` text COMMENT '私钥';
+
+ALTER TABLE `payment_account_copy`
+    MODIFY `md5_key` text COMMENT 'MD5密钥',
+    MODIFY `public_key` text COMMENT '公钥',
+    MODIFY `private_key` text COMMENT '私钥';
+
+-- 入款渠道按权重轮询
+ALTER TABLE `payment_account`
+    ADD `weight` int NOT NULL DEFAULT '0' COMMENT '权重。按权重轮询时使用，值越大被选中概率越高' AFTER `sort`;
+
+ALTER TABLE `transfer_account`
+    ADD `weight` int NOT NULL DEFAULT '0' COMMENT '权重。按权重轮询时使用，值越大被选中概率越高' AFTER `sort`;
+
+-- 会员余额
+ALTER TABLE `user`
+    ADD `balance` decimal(14,2) NOT NULL DEFAULT '0.00' COMMENT '可用余额' AFTER `balance_status`,
+    ADD `balance_frozen` decimal(14,2) NOT NULL DEFAULT '0.00' COMMENT '冻结余额' AFTER `balance`;
+
+-- 账变记录
+CREATE TABLE `balance_change` (
+    `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+    `site_id` int NOT NULL DEFAULT '0' COMMENT '站点ID',
+    `user_id` int NOT NULL DEFAULT '0' COMMENT '会员ID',
+    `username` varchar(64) NOT NULL DEFAULT '' COMMENT '会员账号',
+    `change_type` tinyint NOT NULL DEFAULT '1' COMMENT '账变类型。1=入款；2=出款',
+    `trade_type` int NOT NULL DEFAULT '0' COMMENT '交易类型',
+    `trade_no` varchar(64) NOT NULL DEFAULT '' COMMENT '流水号。同一站点唯一，用于幂等',
+    `balance_old` decimal(14,2) NOT NULL DEFAULT '0.00' COMMENT '变动前余额',
+    `money` decimal(14,2) NOT NULL DEFAULT '0.00' COMMENT '变动金额',
+    `balance_new` decimal(14,2) NOT NULL DEFAULT '0.00' COMMENT '变动后余额',
+    `balance_frozen` decimal(14,2) NOT NULL DEFAULT '0.00' COMMENT '冻结余额',
+    `status` tinyint NOT NULL DEFAULT '1' COMMENT '状态。1=成功；0=失败',
+    `admin_id` int NOT NULL DEFAULT '0' COMMENT '操作管理员ID。0=系统',
+    `remark` varchar(255) NOT NULL DEFAULT '' COMMENT '备注',
+    `created_at` datetime DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uniq_site_trade_no` (`site_id`, `trade_no`),
+    KEY `idx_user` (`site_id`, `user_id`, `created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='账变记录';
+
+-- 转账入款订单
+CREATE TABLE `recharge_manual` (
+    `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+    `site_id` int NOT NULL DEFAULT '0' COMMENT '站点ID',
+    `user_id` int NOT NULL DEFAULT '0' COMMENT '会员ID',
+    `username` varchar(64) NOT NULL DEFAULT '' COMMENT '会员账号',
+    `trade_no` varchar(64) NOT NULL DEFAULT '' COMMENT '订单号',
+    `transfer_account_id` int NOT NULL DEFAULT '0' COMMENT '收款转账接口ID',
+    `payer_name` varchar(64) NOT NULL DEFAULT '' COMMENT '存款人姓名',
+    `money` decimal(14,2) NOT NULL DEFAULT '0.00' COMMENT '存款金额',
+    `deposit_time` datetime DEFAULT NULL COMMENT '会员填写的存款时间',
+    `status` tinyint NOT NULL DEFAULT '1' COMMENT '状态。1=待确认；2=已确认；3=已取消',
+    `batch_id` int NOT NULL DEFAULT '0' COMMENT '自动匹配的对账批次ID',
+    `admin_id` int NOT NULL DEFAULT '0' COMMENT '确认管理员ID。0=系统自动匹配',
+    `admin_name` varchar(64) NOT NULL DEFAULT '' COMMENT '确认管理员账号',
+    `remark` varchar(255) NOT NULL DEFAULT '' COMMENT '备注',
+    `confirmed_at` datetime DEFAULT NULL COMMENT '确认时间',
+    `created_at` datetime DEFAULT NULL,
+    `updated_at` datetime DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uniq_site_trade_no` (`site_id`, `trade_no`),
+    KEY `idx_status_money` (`site_id`, `status`, `money`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='转账入款订单';
+
+-- 银行流水对账批次
+CREATE TABLE `bank_statement_batch` (
+    `id` int unsigned NOT NULL AUTO_INCREMENT,
+    `site_id` int NOT NULL DEFAULT '0' COMMENT '站点ID',
+    `transfer_account_id` int NOT NULL DEFAULT '0' COMMENT '收款转账接口ID。0=不限',
+    `file_name` varchar(255) NOT NULL DEFAULT '' COMMENT '导入文件名',
+    `file_hash` char(64) NOT NULL DEFAULT '' COMMENT '文件SHA256，用于防止重复导入',
+    `match_window` int NOT NULL DEFAULT '30' COMMENT '匹配时间窗口(分钟)',
+    `total_rows` int NOT NULL DEFAULT '0' COMMENT '总行数',
+    `matched_rows` int NOT NULL DEFAULT '0' COMMENT '自动匹配行数',
+    `review_rows` int NOT NULL DEFAULT '0' COMMENT '待复核行数',
+    `unmatched_rows` int NOT NULL DEFAULT '0' COMMENT '未匹配行数',
+    `invalid_rows` int NOT NULL DEFAULT '0' COMMENT '无效行数',
+    `admin_id` int NOT NULL DEFAULT '0' COMMENT '导入管理员ID',
+    `admin_name` varchar(64) NOT NULL DEFAULT '' COMMENT '导入管理员账号',
+    `created_at` datetime DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uniq_site_file_hash` (`site_id`, `file_hash`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='银行流水对账批次';
+
+-- 银行流水对账明细 (status=2 为待复核队列)
+CREATE TABLE `bank_statement_item` (
+    `id` int unsigned NOT NULL AUTO_INCREMENT,
+    `site_id` int NOT NULL DEFAULT '0' COMMENT '站点ID',
+    `batch_id` int NOT NULL DEFAULT '0' COMMENT '对账批次ID',
+    `row_no` int NOT NULL DEFAULT '0' COMMENT '文件行号',
+    `trade_time` datetime DEFAULT NULL COMMENT '交易时间',
+    `amount` decimal(14,2) NOT NULL DEFAULT '0.00' COMMENT '交易金额',
+    `payer_name` varchar(64) NOT NULL DEFAULT '' COMMENT '付款人',
+    `bank_ref` varchar(64) NOT NULL DEFAULT '' COMMENT '银行流水号',
+    `raw` varchar(1024) NOT NULL DEFAULT '' COMMENT '原始行内容',
+    `status` tinyint NOT NULL DEFAULT '0' COMMENT '状态。1=已匹配；2=待复核；3=未匹配；4=无效；5=复核已确认；6=复核已忽略',
+    `recharge_manual_id` int NOT NULL DEFAULT '0' COMMENT '匹配的入款订单ID',
+    `candidate_ids` varchar(255) NOT NULL DEFAULT '' COMMENT '候选入款订单ID，逗号分隔',
+    `reason` varchar(255) NOT NULL DEFAULT '' COMMENT '待复核或未匹配原因',
+    `reviewed_by` varchar(64) NOT NULL DEFAULT '' COMMENT '复核管理员账号',
+    `reviewed_at` datetime DEFAULT NULL COMMENT '复核时间',
+    `created_at` datetime DEFAULT NULL,
+    `updated_at` datetime DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    KEY `idx_batch` (`batch_id`, `row_no`),
+    KEY `idx_status` (`site_id`, `status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='银行流水对账明细';
+
+-- 游戏转账订单
+CREATE TABLE `game_transfer` (
+    `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+    `site_id` int NOT NULL DEFAULT '0' COMMENT '站点ID',
+    `user_id` int NOT NULL DEFAULT '0' COMMENT '会员ID',
+    `username` varchar(64) NOT NULL DEFAULT '' COMMENT '会员账号',
+    `game_id` int NOT NULL DEFAULT '0' COMMENT '游戏ID',
+    `order_no` varchar(64) NOT NULL DEFAULT '' COMMENT '本站订单号',
+    `vendor_order_no` varchar(64) NOT NULL DEFAULT '' COMMENT '厂商订单号',
+    `direction` tinyint NOT NULL DEFAULT '1' COMMENT '转账方向。1=账户转入游戏；2=游戏转出到账户',
+    `money` decimal(14,2) NOT NULL DEFAULT '0.00' COMMENT '转账金额',
+    `status` tinyint NOT NULL DEFAULT '0' COMMENT '状态。0=处理中；1=成功；2=失败；3=待对账',
+    `message` varchar(255) NOT NULL DEFAULT '' COMMENT '厂商返回信息',
+    `reconcile_times` int NOT NULL DEFAULT '0' COMMENT '对账次数',
+    `created_at` datetime DEFAULT NULL,
+    `updated_at` datetime DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uniq_order_no` (`order_no`),
+    KEY `idx_status` (`status`, `created_at`),
+    KEY `idx_user` (`site_id`, `user_id`, `created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='游戏转账订单';
+
+-- 在线支付订单
+CREATE TABLE `recharge_payment` (
+    `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+    `site_id` int NOT NULL DEFAULT '0' COMMENT '站点ID',
+    `user_id` int NOT NULL DEFAULT '0' COMMENT '会员ID',
+    `username` varchar(64) NOT NULL DEFAULT '' COMMENT '会员账号',
+    `activity_recharge_id` int NOT NULL DEFAULT '0' COMMENT '充值活动ID',
+    `gateway` int NOT NULL DEFAULT '0' COMMENT '支付网关',
+    `payment_id` int NOT NULL DEFAULT '0' COMMENT '第三方支付ID',
+    `payment_account_id` int NOT NULL DEFAULT '0' COMMENT '支付接口ID',
+    `bank_value` varchar(32) NOT NULL DEFAULT '' COMMENT '银行代码',
+    `trade_no` varchar(64) NOT NULL DEFAULT '' COMMENT '订单号',
+    `money` decimal(14,2) NOT NULL DEFAULT '0.00' COMMENT '充值金额',
+    `fee` decimal(14,2) NOT NULL DEFAULT '0.00' COMMENT '手续费',
+    `status` tinyint NOT NULL DEFAULT '1' COMMENT '状态。1=待支付；2=已支付；3=已失败',
+    `admin_id` int NOT NULL DEFAULT '0' COMMENT '补单管理员ID',
+    `admin_name` varchar(64) NOT NULL DEFAULT '' COMMENT '补单管理员账号',
+    `remark` varchar(255) NOT NULL DEFAULT '' COMMENT '备注',
+    `paid_at` datetime DEFAULT NULL COMMENT '支付成功时间',
+    `created_at` datetime DEFAULT NULL,
+    `updated_at` datetime DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uniq_trade_no` (`site_id`, `trade_no`),
+    KEY `idx_paid` (`site_id`, `status`, `paid_at`),
+    KEY `idx_user` (`site_id`, `user_id`, `created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='在线支付订单';
+
+-- 入款渠道每日统计，每日零点清零今日计数前保存
+CREATE TABLE `payment_channel_daily` (
+    `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+    `site_id` int NOT NULL DEFAULT '0' COMMENT '站点ID',
+    `channel_type` tinyint NOT NULL DEFAULT '1' COMMENT '渠道类型。1=在线支付；2=转账汇款',
+    `account_id` int NOT NULL DEFAULT '0' COMMENT '支付接口ID或转账接口ID',
+    `stat_date` date NOT NULL COMMENT '统计日期',
+    `deposit_count` int NOT NULL DEFAULT '0' COMMENT '当日入款次数',
+    `deposit_amount` decimal(14,2) NOT NULL DEFAULT '0.00' COMMENT '当日入款金额',
+    `created_at` datetime DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uniq_channel_date` (`site_id`, `channel_type`, `account_id`, `stat_date`),
+    KEY `idx_stat_date` (`site_id`, `stat_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='入款渠道每日统计';
+
+-- 入款日对账差异
+CREATE TABLE `payment_reconcile` (
+    `id` int unsigned NOT NULL AUTO_INCREMENT,
+    `site_id` int NOT NULL DEFAULT '0' COMMENT '站点ID',
+    `stat_date` date NOT NULL COMMENT '对账日期',
+    `channel_type` tinyint NOT NULL DEFAULT '1' COMMENT '渠道类型。1=在线支付；2=转账汇款',
+    `account_id` int NOT NULL DEFAULT '0' COMMENT '支付接口ID或转账接口ID',
+    `account_name` varchar(64) NOT NULL DEFAULT '' COMMENT '接口名称',
+    `counter_count` int NOT NULL DEFAULT '0' COMMENT '渠道统计入款次数',
+    `counter_amount` decimal(14,2) NOT NULL DEFAULT '0.00' COMMENT '渠道统计入款金额',
+    `order_count` int NOT NULL DEFAULT '0' COMMENT '已确认订单笔数',
+    `order_amount` decimal(14,2) NOT NULL DEFAULT '0.00' COMMENT '已确认订单金额',
+    `ledger_count` int NOT NULL DEFAULT '0' COMMENT '账变入款笔数',
+    `ledger_amount` decimal(14,2) NOT NULL DEFAULT '0.00' COMMENT '账变入款金额',
+    `status` tinyint NOT NULL DEFAULT '1' COMMENT '状态。1=待处理；2=已处理；3=已忽略',
+    `resolved_by` varchar(64) NOT NULL DEFAULT '' COMMENT '处理管理员账号',
+    `resolved_at` datetime DEFAULT NULL COMMENT '处理时间',
+    `resolve_remark` varchar(255) NOT NULL DEFAULT '' COMMENT '处理说明',
+    `created_at` datetime DEFAULT NULL,
+    `updated_at` datetime DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uniq_channel_date` (`site_id`, `stat_date`, `channel_type`, `account_id`),
+    KEY `idx_status` (`site_id`, `status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='入款日对账差异';
+
+-- 支付接口熔断
+ALTER TABLE `payment_account`
+    ADD `circuit_state` tinyint NOT NULL DEFAULT '0' COMMENT '熔断状态。0=正常；1=已熔断；2=半开探测' AFTER `weight`,
+    ADD `circuit_opened_at` datetime DEFAULT NULL COMMENT '熔断或开始探测时间' AFTER `circuit_state`,
+    ADD `circuit_reason` varchar(255) NOT NULL DEFAULT '' COMMENT '熔断原因' AFTER `circuit_opened_at`;
+
+-- 管理员日志记录IP所在地区
+ALTER TABLE `admin_log` ADD `address` varchar(128) NOT NULL DEFAULT '' COMMENT 'IP所在地区' AFTER `ip`;
+
+-- 风险预警类型和处理记录
+ALTER TABLE `risk_forewarn_log`
+    ADD `type` tinyint NOT NULL DEFAULT '0' COMMENT '预警类型。1=转入游戏；2=游戏转出；3=赢得金额；4=投注金额；5=修改银行卡；6=登录地区变化；7=同IP注册' AFTER `user_id`,
+    ADD `handle_admin` varchar(64) NOT NULL DEFAULT '' COMMENT '处理管理员' AFTER `status`,
+    ADD `handled_at` datetime DEFAULT NULL COMMENT '处理时间' AFTER `handle_admin`,
+    ADD INDEX `idx_site_status` (`site_id`, `status`);
+
+-- 多账号关联审核
+CREATE TABLE `risk_account_cluster` (
+    `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+    `site_id` int NOT NULL DEFAULT '0' COMMENT '站点ID',
+    `type` tinyint NOT NULL DEFAULT '0' COMMENT '关联类型。1=注册IP；2=登录IP；3=设备指纹',
+    `cluster_key` varchar(255) NOT NULL DEFAULT '' COMMENT '关联值，IP或设备指纹',
+    `member_count` int NOT NULL DEFAULT '0' COMMENT '关联会员数',
+    `user_ids` mediumtext COMMENT '关联会员ID，以,隔开，保存全部关联会员',
+    `status` tinyint NOT NULL DEFAULT '1' COMMENT '审核状态。1=待审核；2=已确认；3=已忽略',
+    `review_admin` varchar(64) NOT NULL DEFAULT '' COMMENT '审核管理员',
+    `reviewed_at` datetime DEFAULT NULL COMMENT '审核时间',
+    `remark` varchar(255) NOT NULL DEFAULT '' COMMENT '审核备注',
+    `created_at` datetime DEFAULT NULL,
+    `updated_at` datetime DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_site_type_key` (`site_id`, `type`, `cluster_key`),
+    KEY `idx_site_status` (`site_id`, `status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='多账号关联审核';
+
+-- 会员银行卡号加密存储
+ALTER TABLE `user_bank`
+    MODIFY `card_no` varchar(512) NOT NULL DEFAULT '' COMMENT '银行卡号 (加密)';
+
+-- 会员银行卡变更记录
+CREATE TABLE `user_bank_log` (
+    `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+    `site_id` int NOT NULL DEFAULT '0' COMMENT '站点ID',
+    `user_id` int NOT NULL DEFAULT '0' COMMENT '会员ID',
+    `bank_id` int NOT NULL DEFAULT '0' COMMENT '银行卡ID',
+    `action` tinyint NOT NULL DEFAULT '0' COMMENT '操作。1=添加；2=修改；3=设为默认；4=删除',
+    `old_card_no` varchar(512) NOT NULL DEFAULT '' COMMENT '修改前卡号 (加密)',
+    `new_card_no` varchar(512) NOT NULL DEFAULT '' COMMENT '修改后卡号 (加密)',
+    `content` varchar(512) NOT NULL DEFAULT '' COMMENT '变更内容',
+    `admin_id` int NOT NULL DEFAULT '0' COMMENT '操作管理员ID',
+    `admin_name` varchar(64) NOT NULL DEFAULT '' COMMENT '操作管理员',
+    `ip` varchar(64) NOT NULL DEFAULT '' COMMENT '操作IP',
+    `created_at` datetime DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    KEY `idx_site_user` (`site_id`, `user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='会员银行卡变更记录';
+
+-- 会员积分
+ALTER TABLE `user`
+    ADD `points` int NOT NULL DEFAULT '0' COMMENT '积分' AFTER `pay_times`;
+
+-- 会员等级变更记录
+CREATE TABLE `user_grade_log` (
+    `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+    `site_id` int NOT NULL DEFAULT '0' COMMENT '站点ID',
+    `user_id` int NOT NULL DEFAULT '0' COMMENT '会员ID',
+    `username` varchar(64) NOT NULL DEFAULT '' COMMENT '会员账号',
+    `from_grade_id` int NOT NULL DEFAULT '0' COMMENT '原等级ID',
+    `to_grade_id` int NOT NULL DEFAULT '0' COMMENT '新等级ID',
+    `points` int NOT NULL DEFAULT '0' COMMENT '升级时积分',
+    `bonus` decimal(14,2) NOT NULL DEFAULT '0.00' COMMENT '升级彩金',
+    `bonus_status` tinyint NOT NULL DEFAULT '0' COMMENT '彩金状态。0=无彩金；1=已发放；2=未开启自动发放；3=此前已发放',
+    `trade_no` varchar(64) NOT NULL DEFAULT '' COMMENT '彩金流水号',
+    `remark` varchar(255) NOT NULL DEFAULT '' COMMENT '备注',
+    `created_at` datetime DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    KEY `idx_site_user` (`site_id`, `user_id`),
+    KEY `idx_site_created` (`site_id`, `created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='会员等级变更记录';
+
+-- 生日彩金发放记录，每个会员每年一条
+CREATE TABLE `user_birthday_bonus` (
+    `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+    `site_id` int NOT NULL DEFAULT '0' COMMENT '站点ID',
+    `user_id` int NOT NULL DEFAULT '0' COMMENT '会员ID',
+    `username` varchar(64) NOT NULL DEFAULT '' COMMENT '会员账号',
+    `grade_id` int NOT NULL DEFAULT '0' COMMENT '发放时等级ID',
+    `year` int NOT NULL DEFAULT '0' COMMENT '发放年份',
+    `money` decimal(14,2) NOT NULL DEFAULT '0.00' COMMENT '彩金金额',
+    `trade_no` varchar(64) NOT NULL DEFAULT '' COMMENT '彩金流水号',
+    `created_at` datetime DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_site_user_year` (`site_id`, `user_id`, `year`),
+    KEY `idx_site_created` (`site_id`, `created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='生日彩金发放记录';
+
+-- 会员积分记录
+CREATE TABLE `user_points_log` (
+    `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+    `site_id` int NOT NULL DEFAULT '0' COMMENT '站点ID',
+    `user_id` int NOT NULL DEFAULT '0' COMMENT '会员ID',
+    `username` varchar(64) NOT NULL DEFAULT '' COMMENT '会员账号',
+    `source` tinyint NOT NULL DEFAULT '0' COMMENT '积分来源。1=充值；2=投注；3=后台调整',
+    `change_type` tinyint NOT NULL DEFAULT '0' COMMENT '变动类型。1=增加；2=扣除',
+    `points` int NOT NULL DEFAULT '0' COMMENT '变动积分',
+    `points_old` int NOT NULL DEFAULT '0' COMMENT '变动前积分',
+    `points_new` int NOT NULL DEFAULT '0' COMMENT '变动后积分',
+    `biz_no` varchar(64) NOT NULL DEFAULT '' COMMENT '业务单号，同一站点唯一',
+    `points_date` date DEFAULT NULL COMMENT '积分归属日期，用于每日上限',
+    `admin_id` int NOT NULL DEFAULT '0' COMMENT '操作管理员ID',
+    `remark` varchar(255) NOT NULL DEFAULT '' COMMENT '备注',
+    `created_at` datetime DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_site_biz_no` (`site_id`, `biz_no`),
+    KEY `idx_user_date` (`user_id`, `points_date`),
+    KEY `idx_site_created` (`site_id`, `created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='会员积分记录';
+
+-- 签到活动：签到记录增加活动、连续天数和奖励，每个会员每天只能签到一次
+-- 加唯一索引前先清理同一会员同一天的重复签到记录，保留最早的一条
+DELETE l1 FROM `sign_log` l1
+    INNER JOIN `sign_log` l2
+        ON l1.`site_id` = l2.`site_id` AND l1.`user_id` = l2.`user_id` AND l1.`sign_date` = l2.`sign_date` AND l1.`id` > l2.`id`;
+
+ALTER TABLE `sign_log`
+    ADD `sign_id` int NOT NULL DEFAULT '0' COMMENT '签到活动ID' AFTER `user_id`,
+    ADD `username` varchar(64) NOT NULL DEFAULT '' COMMENT '会员账号' AFTER `sign_id`,
+    ADD `platform` tinyint NOT NULL DEFAULT '0' COMMENT '签到终端。1=网站；2=手机' AFTER `username`,
+    ADD `streak` int NOT NULL DEFAULT '0' COMMENT '连续签到天数' AFTER `platform`,
+    ADD `reward_money` decimal(14,2) NOT NULL DEFAULT '0.00' COMMENT '奖励彩金' AFTER `streak`,
+    ADD `reward_points` int NOT NULL DEFAULT '0' COMMENT '奖励积分' AFTER `reward_money`,
+    ADD `trade_no` varchar(64) NOT NULL DEFAULT '' COMMENT '彩金流水号' AFTER `reward_points`,
+    ADD UNIQUE KEY `uk_site_user_date` (`site_id`, `user_id`, `sign_date`),
+    ADD KEY `idx_sign_date` (`sign_id`, `sign_date`);
+
+ALTER TABLE `user_points_log`
+    MODIFY `source` tinyint NOT NULL DEFAULT '0' COMMENT '积分来源。1=充值；2=投注；3=后台调整；4=签到奖励';
+
+-- 签到活动连续签到奖励
+CREATE TABLE `site_sign_reward` (
+    `id` int unsigned NOT NULL AUTO_INCREMENT,
+    `site_id` int NOT NULL DEFAULT '0' COMMENT '站点ID',
+    `sign_id` int NOT NULL DEFAULT '0' COMMENT '签到活动ID',
+    `days` int NOT NULL DEFAULT '0' COMMENT '连续签到天数',
+    `money` decimal(14,2) NOT NULL DEFAULT '0.00' COMMENT '奖励彩金',
+    `points` int NOT NULL DEFAULT '0' COMMENT '奖励积分',
+    `created_at` datetime DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_sign_days` (`sign_id`, `days`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='签到活动连续签到奖励';
+
+-- 返水审核：每个站点每天一条汇总，审核通过后发放
+ALTER TABLE `rebate_history`
+    ADD `status` tinyint NOT NULL DEFAULT '0' COMMENT '状态。0=待审核；1=已发放；2=已驳回' AFTER `money`,
+    ADD `admin_id` int NOT NULL DEFAULT '0' COMMENT '审核管理员ID' AFTER `status`,
+    ADD `approved_at` datetime DEFAULT NULL COMMENT '审核时间' AFTER `admin_id`,
+    ADD `remark` varchar(255) NOT NULL DEFAULT '' COMMENT '审核备注' AFTER `approved_at`,
+    ADD UNIQUE KEY `uk_site_date` (`site_id`, `rebate_date`);
+
+-- 已有的返水记录都是已发放的，不能进入待审核
+UPDATE `rebate_history` SET `status` = 1;
+
+-- 会员每日各游戏返水明细
+CREATE TABLE `rebate_detail` (
+    `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+    `site_id` int NOT NULL DEFAULT '0' COMMENT '站点ID',
+    `rebate_date` date DEFAULT NULL COMMENT '返水日期',
+    `user_id` int NOT NULL DEFAULT '0' COMMENT '会员ID',
+    `username` varchar(64) NOT NULL DEFAULT '' COMMENT '会员账号',
+    `level_id` int NOT NULL DEFAULT '0' COMMENT '会员层级ID',
+    `grade_id` int NOT NULL DEFAULT '0' COMMENT '会员等级ID',
+    `rule_id` int NOT NULL DEFAULT '0' COMMENT '返水规则ID',
+    `rule_option_id` int NOT NULL DEFAULT '0' COMMENT '返水档位ID',
+    `game_id` int NOT NULL DEFAULT '0' COMMENT '游戏ID',
+    `game_type` int NOT NULL DEFAULT '0' COMMENT '游戏类型',
+    `valid_bet_amount` decimal(14,2) NOT NULL DEFAULT '0.00' COMMENT '有效投注金额',
+    `percent` decimal(6,3) NOT NULL DEFAULT '0.000' COMMENT '返水比例',
+    `grade_percent` decimal(6,3) NOT NULL DEFAULT '0.000' COMMENT '等级额外返水比例',
+    `money` decimal(14,2) NOT NULL DEFAULT '0.00' COMMENT '返水金额',
+    `status` tinyint NOT NULL DEFAULT '0' COMMENT '状态。0=待审核；1=已发放；2=已驳回',
+    `trade_no` varchar(64) NOT NULL DEFAULT '' COMMENT '返水流水号',
+    `created_at` datetime DEFAULT NULL,
+    `updated_at` datetime DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_site_date_user_game` (`site_id`, `rebate_date`, `user_id`, `game_id`),
+    KEY `idx_site_date_status` (`site_id`, `rebate_date`, `status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='会员返水明细';
+
+-- 活动类型决定活动内容保存在哪张表
+ALTER TABLE `activity`
+    MODIFY `activity_type` int NOT NULL DEFAULT '0' COMMENT '活动类型。1=自定义活动 (activity_custom)；2=充值活动 (activity_recharge)';
+
+-- 会员注册：账号按站点唯一，密保答案只保存 bcrypt 哈希
+-- 执行前先确认没有重复账号: SELECT site_id, username, COUNT(*) FROM `user` GROUP BY site_id, username HAVING COUNT(*) > 1;
+ALTER TABLE `user`
+    MODIFY `safe_answer` varchar(255) NOT NULL DEFAULT '' COMMENT '密保答案 (bcrypt)',
+    ADD UNIQUE KEY `uk_site_username` (`site_id`, `username`);
+
+-- 会员注册：同一IP的注册按此表的行锁排队，保证同IP注册间隔限制在并发时有效
+CREATE TABLE `user_register_lock` (
+    `site_id` int NOT NULL DEFAULT '0' COMMENT '站点ID',
+    `register_ip` varchar(64) NOT NULL DEFAULT '' COMMENT '注册IP',
+    `created_at` datetime DEFAULT NULL,
+    PRIMARY KEY (`site_id`, `register_ip`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='会员注册IP锁';